@@ -0,0 +1,100 @@
+package rst
+
+import (
+	"bufio"
+	"net"
+	"net/http"
+	"time"
+)
+
+// AccessEntry describes a response served by a mux.
+type AccessEntry struct {
+	Request     *http.Request
+	Method      string
+	Pattern     string            // Pattern of the matched route, or an empty string.
+	Vars        RouteVars         // Variables extracted from the URL by the matched route.
+	Variants    map[string]string // Variants of the experiments assigned to the request, by name of experiment.
+	Status      int               // Status code of the response.
+	ContentType string            // Content-Type negotiated for the response.
+	Bytes       int64             // Bytes written in the body of the response, after compression.
+	NotModified bool              // True if a conditional request was answered with 304 Not Modified.
+	Latency     time.Duration     // Time taken to serve the response.
+}
+
+/*
+AccessLogger is called by a mux after each response it serves.
+
+	mux.AccessLogger = rst.AccessLogFunc(func(e *rst.AccessEntry) {
+		log.Printf("%s %s %d %s %dB %s", e.Method, e.Pattern, e.Status, e.ContentType, e.Bytes, e.Latency)
+	})
+
+Unlike a middleware wrapping the mux, access loggers receive the pattern and
+variables of the route matched for the request.
+*/
+type AccessLogger interface {
+	LogAccess(*AccessEntry)
+}
+
+// AccessLogFunc allows a function to be used as an AccessLogger.
+type AccessLogFunc func(*AccessEntry)
+
+// LogAccess implements the AccessLogger interface.
+func (f AccessLogFunc) LogAccess(e *AccessEntry) {
+	f(e)
+}
+
+// accessWriter records the status code and the size of a response.
+type accessWriter struct {
+	http.ResponseWriter
+	status int
+	n      int64
+}
+
+func (w *accessWriter) WriteHeader(code int) {
+	if w.status == 0 && code >= http.StatusOK {
+		w.status = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *accessWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.n += int64(n)
+	return n, err
+}
+
+// Flush implements the http.Flusher interface.
+func (w *accessWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack implements the http.Hijacker interface.
+func (w *accessWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return hijack(w.ResponseWriter)
+}
+
+// logAccess calls the access logger of s with the response written in w to r,
+// whose serving started at start.
+func (s *Mux) logAccess(w *accessWriter, r *http.Request, start time.Time) {
+	status := w.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	s.AccessLogger.LogAccess(&AccessEntry{
+		Request:     r,
+		Method:      r.Method,
+		Pattern:     RoutePattern(r),
+		Vars:        getVars(r),
+		Variants:    variantsOf(r),
+		Status:      status,
+		ContentType: w.Header().Get("Content-Type"),
+		Bytes:       w.n,
+		NotModified: status == http.StatusNotModified,
+		Latency:     time.Since(start),
+	})
+}
@@ -0,0 +1,59 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAccessLogger(t *testing.T) {
+	lastModified := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	mux := NewMux()
+	mux.Get("/people/{id}", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(&viewResource{"Francis"}, lastModified, "etag", time.Minute), nil
+	})
+
+	var entries []*AccessEntry
+	mux.AccessLogger = AccessLogFunc(func(e *AccessEntry) {
+		entries = append(entries, e)
+	})
+
+	var test = func(header http.Header, status int, notModified bool) *AccessEntry {
+		r, _ := http.NewRequest(Get, "http://example.com/people/1", nil)
+		r.Header = header
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+
+		e := entries[len(entries)-1]
+		if e.Method != Get || e.Pattern != "/people/{id}" || e.Vars.Get("id") != "1" {
+			t.Errorf("Got: %s %s %v Wanted: GET /people/{id} map[id:1]", e.Method, e.Pattern, e.Vars)
+		}
+		if e.Status != status || e.Status != w.Code {
+			t.Errorf("Status: Got: %d Wanted: %d", e.Status, status)
+		}
+		if e.NotModified != notModified {
+			t.Errorf("NotModified: Got: %t Wanted: %t", e.NotModified, notModified)
+		}
+		if e.Bytes != int64(w.Body.Len()) {
+			t.Errorf("Bytes: Got: %d Wanted: %d", e.Bytes, w.Body.Len())
+		}
+		if e.Latency <= 0 {
+			t.Errorf("Latency: Got: %s", e.Latency)
+		}
+		return e
+	}
+
+	e := test(http.Header{"Accept": {"application/json"}}, http.StatusOK, false)
+	if !strings.HasPrefix(e.ContentType, "application/json") {
+		t.Errorf("ContentType: Got: %s Wanted: application/json", e.ContentType)
+	}
+	test(http.Header{"If-None-Match": {"etag"}}, http.StatusNotModified, true)
+
+	r, _ := http.NewRequest(Get, "http://example.com/unknown", nil)
+	mux.ServeHTTP(httptest.NewRecorder(), r)
+	if e := entries[len(entries)-1]; e.Status != http.StatusNotFound || e.Pattern != "" {
+		t.Errorf("Got: %d %q Wanted: 404 \"\"", e.Status, e.Pattern)
+	}
+}
@@ -0,0 +1,197 @@
+/*
+Package auth authenticates the requests of an rst mux with the JSON Web Tokens
+of the OAuth 2.0 Bearer scheme.
+
+	v := &auth.Validator{
+		Keys:     auth.NewJWKS("https://accounts.example.com/.well-known/jwks.json"),
+		Issuer:   "https://accounts.example.com/",
+		Audience: "https://api.example.com",
+		Realm:    "api",
+	}
+	mux.SetAuthenticator(v)
+
+The claims of valid tokens are the principal of the requests, and can be read
+by the handlers with ClaimsOf.
+
+	func (ep *PeopleEndpoint) Get(vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+		claims := auth.ClaimsOf(r)
+		if !claims.HasScope("people:read") {
+			return nil, auth.InsufficientScope("api", "people:read")
+		}
+		// ...
+	}
+
+Failures are responded to with the errors and the WWW-Authenticate challenges
+defined by RFC 6750: requests without a token receive a 401 Unauthorized
+response, invalid tokens an invalid_token error, and tokens lacking a scope an
+insufficient_scope error.
+*/
+package auth
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mohamedattahri/rst"
+)
+
+// Validator is an rst.Authenticator validating the JSON Web Tokens sent in the
+// Authorization header of requests.
+type Validator struct {
+	// Keys verify the signatures of the tokens.
+	Keys KeySet
+
+	// Issuer is the expected iss claim. Ignored if empty.
+	Issuer string
+
+	// Audience is a value expected in the aud claim. Ignored if empty.
+	Audience string
+
+	// Realm is advertised in the WWW-Authenticate challenges.
+	Realm string
+
+	// Leeway is tolerated on the exp and nbf claims to account for clock
+	// skews.
+	Leeway time.Duration
+
+	// Optional allows the requests without a token, which are anonymous.
+	Optional bool
+}
+
+// Authenticate implements the rst.Authenticator interface. The principal of
+// valid tokens are their Claims.
+func (v *Validator) Authenticate(r *http.Request) (interface{}, error) {
+	authorization := r.Header.Get("Authorization")
+	if authorization == "" {
+		if v.Optional {
+			return nil, nil
+		}
+		return nil, rst.Unauthorized()
+	}
+
+	fields := strings.Fields(authorization)
+	if len(fields) != 2 || !strings.EqualFold(fields[0], "Bearer") {
+		return nil, InvalidRequest(v.Realm, "The Authorization header must contain a Bearer token.")
+	}
+	claims, err := v.Validate(fields[1])
+	if err != nil {
+		return nil, InvalidToken(v.Realm, err.Error())
+	}
+	return claims, nil
+}
+
+// Challenges implements the rst.Challenger interface.
+func (v *Validator) Challenges() []*rst.Challenge {
+	return []*rst.Challenge{{Scheme: "Bearer", Realm: v.Realm}}
+}
+
+// Validate verifies the signature and the claims of token, and returns its
+// claims.
+func (v *Validator) Validate(token string) (Claims, error) {
+	claims, err := parse(token, v.Keys)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if exp, ok := claims.Time("exp"); ok && now.After(exp.Add(v.Leeway)) {
+		return nil, errorString("The token has expired.")
+	}
+	if nbf, ok := claims.Time("nbf"); ok && now.Add(v.Leeway).Before(nbf) {
+		return nil, errorString("The token is not valid yet.")
+	}
+	if v.Issuer != "" && claims.Issuer() != v.Issuer {
+		return nil, errorString("The token was not issued by " + v.Issuer + ".")
+	}
+	if v.Audience != "" && !contains(claims.Audience(), v.Audience) {
+		return nil, errorString("The token is not intended for " + v.Audience + ".")
+	}
+	return claims, nil
+}
+
+type errorString string
+
+func (e errorString) Error() string {
+	return string(e)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ClaimsOf returns the claims of the token authenticating r, or nil.
+func ClaimsOf(r *http.Request) Claims {
+	claims, _ := rst.Principal(r).(Claims)
+	return claims
+}
+
+/*
+RequireScope returns a middleware responding with an insufficient_scope error
+to the requests whose token wasn't granted all of scopes.
+
+	mux.HandleEndpoint("/people", &PeopleEndpoint{}, auth.RequireScope("api", "people:read"))
+*/
+func RequireScope(realm string, scopes ...string) rst.Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims := ClaimsOf(r)
+			if claims == nil {
+				rst.ErrorHandler(bearerError(rst.Unauthorized(), realm, nil)).ServeHTTP(w, r)
+				return
+			}
+			for _, scope := range scopes {
+				if !claims.HasScope(scope) {
+					rst.ErrorHandler(InsufficientScope(realm, scopes...)).ServeHTTP(w, r)
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// InvalidRequest is returned when the request is missing a parameter, or
+// includes the token in more than one way.
+func InvalidRequest(realm, description string) *rst.Error {
+	return bearerError(rst.BadRequest("", description), realm, map[string]string{
+		"error":             "invalid_request",
+		"error_description": description,
+	})
+}
+
+// InvalidToken is returned when the token is expired, revoked, malformed, or
+// invalid for other reasons.
+func InvalidToken(realm, description string) *rst.Error {
+	e := rst.Unauthorized()
+	e.Description = description
+	return bearerError(e, realm, map[string]string{
+		"error":             "invalid_token",
+		"error_description": description,
+	})
+}
+
+// InsufficientScope is returned when the request requires higher privileges
+// than provided by the token. scopes are the ones required to access the
+// resource.
+func InsufficientScope(realm string, scopes ...string) *rst.Error {
+	e := rst.Forbidden()
+	e.Description = "The token doesn't grant the scopes required by the request."
+	return bearerError(e, realm, map[string]string{
+		"error":             "insufficient_scope",
+		"error_description": e.Description,
+		"scope":             strings.Join(scopes, " "),
+	})
+}
+
+// bearerError sets the WWW-Authenticate challenge of e.
+func bearerError(e *rst.Error, realm string, params map[string]string) *rst.Error {
+	c := &rst.Challenge{Scheme: "Bearer", Realm: realm, Params: params}
+	e.Header.Set("WWW-Authenticate", c.String())
+	return e
+}
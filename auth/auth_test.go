@@ -0,0 +1,182 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mohamedattahri/rst"
+)
+
+var (
+	rsaKey, _ = rsa.GenerateKey(rand.Reader, 2048)
+	ecKey, _  = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	hmacKey   = []byte("secret")
+)
+
+// sign returns a token of claims signed with key.
+func sign(alg, kid string, key interface{}, claims map[string]interface{}) string {
+	h, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	c, _ := json.Marshal(claims)
+	payload := base64.RawURLEncoding.EncodeToString(h) + "." + base64.RawURLEncoding.EncodeToString(c)
+
+	hash := hashes[alg]
+	digest := hash.New()
+	digest.Write([]byte(payload))
+
+	var signature []byte
+	switch k := key.(type) {
+	case []byte:
+		mac := hmac.New(hash.New, k)
+		mac.Write([]byte(payload))
+		signature = mac.Sum(nil)
+	case *rsa.PrivateKey:
+		if alg[:2] == "PS" {
+			signature, _ = rsa.SignPSS(rand.Reader, k, hash, digest.Sum(nil), nil)
+		} else {
+			signature, _ = rsa.SignPKCS1v15(rand.Reader, k, hash, digest.Sum(nil))
+		}
+	case *ecdsa.PrivateKey:
+		r, s, _ := ecdsa.Sign(rand.Reader, k, digest.Sum(nil))
+		size := (k.Curve.Params().BitSize + 7) / 8
+		signature = make([]byte, 2*size)
+		rb, sb := r.Bytes(), s.Bytes()
+		copy(signature[size-len(rb):size], rb)
+		copy(signature[2*size-len(sb):], sb)
+	}
+	return payload + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func claims(scope string) map[string]interface{} {
+	return map[string]interface{}{
+		"sub":   "francis",
+		"iss":   "https://accounts.example.com/",
+		"aud":   []string{"https://api.example.com"},
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"scope": scope,
+	}
+}
+
+func validator() *Validator {
+	return &Validator{
+		Keys: StaticKeys{
+			"rsa":  &rsaKey.PublicKey,
+			"ec":   &ecKey.PublicKey,
+			"hmac": hmacKey,
+		},
+		Issuer:   "https://accounts.example.com/",
+		Audience: "https://api.example.com",
+		Realm:    "api",
+	}
+}
+
+func TestValidate(t *testing.T) {
+	v := validator()
+
+	var test = func(name, token string, valid bool) {
+		c, err := v.Validate(token)
+		if valid && err != nil {
+			t.Errorf("%s: %s", name, err)
+		}
+		if !valid && err == nil {
+			t.Errorf("%s: token should be invalid", name)
+		}
+		if valid && c.Subject() != "francis" {
+			t.Errorf("%s: sub: Got: %q", name, c.Subject())
+		}
+	}
+
+	test("RS256", sign("RS256", "rsa", rsaKey, claims("")), true)
+	test("PS384", sign("PS384", "rsa", rsaKey, claims("")), true)
+	test("ES256", sign("ES256", "ec", ecKey, claims("")), true)
+	test("HS512", sign("HS512", "hmac", hmacKey, claims("")), true)
+
+	// Algorithms must match the type of the key.
+	test("HS256 with RSA key", sign("HS256", "rsa", hmacKey, claims("")), false)
+	test("RS256 with EC key", sign("RS256", "ec", rsaKey, claims("")), false)
+	test("ES384 with P-256 key", sign("ES384", "ec", ecKey, claims("")), false)
+	none := strings.Split(sign("RS256", "rsa", rsaKey, claims("")), ".")
+	none[0] = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","kid":"rsa"}`))
+	test("none", none[0]+"."+none[1]+".", false)
+	test("unknown key", sign("RS256", "other", rsaKey, claims("")), false)
+	test("tampered", sign("RS256", "rsa", rsaKey, claims(""))+"A", false)
+	test("malformed", "not.a.token", false)
+
+	expired := claims("")
+	expired["exp"] = time.Now().Add(-time.Minute).Unix()
+	test("expired", sign("RS256", "rsa", rsaKey, expired), false)
+	v.Leeway = 2 * time.Minute
+	test("expired within leeway", sign("RS256", "rsa", rsaKey, expired), true)
+	v.Leeway = 0
+
+	early := claims("")
+	early["nbf"] = time.Now().Add(time.Hour).Unix()
+	test("not before", sign("RS256", "rsa", rsaKey, early), false)
+
+	issuer := claims("")
+	issuer["iss"] = "https://evil.example.com/"
+	test("issuer", sign("RS256", "rsa", rsaKey, issuer), false)
+
+	audience := claims("")
+	audience["aud"] = "https://other.example.com"
+	test("audience", sign("RS256", "rsa", rsaKey, audience), false)
+	audience["aud"] = "https://api.example.com"
+	test("audience string", sign("RS256", "rsa", rsaKey, audience), true)
+}
+
+func TestClaims(t *testing.T) {
+	c := Claims{"scope": "people:read people:write"}
+	if !c.HasScope("people:write") || c.HasScope("people") {
+		t.Errorf("scope: Got: %v", c.Scopes())
+	}
+	c = Claims{"scp": []interface{}{"people:read"}}
+	if !c.HasScope("people:read") {
+		t.Errorf("scp: Got: %v", c.Scopes())
+	}
+}
+
+type peopleEndpoint struct{}
+
+func (ep *peopleEndpoint) Get(vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+	if ClaimsOf(r).Subject() != "francis" {
+		return nil, rst.Forbidden()
+	}
+	return nil, nil
+}
+
+func TestAuthenticate(t *testing.T) {
+	mux := rst.NewMux()
+	mux.SetAuthenticator(validator())
+	mux.HandleEndpoint("/people", &peopleEndpoint{}, RequireScope("api", "people:read"))
+
+	var test = func(name, authorization string, status int, challenge string) {
+		r, _ := http.NewRequest(rst.Get, "http://example.com/people", nil)
+		r.Header.Set("Accept", "application/json")
+		if authorization != "" {
+			r.Header.Set("Authorization", authorization)
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Errorf("%s: Got: %d Wanted: %d", name, w.Code, status)
+		}
+		if got := w.Header().Get("WWW-Authenticate"); !strings.HasPrefix(got, challenge) {
+			t.Errorf("%s: WWW-Authenticate: Got: %q Wanted: %q", name, got, challenge)
+		}
+	}
+
+	test("valid", "Bearer "+sign("RS256", "rsa", rsaKey, claims("people:read")), http.StatusNoContent, "")
+	test("missing", "", http.StatusUnauthorized, `Bearer realm="api"`)
+	test("basic", "Basic Zm9vOmJhcg==", http.StatusBadRequest, `Bearer realm="api", error="invalid_request"`)
+	test("invalid", "Bearer "+sign("RS256", "rsa", ecKey, claims("people:read")), http.StatusUnauthorized, `Bearer realm="api", error="invalid_token"`)
+	test("scope", "Bearer "+sign("RS256", "rsa", rsaKey, claims("people:write")), http.StatusForbidden, `Bearer realm="api", error="insufficient_scope", error_description="The token doesn't grant the scopes required by the request.", scope="people:read"`)
+}
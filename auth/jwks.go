@@ -0,0 +1,254 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// KeySet returns the keys verifying the signatures of tokens.
+//
+// Keys are a []byte for the HS algorithms, an *rsa.PublicKey for the RS and
+// PS algorithms, and an *ecdsa.PublicKey for the ES algorithms.
+type KeySet interface {
+	Key(kid, alg string) (interface{}, error)
+}
+
+// StaticKeys is a KeySet of keys indexed by ID. The key of ID "" verifies the
+// tokens without a kid header.
+type StaticKeys map[string]interface{}
+
+// Key implements the KeySet interface.
+func (keys StaticKeys) Key(kid, alg string) (interface{}, error) {
+	key, found := keys[kid]
+	if !found {
+		return nil, errUnknownKey
+	}
+	return key, nil
+}
+
+var errUnknownKey = errors.New("The key of the token is unknown.")
+
+// Defaults of a JWKS.
+const (
+	DefaultRefreshInterval = time.Hour
+	DefaultMinRefreshWait  = time.Minute
+	DefaultFetchTimeout    = 10 * time.Second
+)
+
+var defaultJWKSClient = &http.Client{Timeout: DefaultFetchTimeout}
+
+/*
+JWKS is a KeySet fetched from a JSON Web Key Set URL, such as the jwks_uri of
+an OpenID Connect provider.
+
+	keys := auth.NewJWKS("https://accounts.example.com/.well-known/jwks.json")
+
+The set is fetched again after RefreshInterval, or when a token is signed with
+a key it doesn't contain, which happens when the provider rotates its keys.
+Tokens keep being verified with the current keys while the set is fetched, and
+concurrent requests for a new set share a single fetch.
+*/
+type JWKS struct {
+	// URL of the key set.
+	URL string
+
+	// HTTPClient fetches the key set. Defaults to a client giving up after
+	// DefaultFetchTimeout.
+	HTTPClient *http.Client
+
+	// RefreshInterval is the duration after which the key set is fetched
+	// again.
+	RefreshInterval time.Duration
+
+	// MinRefreshWait is the shortest duration between two fetches caused by
+	// unknown keys.
+	MinRefreshWait time.Duration
+
+	mu       sync.RWMutex
+	keys     map[string]*jwk
+	fetched  time.Time
+	fetching *jwksFetch // In-flight fetch, if any.
+}
+
+// jwksFetch is a fetch of a key set shared by the requests waiting for it.
+type jwksFetch struct {
+	done chan struct{}
+	err  error
+}
+
+// NewJWKS returns the key set at url.
+func NewJWKS(url string) *JWKS {
+	return &JWKS{
+		URL:             url,
+		RefreshInterval: DefaultRefreshInterval,
+		MinRefreshWait:  DefaultMinRefreshWait,
+	}
+}
+
+// Key implements the KeySet interface.
+func (s *JWKS) Key(kid, alg string) (interface{}, error) {
+	s.mu.RLock()
+	k, found := s.keys[kid]
+	fetched := s.fetched
+	stale := time.Since(fetched) > s.RefreshInterval
+	refresh := s.keys == nil || stale || (!found && time.Since(fetched) > s.MinRefreshWait)
+	s.mu.RUnlock()
+
+	if refresh {
+		err := s.refresh(fetched)
+		s.mu.RLock()
+		k, found = s.keys[kid]
+		empty := s.keys == nil
+		s.mu.RUnlock()
+		if err != nil && empty {
+			return nil, err
+		}
+	}
+	if !found {
+		return nil, errUnknownKey
+	}
+	if k.Alg != "" && k.Alg != alg {
+		return nil, fmt.Errorf("The key of the token can't verify %s signatures.", alg)
+	}
+	return k.key, nil
+}
+
+// refresh replaces the keys of s with the ones at its URL, or waits for the
+// fetch in flight to do so. It doesn't fetch them again if they were fetched
+// after the time given by the caller.
+func (s *JWKS) refresh(fetched time.Time) error {
+	s.mu.Lock()
+	if f := s.fetching; f != nil {
+		s.mu.Unlock()
+		<-f.done
+		return f.err
+	}
+	if !s.fetched.Equal(fetched) {
+		s.mu.Unlock()
+		return nil
+	}
+	f := &jwksFetch{done: make(chan struct{})}
+	s.fetching = f
+	s.fetched = time.Now()
+	s.mu.Unlock()
+
+	keys, err := s.fetch()
+	s.mu.Lock()
+	if err == nil {
+		s.keys = keys
+	}
+	s.fetching = nil
+	s.mu.Unlock()
+
+	f.err = err
+	close(f.done)
+	return err
+}
+
+// fetch returns the keys at the URL of s.
+func (s *JWKS) fetch() (map[string]*jwk, error) {
+	client := s.HTTPClient
+	if client == nil {
+		client = defaultJWKSClient
+	}
+	resp, err := client.Get(s.URL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("auth: fetching %s returned %s", s.URL, resp.Status)
+	}
+
+	var set struct {
+		Keys []*jwk `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]*jwk, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		// Keys of unsupported types are ignored.
+		if k.key, err = k.publicKey(); err == nil {
+			keys[k.Kid] = k
+		}
+	}
+	return keys, nil
+}
+
+// jwk is a JSON Web Key, as defined by RFC 7517.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	K   string `json:"k"`
+	key interface{}
+}
+
+var curves = map[string]elliptic.Curve{
+	"P-256": elliptic.P256(),
+	"P-384": elliptic.P384(),
+	"P-521": elliptic.P521(),
+}
+
+// publicKey returns the key described by k.
+func (k *jwk) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		curve, supported := curves[k.Crv]
+		if !supported {
+			return nil, fmt.Errorf("auth: unsupported curve %q", k.Crv)
+		}
+		x, err := decodeInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "oct":
+		return base64.RawURLEncoding.DecodeString(k.K)
+	}
+	return nil, fmt.Errorf("auth: unsupported key type %q", k.Kty)
+}
+
+func decodeInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, errors.New("auth: empty key parameter")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
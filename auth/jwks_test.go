@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func encodeInt(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+func TestJWKS(t *testing.T) {
+	keys := []map[string]string{
+		{"kty": "EC", "kid": "ec", "crv": "P-256", "x": encodeInt(ecKey.X), "y": encodeInt(ecKey.Y)},
+		{"kty": "OKP", "kid": "unsupported"},
+	}
+	fetches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches++
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": keys})
+	}))
+	defer server.Close()
+
+	v := validator()
+	jwks := NewJWKS(server.URL)
+	v.Keys = jwks
+
+	if _, err := v.Validate(sign("ES256", "ec", ecKey, claims(""))); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.Validate(sign("ES256", "ec", ecKey, claims(""))); err != nil || fetches != 1 {
+		t.Fatalf("cached: Got: %d fetches, %v", fetches, err)
+	}
+
+	// Rotated keys are fetched once the minimum wait has elapsed.
+	keys = append(keys, map[string]string{
+		"kty": "RSA", "kid": "rsa", "alg": "RS256", "use": "sig",
+		"n": encodeInt(rsaKey.N), "e": encodeInt(big.NewInt(int64(rsaKey.E))),
+	})
+	if _, err := v.Validate(sign("RS256", "rsa", rsaKey, claims(""))); err == nil {
+		t.Error("unknown keys shouldn't be fetched before the minimum wait")
+	}
+	jwks.MinRefreshWait = 0
+	if _, err := v.Validate(sign("RS256", "rsa", rsaKey, claims(""))); err != nil || fetches != 2 {
+		t.Fatalf("rotated: Got: %d fetches, %v", fetches, err)
+	}
+
+	// The alg of a key restricts the algorithms it verifies.
+	if _, err := v.Validate(sign("PS256", "rsa", rsaKey, claims(""))); err == nil {
+		t.Error("RS256 key shouldn't verify PS256 tokens")
+	}
+}
+
+func TestJWKSConcurrentFetch(t *testing.T) {
+	keys := []map[string]string{
+		{"kty": "EC", "kid": "ec", "crv": "P-256", "x": encodeInt(ecKey.X), "y": encodeInt(ecKey.Y)},
+	}
+	var fetches int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&fetches, 1)
+		<-release
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": keys})
+	}))
+	defer server.Close()
+
+	v := validator()
+	v.Keys = NewJWKS(server.URL)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < cap(errs); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := v.Validate(sign("ES256", "ec", ecKey, claims("")))
+			errs <- err
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := atomic.LoadInt32(&fetches); n != 1 {
+		t.Errorf("concurrent requests should share a fetch, Got: %d fetches", n)
+	}
+}
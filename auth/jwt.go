@@ -0,0 +1,211 @@
+package auth
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	// Hash functions of the supported algorithms.
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+)
+
+// Claims are the claims of a JSON Web Token.
+type Claims map[string]interface{}
+
+// String returns the string claim with the given name, or an empty string.
+func (c Claims) String(name string) string {
+	s, _ := c[name].(string)
+	return s
+}
+
+// Subject returns the sub claim.
+func (c Claims) Subject() string {
+	return c.String("sub")
+}
+
+// Issuer returns the iss claim.
+func (c Claims) Issuer() string {
+	return c.String("iss")
+}
+
+// Audience returns the aud claim, which can be a string or an array.
+func (c Claims) Audience() []string {
+	return c.strings("aud")
+}
+
+// Scopes returns the scopes granted to the token, read from the
+// space-separated scope claim, or from the scp array.
+func (c Claims) Scopes() []string {
+	if scope := c.String("scope"); scope != "" {
+		return strings.Fields(scope)
+	}
+	return c.strings("scp")
+}
+
+// HasScope returns true if scope was granted to the token.
+func (c Claims) HasScope(scope string) bool {
+	for _, s := range c.Scopes() {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Time returns the NumericDate claim with the given name, and false if it's
+// missing.
+func (c Claims) Time(name string) (time.Time, bool) {
+	n, ok := c[name].(json.Number)
+	if !ok {
+		return time.Time{}, false
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(0, int64(f*float64(time.Second))), true
+}
+
+func (c Claims) strings(name string) []string {
+	switch v := c[name].(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var values []string
+		for _, value := range v {
+			if s, ok := value.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+// header is the JOSE header of a token.
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// hashes of the supported algorithms.
+var hashes = map[string]crypto.Hash{
+	"HS256": crypto.SHA256, "HS384": crypto.SHA384, "HS512": crypto.SHA512,
+	"RS256": crypto.SHA256, "RS384": crypto.SHA384, "RS512": crypto.SHA512,
+	"PS256": crypto.SHA256, "PS384": crypto.SHA384, "PS512": crypto.SHA512,
+	"ES256": crypto.SHA256, "ES384": crypto.SHA384, "ES512": crypto.SHA512,
+}
+
+// algorithmCurves are the names of the curves of the ES algorithms, which are
+// each defined for a single curve.
+var algorithmCurves = map[string]string{"ES256": "P-256", "ES384": "P-384", "ES512": "P-521"}
+
+var errMalformed = errors.New("The token is malformed.")
+
+// parse verifies the signature of token with the keys of keys, and returns
+// its claims.
+func parse(token string, keys KeySet) (Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errMalformed
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return nil, errMalformed
+	}
+	hash, supported := hashes[h.Alg]
+	if !supported {
+		return nil, fmt.Errorf("The %q signing algorithm is not supported.", h.Alg)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errMalformed
+	}
+
+	key, err := keys.Key(h.Kid, h.Alg)
+	if err != nil {
+		return nil, err
+	}
+	if err := verify(h.Alg, hash, key, []byte(parts[0]+"."+parts[1]), signature); err != nil {
+		return nil, err
+	}
+
+	var claims Claims
+	if err := decodeSegment(parts[1], &claims); err != nil || claims == nil {
+		return nil, errMalformed
+	}
+	return claims, nil
+}
+
+// decodeSegment decodes a base64url encoded JSON segment of a token in v.
+func decodeSegment(segment string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+var errSignature = errors.New("The signature of the token is invalid.")
+
+// verify verifies the signature of payload with key, which must be of the
+// type of alg, and of its curve for the ES algorithms.
+func verify(alg string, hash crypto.Hash, key interface{}, payload, signature []byte) error {
+	h := hash.New()
+	h.Write(payload)
+	digest := h.Sum(nil)
+
+	switch k := key.(type) {
+	case []byte:
+		if alg[:2] != "HS" {
+			break
+		}
+		mac := hmac.New(hash.New, k)
+		mac.Write(payload)
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return errSignature
+		}
+		return nil
+	case *rsa.PublicKey:
+		switch alg[:2] {
+		case "RS":
+			if rsa.VerifyPKCS1v15(k, hash, digest, signature) != nil {
+				return errSignature
+			}
+			return nil
+		case "PS":
+			if rsa.VerifyPSS(k, hash, digest, signature, nil) != nil {
+				return errSignature
+			}
+			return nil
+		}
+	case *ecdsa.PublicKey:
+		size := (k.Curve.Params().BitSize + 7) / 8
+		if algorithmCurves[alg] != k.Curve.Params().Name {
+			break
+		}
+		if len(signature) != 2*size {
+			return errSignature
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(k, digest, r, s) {
+			return errSignature
+		}
+		return nil
+	}
+	return fmt.Errorf("The key of the token can't verify %s signatures.", alg)
+}
@@ -0,0 +1,195 @@
+package rst
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/context"
+)
+
+/*
+Authenticator authenticates the requests served by a mux, before they are
+dispatched to the handler of their method.
+
+	mux.SetAuthenticator(rst.AuthenticatorFunc(func(r *http.Request) (interface{}, error) {
+		user, err := sessions.Lookup(r.Header.Get("X-Session"))
+		if err != nil {
+			return nil, rst.Unauthorized()
+		}
+		return user, nil
+	}))
+
+The principal it returns is available to the handlers with Principal, and can
+be nil for anonymous requests. Errors are written in response to the request,
+with status code 401 Unauthorized unless they are an *Error, and the challenges
+of authenticators implementing Challenger are sent in the WWW-Authenticate
+header of 401 Unauthorized responses.
+
+Endpoints implementing Authenticator authenticate their own requests instead
+of the authenticator of the mux. OPTIONS requests, which include CORS
+preflights sent without credentials, are not authenticated.
+*/
+type Authenticator interface {
+	Authenticate(r *http.Request) (principal interface{}, err error)
+}
+
+// AuthenticatorFunc allows a function to be used as an Authenticator.
+type AuthenticatorFunc func(r *http.Request) (interface{}, error)
+
+// Authenticate implements the Authenticator interface.
+func (f AuthenticatorFunc) Authenticate(r *http.Request) (interface{}, error) {
+	return f(r)
+}
+
+// Challenger is implemented by authenticators to advertise the authentication
+// schemes they support in the WWW-Authenticate header of 401 Unauthorized
+// responses.
+type Challenger interface {
+	Challenges() []*Challenge
+}
+
+// Challenge is an authentication challenge of the WWW-Authenticate header.
+type Challenge struct {
+	Scheme string            // Authentication scheme, such as Basic or Bearer.
+	Realm  string            // Optional. Protection space of the challenge.
+	Params map[string]string // Optional. Other parameters of the challenge.
+}
+
+// String returns the challenge as written in the WWW-Authenticate header, such
+// as Basic realm="api".
+func (c *Challenge) String() string {
+	var params []string
+	if c.Realm != "" {
+		params = append(params, "realm="+strconv.Quote(c.Realm))
+	}
+	var keys []string
+	for key := range c.Params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		params = append(params, key+"="+strconv.Quote(c.Params[key]))
+	}
+	if len(params) == 0 {
+		return c.Scheme
+	}
+	return c.Scheme + " " + strings.Join(params, ", ")
+}
+
+/*
+BasicAuthenticator returns an authenticator of the credentials of the HTTP
+Basic scheme. validate returns the principal identified by the credentials, or
+an error if they are invalid. Requests without credentials are anonymous.
+
+	mux.SetAuthenticator(rst.BasicAuthenticator("api", func(username, password string) (interface{}, error) {
+		user := database.FindUser(username)
+		if user == nil || !user.CheckPassword(password) {
+			return nil, rst.Unauthorized()
+		}
+		return user, nil
+	}))
+*/
+func BasicAuthenticator(realm string, validate func(username, password string) (interface{}, error)) Authenticator {
+	return &basicAuthenticator{realm, validate}
+}
+
+type basicAuthenticator struct {
+	realm    string
+	validate func(username, password string) (interface{}, error)
+}
+
+func (a *basicAuthenticator) Authenticate(r *http.Request) (interface{}, error) {
+	if r.Header.Get("Authorization") == "" {
+		return nil, nil
+	}
+	username, password, ok := r.BasicAuth()
+	if !ok {
+		return nil, Unauthorized()
+	}
+	return a.validate(username, password)
+}
+
+func (a *basicAuthenticator) Challenges() []*Challenge {
+	return []*Challenge{{Scheme: "Basic", Realm: a.realm, Params: map[string]string{"charset": "UTF-8"}}}
+}
+
+// SetAuthenticator sets the authenticator of the requests served by the mux.
+// A nil value disables authentication, which is the default.
+func (s *Mux) SetAuthenticator(a Authenticator) {
+	s.authenticator = a
+}
+
+// authenticatorOf returns the authenticator of the requests served by handler,
+// or nil.
+func (s *Mux) authenticatorOf(handler http.Handler) Authenticator {
+	if h, valid := handler.(*endpointHandler); valid {
+		if a, implemented := policyEndpoint(h.endpoint).(Authenticator); implemented {
+			return a
+		}
+	}
+	return s.authenticator
+}
+
+// authenticate stores the principal of r returned by a. It returns the error
+// to write in response to r if the authentication failed.
+func authenticate(a Authenticator, r *http.Request) error {
+	principal, err := a.Authenticate(r)
+	if err == nil {
+		if principal != nil {
+			context.Set(r, principalKey, principal)
+		}
+		return nil
+	}
+
+	e, ok := err.(*Error)
+	if !ok {
+		e = Unauthorized()
+	}
+	if challenger, implemented := a.(Challenger); implemented && e.Code == http.StatusUnauthorized && e.Header.Get("WWW-Authenticate") == "" {
+		for _, c := range challenger.Challenges() {
+			e.Header.Add("WWW-Authenticate", c.String())
+		}
+	}
+	return e
+}
+
+const principalKey = "__rst__principal"
+
+// Principal returns the principal returned by the authenticator of the mux for
+// r, or nil.
+func Principal(r *http.Request) interface{} {
+	return context.Get(r, principalKey)
+}
+
+/*
+Authorizer is implemented by endpoints to authorize the requests of all their
+methods in one place, before the request is dispatched to Get, Post, Put,
+Patch, Delete or Handle.
+
+	func (ep *DocumentEndpoint) Authorize(method string, vars rst.RouteVars, r *http.Request) error {
+		user, _ := rst.Principal(r).(*User)
+		if user == nil {
+			return rst.Unauthorized()
+		}
+		if method != rst.Get && method != rst.Head && !user.CanEdit(vars.Get("id")) {
+			return rst.Forbidden()
+		}
+		return nil
+	}
+
+The error returned by Authorize is written in response to the request. OPTIONS
+requests are not authorized.
+*/
+type Authorizer interface {
+	Authorize(method string, vars RouteVars, r *http.Request) error
+}
+
+// authorize returns the error of the Authorizer of endpoint for r, if any.
+func authorize(endpoint Endpoint, r *http.Request) error {
+	if a, implemented := endpoint.(Authorizer); implemented && r.Method != Options {
+		return a.Authorize(r.Method, getVars(r), r)
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package rst
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type publicEndpoint struct{}
+
+func (e *publicEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	return nil, nil
+}
+
+func (e *publicEndpoint) Authenticate(r *http.Request) (interface{}, error) {
+	return nil, nil
+}
+
+func TestAuthenticator(t *testing.T) {
+	mux := NewMux()
+	mux.SetAuthenticator(BasicAuthenticator("api", func(username, password string) (interface{}, error) {
+		switch {
+		case username == "francis" && password == "secret":
+			return username, nil
+		case username == "banned":
+			return nil, Forbidden()
+		}
+		return nil, errors.New("invalid credentials")
+	}))
+
+	var principal interface{}
+	mux.Get("/me", func(vars RouteVars, r *http.Request) (Resource, error) {
+		if principal = Principal(r); principal == nil {
+			return nil, Unauthorized()
+		}
+		return nil, nil
+	})
+	mux.HandleEndpoint("/public", &publicEndpoint{})
+
+	var test = func(method, path, username, password string, status int, challenge string) {
+		principal = nil
+		r, _ := http.NewRequest(method, "http://example.com"+path, nil)
+		r.Header.Set("Accept", "application/json")
+		if username != "" {
+			r.SetBasicAuth(username, password)
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Errorf("%s %s %s: Got: %d Wanted: %d", method, path, username, w.Code, status)
+		}
+		if got := w.Header().Get("WWW-Authenticate"); got != challenge {
+			t.Errorf("%s %s %s: WWW-Authenticate: Got: %q Wanted: %q", method, path, username, got, challenge)
+		}
+	}
+
+	challenge := `Basic realm="api", charset="UTF-8"`
+	test(Get, "/me", "francis", "secret", http.StatusNoContent, "")
+	if principal != "francis" {
+		t.Errorf("Got: %v Wanted: francis", principal)
+	}
+	test(Get, "/me", "francis", "wrong", http.StatusUnauthorized, challenge)
+	test(Get, "/me", "banned", "", http.StatusForbidden, "")
+	// Anonymous requests are rejected by the handler, without a challenge.
+	test(Get, "/me", "", "", http.StatusUnauthorized, "")
+	test(Options, "/me", "francis", "wrong", http.StatusNoContent, "")
+	test(Get, "/public", "francis", "wrong", http.StatusNoContent, "")
+}
+
+func TestChallenge(t *testing.T) {
+	var test = func(c *Challenge, expected string) {
+		if got := c.String(); got != expected {
+			t.Errorf("Got: %s Wanted: %s", got, expected)
+		}
+	}
+	test(&Challenge{Scheme: "Negotiate"}, "Negotiate")
+	test(&Challenge{Scheme: "Basic", Realm: "api"}, `Basic realm="api"`)
+	test(&Challenge{Scheme: "Bearer", Realm: "api", Params: map[string]string{"scope": "read", "error": "invalid_token"}}, `Bearer realm="api", error="invalid_token", scope="read"`)
+}
+
+type guardedEndpoint struct{}
+
+func (e *guardedEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	return nil, nil
+}
+
+func (e *guardedEndpoint) Delete(vars RouteVars, r *http.Request) error {
+	return nil
+}
+
+func (e *guardedEndpoint) Authorize(method string, vars RouteVars, r *http.Request) error {
+	if method == Delete && vars.Get("id") != r.Header.Get("X-Owner") {
+		return Forbidden()
+	}
+	return nil
+}
+
+func TestAuthorizer(t *testing.T) {
+	mux := NewMux()
+	mux.HandleEndpoint("/documents/{id}", &guardedEndpoint{})
+	mux.HandleBreaker("/breaker/{id}", &guardedEndpoint{}, &Breaker{})
+
+	var test = func(method, path, owner string, status int) {
+		r, _ := http.NewRequest(method, "http://example.com"+path, nil)
+		r.Header.Set("Accept", "application/json")
+		r.Header.Set("X-Owner", owner)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Errorf("%s %s %s: Got: %d Wanted: %d", method, path, owner, w.Code, status)
+		}
+	}
+
+	for _, prefix := range []string{"/documents", "/breaker"} {
+		test(Get, prefix+"/1", "", http.StatusNoContent)
+		test(Delete, prefix+"/1", "2", http.StatusForbidden)
+		test(Delete, prefix+"/1", "1", http.StatusNoContent)
+		test(Post, prefix+"/1", "", http.StatusMethodNotAllowed)
+		test(Options, prefix+"/1", "", http.StatusNoContent)
+	}
+}
+
+// protectedEndpoint requires a token, and wraps its responses in an envelope.
+type protectedEndpoint struct{}
+
+func (e *protectedEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	return NewEnvelope(map[string]string{"id": vars.Get("id")}, testTimeReference, "protected", 0), nil
+}
+
+func (e *protectedEndpoint) Authenticate(r *http.Request) (interface{}, error) {
+	if r.Header.Get("X-Token") != "secret" {
+		return nil, Unauthorized()
+	}
+	return "francis", nil
+}
+
+func (e *protectedEndpoint) EnvelopeJSON() bool {
+	return true
+}
+
+func TestWrappedEndpointPolicies(t *testing.T) {
+	mux := NewMux()
+	mux.HandleEndpoint("/endpoint/{id}", &protectedEndpoint{})
+	mux.HandleBreaker("/breaker/{id}", &protectedEndpoint{}, &Breaker{})
+	mux.HandleCanary("/canary/{id}", &protectedEndpoint{}, &protectedEndpoint{}, &Canary{Weight: 0.5})
+	mux.HandleChangelog("/changelog/{id}", &protectedEndpoint{}, &Changelog{Store: NewHistoryStore()})
+
+	var test = func(path, token string, status int) {
+		r, _ := http.NewRequest(Get, "http://example.com"+path, nil)
+		r.Header.Set("Accept", "application/json")
+		if token != "" {
+			r.Header.Set("X-Token", token)
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Errorf("%s %q: Got: %d Wanted: %d", path, token, w.Code, status)
+		}
+		if status == http.StatusOK && !strings.HasPrefix(w.Body.String(), `{"data":`) {
+			t.Errorf("%s: the response isn't wrapped in an envelope: %s", path, w.Body.String())
+		}
+	}
+
+	for _, prefix := range []string{"/endpoint", "/breaker", "/canary", "/changelog"} {
+		test(prefix+"/1", "", http.StatusUnauthorized)
+		test(prefix+"/1", "wrong", http.StatusUnauthorized)
+		test(prefix+"/1", "secret", http.StatusOK)
+	}
+}
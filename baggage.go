@@ -0,0 +1,227 @@
+package rst
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Limits of W3C Baggage and Trace Context headers.
+const (
+	maxBaggageMembers    = 180
+	maxBaggageSize       = 8192
+	maxTraceStateMembers = 32
+)
+
+var (
+	traceParentRe   = regexp.MustCompile(`^[0-9a-f]{2}-[0-9a-f]{32}-[0-9a-f]{16}-[0-9a-f]{2}$`)
+	traceStateKeyRe = regexp.MustCompile(`^([a-z0-9][a-z0-9_\-*/]{0,240}@[a-z][a-z0-9_\-*/]{0,13}|[a-z][a-z0-9_\-*/]{0,255})$`)
+	traceStateValRe = regexp.MustCompile(`^[\x20-\x2b\x2d-\x3c\x3e-\x7e]{0,255}[\x21-\x2b\x2d-\x3c\x3e-\x7e]$`)
+	baggageKeyRe    = regexp.MustCompile("^[!#$%&'*+\\-.^_`|~0-9A-Za-z]+$")
+)
+
+// BaggageMember is an entry of a W3C Baggage header.
+type BaggageMember struct {
+	Key        string
+	Value      string   // Decoded value.
+	Properties []string // Properties of the member, such as "ttl=30".
+}
+
+// Baggage contains the members of a W3C Baggage header, propagating
+// user-defined properties across services.
+type Baggage []BaggageMember
+
+// Get returns the value of the member with the given key, or an empty string.
+func (b Baggage) Get(key string) string {
+	for _, m := range b {
+		if m.Key == key {
+			return m.Value
+		}
+	}
+	return ""
+}
+
+// String returns the value of the Baggage header representing b.
+func (b Baggage) String() string {
+	members := make([]string, 0, len(b))
+	for _, m := range b {
+		member := m.Key + "=" + strings.Replace(url.QueryEscape(m.Value), "+", "%20", -1)
+		for _, property := range m.Properties {
+			member += ";" + property
+		}
+		members = append(members, member)
+	}
+	return strings.Join(members, ",")
+}
+
+// ParseBaggage parses the value of a W3C Baggage header. Invalid members are
+// ignored, which means an empty Baggage is returned if none is valid.
+func ParseBaggage(raw string) Baggage {
+	if len(raw) > maxBaggageSize {
+		return nil
+	}
+	var b Baggage
+	for _, member := range strings.Split(raw, ",") {
+		parts := strings.Split(member, ";")
+		i := strings.Index(parts[0], "=")
+		if i < 0 {
+			continue
+		}
+		key := strings.TrimSpace(parts[0][:i])
+		value, err := url.QueryUnescape(strings.Replace(strings.TrimSpace(parts[0][i+1:]), "+", "%2B", -1))
+		if err != nil || !baggageKeyRe.MatchString(key) {
+			continue
+		}
+		m := BaggageMember{Key: key, Value: value}
+		for _, property := range parts[1:] {
+			if property = strings.TrimSpace(property); property != "" {
+				m.Properties = append(m.Properties, property)
+			}
+		}
+		if b = append(b, m); len(b) == maxBaggageMembers {
+			break
+		}
+	}
+	return b
+}
+
+// TraceStateMember is an entry of a W3C tracestate header.
+type TraceStateMember struct {
+	Key   string
+	Value string
+}
+
+// TraceState contains the members of a W3C tracestate header, carrying
+// vendor-specific trace identification.
+type TraceState []TraceStateMember
+
+// Get returns the value of the member with the given key, or an empty string.
+func (ts TraceState) Get(key string) string {
+	for _, m := range ts {
+		if m.Key == key {
+			return m.Value
+		}
+	}
+	return ""
+}
+
+// String returns the value of the tracestate header representing ts.
+func (ts TraceState) String() string {
+	members := make([]string, 0, len(ts))
+	for _, m := range ts {
+		members = append(members, m.Key+"="+m.Value)
+	}
+	return strings.Join(members, ",")
+}
+
+// ParseTraceState parses the value of a W3C tracestate header. It returns nil
+// if the header is invalid, since a partial trace state must not be
+// propagated.
+func ParseTraceState(raw string) TraceState {
+	var ts TraceState
+	for _, member := range strings.Split(raw, ",") {
+		if member = strings.TrimSpace(member); member == "" {
+			continue
+		}
+		i := strings.Index(member, "=")
+		if i < 0 {
+			return nil
+		}
+		key, value := member[:i], member[i+1:]
+		if !traceStateKeyRe.MatchString(key) || !traceStateValRe.MatchString(value) {
+			return nil
+		}
+		if ts = append(ts, TraceStateMember{key, value}); len(ts) > maxTraceStateMembers {
+			return nil
+		}
+	}
+	return ts
+}
+
+// Correlation holds the W3C Trace Context and Baggage of a request, to be
+// propagated to the requests it makes to other services.
+type Correlation struct {
+	TraceParent string     // Value of the traceparent header.
+	TraceState  TraceState // Members of the tracestate header.
+	Baggage     Baggage    // Members of the baggage header.
+}
+
+// parseCorrelation returns the correlation found in header, or nil.
+func parseCorrelation(header http.Header) *Correlation {
+	c := &Correlation{}
+	if tp := strings.TrimSpace(header.Get("traceparent")); traceParentRe.MatchString(tp) && !strings.HasPrefix(tp, "ff") {
+		c.TraceParent = tp
+		c.TraceState = ParseTraceState(strings.Join(header["Tracestate"], ","))
+	}
+	if raw := header["Baggage"]; len(raw) > 0 {
+		c.Baggage = ParseBaggage(strings.Join(raw, ","))
+	}
+	if c.TraceParent == "" && len(c.Baggage) == 0 {
+		return nil
+	}
+	return c
+}
+
+// Inject sets the headers of c in header.
+func (c *Correlation) Inject(header http.Header) {
+	if c.TraceParent != "" {
+		header.Set("traceparent", c.TraceParent)
+		if len(c.TraceState) > 0 {
+			header.Set("tracestate", c.TraceState.String())
+		}
+	}
+	if len(c.Baggage) > 0 {
+		header.Set("baggage", c.Baggage.String())
+	}
+}
+
+type correlationKey struct{}
+
+// withCorrelation returns a copy of ctx carrying c.
+func withCorrelation(ctx context.Context, c *Correlation) context.Context {
+	return context.WithValue(ctx, correlationKey{}, c)
+}
+
+// CorrelationFromContext returns the correlation of the request whose context
+// is ctx, or nil.
+func CorrelationFromContext(ctx context.Context) *Correlation {
+	c, _ := ctx.Value(correlationKey{}).(*Correlation)
+	return c
+}
+
+// BaggageFromContext returns the baggage of the request whose context is ctx.
+func BaggageFromContext(ctx context.Context) Baggage {
+	if c := CorrelationFromContext(ctx); c != nil {
+		return c.Baggage
+	}
+	return nil
+}
+
+// TraceStateFromContext returns the trace state of the request whose context
+// is ctx.
+func TraceStateFromContext(ctx context.Context) TraceState {
+	if c := CorrelationFromContext(ctx); c != nil {
+		return c.TraceState
+	}
+	return nil
+}
+
+/*
+Propagate sets the correlation headers of the request whose context is ctx in
+the outbound request req, so that cross-service correlation survives calls
+made by endpoints.
+
+	func (ep *OrderEP) Get(vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+		req, _ := http.NewRequest("GET", "https://billing/invoices/"+vars.Get("id"), nil)
+		rst.Propagate(r.Context(), req)
+		resp, err := http.DefaultClient.Do(req)
+		...
+	}
+*/
+func Propagate(ctx context.Context, req *http.Request) {
+	if c := CorrelationFromContext(ctx); c != nil {
+		c.Inject(req.Header)
+	}
+}
@@ -0,0 +1,74 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseBaggage(t *testing.T) {
+	b := ParseBaggage("userId=alice, serverNode = DF%2028 ;ttl=30, isProduction=false, invalid, b@d=1")
+	if len(b) != 3 {
+		t.Fatalf("got %d members, wanted 3: %v", len(b), b)
+	}
+	if v := b.Get("serverNode"); v != "DF 28" {
+		t.Fatalf("got serverNode %q, wanted %q", v, "DF 28")
+	}
+	if p := b[1].Properties; len(p) != 1 || p[0] != "ttl=30" {
+		t.Fatalf("got properties %v", p)
+	}
+	if s := b.String(); s != "userId=alice,serverNode=DF%2028;ttl=30,isProduction=false" {
+		t.Fatalf("got %q", s)
+	}
+}
+
+func TestParseTraceState(t *testing.T) {
+	ts := ParseTraceState("rojo=00f067aa0ba902b7, congo=t61rcWkgMzE, tenant@vendor=x")
+	if len(ts) != 3 || ts.Get("congo") != "t61rcWkgMzE" || ts.Get("tenant@vendor") != "x" {
+		t.Fatalf("got %v", ts)
+	}
+	if s := ts.String(); s != "rojo=00f067aa0ba902b7,congo=t61rcWkgMzE,tenant@vendor=x" {
+		t.Fatalf("got %q", s)
+	}
+	if ts := ParseTraceState("rojo=00f067aa0ba902b7,Invalid=1"); ts != nil {
+		t.Fatalf("got %v for an invalid header, wanted nil", ts)
+	}
+}
+
+func TestPropagate(t *testing.T) {
+	const traceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+	var outbound *http.Request
+	mux := NewMux()
+	mux.Handle("/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if v := BaggageFromContext(r.Context()).Get("userId"); v != "alice" {
+			t.Fatalf("got userId %q, wanted %q", v, "alice")
+		}
+		if v := TraceStateFromContext(r.Context()).Get("rojo"); v != "00f067aa0ba902b7" {
+			t.Fatalf("got rojo %q", v)
+		}
+		outbound, _ = http.NewRequest(Get, "http://billing/invoices", nil)
+		Propagate(r.Context(), outbound)
+	}))
+
+	r, _ := http.NewRequest(Get, "/", nil)
+	r.Header.Set("traceparent", traceParent)
+	r.Header.Set("tracestate", "rojo=00f067aa0ba902b7")
+	r.Header.Set("baggage", "userId=alice")
+	mux.ServeHTTP(httptest.NewRecorder(), r)
+
+	for key, wanted := range map[string]string{
+		"traceparent": traceParent,
+		"tracestate":  "rojo=00f067aa0ba902b7",
+		"baggage":     "userId=alice",
+	} {
+		if got := outbound.Header.Get(key); got != wanted {
+			t.Fatalf("got %s %q, wanted %q", key, got, wanted)
+		}
+	}
+
+	// The trace state is ignored without a valid trace parent.
+	if c := parseCorrelation(http.Header{"Tracestate": {"rojo=1"}}); c != nil {
+		t.Fatalf("got %+v, wanted nil", c)
+	}
+}
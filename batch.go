@@ -0,0 +1,352 @@
+package rst
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strconv"
+	"strings"
+)
+
+// DefaultBatchMaxRequests is the maximum number of requests of a batch by
+// default.
+const DefaultBatchMaxRequests = 50
+
+const (
+	batchMultipartType = "multipart/mixed"
+	batchPartType      = "application/http"
+)
+
+/*
+Batch serves batches of requests, which are dispatched to the mux serving the
+batch one after the other, so that clients can collapse many small requests
+in a single round trip.
+
+	mux.HandleBatch("/batch")
+	mux.Handle("/batch", &rst.Batch{MaxRequests: 20, FailFast: true})
+
+Batches are POST requests whose body is a JSON array of requests, whose paths
+are relative to the mux:
+
+	[
+		{"id": "francis", "method": "GET", "path": "/people/1"},
+		{"id": "claire", "method": "PATCH", "path": "/people/2", "body": {"name": "Claire"}}
+	]
+
+The response is a JSON array holding the status code, the headers and the body
+of each request, in the same order. Bodies are embedded as is when they're
+JSON, and as strings otherwise:
+
+	[
+		{"id": "francis", "status": 200, "headers": {"Content-Type": "application/json", ...}, "body": {"id": "1", ...}},
+		{"id": "claire", "status": 412, "headers": {...}, "body": {"code": 412, ...}}
+	]
+
+Batches can also be multipart/mixed bodies whose parts are application/http
+requests, as sent by some clients, in which case the response is a
+multipart/mixed body of application/http responses, sharing the Content-ID of
+their request.
+
+Requests inherit the headers of the batch, such as Authorization or Cookie, so
+that they share its authentication, unless they set their own. Their Accept
+header is application/json by default.
+
+With FailFast, or when the failFast parameter of the batch is true, the
+requests following a request failing with a status code of 400 or more are not
+served, and respond with status code 424 Failed Dependency. Batches are not
+transactions though: the requests served before a failure are not undone.
+*/
+type Batch struct {
+	MaxRequests int  // Optional. Maximum number of requests of a batch, DefaultBatchMaxRequests by default.
+	FailFast    bool // Optional. Set to true to stop serving the requests of all the batches after a failure.
+}
+
+// BatchRequest is a request of a batch.
+type BatchRequest struct {
+	ID     string            `json:"id,omitempty"`
+	Method string            `json:"method"`
+	Path   string            `json:"path"`
+	Header map[string]string `json:"headers,omitempty"`
+	Body   json.RawMessage   `json:"body,omitempty"`
+}
+
+// BatchResponse is the response to a request of a batch.
+type BatchResponse struct {
+	ID     string            `json:"id,omitempty"`
+	Status int               `json:"status"`
+	Header map[string]string `json:"headers,omitempty"`
+	Body   json.RawMessage   `json:"body,omitempty"`
+
+	header http.Header
+	body   []byte
+}
+
+// HandleBatch serves batches of requests at pattern with the default settings.
+// It's a shorthand for:
+//
+//	s.Handle(pattern, &rst.Batch{})
+func (s *Mux) HandleBatch(pattern string) {
+	s.Handle(pattern, &Batch{})
+}
+
+func (b *Batch) maxRequests() int {
+	if b.MaxRequests <= 0 {
+		return DefaultBatchMaxRequests
+	}
+	return b.MaxRequests
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (b *Batch) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.ToUpper(r.Method) != Post {
+		writeError(MethodNotAllowed(r.Method, []string{Post}), w, r)
+		return
+	}
+
+	mediaType, params, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	multipartBatch := mediaType == batchMultipartType
+	var (
+		requests []*BatchRequest
+		err      error
+	)
+	switch {
+	case multipartBatch:
+		requests, err = readMultipartBatch(r.Body, params["boundary"])
+	case isJSON(mediaType):
+		requests, err = readJSONBatch(r.Body)
+	default:
+		err = UnsupportedMediaType("application/json", batchMultipartType)
+	}
+	if err == nil && len(requests) > b.maxRequests() {
+		err = NewError(
+			http.StatusRequestEntityTooLarge,
+			"Batch is too large",
+			fmt.Sprintf("A batch must not contain more than %d requests.", b.maxRequests()),
+		)
+	}
+	if err != nil {
+		writeError(err, w, r)
+		return
+	}
+
+	failFast := b.FailFast
+	if values, found := r.URL.Query()["failFast"]; found {
+		failFast = truthy(values[0])
+	}
+	responses := make([]*BatchResponse, len(requests))
+	failed := false
+	for i, request := range requests {
+		if failed {
+			responses[i] = &BatchResponse{ID: request.ID, Status: http.StatusFailedDependency}
+			continue
+		}
+		responses[i] = serveBatchRequest(request, r)
+		failed = failFast && responses[i].Status >= http.StatusBadRequest
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	if multipartBatch {
+		writeMultipartBatch(responses, w)
+		return
+	}
+	body, err := json.Marshal(responses)
+	if err != nil {
+		writeError(err, w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// readJSONBatch reads the requests of a batch encoded in JSON.
+func readJSONBatch(body io.Reader) ([]*BatchRequest, error) {
+	var requests []*BatchRequest
+	if err := json.NewDecoder(body).Decode(&requests); err != nil {
+		return nil, bodyError(err, BadRequest("Malformed batch", "The body of the request must be a JSON array of requests."))
+	}
+	return requests, nil
+}
+
+// readMultipartBatch reads the requests of a multipart/mixed batch.
+func readMultipartBatch(body io.Reader, boundary string) ([]*BatchRequest, error) {
+	malformed := BadRequest("Malformed batch", "The parts of the batch must be application/http requests.")
+	if boundary == "" {
+		return nil, malformed
+	}
+	var requests []*BatchRequest
+	reader := multipart.NewReader(body, boundary)
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return requests, nil
+		}
+		if err != nil {
+			return nil, bodyError(err, malformed)
+		}
+		if mediaType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type")); mediaType != batchPartType {
+			return nil, malformed
+		}
+		sub, err := http.ReadRequest(bufio.NewReader(part))
+		if err != nil {
+			return nil, bodyError(err, malformed)
+		}
+		b, err := io.ReadAll(sub.Body)
+		if err != nil {
+			return nil, bodyError(err, malformed)
+		}
+		request := &BatchRequest{ID: part.Header.Get("Content-ID"), Method: sub.Method, Path: sub.RequestURI, Body: b}
+		request.Header = flattenHeader(sub.Header)
+		requests = append(requests, request)
+	}
+}
+
+// bodyError returns the error of the mux matching err, an error reading the
+// body of a batch, or malformed.
+func bodyError(err error, malformed *Error) error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	return malformed
+}
+
+// serveBatchRequest serves request, a request of the batch r, with the mux
+// serving r.
+func serveBatchRequest(request *BatchRequest, r *http.Request) *BatchResponse {
+	response := &BatchResponse{ID: request.ID}
+	if !strings.HasPrefix(request.Path, "/") || strings.HasPrefix(request.Path, "//") {
+		return response.fail(BadRequest("Invalid request", "The path of the requests of a batch must be relative to the service."))
+	}
+
+	var body io.Reader
+	if len(request.Body) > 0 {
+		body = bytes.NewReader(request.Body)
+	}
+	method := strings.ToUpper(request.Method)
+	if method == "" {
+		method = Get
+	}
+	sub, err := http.NewRequestWithContext(r.Context(), method, request.Path, body)
+	if err != nil {
+		return response.fail(BadRequest("Invalid request", "The method or the path of the request is invalid."))
+	}
+	if sub.URL.Path == r.URL.Path {
+		return response.fail(BadRequest("Invalid request", "Batches can't be nested."))
+	}
+	sub.Host, sub.RemoteAddr, sub.TLS = r.Host, r.RemoteAddr, r.TLS
+	sub.URL.Host, sub.URL.Scheme = "", ""
+	sub.Header = r.Header.Clone()
+	for _, key := range []string{"Content-Type", "Content-Length", "Content-Encoding", "Accept", "Accept-Encoding", "Expect", "If-Match", "If-None-Match", "If-Modified-Since", "If-Unmodified-Since", "Range"} {
+		sub.Header.Del(key)
+	}
+	sub.Header.Set("Accept", "application/json")
+	if body != nil {
+		sub.Header.Set("Content-Type", "application/json")
+	}
+	for key, value := range request.Header {
+		sub.Header.Set(key, value)
+	}
+
+	w := &batchWriter{header: make(http.Header)}
+	getMux(r).ServeHTTP(w, sub)
+	if w.code == 0 {
+		w.code = http.StatusOK
+	}
+	response.Status, response.header, response.body = w.code, w.header, w.body.Bytes()
+	response.Header = flattenHeader(w.header)
+	switch {
+	case len(response.body) == 0:
+	case isJSON(w.header.Get("Content-Type")) && json.Valid(response.body):
+		response.Body = response.body
+	default:
+		response.Body, _ = json.Marshal(string(response.body))
+	}
+	return response
+}
+
+// fail sets err as the response to a request which can't be served.
+func (response *BatchResponse) fail(err *Error) *BatchResponse {
+	response.Status = err.Code
+	response.header = http.Header{"Content-Type": {"application/json; charset=utf-8"}}
+	response.Header = flattenHeader(response.header)
+	response.body, _ = json.Marshal(err)
+	response.Body = response.body
+	return response
+}
+
+// writeMultipartBatch writes responses as a multipart/mixed body of
+// application/http responses.
+func writeMultipartBatch(responses []*BatchResponse, w http.ResponseWriter) {
+	var buffer bytes.Buffer
+	writer := multipart.NewWriter(&buffer)
+	for _, response := range responses {
+		header := textproto.MIMEHeader{"Content-Type": {batchPartType}}
+		if response.ID != "" {
+			header.Set("Content-ID", response.ID)
+		}
+		part, _ := writer.CreatePart(header)
+		resp := &http.Response{
+			StatusCode:    response.Status,
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        response.header,
+			ContentLength: int64(len(response.body)),
+			Body:          io.NopCloser(bytes.NewReader(response.body)),
+		}
+		if resp.Header == nil {
+			resp.Header = make(http.Header)
+		}
+		resp.Write(part)
+	}
+	writer.Close()
+
+	w.Header().Set("Content-Type", batchMultipartType+"; boundary="+writer.Boundary())
+	w.Header().Set("Content-Length", strconv.Itoa(buffer.Len()))
+	w.WriteHeader(http.StatusOK)
+	w.Write(buffer.Bytes())
+}
+
+// flattenHeader returns the values of header joined by commas, or nil if it's
+// empty.
+func flattenHeader(header http.Header) map[string]string {
+	if len(header) == 0 {
+		return nil
+	}
+	flattened := make(map[string]string, len(header))
+	for key, values := range header {
+		flattened[key] = strings.Join(values, ", ")
+	}
+	return flattened
+}
+
+// batchWriter records the response to a request of a batch.
+type batchWriter struct {
+	header http.Header
+	code   int
+	body   bytes.Buffer
+}
+
+func (w *batchWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *batchWriter) WriteHeader(code int) {
+	if w.code == 0 && code >= http.StatusOK {
+		w.code = code
+	}
+}
+
+func (w *batchWriter) Write(b []byte) (int, error) {
+	if w.code == 0 {
+		w.code = http.StatusOK
+	}
+	return w.body.Write(b)
+}
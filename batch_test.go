@@ -0,0 +1,128 @@
+package rst
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newBatchMux() *Mux {
+	mux := NewMux()
+	mux.Get("/people/{id}", func(vars RouteVars, r *http.Request) (Resource, error) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			return nil, Unauthorized()
+		}
+		return NewEnvelope(map[string]string{"id": vars.Get("id")}, time.Now(), "v1", 0), nil
+	})
+	mux.Get("/text", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return &echoResource{[]byte("plain")}, nil
+	})
+	mux.HandleBatch("/batch")
+	return mux
+}
+
+func postBatch(mux *Mux, path, contentType, body string) *httptest.ResponseRecorder {
+	r, _ := http.NewRequest(Post, "http://example.com"+path, strings.NewReader(body))
+	r.Header.Set("Content-Type", contentType)
+	r.Header.Set("Authorization", "Bearer token")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	return w
+}
+
+func TestBatch(t *testing.T) {
+	mux := newBatchMux()
+	w := postBatch(mux, "/batch", "application/json", `[
+		{"id": "1", "method": "GET", "path": "/people/1"},
+		{"id": "2", "path": "/people/2", "headers": {"Authorization": "Bearer other"}},
+		{"id": "3", "method": "GET", "path": "/text"},
+		{"id": "4", "method": "GET", "path": "http://example.org/people/1"},
+		{"id": "5", "method": "POST", "path": "/batch"}
+	]`)
+	if w.Code != http.StatusOK || w.Header().Get("Content-Type") != "application/json; charset=utf-8" {
+		t.Fatalf("Status code is %d with Content-Type %q, expected 200 OK", w.Code, w.Header().Get("Content-Type"))
+	}
+	var responses []*BatchResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &responses); err != nil || len(responses) != 5 {
+		t.Fatalf("Responses are %s", w.Body.String())
+	}
+
+	var test = func(response *BatchResponse, id string, status int, body string) {
+		if response.ID != id || response.Status != status {
+			t.Errorf("Response %s is %d, expected %d", response.ID, response.Status, status)
+		}
+		if body != "" && string(response.Body) != body {
+			t.Errorf("Body of response %s is %s, expected %s", response.ID, response.Body, body)
+		}
+	}
+	test(responses[0], "1", http.StatusOK, `{"id":"1"}`)
+	test(responses[1], "2", http.StatusUnauthorized, "")
+	test(responses[2], "3", http.StatusOK, `"plain"`)
+	test(responses[3], "4", http.StatusBadRequest, "")
+	test(responses[4], "5", http.StatusBadRequest, "")
+	if responses[0].Header["Etag"] != "v1" && responses[0].Header["ETag"] != "v1" {
+		t.Errorf("Headers of response 1 are %v", responses[0].Header)
+	}
+
+	w = postBatch(mux, "/batch?failFast=true", "application/json", `[
+		{"path": "/people/1", "headers": {"Authorization": ""}},
+		{"path": "/people/2"}
+	]`)
+	responses = nil
+	json.Unmarshal(w.Body.Bytes(), &responses)
+	if len(responses) != 2 || responses[0].Status != http.StatusUnauthorized || responses[1].Status != http.StatusFailedDependency {
+		t.Errorf("Fail fast responses are %s", w.Body.String())
+	}
+
+	if w = postBatch(mux, "/batch", "text/plain", "[]"); w.Code != http.StatusUnsupportedMediaType {
+		t.Errorf("Status code is %d, expected 415 Unsupported Media Type", w.Code)
+	}
+	if w = postBatch(mux, "/batch", "application/json", "{"); w.Code != http.StatusBadRequest {
+		t.Errorf("Status code is %d, expected 400 Bad Request", w.Code)
+	}
+	mux.Handle("/small", &Batch{MaxRequests: 1})
+	if w = postBatch(mux, "/small", "application/json", `[{"path": "/text"}, {"path": "/text"}]`); w.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("Status code is %d, expected 413 Request Entity Too Large", w.Code)
+	}
+}
+
+func TestMultipartBatch(t *testing.T) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for _, path := range []string{"/people/1", "/missing"} {
+		part, _ := writer.CreatePart(map[string][]string{"Content-Type": {"application/http"}, "Content-ID": {path}})
+		io.WriteString(part, "GET "+path+" HTTP/1.1\r\nHost: example.com\r\n\r\n")
+	}
+	writer.Close()
+
+	w := postBatch(newBatchMux(), "/batch", "multipart/mixed; boundary="+writer.Boundary(), body.String())
+	mediaType, params, _ := mime.ParseMediaType(w.Header().Get("Content-Type"))
+	if w.Code != http.StatusOK || mediaType != "multipart/mixed" {
+		t.Fatalf("Status code is %d with Content-Type %q", w.Code, w.Header().Get("Content-Type"))
+	}
+	reader := multipart.NewReader(w.Body, params["boundary"])
+	for _, expected := range []struct {
+		id     string
+		status int
+	}{{"/people/1", http.StatusOK}, {"/missing", http.StatusNotFound}} {
+		part, err := reader.NextPart()
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := http.ReadResponse(bufio.NewReader(part), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if part.Header.Get("Content-ID") != expected.id || resp.StatusCode != expected.status {
+			t.Errorf("Part %s is %d, expected %d", part.Header.Get("Content-ID"), resp.StatusCode, expected.status)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package rst
+
+import (
+	"io"
+	"net/http"
+)
+
+/*
+BodyLimitPolicy is implemented by endpoints accepting request bodies of a
+different size than the MaxRequestBodyBytes of the mux, such as an upload
+endpoint.
+
+	func (ep *UploadEndpoint) MaxRequestBodyBytes() int64 {
+		return 100 << 20
+	}
+
+A value <= 0 means no limit.
+*/
+type BodyLimitPolicy interface {
+	MaxRequestBodyBytes() int64
+}
+
+// maxRequestBodyBytes returns the maximum size of the bodies of the requests
+// served by handler, or a value <= 0 if there's none.
+func (s *Mux) maxRequestBodyBytes(handler http.Handler) int64 {
+	if h, valid := handler.(*endpointHandler); valid {
+		if policy, implemented := policyEndpoint(h.endpoint).(BodyLimitPolicy); implemented {
+			return policy.MaxRequestBodyBytes()
+		}
+	}
+	return s.MaxRequestBodyBytes
+}
+
+// limitBody limits the body of r to limit bytes. It returns an error if the
+// Content-Length of r is larger. Otherwise, reading more than limit bytes from
+// the body returns an error responding with status code 413 Request Entity Too
+// Large.
+func limitBody(r *http.Request, limit int64) error {
+	if r.ContentLength > limit {
+		return RequestEntityTooLarge(limit)
+	}
+	if r.Body != nil && r.Body != http.NoBody {
+		r.Body = &limitedBody{r.Body, limit, limit}
+	}
+	return nil
+}
+
+// limitedBody is a request body returning an error once more than limit
+// bytes have been read.
+type limitedBody struct {
+	io.ReadCloser
+	limit     int64
+	remaining int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.remaining < 0 {
+		return 0, RequestEntityTooLarge(b.limit)
+	}
+	// Reading one more byte than allowed detects bodies exceeding the limit.
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+	n, err := b.ReadCloser.Read(p)
+	if int64(n) > b.remaining {
+		n = int(b.remaining)
+		b.remaining = -1
+		return n, RequestEntityTooLarge(b.limit)
+	}
+	b.remaining -= int64(n)
+	return n, err
+}
@@ -0,0 +1,63 @@
+package rst
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type uploadEndpoint struct {
+	limit int64
+}
+
+func (e *uploadEndpoint) Post(vars RouteVars, r *http.Request) (Resource, string, error) {
+	if _, err := ioutil.ReadAll(r.Body); err != nil {
+		return nil, "", err
+	}
+	return nil, "", nil
+}
+
+func (e *uploadEndpoint) MaxRequestBodyBytes() int64 {
+	return e.limit
+}
+
+func TestMaxRequestBodyBytes(t *testing.T) {
+	mux := NewMux()
+	mux.MaxRequestBodyBytes = 10
+	mux.HandleEndpoint("/echo", &formEndpoint{})
+	mux.Post("/read", func(vars RouteVars, r *http.Request) (Resource, string, error) {
+		_, err := ioutil.ReadAll(r.Body)
+		return nil, "", err
+	})
+	mux.HandleEndpoint("/upload", &uploadEndpoint{20})
+	mux.HandleEndpoint("/unlimited", &uploadEndpoint{0})
+
+	var test = func(path string, size int, chunked bool, status int) {
+		body := strings.Repeat("a", size)
+		r, _ := http.NewRequest(Post, "http://example.com"+path, strings.NewReader(body))
+		if chunked {
+			r.ContentLength = -1
+		}
+		r.Header.Set("Accept", "application/json")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Errorf("%s %d bytes (chunked: %t): Got: %d Wanted: %d", path, size, chunked, w.Code, status)
+		}
+		if status == http.StatusRequestEntityTooLarge && w.Header().Get("Connection") != "close" {
+			t.Errorf("%s %d bytes: the connection should be closed", path, size)
+		}
+	}
+
+	test("/read", 10, false, http.StatusCreated)
+	test("/read", 10, true, http.StatusCreated)
+	test("/read", 11, false, http.StatusRequestEntityTooLarge)
+	test("/read", 11, true, http.StatusRequestEntityTooLarge)
+	// Rejected before the endpoint reads the body.
+	test("/echo", 11, false, http.StatusRequestEntityTooLarge)
+	test("/upload", 20, true, http.StatusCreated)
+	test("/upload", 21, true, http.StatusRequestEntityTooLarge)
+	test("/unlimited", 1000, false, http.StatusCreated)
+}
@@ -0,0 +1,356 @@
+package rst
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Defaults of a Breaker.
+const (
+	DefaultBreakerThreshold = 5
+	DefaultBreakerCooldown  = 30 * time.Second
+)
+
+// BreakerState is the state of a Breaker.
+type BreakerState int
+
+// States of a breaker.
+const (
+	BreakerClosed   BreakerState = iota // Calls are allowed.
+	BreakerOpen                         // Calls are rejected until the cooldown expires.
+	BreakerHalfOpen                     // A single call is allowed to probe the downstream service.
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	}
+	return "closed"
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (s BreakerState) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+/*
+Breaker is a circuit breaker protecting the calls made to a downstream service.
+After Threshold consecutive failures, the breaker opens and calls are rejected
+with status code 503 Service Unavailable and a Retry-After header, instead of
+piling up timeouts. Once Cooldown has elapsed, a single call is allowed to
+probe the service, and closes the breaker if it succeeds.
+
+	var billing = &rst.Breaker{Name: "billing"}
+
+	func (ep *InvoiceEP) Get(vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+		var invoice *Invoice
+		err := billing.Do(func() (err error) {
+			invoice, err = billingClient.Invoice(vars.Get("id"))
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return invoice, nil
+	}
+
+A breaker can also be set declaratively on a route with HandleBreaker, in which
+case it trips when the endpoint fails with a status code >= 500.
+*/
+type Breaker struct {
+	Name      string        // Name of the downstream service.
+	Threshold int           // Number of consecutive failures opening the breaker. Defaults to DefaultBreakerThreshold.
+	Cooldown  time.Duration // Duration during which the breaker stays open. Defaults to DefaultBreakerCooldown.
+
+	mu       sync.Mutex
+	state    BreakerState
+	failures int
+	trips    uint64
+	probes   uint64
+	resets   uint64
+	opened   time.Time
+	probed   time.Time
+}
+
+// BreakerStats describes the state of a breaker.
+type BreakerStats struct {
+	Name     string       `json:"name" xml:"Name"`
+	State    BreakerState `json:"state" xml:"State"`
+	Failures int          `json:"failures" xml:"Failures"` // Consecutive failures.
+	Trips    uint64       `json:"trips" xml:"Trips"`       // Number of times the breaker opened.
+	Probes   uint64       `json:"probes" xml:"Probes"`     // Number of times the breaker half-opened to probe the service.
+	Resets   uint64       `json:"resets" xml:"Resets"`     // Number of times the breaker closed after a successful probe.
+	Opened   time.Time    `json:"opened,omitempty" xml:"Opened,omitempty"`
+}
+
+// Stats returns the current state of the breaker.
+func (b *Breaker) Stats() BreakerStats {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return BreakerStats{
+		Name:     b.Name,
+		State:    b.currentState(time.Now()),
+		Failures: b.failures,
+		Trips:    b.trips,
+		Probes:   b.probes,
+		Resets:   b.resets,
+		Opened:   b.opened,
+	}
+}
+
+// State returns the current state of the breaker.
+func (b *Breaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.currentState(time.Now())
+}
+
+// Do calls f unless the breaker is open, in which case an *Error with status
+// code 503 is returned. Any error returned by f counts as a failure.
+func (b *Breaker) Do(f func() error) error {
+	return b.call(f, func(err error) bool { return err != nil })
+}
+
+// call calls f unless the breaker is open, and records the outcome of the call
+// according to isFailure.
+func (b *Breaker) call(f func() error, isFailure func(error) bool) error {
+	if err := b.allow(); err != nil {
+		return err
+	}
+	err := f()
+	b.record(isFailure(err))
+	return err
+}
+
+func (b *Breaker) cooldown() time.Duration {
+	if b.Cooldown > 0 {
+		return b.Cooldown
+	}
+	return DefaultBreakerCooldown
+}
+
+func (b *Breaker) threshold() int {
+	if b.Threshold > 0 {
+		return b.Threshold
+	}
+	return DefaultBreakerThreshold
+}
+
+// currentState returns the state of the breaker at now. b.mu must be held.
+func (b *Breaker) currentState(now time.Time) BreakerState {
+	if b.state == BreakerOpen && now.Sub(b.opened) >= b.cooldown() {
+		return BreakerHalfOpen
+	}
+	return b.state
+}
+
+// allow returns an error if a call can't be made.
+func (b *Breaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	switch b.state {
+	case BreakerOpen:
+		if b.currentState(now) == BreakerHalfOpen {
+			// Let this call probe the downstream service.
+			b.state = BreakerHalfOpen
+			b.probed = now
+			b.probes++
+			return nil
+		}
+		return breakerOpen(b.Name, b.opened.Add(b.cooldown()).Sub(now))
+	case BreakerHalfOpen:
+		// A probe is in flight, unless it never completed.
+		if now.Sub(b.probed) < b.cooldown() {
+			return breakerOpen(b.Name, b.probed.Add(b.cooldown()).Sub(now))
+		}
+		b.probed = now
+	}
+	return nil
+}
+
+// record records the outcome of a call.
+func (b *Breaker) record(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !failed {
+		if b.state != BreakerClosed {
+			b.resets++
+		}
+		b.state = BreakerClosed
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.state == BreakerHalfOpen || b.failures >= b.threshold() {
+		b.state = BreakerOpen
+		b.opened = time.Now()
+		b.trips++
+	}
+}
+
+// breakerOpen is returned when a call is rejected by an open breaker.
+func breakerOpen(name string, retryAfter time.Duration) *Error {
+	description := "A service this resource depends on is unavailable. Please try again later."
+	if name != "" {
+		description = "The " + name + " service this resource depends on is unavailable. Please try again later."
+	}
+	err := ServiceUnavailable(retryAfter)
+	err.Description = description
+	return err
+}
+
+// HandleBreaker registers an endpoint for the given pattern whose requests are
+// protected by breaker. Requests failing with a status code >= 500 count as
+// failures. The breaker is added to the mux.
+func (s *Mux) HandleBreaker(pattern string, endpoint Endpoint, breaker *Breaker) {
+	s.AddBreaker(breaker)
+	s.HandleEndpoint(pattern, &breakerEndpoint{endpoint, breaker})
+}
+
+// AddBreaker adds a breaker to the ones whose state is reported by the mux,
+// such as the breakers called directly by endpoints. Adding a breaker twice has
+// no effect.
+func (s *Mux) AddBreaker(breaker *Breaker) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, b := range s.breakers {
+		if b == breaker {
+			return
+		}
+	}
+	s.breakers = append(s.breakers, breaker)
+}
+
+// Breakers returns the breakers added to the mux, in the order they were
+// added.
+func (s *Mux) Breakers() []*Breaker {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Breaker(nil), s.breakers...)
+}
+
+// breakerEndpoint protects the requests served by an endpoint with a breaker.
+type breakerEndpoint struct {
+	endpoint Endpoint
+	breaker  *Breaker
+}
+
+// wrapped implements the endpointWrapper interface.
+func (e *breakerEndpoint) wrapped() Endpoint {
+	return e.endpoint
+}
+
+// allowedMethods implements the methodLister interface.
+func (e *breakerEndpoint) allowedMethods() []string {
+	return AllowedMethods(e.endpoint)
+}
+
+// Get implements the Getter interface.
+func (e *breakerEndpoint) Get(vars RouteVars, r *http.Request) (resource Resource, err error) {
+	getter := getterOf(e.endpoint)
+	if getter == nil {
+		return nil, methodNotAllowed(e, r)
+	}
+	err = e.breaker.call(func() error {
+		resource, err = getter.Get(vars, r)
+		return err
+	}, failed)
+	return resource, err
+}
+
+// Post implements the Poster interface.
+func (e *breakerEndpoint) Post(vars RouteVars, r *http.Request) (resource Resource, location string, err error) {
+	poster := posterOf(e.endpoint)
+	if poster == nil {
+		return nil, "", methodNotAllowed(e, r)
+	}
+	err = e.breaker.call(func() error {
+		resource, location, err = poster.Post(vars, r)
+		return err
+	}, failed)
+	return resource, location, err
+}
+
+// Put implements the Putter interface.
+func (e *breakerEndpoint) Put(vars RouteVars, r *http.Request) (resource Resource, err error) {
+	putter := putterOf(e.endpoint)
+	if putter == nil {
+		return nil, methodNotAllowed(e, r)
+	}
+	err = e.breaker.call(func() error {
+		resource, err = putter.Put(vars, r)
+		return err
+	}, failed)
+	return resource, err
+}
+
+// Patch implements the Patcher interface.
+func (e *breakerEndpoint) Patch(vars RouteVars, r *http.Request) (resource Resource, err error) {
+	patcher := patcherOf(e.endpoint)
+	if patcher == nil {
+		return nil, methodNotAllowed(e, r)
+	}
+	err = e.breaker.call(func() error {
+		resource, err = patcher.Patch(vars, r)
+		return err
+	}, failed)
+	return resource, err
+}
+
+// Delete implements the Deleter interface.
+func (e *breakerEndpoint) Delete(vars RouteVars, r *http.Request) error {
+	deleter := deleterOf(e.endpoint)
+	if deleter == nil {
+		return methodNotAllowed(e, r)
+	}
+	return e.breaker.call(func() error {
+		return deleter.Delete(vars, r)
+	}, failed)
+}
+
+// Methods implements the MethodHandler interface.
+func (e *breakerEndpoint) Methods() []string {
+	return extensionMethods(e.endpoint)
+}
+
+// Handle implements the MethodHandler interface.
+func (e *breakerEndpoint) Handle(method string, vars RouteVars, r *http.Request) (resource Resource, err error) {
+	handler, implemented := e.endpoint.(MethodHandler)
+	if !implemented {
+		return nil, methodNotAllowed(e, r)
+	}
+	err = e.breaker.call(func() error {
+		resource, err = handler.Handle(method, vars, r)
+		return err
+	}, failed)
+	return resource, err
+}
+
+// Validators implements the Validator interface.
+func (e *breakerEndpoint) Validators(vars RouteVars, r *http.Request) (string, time.Time, error) {
+	return validatorsOf(e.endpoint, vars, r)
+}
+
+// Authorize implements the Authorizer interface.
+func (e *breakerEndpoint) Authorize(method string, vars RouteVars, r *http.Request) error {
+	if authorizer, implemented := e.endpoint.(Authorizer); implemented {
+		return authorizer.Authorize(method, vars, r)
+	}
+	return nil
+}
+
+// Preflight implements the Preflighter interface.
+func (e *breakerEndpoint) Preflight(req *AccessControlRequest, vars RouteVars, r *http.Request) *AccessControlResponse {
+	if preflighter, implemented := e.endpoint.(Preflighter); implemented {
+		return preflighter.Preflight(req, vars, r)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package rst
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestBreaker(t *testing.T) {
+	b := &Breaker{Name: "billing", Threshold: 2, Cooldown: 20 * time.Millisecond}
+	failure := errors.New("failure")
+	fail := func() error { return failure }
+	succeed := func() error { return nil }
+
+	if err := b.Do(fail); err != failure {
+		t.Fatalf("got %v, wanted %v", err, failure)
+	}
+	if s := b.State(); s != BreakerClosed {
+		t.Fatalf("got state %s after 1 failure, wanted %s", s, BreakerClosed)
+	}
+	b.Do(fail)
+	if s := b.State(); s != BreakerOpen {
+		t.Fatalf("got state %s after 2 failures, wanted %s", s, BreakerOpen)
+	}
+
+	called := false
+	err := b.Do(func() error { called = true; return nil })
+	if called {
+		t.Fatal("open breaker allowed a call")
+	}
+	e, ok := err.(*Error)
+	if !ok || e.Code != http.StatusServiceUnavailable || e.Header.Get("Retry-After") != "1" {
+		t.Fatalf("got %#v, wanted a 503 error with Retry-After", err)
+	}
+
+	// A failed probe opens the breaker again.
+	time.Sleep(20 * time.Millisecond)
+	if s := b.State(); s != BreakerHalfOpen {
+		t.Fatalf("got state %s after the cooldown, wanted %s", s, BreakerHalfOpen)
+	}
+	b.Do(fail)
+	if s := b.State(); s != BreakerOpen {
+		t.Fatalf("got state %s after a failed probe, wanted %s", s, BreakerOpen)
+	}
+
+	// A successful probe closes it.
+	time.Sleep(20 * time.Millisecond)
+	if err := b.Do(succeed); err != nil {
+		t.Fatal(err)
+	}
+	stats := b.Stats()
+	if stats.State != BreakerClosed || stats.Failures != 0 || stats.Trips != 2 || stats.Probes != 2 || stats.Resets != 1 {
+		t.Fatalf("got %+v", stats)
+	}
+}
+
+func TestHandleBreaker(t *testing.T) {
+	b := &Breaker{Threshold: 1, Cooldown: time.Minute}
+	mux := NewMux()
+	mux.HandleBreaker("/", &failingEndpoint{}, b)
+
+	codes := []int{http.StatusInternalServerError, http.StatusServiceUnavailable}
+	var w *httptest.ResponseRecorder
+	for i, code := range codes {
+		r, _ := http.NewRequest(Get, "/", nil)
+		w = httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != code {
+			t.Fatalf("%d: got %d, wanted %d", i, w.Code, code)
+		}
+	}
+	if got := w.Header().Get("Retry-After"); got != "60" {
+		t.Fatalf("got Retry-After %q, wanted %q", got, "60")
+	}
+
+	mux.HandleBreaker("/other", &failingEndpoint{}, b)
+	if breakers := mux.Breakers(); len(breakers) != 1 || breakers[0] != b {
+		t.Fatalf("got breakers %v, wanted [%p]", breakers, b)
+	}
+}
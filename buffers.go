@@ -0,0 +1,59 @@
+package rst
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// maxPooledBufferSize is the capacity beyond which buffers are dropped instead
+// of being returned to their pool, so that a few large representations don't
+// hold on to their memory.
+const maxPooledBufferSize = 8 << 20
+
+// copyBufferSize is the size of the buffers used to copy streamed
+// representations.
+const copyBufferSize = 32 << 10
+
+var (
+	bufferPool = sync.Pool{
+		New: func() interface{} { return new(bytes.Buffer) },
+	}
+	copyBufferPool = sync.Pool{
+		New: func() interface{} {
+			b := make([]byte, copyBufferSize)
+			return &b
+		},
+	}
+)
+
+// getBuffer returns an empty buffer from the pool. It must be released with
+// putBuffer or detachBuffer once it's no longer used.
+func getBuffer() *bytes.Buffer {
+	buffer := bufferPool.Get().(*bytes.Buffer)
+	buffer.Reset()
+	return buffer
+}
+
+// putBuffer returns buffer to the pool. Its content must no longer be used.
+func putBuffer(buffer *bytes.Buffer) {
+	if buffer.Cap() <= maxPooledBufferSize {
+		bufferPool.Put(buffer)
+	}
+}
+
+// detachBuffer returns a copy of the content of buffer, sized to its length,
+// and returns buffer to the pool.
+func detachBuffer(buffer *bytes.Buffer) []byte {
+	b := make([]byte, buffer.Len())
+	copy(b, buffer.Bytes())
+	putBuffer(buffer)
+	return b
+}
+
+// copyStream copies src to dst with a buffer from the pool.
+func copyStream(dst io.Writer, src io.Reader) (int64, error) {
+	b := copyBufferPool.Get().(*[]byte)
+	defer copyBufferPool.Put(b)
+	return io.CopyBuffer(dst, src, *b)
+}
@@ -0,0 +1,94 @@
+package rst
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// discardWriter is a ResponseWriter dropping what's written.
+type discardWriter struct {
+	header http.Header
+}
+
+func (w *discardWriter) Header() http.Header         { return w.header }
+func (w *discardWriter) Write(b []byte) (int, error) { return len(b), nil }
+func (w *discardWriter) WriteHeader(int)             {}
+
+// streamedText is a StreamedResource reading its content from memory.
+type streamedText struct {
+	content []byte
+}
+
+func (s *streamedText) ETag() string            { return "streamed" }
+func (s *streamedText) LastModified() time.Time { return testTimeReference }
+func (s *streamedText) TTL() time.Duration      { return 0 }
+func (s *streamedText) StreamRST(r *http.Request) (string, io.ReadCloser, int64, error) {
+	return "text/plain", io.NopCloser(bytes.NewReader(s.content)), int64(len(s.content)), nil
+}
+
+// xmlLines is a resource encoded in XML with an envelope.
+type xmlLines []string
+
+func (l xmlLines) ETag() string            { return "lines" }
+func (l xmlLines) LastModified() time.Time { return testTimeReference }
+func (l xmlLines) TTL() time.Duration      { return 0 }
+
+func TestBuffers(t *testing.T) {
+	buffer := getBuffer()
+	buffer.WriteString("pooled")
+	b := detachBuffer(buffer)
+	reused := getBuffer()
+	reused.WriteString("reused")
+	if string(b) != "pooled" || reused.Len() != len("reused") {
+		t.Errorf("Detached content is %q, expected pooled", b)
+	}
+	putBuffer(reused)
+
+	var copied bytes.Buffer
+	if n, err := copyStream(&copied, strings.NewReader("streamed")); err != nil || n != 8 || copied.String() != "streamed" {
+		t.Errorf("Copied %d bytes %q with error %v", n, copied.String(), err)
+	}
+}
+
+func benchmarkResource(b *testing.B, resource Resource, header http.Header) {
+	mux := NewMux()
+	mux.Get("/resource", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return resource, nil
+	})
+	r, _ := http.NewRequest(Get, "http://example.com/resource", nil)
+	r.Header = header
+	w := &discardWriter{}
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(testMBText)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		w.header = make(http.Header)
+		mux.ServeHTTP(w, r)
+	}
+}
+
+func BenchmarkWriteMB(b *testing.B) {
+	benchmarkResource(b, &echoResource{testMBText}, http.Header{})
+}
+
+func BenchmarkWriteMBGzip(b *testing.B) {
+	benchmarkResource(b, &echoResource{testMBText}, http.Header{"Accept-Encoding": {"gzip"}})
+}
+
+func BenchmarkWriteMBDeflate(b *testing.B) {
+	benchmarkResource(b, &echoResource{testMBText}, http.Header{"Accept-Encoding": {"deflate"}})
+}
+
+func BenchmarkStreamMB(b *testing.B) {
+	benchmarkResource(b, &streamedText{testMBText}, http.Header{})
+}
+
+func BenchmarkMarshalXMLMB(b *testing.B) {
+	lines := xmlLines(strings.Split(string(testMBText), "\n"))
+	benchmarkResource(b, lines, http.Header{"Accept": {"application/xml"}})
+}
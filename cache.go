@@ -0,0 +1,88 @@
+package rst
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+CacheDirectives are the directives of the Cache-Control header of a
+representation.
+
+	func (p *Person) CacheDirectives() *rst.CacheDirectives {
+		return &rst.CacheDirectives{
+			Private:              true,
+			MustRevalidate:       true,
+			StaleWhileRevalidate: time.Minute,
+		}
+	}
+
+The max-age directive is set with the TTL of the resource, which also sets
+the Expires header, unless NoStore is true.
+*/
+type CacheDirectives struct {
+	Public               bool          // The response may be stored by shared caches, even if it would otherwise be restricted.
+	Private              bool          // The response is intended for a single user, and must not be stored by shared caches.
+	NoCache              bool          // Caches must revalidate the response before using it.
+	NoStore              bool          // Caches must not store the response.
+	MustRevalidate       bool          // Caches must not serve the response once stale without revalidating it.
+	ProxyRevalidate      bool          // Same as MustRevalidate, for shared caches only.
+	NoTransform          bool          // Intermediaries must not transform the payload.
+	Immutable            bool          // The response won't change while it's fresh, and must not be revalidated.
+	SharedMaxAge         time.Duration // Optional. Lifetime of the response in shared caches, which overrides the TTL.
+	StaleWhileRevalidate time.Duration // Optional. Duration during which a stale response can be served while it's revalidated in the background.
+	StaleIfError         time.Duration // Optional. Duration during which a stale response can be served when the origin responds with an error.
+}
+
+// CacheableResource is implemented by resources specifying the Cache-Control
+// directives of their representations.
+type CacheableResource interface {
+	CacheDirectives() *CacheDirectives
+}
+
+// cacheDirectivesOf returns the directives of resource, or of the projection
+// of an envelope, or nil.
+func cacheDirectivesOf(resource interface{}) *CacheDirectives {
+	if cacheable, implemented := resource.(CacheableResource); implemented {
+		return cacheable.CacheDirectives()
+	}
+	if envelope, valid := resource.(*Envelope); valid {
+		return cacheDirectivesOf(envelope.projection)
+	}
+	return nil
+}
+
+// header returns the value of the Cache-Control header with a max-age of ttl.
+func (d *CacheDirectives) header(ttl time.Duration) string {
+	var directives []string
+	var flag = func(set bool, name string) {
+		if set {
+			directives = append(directives, name)
+		}
+	}
+	var delta = func(d time.Duration, name string) {
+		if d > 0 {
+			directives = append(directives, name+"="+strconv.FormatInt(int64(d/time.Second), 10))
+		}
+	}
+
+	flag(d.Public, "public")
+	flag(d.Private, "private")
+	flag(d.NoCache, "no-cache")
+	flag(d.NoStore, "no-store")
+	if !d.NoStore {
+		if ttl < 0 {
+			ttl = 0
+		}
+		directives = append(directives, "max-age="+strconv.FormatInt(int64(ttl/time.Second), 10))
+		delta(d.SharedMaxAge, "s-maxage")
+	}
+	flag(d.MustRevalidate, "must-revalidate")
+	flag(d.ProxyRevalidate, "proxy-revalidate")
+	flag(d.NoTransform, "no-transform")
+	flag(d.Immutable, "immutable")
+	delta(d.StaleWhileRevalidate, "stale-while-revalidate")
+	delta(d.StaleIfError, "stale-if-error")
+	return strings.Join(directives, ", ")
+}
@@ -0,0 +1,68 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type cacheableResource struct {
+	Name       string `json:"name"`
+	directives *CacheDirectives
+}
+
+func (c *cacheableResource) CacheDirectives() *CacheDirectives {
+	return c.directives
+}
+
+type cacheableEndpoint struct {
+	directives *CacheDirectives
+}
+
+func (e *cacheableEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	projection := &cacheableResource{"Francis", e.directives}
+	return NewEnvelope(projection, time.Date(2015, 1, 2, 3, 4, 5, 0, time.UTC), "v1", time.Hour), nil
+}
+
+func TestCacheDirectives(t *testing.T) {
+	var test = func(d *CacheDirectives, ttl time.Duration, expected string) {
+		if got := d.header(ttl); got != expected {
+			t.Errorf("%+v: Got: %q Wanted: %q", d, got, expected)
+		}
+	}
+
+	test(&CacheDirectives{}, time.Hour, "max-age=3600")
+	test(&CacheDirectives{}, -time.Hour, "max-age=0")
+	test(&CacheDirectives{Public: true, Immutable: true}, 365*24*time.Hour, "public, max-age=31536000, immutable")
+	test(&CacheDirectives{Private: true, NoCache: true, MustRevalidate: true}, 0, "private, no-cache, max-age=0, must-revalidate")
+	test(&CacheDirectives{NoStore: true, SharedMaxAge: time.Hour}, time.Hour, "no-store")
+	test(&CacheDirectives{SharedMaxAge: 10 * time.Minute, ProxyRevalidate: true, NoTransform: true}, time.Minute, "max-age=60, s-maxage=600, proxy-revalidate, no-transform")
+	test(&CacheDirectives{StaleWhileRevalidate: 30 * time.Second, StaleIfError: 24 * time.Hour}, time.Minute, "max-age=60, stale-while-revalidate=30, stale-if-error=86400")
+}
+
+func TestCacheableResource(t *testing.T) {
+	endpoint := &cacheableEndpoint{&CacheDirectives{Public: true, StaleIfError: time.Minute}}
+	mux := NewMux()
+	mux.HandleEndpoint("/cacheable", endpoint)
+
+	var test = func(path string, header http.Header, status int, expected string) {
+		r, _ := http.NewRequest(Get, "http://example.com"+path, nil)
+		r.Header = header
+		r.Header.Set("Accept", "application/json")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Errorf("%s %v: Got: %d Wanted: %d", path, header, w.Code, status)
+		}
+		if got := w.Header().Get("Cache-Control"); got != expected {
+			t.Errorf("%s %v: Got: %q Wanted: %q", path, header, got, expected)
+		}
+	}
+
+	test("/cacheable", http.Header{}, http.StatusOK, "public, max-age=3600, stale-if-error=60")
+	test("/cacheable", http.Header{"If-None-Match": {"v1"}}, http.StatusNotModified, "public, max-age=3600, stale-if-error=60")
+
+	endpoint.directives = nil
+	test("/cacheable", http.Header{}, http.StatusOK, "")
+}
@@ -0,0 +1,258 @@
+package rst
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+/*
+Canary splits the traffic of a route between a stable and a canary
+implementation of an endpoint, and collects metrics to compare them.
+
+	canary := &rst.Canary{
+		Weight: 0.05,
+		Key: func(r *http.Request) string {
+			user, _, _ := r.BasicAuth()
+			return user
+		},
+	}
+	mux.HandleCanary("/people/{id}", &PersonEP{}, &NewPersonEP{}, canary)
+
+	stable, next := canary.Stats()
+	if next.ErrorRate() > stable.ErrorRate() {
+		// roll back
+	}
+
+Requests matching Predicate are always served by the canary. Other requests are
+served by the canary with a probability of Weight, which is sticky for requests
+sharing the same Key. Methods which aren't implemented by the canary are
+served by the stable endpoint.
+*/
+type Canary struct {
+	Weight    float64                      // Fraction of the requests served by the canary, between 0 and 1.
+	Predicate func(r *http.Request) bool   // Optional. Returns true for requests that must be served by the canary.
+	Key       func(r *http.Request) string // Optional. Returns the key of sticky assignments, such as a principal.
+
+	mu     sync.Mutex
+	stable CanaryStats
+	canary CanaryStats
+}
+
+// CanaryStats are the metrics collected for an implementation of a canary.
+type CanaryStats struct {
+	Requests uint64        // Number of requests served.
+	Errors   uint64        // Number of requests which failed with a status code >= 500.
+	Latency  time.Duration // Total duration of the requests served.
+}
+
+// ErrorRate returns the fraction of requests which failed.
+func (s CanaryStats) ErrorRate() float64 {
+	if s.Requests == 0 {
+		return 0
+	}
+	return float64(s.Errors) / float64(s.Requests)
+}
+
+// MeanLatency returns the average duration of requests.
+func (s CanaryStats) MeanLatency() time.Duration {
+	if s.Requests == 0 {
+		return 0
+	}
+	return s.Latency / time.Duration(s.Requests)
+}
+
+// Stats returns the metrics collected for the stable and the canary
+// implementations.
+func (c *Canary) Stats() (stable, canary CanaryStats) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stable, c.canary
+}
+
+// selected returns true if r must be served by the canary.
+func (c *Canary) selected(r *http.Request) bool {
+	if c.Predicate != nil && c.Predicate(r) {
+		return true
+	}
+	if c.Weight <= 0 {
+		return false
+	}
+	if c.Key != nil {
+		if key := c.Key(r); key != "" {
+			return bucket(key) < c.Weight
+		}
+	}
+	return rand.Float64() < c.Weight
+}
+
+// bucket deterministically maps key to a number in [0, 1).
+func bucket(key string) float64 {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return float64(h.Sum32()%10000) / 10000
+}
+
+func (c *Canary) record(stats *CanaryStats, start time.Time, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats.Requests++
+	stats.Latency += time.Since(start)
+	if failed(err) {
+		stats.Errors++
+	}
+}
+
+// failed returns true if err will respond with a status code >= 500.
+func failed(err error) bool {
+	switch e := err.(type) {
+	case nil, *Tombstone, *Redirection:
+		return false
+	case *Error:
+		return e.Code >= http.StatusInternalServerError
+	case *Problem:
+		return e.Status >= http.StatusInternalServerError
+	}
+	return !errors.Is(err, context.Canceled)
+}
+
+// HandleCanary registers an endpoint for the given pattern splitting its
+// traffic between stable and canary.
+func (s *Mux) HandleCanary(pattern string, stable, canary Endpoint, c *Canary) {
+	s.HandleEndpoint(pattern, &canaryEndpoint{stable, canary, c})
+}
+
+// canaryEndpoint dispatches requests to the stable or the canary endpoint.
+type canaryEndpoint struct {
+	stable Endpoint
+	canary Endpoint
+	c      *Canary
+}
+
+// wrapped implements the endpointWrapper interface. The policies of the
+// stable endpoint apply to both endpoints.
+func (e *canaryEndpoint) wrapped() Endpoint {
+	return e.stable
+}
+
+// allowedMethods implements the methodLister interface.
+func (e *canaryEndpoint) allowedMethods() []string {
+	return AllowedMethods(e.stable)
+}
+
+// pick returns the endpoint serving r, and its stats.
+func (e *canaryEndpoint) pick(r *http.Request) (Endpoint, *CanaryStats) {
+	if getMethodHandler(e.canary, r.Method, r.Header) != nil && e.c.selected(r) {
+		return e.canary, &e.c.canary
+	}
+	return e.stable, &e.c.stable
+}
+
+// Get implements the Getter interface.
+func (e *canaryEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	endpoint, stats := e.pick(r)
+	getter := getterOf(endpoint)
+	if getter == nil {
+		return nil, methodNotAllowed(e, r)
+	}
+	start := time.Now()
+	resource, err := getter.Get(vars, r)
+	e.c.record(stats, start, err)
+	return resource, err
+}
+
+// Post implements the Poster interface.
+func (e *canaryEndpoint) Post(vars RouteVars, r *http.Request) (Resource, string, error) {
+	endpoint, stats := e.pick(r)
+	poster := posterOf(endpoint)
+	if poster == nil {
+		return nil, "", methodNotAllowed(e, r)
+	}
+	start := time.Now()
+	resource, location, err := poster.Post(vars, r)
+	e.c.record(stats, start, err)
+	return resource, location, err
+}
+
+// Put implements the Putter interface.
+func (e *canaryEndpoint) Put(vars RouteVars, r *http.Request) (Resource, error) {
+	endpoint, stats := e.pick(r)
+	putter := putterOf(endpoint)
+	if putter == nil {
+		return nil, methodNotAllowed(e, r)
+	}
+	start := time.Now()
+	resource, err := putter.Put(vars, r)
+	e.c.record(stats, start, err)
+	return resource, err
+}
+
+// Patch implements the Patcher interface.
+func (e *canaryEndpoint) Patch(vars RouteVars, r *http.Request) (Resource, error) {
+	endpoint, stats := e.pick(r)
+	patcher := patcherOf(endpoint)
+	if patcher == nil {
+		return nil, methodNotAllowed(e, r)
+	}
+	start := time.Now()
+	resource, err := patcher.Patch(vars, r)
+	e.c.record(stats, start, err)
+	return resource, err
+}
+
+// Delete implements the Deleter interface.
+func (e *canaryEndpoint) Delete(vars RouteVars, r *http.Request) error {
+	endpoint, stats := e.pick(r)
+	deleter := deleterOf(endpoint)
+	if deleter == nil {
+		return methodNotAllowed(e, r)
+	}
+	start := time.Now()
+	err := deleter.Delete(vars, r)
+	e.c.record(stats, start, err)
+	return err
+}
+
+// Methods implements the MethodHandler interface.
+func (e *canaryEndpoint) Methods() []string {
+	return extensionMethods(e.stable)
+}
+
+// Handle implements the MethodHandler interface.
+func (e *canaryEndpoint) Handle(method string, vars RouteVars, r *http.Request) (Resource, error) {
+	endpoint, stats := e.pick(r)
+	handler, implemented := endpoint.(MethodHandler)
+	if !implemented {
+		return nil, methodNotAllowed(e, r)
+	}
+	start := time.Now()
+	resource, err := handler.Handle(method, vars, r)
+	e.c.record(stats, start, err)
+	return resource, err
+}
+
+// Validators implements the Validator interface.
+func (e *canaryEndpoint) Validators(vars RouteVars, r *http.Request) (string, time.Time, error) {
+	return validatorsOf(e.stable, vars, r)
+}
+
+// Authorize implements the Authorizer interface. Requests are authorized by the
+// stable endpoint, whichever endpoint serves them.
+func (e *canaryEndpoint) Authorize(method string, vars RouteVars, r *http.Request) error {
+	if authorizer, implemented := e.stable.(Authorizer); implemented {
+		return authorizer.Authorize(method, vars, r)
+	}
+	return nil
+}
+
+// Preflight implements the Preflighter interface.
+func (e *canaryEndpoint) Preflight(req *AccessControlRequest, vars RouteVars, r *http.Request) *AccessControlResponse {
+	if preflighter, implemented := e.stable.(Preflighter); implemented {
+		return preflighter.Preflight(req, vars, r)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package rst
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type failingEndpoint struct{}
+
+func (e *failingEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	return nil, InternalServerError("Failed", "", false)
+}
+
+func TestCanary(t *testing.T) {
+	canary := &Canary{
+		Weight: 0.5,
+		Predicate: func(r *http.Request) bool {
+			return r.Header.Get("X-Canary") == "always"
+		},
+		Key: func(r *http.Request) string {
+			return r.Header.Get("X-User")
+		},
+	}
+	mux := NewMux()
+	mux.HandleCanary("/docs/{id}", &versionedEndpoint{doc: &versionedDocument{}}, &failingEndpoint{}, canary)
+
+	get := func(header http.Header) int {
+		r, _ := http.NewRequest(Get, "/docs/1", nil)
+		for key, values := range header {
+			r.Header[key] = values
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	if code := get(http.Header{"X-Canary": {"always"}}); code != http.StatusInternalServerError {
+		t.Fatalf("predicate: got %d, wanted %d", code, http.StatusInternalServerError)
+	}
+
+	// Assignments by key are sticky.
+	for i := 0; i < 20; i++ {
+		header := http.Header{"X-User": {fmt.Sprintf("user-%d", i)}}
+		first := get(header)
+		for j := 0; j < 3; j++ {
+			if code := get(header); code != first {
+				t.Fatalf("user-%d: got %d, wanted %d", i, code, first)
+			}
+		}
+	}
+
+	stable, next := canary.Stats()
+	if stable.Requests+next.Requests != 81 {
+		t.Fatalf("got %d requests, wanted 81", stable.Requests+next.Requests)
+	}
+	if stable.Requests == 0 || next.Requests == 0 {
+		t.Fatalf("traffic wasn't split: stable=%d canary=%d", stable.Requests, next.Requests)
+	}
+	if stable.ErrorRate() != 0 || next.ErrorRate() != 1 {
+		t.Fatalf("got error rates %f and %f, wanted 0 and 1", stable.ErrorRate(), next.ErrorRate())
+	}
+
+	// Methods not implemented by the canary are served by the stable endpoint.
+	canary.Weight = 1
+	r, _ := http.NewRequest(Put, "/docs/1", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("put: got %d, wanted %d", w.Code, http.StatusOK)
+	}
+}
@@ -0,0 +1,231 @@
+/*
+Package capture records the traffic served by an HTTP handler, such as an rst
+mux, and replays it against another build of the service for regression
+testing. It can also mirror live traffic to a secondary service.
+
+	store, err := capture.NewFileStore("traffic.jsonl")
+	if err != nil {
+		log.Fatal(err)
+	}
+	recorder := capture.NewRecorder(store)
+	recorder.SampleRate = 0.01
+	recorder.RedactHeaders = []string{"Authorization", "Cookie"}
+	http.ListenAndServe(":8080", recorder.Handler(mux))
+
+The exchanges recorded in the store can later be replayed:
+
+	exchanges, _ := store.Exchanges()
+	replayer := &capture.Replayer{Target: "http://staging.example.com"}
+	for _, result := range replayer.Replay(exchanges) {
+		if !result.Matches() {
+			log.Println(result)
+		}
+	}
+*/
+package capture
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Redacted replaces the values removed from recorded exchanges.
+const Redacted = "REDACTED"
+
+// DefaultMaxBodySize is the default number of bytes of the bodies recorded in
+// an exchange.
+const DefaultMaxBodySize = 1 << 20
+
+// Exchange is a request and the response it received. Bodies longer than the
+// MaxBodySize of the recorder are truncated, and flagged as such.
+type Exchange struct {
+	Date              time.Time     `json:"date"`
+	Duration          time.Duration `json:"duration"`
+	Method            string        `json:"method"`
+	URL               string        `json:"url"`
+	RequestHeader     http.Header   `json:"requestHeader"`
+	RequestBody       []byte        `json:"requestBody,omitempty"`
+	RequestTruncated  bool          `json:"requestTruncated,omitempty"`
+	StatusCode        int           `json:"statusCode"`
+	ResponseHeader    http.Header   `json:"responseHeader"`
+	ResponseBody      []byte        `json:"responseBody,omitempty"`
+	ResponseTruncated bool          `json:"responseTruncated,omitempty"`
+}
+
+// Recorder saves a sample of the exchanges served by a handler in a store.
+type Recorder struct {
+	Store Store
+
+	// SampleRate is the fraction of requests recorded, between 0 and 1.
+	SampleRate float64
+
+	// MaxBodySize is the number of bytes of the bodies recorded. Longer
+	// bodies are truncated, and only the first MaxBodySize bytes of request
+	// bodies are buffered before the handler reads them. A negative value
+	// records whole bodies.
+	MaxBodySize int
+
+	// RedactHeaders lists the request and response headers whose values are
+	// replaced by Redacted.
+	RedactHeaders []string
+
+	// RedactQuery lists the parameters of the query string whose values are
+	// replaced by Redacted.
+	RedactQuery []string
+
+	// Redact is an optional function applying custom redaction rules, such as
+	// removing personal data from bodies, before an exchange is saved.
+	Redact func(exchange *Exchange)
+}
+
+// NewRecorder returns a recorder saving all exchanges in store.
+func NewRecorder(store Store) *Recorder {
+	return &Recorder{
+		Store:       store,
+		SampleRate:  1,
+		MaxBodySize: DefaultMaxBodySize,
+	}
+}
+
+// Handler returns a handler serving requests with h, and recording them.
+func (rec *Recorder) Handler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if rec.SampleRate <= 0 || rand.Float64() >= rec.SampleRate {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		exchange := &Exchange{
+			Date:          time.Now().UTC(),
+			Method:        r.Method,
+			URL:           r.URL.RequestURI(),
+			RequestHeader: cloneHeader(r.Header),
+		}
+		if r.Body != nil {
+			body, truncated := rec.capture(r)
+			exchange.RequestBody = body
+			exchange.RequestTruncated = truncated
+		}
+
+		cw := &capturingWriter{ResponseWriter: w, limit: rec.MaxBodySize}
+		h.ServeHTTP(cw, r)
+
+		exchange.Duration = time.Since(exchange.Date)
+		exchange.StatusCode = cw.status()
+		exchange.ResponseHeader = cloneHeader(w.Header())
+		exchange.ResponseBody = cw.body.Bytes()
+		exchange.ResponseTruncated = cw.truncated
+		rec.redact(exchange)
+		rec.Store.Save(exchange)
+	})
+}
+
+// capture returns the first bytes of the body of r, and true if the body is
+// longer than the bytes recorded. The body of r is replaced by a reader
+// returning the captured bytes, followed by the rest of the original body.
+func (rec *Recorder) capture(r *http.Request) ([]byte, bool) {
+	if rec.MaxBodySize < 0 {
+		body, _ := ioutil.ReadAll(r.Body)
+		r.Body.Close()
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		return body, false
+	}
+
+	b, _ := ioutil.ReadAll(io.LimitReader(r.Body, int64(rec.MaxBodySize)+1))
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(b), r.Body), r.Body}
+	if len(b) > rec.MaxBodySize {
+		return b[:rec.MaxBodySize], true
+	}
+	return b, false
+}
+
+// redact applies the redaction rules of rec to exchange.
+func (rec *Recorder) redact(exchange *Exchange) {
+	for _, name := range rec.RedactHeaders {
+		for _, header := range []http.Header{exchange.RequestHeader, exchange.ResponseHeader} {
+			if _, found := header[http.CanonicalHeaderKey(name)]; found {
+				header.Set(name, Redacted)
+			}
+		}
+	}
+
+	if len(rec.RedactQuery) > 0 {
+		if u, err := url.Parse(exchange.URL); err == nil {
+			query := u.Query()
+			for _, name := range rec.RedactQuery {
+				if _, found := query[name]; found {
+					query.Set(name, Redacted)
+				}
+			}
+			u.RawQuery = query.Encode()
+			exchange.URL = u.RequestURI()
+		}
+	}
+
+	if rec.Redact != nil {
+		rec.Redact(exchange)
+	}
+}
+
+func cloneHeader(header http.Header) http.Header {
+	cloned := make(http.Header)
+	for key, values := range header {
+		cloned[key] = append([]string(nil), values...)
+	}
+	return cloned
+}
+
+// capturingWriter copies the status code and the first bytes of the body
+// written in a response.
+type capturingWriter struct {
+	http.ResponseWriter
+	code      int
+	limit     int
+	body      bytes.Buffer
+	truncated bool
+}
+
+func (cw *capturingWriter) WriteHeader(code int) {
+	// Informational responses precede the final status code.
+	if cw.code == 0 && code >= http.StatusOK {
+		cw.code = code
+	}
+	cw.ResponseWriter.WriteHeader(code)
+}
+
+func (cw *capturingWriter) Write(b []byte) (int, error) {
+	if cw.code == 0 {
+		cw.code = http.StatusOK
+	}
+	if remaining := cw.limit - cw.body.Len(); cw.limit < 0 || len(b) <= remaining {
+		cw.body.Write(b)
+	} else {
+		if remaining > 0 {
+			cw.body.Write(b[:remaining])
+		}
+		cw.truncated = true
+	}
+	return cw.ResponseWriter.Write(b)
+}
+
+// Flush implements the http.Flusher interface.
+func (cw *capturingWriter) Flush() {
+	if flusher, ok := cw.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (cw *capturingWriter) status() int {
+	if cw.code == 0 {
+		return http.StatusOK
+	}
+	return cw.code
+}
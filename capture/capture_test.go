@@ -0,0 +1,143 @@
+package capture
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mohamedattahri/rst"
+)
+
+func newTestMux() *rst.Mux {
+	mux := rst.NewMux()
+	mux.Get("/people/{id}", func(vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+		return rst.NewEnvelope(map[string]string{"id": vars.Get("id")}, time.Now(), "etag", 0), nil
+	})
+	mux.Post("/people", func(vars rst.RouteVars, r *http.Request) (rst.Resource, string, error) {
+		b, _ := ioutil.ReadAll(r.Body)
+		return rst.NewEnvelope(map[string]string{"body": string(b)}, time.Now(), "etag", 0), "/people/2", nil
+	})
+	return mux
+}
+
+func TestRecorder(t *testing.T) {
+	store := NewMemoryStore()
+	recorder := NewRecorder(store)
+	recorder.RedactHeaders = []string{"Authorization"}
+	recorder.RedactQuery = []string{"token"}
+	recorder.Redact = func(exchange *Exchange) {
+		exchange.RequestBody = []byte(strings.Replace(string(exchange.RequestBody), "secret", "******", -1))
+	}
+	handler := recorder.Handler(newTestMux())
+
+	r, _ := http.NewRequest(rst.Post, "http://example.com/people?token=abc&page=1", strings.NewReader("my secret"))
+	r.Header.Set("Authorization", "Bearer abc")
+	r.Header.Set("Accept", "application/json")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	// The endpoint must still read the whole body.
+	if expected := `{"body":"my secret"}`; w.Body.String() != expected {
+		t.Fatalf("Got: %s Wanted: %s", w.Body.String(), expected)
+	}
+
+	exchanges, _ := store.Exchanges()
+	if len(exchanges) != 1 {
+		t.Fatalf("Got %d exchanges. Wanted 1", len(exchanges))
+	}
+	exchange := exchanges[0]
+	if exchange.StatusCode != http.StatusCreated || string(exchange.ResponseBody) != w.Body.String() {
+		t.Errorf("Got: %d %s", exchange.StatusCode, exchange.ResponseBody)
+	}
+	if exchange.RequestHeader.Get("Authorization") != Redacted {
+		t.Error("Authorization header was not redacted")
+	}
+	if exchange.URL != "/people?page=1&token=REDACTED" {
+		t.Errorf("Got URL: %s", exchange.URL)
+	}
+	if string(exchange.RequestBody) != "my ******" {
+		t.Errorf("Got body: %s", exchange.RequestBody)
+	}
+
+	recorder.SampleRate = 0
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	if exchanges, _ := store.Exchanges(); len(exchanges) != 1 {
+		t.Errorf("Got %d exchanges. Wanted 1", len(exchanges))
+	}
+}
+
+func TestRecorderTruncation(t *testing.T) {
+	store := NewMemoryStore()
+	recorder := NewRecorder(store)
+	recorder.MaxBodySize = 4
+	handler := recorder.Handler(newTestMux())
+
+	r, _ := http.NewRequest(rst.Post, "http://example.com/people", strings.NewReader("my secret"))
+	r.Header.Set("Accept", "application/json")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	// The endpoint must still read the whole body.
+	if expected := `{"body":"my secret"}`; w.Body.String() != expected {
+		t.Fatalf("Got: %s Wanted: %s", w.Body.String(), expected)
+	}
+
+	exchanges, _ := store.Exchanges()
+	exchange := exchanges[0]
+	if string(exchange.RequestBody) != "my s" || !exchange.RequestTruncated {
+		t.Errorf("Got request body: %q truncated: %v", exchange.RequestBody, exchange.RequestTruncated)
+	}
+	if string(exchange.ResponseBody) != `{"bo` || !exchange.ResponseTruncated {
+		t.Errorf("Got response body: %q truncated: %v", exchange.ResponseBody, exchange.ResponseTruncated)
+	}
+
+	server := httptest.NewServer(newTestMux())
+	defer server.Close()
+	if result := (&Replayer{Target: server.URL}).Replay(exchanges)[0]; result.Err != ErrTruncated || result.Matches() {
+		t.Errorf("Got: %v Wanted: %v", result.Err, ErrTruncated)
+	}
+}
+
+func TestRecorderInformational(t *testing.T) {
+	store := NewMemoryStore()
+	handler := NewRecorder(store).Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Link", "</style.css>; rel=preload")
+		w.WriteHeader(http.StatusEarlyHints)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+
+	r, _ := http.NewRequest(rst.Get, "http://example.com/people", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+
+	exchanges, _ := store.Exchanges()
+	if len(exchanges) != 1 || exchanges[0].StatusCode != http.StatusAccepted {
+		t.Fatalf("the final status code should be captured, got %+v", exchanges)
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "capture")
+	if err != nil {
+		t.Fatal(err)
+	}
+	store, err := NewFileStore(filepath.Join(dir, "traffic.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	for _, url := range []string{"/people/1", "/people/2"} {
+		store.Save(&Exchange{Method: rst.Get, URL: url, StatusCode: 200, ResponseBody: []byte("{}")})
+	}
+	exchanges, err := store.Exchanges()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exchanges) != 2 || exchanges[1].URL != "/people/2" || string(exchanges[1].ResponseBody) != "{}" {
+		t.Errorf("Got: %v", exchanges)
+	}
+}
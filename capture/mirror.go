@@ -0,0 +1,166 @@
+package capture
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// MirroredHeader is set in the requests sent by a Mirror, so that secondary
+// handlers can avoid side effects such as sending emails.
+const MirroredHeader = "X-Mirrored-Request"
+
+// Defaults of a Mirror.
+const (
+	DefaultMirrorConcurrency = 100
+	DefaultMirrorTimeout     = 10 * time.Second
+)
+
+/*
+Mirror asynchronously copies a sample of the requests served by a handler to a
+secondary handler or upstream, whose responses are discarded. It can be used to
+validate a rewritten service against production traffic.
+
+	mirror := capture.NewMirror("http://rewrite.internal:8080")
+	mirror.SampleRate = 0.05
+	http.ListenAndServe(":8080", mirror.Handler(mux))
+
+Requests whose body is larger than MaxBodySize are not mirrored, and requests
+are dropped when Concurrency mirrored requests are already in flight, so that
+the primary handler is never slowed down.
+*/
+type Mirror struct {
+	// Shadow is the secondary handler. If nil, requests are sent to
+	// Upstream.
+	Shadow http.Handler
+
+	// Upstream is the base URL of the secondary service, such as
+	// http://rewrite.internal:8080.
+	Upstream string
+
+	// HTTPClient sends the requests to Upstream. Defaults to
+	// http.DefaultClient.
+	HTTPClient *http.Client
+
+	// SampleRate is the fraction of requests mirrored, between 0 and 1.
+	SampleRate float64
+
+	// MaxBodySize is the size of the largest request body mirrored.
+	MaxBodySize int
+
+	// Timeout is the maximum duration of a mirrored request.
+	Timeout time.Duration
+
+	// Concurrency is the maximum number of mirrored requests in flight.
+	Concurrency int
+
+	sem chan struct{}
+}
+
+// NewMirror returns a mirror sending all requests to upstream.
+func NewMirror(upstream string) *Mirror {
+	return &Mirror{
+		Upstream:    strings.TrimRight(upstream, "/"),
+		SampleRate:  1,
+		MaxBodySize: DefaultMaxBodySize,
+		Timeout:     DefaultMirrorTimeout,
+		Concurrency: DefaultMirrorConcurrency,
+	}
+}
+
+// NewShadowMirror returns a mirror serving all requests with shadow.
+func NewShadowMirror(shadow http.Handler) *Mirror {
+	m := NewMirror("")
+	m.Shadow = shadow
+	return m
+}
+
+// Handler returns a handler serving requests with h, and mirroring them.
+func (m *Mirror) Handler(h http.Handler) http.Handler {
+	concurrency := m.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultMirrorConcurrency
+	}
+	m.sem = make(chan struct{}, concurrency)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if m.SampleRate > 0 && rand.Float64() < m.SampleRate {
+			m.mirror(r)
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// mirror sends a copy of r to the secondary handler in a new goroutine.
+func (m *Mirror) mirror(r *http.Request) {
+	var body []byte
+	if r.Body != nil {
+		b, err := ioutil.ReadAll(io.LimitReader(r.Body, int64(m.MaxBodySize)+1))
+		r.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(b), r.Body), r.Body}
+		if err != nil || len(b) > m.MaxBodySize {
+			return
+		}
+		body = b
+	}
+
+	select {
+	case m.sem <- struct{}{}:
+	default:
+		return // Too many mirrored requests in flight.
+	}
+
+	header := cloneHeader(r.Header)
+	header.Set(MirroredHeader, "1")
+	method, uri, host, remoteAddr := r.Method, r.URL.RequestURI(), r.Host, r.RemoteAddr
+
+	go func() {
+		defer func() { <-m.sem }()
+		defer func() { recover() }() // The secondary must never take the primary down.
+
+		ctx, cancel := context.WithTimeout(context.Background(), m.Timeout)
+		defer cancel()
+
+		if m.Shadow != nil {
+			req, err := http.NewRequest(method, uri, bytes.NewReader(body))
+			if err != nil {
+				return
+			}
+			req.Header, req.Host, req.RemoteAddr = header, host, remoteAddr
+			m.Shadow.ServeHTTP(&discardWriter{header: make(http.Header)}, req.WithContext(ctx))
+			return
+		}
+
+		req, err := http.NewRequest(method, m.Upstream+uri, bytes.NewReader(body))
+		if err != nil {
+			return
+		}
+		req.Header = header
+		client := m.HTTPClient
+		if client == nil {
+			client = http.DefaultClient
+		}
+		resp, err := client.Do(req.WithContext(ctx))
+		if err != nil {
+			return
+		}
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+	}()
+}
+
+// discardWriter is a ResponseWriter discarding what's written to it.
+type discardWriter struct {
+	header http.Header
+}
+
+func (w *discardWriter) Header() http.Header         { return w.header }
+func (w *discardWriter) Write(b []byte) (int, error) { return len(b), nil }
+func (w *discardWriter) WriteHeader(int)             {}
@@ -0,0 +1,64 @@
+package capture
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mohamedattahri/rst"
+)
+
+func TestMirror(t *testing.T) {
+	type mirrored struct {
+		method, uri, body, header string
+	}
+	received := make(chan *mirrored, 10)
+	shadow := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		received <- &mirrored{r.Method, r.URL.RequestURI(), string(b), r.Header.Get(MirroredHeader)}
+		w.Write([]byte("discarded"))
+	})
+
+	var test = func(m *Mirror, body string, expectMirror bool) {
+		handler := m.Handler(newTestMux())
+		r, _ := http.NewRequest(rst.Post, "http://example.com/people?page=1", strings.NewReader(body))
+		r.Header.Set("Accept", "application/json")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+
+		// The primary handler must receive the whole body.
+		if expected := `{"body":"` + body + `"}`; w.Body.String() != expected {
+			t.Errorf("Got: %s Wanted: %s", w.Body.String(), expected)
+		}
+
+		select {
+		case got := <-received:
+			if !expectMirror {
+				t.Error("Unexpected mirrored request")
+			} else if got.method != rst.Post || got.uri != "/people?page=1" || got.body != body || got.header != "1" {
+				t.Errorf("Got: %#v", got)
+			}
+		case <-time.After(100 * time.Millisecond):
+			if expectMirror {
+				t.Error("Request was not mirrored")
+			}
+		}
+	}
+
+	test(NewShadowMirror(shadow), "hello", true)
+
+	m := NewShadowMirror(shadow)
+	m.MaxBodySize = 3
+	test(m, "hello", false)
+
+	m = NewShadowMirror(shadow)
+	m.SampleRate = 0
+	test(m, "hello", false)
+
+	upstream := httptest.NewServer(shadow)
+	defer upstream.Close()
+	test(NewMirror(upstream.URL), "world", true)
+}
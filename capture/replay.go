@@ -0,0 +1,103 @@
+package capture
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// ErrTruncated is the error of the results of exchanges whose request body was
+// truncated when it was recorded, which aren't replayed.
+var ErrTruncated = errors.New("capture: the recorded request body is truncated")
+
+// Replayer re-issues recorded exchanges against a service.
+type Replayer struct {
+	// Target is the base URL of the service, such as
+	// http://staging.example.com.
+	Target string
+
+	// HTTPClient sends the requests. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	// IgnoreBody disables the comparison of response bodies.
+	IgnoreBody bool
+}
+
+// Result is the outcome of the replay of an exchange.
+type Result struct {
+	Exchange   *Exchange
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	Err        error
+	ignoreBody bool
+}
+
+// Matches returns true if the response to the replayed request has the same
+// status code, and unless the replayer ignores them, the same body as the
+// recorded response. The bodies of responses recorded compressed are not
+// compared.
+func (r *Result) Matches() bool {
+	if r.Err != nil || r.StatusCode != r.Exchange.StatusCode {
+		return false
+	}
+	if r.ignoreBody || len(r.Exchange.ResponseBody) == 0 || r.Exchange.ResponseHeader.Get("Content-Encoding") != "" {
+		return true
+	}
+	return bytes.HasPrefix(r.Body, r.Exchange.ResponseBody)
+}
+
+func (r *Result) String() string {
+	if r.Err != nil {
+		return fmt.Sprintf("%s %s: %s", r.Exchange.Method, r.Exchange.URL, r.Err)
+	}
+	return fmt.Sprintf("%s %s: got %d (%d bytes), recorded %d (%d bytes)",
+		r.Exchange.Method, r.Exchange.URL,
+		r.StatusCode, len(r.Body), r.Exchange.StatusCode, len(r.Exchange.ResponseBody))
+}
+
+// Replay issues the requests of exchanges in order, and returns their results.
+func (rp *Replayer) Replay(exchanges []*Exchange) []*Result {
+	results := make([]*Result, len(exchanges))
+	for i, exchange := range exchanges {
+		results[i] = rp.replay(exchange)
+	}
+	return results
+}
+
+func (rp *Replayer) replay(exchange *Exchange) *Result {
+	result := &Result{Exchange: exchange, ignoreBody: rp.IgnoreBody}
+	if exchange.RequestTruncated {
+		result.Err = ErrTruncated
+		return result
+	}
+
+	req, err := http.NewRequest(exchange.Method, strings.TrimRight(rp.Target, "/")+exchange.URL, bytes.NewReader(exchange.RequestBody))
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	req.Header = cloneHeader(exchange.RequestHeader)
+
+	// Compression is left to the transport, so that bodies can be compared.
+	req.Header.Del("Accept-Encoding")
+
+	client := rp.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	defer resp.Body.Close()
+
+	result.StatusCode = resp.StatusCode
+	result.Header = resp.Header
+	result.Body, result.Err = ioutil.ReadAll(resp.Body)
+	return result
+}
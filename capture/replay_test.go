@@ -0,0 +1,49 @@
+package capture
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mohamedattahri/rst"
+)
+
+func TestReplayer(t *testing.T) {
+	store := NewMemoryStore()
+	recorder := recorderFor(store)
+	for _, url := range []string{"/people/1", "/people/2"} {
+		r, _ := http.NewRequest(rst.Get, "http://example.com"+url, nil)
+		r.Header.Set("Accept", "application/json")
+		recorder.ServeHTTP(httptest.NewRecorder(), r)
+	}
+	exchanges, _ := store.Exchanges()
+
+	// Same build.
+	server := httptest.NewServer(newTestMux())
+	defer server.Close()
+	for _, result := range (&Replayer{Target: server.URL}).Replay(exchanges) {
+		if !result.Matches() {
+			t.Error("Unexpected mismatch:", result)
+		}
+	}
+
+	// New build returning different payloads.
+	changed := rst.NewMux()
+	changed.Get("/people/{id}", func(vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+		if vars.Get("id") == "2" {
+			return nil, rst.NotFound()
+		}
+		return nil, nil
+	})
+	server2 := httptest.NewServer(changed)
+	defer server2.Close()
+	for _, result := range (&Replayer{Target: server2.URL}).Replay(exchanges) {
+		if result.Matches() {
+			t.Error("Unexpected match:", result)
+		}
+	}
+}
+
+func recorderFor(store Store) http.Handler {
+	return NewRecorder(store).Handler(newTestMux())
+}
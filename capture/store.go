@@ -0,0 +1,97 @@
+package capture
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"sync"
+)
+
+// Store saves recorded exchanges.
+type Store interface {
+	Save(exchange *Exchange) error
+	Exchanges() ([]*Exchange, error)
+}
+
+// NewMemoryStore returns a store keeping exchanges in memory.
+func NewMemoryStore() Store {
+	return &memoryStore{}
+}
+
+type memoryStore struct {
+	mu        sync.RWMutex
+	exchanges []*Exchange
+}
+
+func (s *memoryStore) Save(exchange *Exchange) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.exchanges = append(s.exchanges, exchange)
+	return nil
+}
+
+func (s *memoryStore) Exchanges() ([]*Exchange, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]*Exchange(nil), s.exchanges...), nil
+}
+
+// NewFileStore returns a store appending exchanges to the file at path, one
+// JSON document per line. The file is created if it doesn't exist.
+func NewFileStore(path string) (*FileStore, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &FileStore{path: path, f: f}, nil
+}
+
+// FileStore is a store saving exchanges in a file.
+type FileStore struct {
+	mu   sync.Mutex
+	path string
+	f    *os.File
+}
+
+// Save implements the Store interface.
+func (s *FileStore) Save(exchange *Exchange) error {
+	b, err := json.Marshal(exchange)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.f.Write(append(b, '\n'))
+	return err
+}
+
+// Exchanges implements the Store interface.
+func (s *FileStore) Exchanges() ([]*Exchange, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.Open(s.path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var exchanges []*Exchange
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 4*DefaultMaxBodySize)
+	for scanner.Scan() {
+		exchange := &Exchange{}
+		if err := json.Unmarshal(scanner.Bytes(), exchange); err != nil {
+			return nil, err
+		}
+		exchanges = append(exchanges, exchange)
+	}
+	return exchanges, scanner.Err()
+}
+
+// Close closes the file of the store.
+func (s *FileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.f.Close()
+}
@@ -0,0 +1,79 @@
+package rst
+
+import "net/http"
+
+/*
+ErrorCatalog translates the messages of the standard errors of rst in the
+language negotiated with the Accept-Language header of the requests.
+
+	type catalog map[string]map[int][2]string
+
+	func (c catalog) Languages() []string {
+		return []string{"en", "fr", "de"}
+	}
+
+	func (c catalog) Translate(lang string, err *rst.Error) (string, string, bool) {
+		if err.Code == http.StatusMethodNotAllowed {
+			return "Méthode non autorisée", "Méthodes autorisées : " + err.Header.Get("Allow"), lang == "fr"
+		}
+		m, ok := c[lang][err.Code]
+		return m[0], m[1], ok
+	}
+
+The standard errors are the ones returned by NotFound, MethodNotAllowed,
+UnsupportedMediaType, and InternalServerError when its reason is the status
+text of 500 and its description is empty, as in the errors written after a
+panic, as long as their message hasn't been changed.
+*/
+type ErrorCatalog interface {
+	// Languages returns the tags of the languages of the catalog, by order of
+	// preference. The first tag is used when no language in the request can
+	// be matched.
+	Languages() []string
+
+	// Translate returns the reason and the description of err in lang, and
+	// false if the catalog has no translation for err.
+	Translate(lang string, err *Error) (reason, description string, ok bool)
+}
+
+// SetErrorCatalog sets the catalog translating the messages of the standard
+// errors written by the mux. The Content-Language header of translated errors
+// is set to their language, and Accept-Language is added to their Vary header.
+// A nil value disables translations, which is the default.
+func (s *Mux) SetErrorCatalog(c ErrorCatalog) {
+	s.errorCatalog = c
+}
+
+// standardize marks the message of e as a standard one, which can be
+// translated by the ErrorCatalog of the mux.
+func (e *Error) standardize() *Error {
+	e.standard = e.Reason + "\n" + e.Description
+	return e
+}
+
+// localize returns e translated by the ErrorCatalog of the mux in the language
+// negotiated with r, or e if it can't be translated.
+func (e *Error) localize(r *http.Request) *Error {
+	catalog := getMux(r).errorCatalog
+	if catalog == nil || e.standard == "" || e.standard != e.Reason+"\n"+e.Description {
+		return e
+	}
+	lang := ParseAcceptLanguage(r.Header.Get("Accept-Language")).Negotiate(catalog.Languages()...)
+	if lang == "" {
+		return e
+	}
+	reason, description, ok := catalog.Translate(lang, e)
+	if !ok {
+		return e
+	}
+
+	localized := *e
+	localized.Reason, localized.Description = reason, description
+	localized.Header = e.Header.Clone()
+	if localized.Header == nil {
+		localized.Header = make(http.Header)
+	}
+	localized.Header.Set("Content-Language", lang)
+	addVary(localized.Header, "Accept-Language")
+	return &localized
+}
@@ -0,0 +1,62 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// frenchCatalog translates the messages of 404 and 405 errors in French.
+type frenchCatalog struct{}
+
+func (c frenchCatalog) Languages() []string {
+	return []string{"en", "fr"}
+}
+
+func (c frenchCatalog) Translate(lang string, err *Error) (string, string, bool) {
+	if lang != "fr" {
+		return "", "", false
+	}
+	switch err.Code {
+	case http.StatusNotFound:
+		return "Introuvable", "Aucune ressource à cette adresse.", true
+	case http.StatusMethodNotAllowed:
+		return "Méthode non autorisée", "Méthodes autorisées : " + err.Header.Get("Allow"), true
+	}
+	return "", "", false
+}
+
+func TestErrorCatalog(t *testing.T) {
+	mux := NewMux()
+	mux.SetErrorCatalog(frenchCatalog{})
+	mux.Get("/people/{id}", func(vars RouteVars, r *http.Request) (Resource, error) {
+		if vars.Get("id") == "custom" {
+			err := NotFound()
+			err.Description = "This person doesn't exist."
+			return nil, err
+		}
+		return nil, NotFound()
+	})
+
+	var test = func(method, path, lang string, code int, language, message string) {
+		r, _ := http.NewRequest(method, "http://www.example.com"+path, nil)
+		r.Header.Set("Accept", "application/json")
+		r.Header.Set("Accept-Language", lang)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != code {
+			t.Fatalf("%s %s: Got: %d Wanted: %d", method, path, w.Code, code)
+		}
+		if got := w.Header().Get("Content-Language"); got != language {
+			t.Errorf("%s %s (%s): Content-Language is %q, wanted %q", method, path, lang, got, language)
+		}
+		if !strings.Contains(w.Body.String(), message) {
+			t.Errorf("%s %s (%s): Got: %s Wanted: %s", method, path, lang, w.Body.String(), message)
+		}
+	}
+	test(Get, "/people/1", "fr-CA, en;q=0.5", http.StatusNotFound, "fr", "Aucune ressource à cette adresse.")
+	test(Get, "/people/1", "en", http.StatusNotFound, "", "No resource could be found")
+	test(Post, "/people/1", "fr", http.StatusMethodNotAllowed, "fr", "Méthodes autorisées : HEAD, GET")
+	test(Get, "/people/custom", "fr", http.StatusNotFound, "", "This person doesn't exist.")
+}
@@ -0,0 +1,87 @@
+package rst
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"sort"
+)
+
+// CBORType is the media type of CBOR payloads.
+const CBORType = "application/cbor"
+
+// CBOR major types.
+const (
+	cborUnsigned byte = iota << 5
+	cborNegative
+	cborBytes
+	cborText
+	cborArray
+	cborMap
+)
+
+// writeCBOR writes the CBOR encoding of v, a value decoded from JSON, in
+// buffer. The keys of maps are sorted by length first, then in increasing
+// order, as required by the canonical encoding of RFC 7049.
+func writeCBOR(buffer *bytes.Buffer, v interface{}) {
+	switch v := v.(type) {
+	case nil:
+		buffer.WriteByte(0xf6)
+	case bool:
+		if v {
+			buffer.WriteByte(0xf5)
+		} else {
+			buffer.WriteByte(0xf4)
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			if i >= 0 {
+				writeCBORHead(buffer, cborUnsigned, uint64(i))
+			} else {
+				writeCBORHead(buffer, cborNegative, uint64(-1-i))
+			}
+			return
+		}
+		f, _ := v.Float64()
+		buffer.WriteByte(0xfb)
+		binary.Write(buffer, binary.BigEndian, math.Float64bits(f))
+	case string:
+		writeCBORHead(buffer, cborText, uint64(len(v)))
+		buffer.WriteString(v)
+	case []interface{}:
+		writeCBORHead(buffer, cborArray, uint64(len(v)))
+		for _, item := range v {
+			writeCBOR(buffer, item)
+		}
+	case map[string]interface{}:
+		keys := sortedKeys(v)
+		sort.SliceStable(keys, func(i, j int) bool { return len(keys[i]) < len(keys[j]) })
+		writeCBORHead(buffer, cborMap, uint64(len(v)))
+		for _, key := range keys {
+			writeCBOR(buffer, key)
+			writeCBOR(buffer, v[key])
+		}
+	}
+}
+
+// writeCBORHead writes the initial bytes of a data item of the given major type
+// and argument n.
+func writeCBORHead(buffer *bytes.Buffer, major byte, n uint64) {
+	switch {
+	case n < 24:
+		buffer.WriteByte(major | byte(n))
+	case n <= math.MaxUint8:
+		buffer.WriteByte(major | 24)
+		buffer.WriteByte(byte(n))
+	case n <= math.MaxUint16:
+		buffer.WriteByte(major | 25)
+		binary.Write(buffer, binary.BigEndian, uint16(n))
+	case n <= math.MaxUint32:
+		buffer.WriteByte(major | 26)
+		binary.Write(buffer, binary.BigEndian, uint32(n))
+	default:
+		buffer.WriteByte(major | 27)
+		binary.Write(buffer, binary.BigEndian, n)
+	}
+}
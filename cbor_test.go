@@ -0,0 +1,60 @@
+package rst
+
+import (
+	"bytes"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWriteCBOR(t *testing.T) {
+	var test = func(doc, expected string) {
+		var v interface{}
+		if err := decodeJSON([]byte(doc), &v); err != nil {
+			t.Fatal(err)
+		}
+		buffer := &bytes.Buffer{}
+		writeCBOR(buffer, v)
+		if got := hex.EncodeToString(buffer.Bytes()); got != expected {
+			t.Errorf("%s: Got: %s Wanted: %s", doc, got, expected)
+		}
+	}
+
+	// Examples of appendix A of RFC 7049.
+	test(`null`, "f6")
+	test(`true`, "f5")
+	test(`false`, "f4")
+	test(`0`, "00")
+	test(`23`, "17")
+	test(`24`, "1818")
+	test(`1000`, "1903e8")
+	test(`1000000`, "1a000f4240")
+	test(`1000000000000`, "1b000000e8d4a51000")
+	test(`-1`, "20")
+	test(`-1000`, "3903e7")
+	test(`1.1`, "fb3ff199999999999a")
+	test(`"IETF"`, "6449455446")
+	test(`[1,[2,3],[4,5]]`, "8301820203820405")
+	test(`{"a":1,"b":[2,3]}`, "a26161016162820203")
+	test(`{"bb":1,"c":2}`, "a261630262626201")
+}
+
+func TestCBORNegotiation(t *testing.T) {
+	mux := NewMux()
+	mux.Get("/", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(&viewResource{"Francis"}, time.Now(), "etag", 0), nil
+	})
+
+	r, _ := http.NewRequest(Get, "/", nil)
+	r.Header.Set("Accept", "application/json;q=0.5, "+CBORType)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if ct := w.Header().Get("Content-Type"); ct != CBORType {
+		t.Errorf("Content-Type: Got: %s Wanted: %s", ct, CBORType)
+	}
+	if expected := "a1644e616d65674672616e636973"; hex.EncodeToString(w.Body.Bytes()) != expected {
+		t.Errorf("Got: %x Wanted: %s", w.Body.Bytes(), expected)
+	}
+}
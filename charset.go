@@ -0,0 +1,122 @@
+package rst
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// CharsetEncoder transcodes UTF-8 text to another charset.
+type CharsetEncoder func(b []byte) ([]byte, error)
+
+var errUnrepresentable = errors.New("rst: text can't be represented in charset")
+
+const utf8Charset = "utf-8"
+
+var (
+	charsetsMu sync.RWMutex
+	charsets   = []string{utf8Charset, "iso-8859-1", "us-ascii"}
+	encodings  = map[string]CharsetEncoder{
+		"iso-8859-1": singleByteEncoder(0xff),
+		"us-ascii":   singleByteEncoder(0x7f),
+	}
+)
+
+/*
+RegisterCharset associates encode with charset, so that text representations
+encoded in UTF-8 can be transcoded to charset when it's requested with the
+Accept-Charset header.
+
+ISO-8859-1 and US-ASCII are supported out of the box. Other charsets can be
+added with the encoders of golang.org/x/text:
+
+	rst.RegisterCharset("windows-1252", charmap.Windows1252.NewEncoder().Bytes)
+
+A nil encode removes charset.
+
+Only text/* representations are transcoded. Marshalers serving legacy content
+in another charset than UTF-8 declare it in the charset parameter of the
+Content-Type they return, and their representations are sent as is.
+*/
+func RegisterCharset(charset string, encode CharsetEncoder) {
+	charsetsMu.Lock()
+	defer charsetsMu.Unlock()
+
+	charset = strings.ToLower(charset)
+	if charset == utf8Charset {
+		return
+	}
+	if _, exists := encodings[charset]; !exists && encode != nil {
+		charsets = append(charsets, charset)
+	}
+	if encode == nil {
+		delete(encodings, charset)
+		for i, c := range charsets {
+			if c == charset {
+				charsets = append(charsets[:i:i], charsets[i+1:]...)
+				break
+			}
+		}
+		return
+	}
+	encodings[charset] = encode
+}
+
+// singleByteEncoder returns a CharsetEncoder to the charsets whose code points
+// match the first max + 1 code points of Unicode, such as ISO-8859-1.
+func singleByteEncoder(max rune) CharsetEncoder {
+	return func(b []byte) ([]byte, error) {
+		encoded := make([]byte, 0, len(b))
+		for len(b) > 0 {
+			r, size := utf8.DecodeRune(b)
+			if r == utf8.RuneError && size <= 1 || r > max {
+				return nil, errUnrepresentable
+			}
+			encoded = append(encoded, byte(r))
+			b = b[size:]
+		}
+		return encoded, nil
+	}
+}
+
+/*
+negotiateCharset transcodes b, a text representation encoded in UTF-8, to the
+charset negotiated with the Accept-Charset header of r, and returns its new
+content type.
+
+Representations which can't be transcoded to the negotiated charset are left in
+UTF-8, and so are those for which no charset is acceptable.
+*/
+func negotiateCharset(contentType string, b []byte, header http.Header, r *http.Request) (string, []byte) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || !strings.HasPrefix(mediaType, "text/") {
+		return contentType, b
+	}
+	if charset, ok := params["charset"]; ok && !strings.EqualFold(charset, utf8Charset) {
+		return contentType, b
+	}
+	addVary(header, "Accept-Charset")
+
+	raw := r.Header.Get("Accept-Charset")
+	if raw == "" {
+		return contentType, b
+	}
+
+	charsetsMu.RLock()
+	charset := ParseAcceptCharset(raw).Negotiate(charsets...)
+	encode := encodings[charset]
+	charsetsMu.RUnlock()
+
+	if encode == nil {
+		return contentType, b
+	}
+	encoded, err := encode(b)
+	if err != nil {
+		return contentType, b
+	}
+	params["charset"] = charset
+	return mime.FormatMediaType(mediaType, params), encoded
+}
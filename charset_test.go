@@ -0,0 +1,87 @@
+package rst
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type textResource struct {
+	text string
+}
+
+func (t *textResource) String() string { return t.text }
+
+func TestNegotiateCharset(t *testing.T) {
+	mux := NewMux()
+	mux.Get("/", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(&textResource{"café"}, time.Now(), "etag", 0), nil
+	})
+
+	var test = func(acceptCharset, contentType string, body []byte) {
+		r, _ := http.NewRequest(Get, "/", nil)
+		r.Header.Set("Accept", "text/plain")
+		r.Header.Set("Accept-Charset", acceptCharset)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if ct := w.Header().Get("Content-Type"); ct != contentType {
+			t.Errorf("%s: Content-Type: Got: %s Wanted: %s", acceptCharset, ct, contentType)
+		}
+		if !bytes.Equal(w.Body.Bytes(), body) {
+			t.Errorf("%s: Got: %q Wanted: %q", acceptCharset, w.Body.Bytes(), body)
+		}
+		if !strings.Contains(strings.Join(w.Header()["Vary"], ", "), "Accept-Charset") {
+			t.Errorf("%s: Accept-Charset missing from Vary header", acceptCharset)
+		}
+	}
+
+	test("", "text/plain; charset=utf-8", []byte("café"))
+	test("utf-8, iso-8859-1;q=0.5", "text/plain; charset=utf-8", []byte("café"))
+	test("iso-8859-1, utf-8;q=0.5", "text/plain; charset=iso-8859-1", []byte("caf\xe9"))
+	test("ISO-8859-1", "text/plain; charset=iso-8859-1", []byte("caf\xe9"))
+
+	// Text that can't be represented in the charset is left in UTF-8.
+	test("us-ascii", "text/plain; charset=utf-8", []byte("café"))
+
+	// No acceptable charset.
+	test("windows-1252", "text/plain; charset=utf-8", []byte("café"))
+}
+
+func TestRegisterCharset(t *testing.T) {
+	RegisterCharset("x-upper", func(b []byte) ([]byte, error) {
+		return bytes.ToUpper(b), nil
+	})
+	defer RegisterCharset("x-upper", nil)
+
+	r, _ := http.NewRequest(Get, "/", nil)
+	r.Header.Set("Accept-Charset", "X-Upper")
+	header := make(http.Header)
+	contentType, b := negotiateCharset("text/csv", []byte("a,b"), header, r)
+	if contentType != "text/csv; charset=x-upper" {
+		t.Errorf("Content-Type: Got: %s Wanted: text/csv; charset=x-upper", contentType)
+	}
+	if string(b) != "A,B" {
+		t.Errorf("Got: %s Wanted: A,B", string(b))
+	}
+}
+
+func TestNegotiateCharsetIgnored(t *testing.T) {
+	r, _ := http.NewRequest(Get, "/", nil)
+	r.Header.Set("Accept-Charset", "iso-8859-1")
+
+	var test = func(contentType string) {
+		header := make(http.Header)
+		ct, b := negotiateCharset(contentType, []byte("café"), header, r)
+		if ct != contentType || string(b) != "café" {
+			t.Errorf("%s: Got: %s %q", contentType, ct, b)
+		}
+	}
+
+	// JSON is always encoded in UTF-8.
+	test("application/json; charset=utf-8")
+	// Legacy content declaring its charset is sent as is.
+	test("text/plain; charset=windows-1252")
+}
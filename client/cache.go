@@ -0,0 +1,49 @@
+package client
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Cache stores the responses to GET requests, so that they can be
+// revalidated.
+type Cache interface {
+	Get(key string) (*Response, error)
+	Set(key string, resp *Response) error
+}
+
+// NewCache returns a Cache that keeps responses in memory.
+func NewCache() Cache {
+	return &memoryCache{responses: make(map[string]*Response)}
+}
+
+type memoryCache struct {
+	mu        sync.RWMutex
+	responses map[string]*Response
+}
+
+func (c *memoryCache) Get(key string) (*Response, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.responses[key], nil
+}
+
+func (c *memoryCache) Set(key string, resp *Response) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.responses[key] = resp
+	return nil
+}
+
+// cacheKey returns the key of the response to a GET request to url with the
+// given headers. The headers which take part in the negotiation of the
+// representation are part of the key.
+func cacheKey(url string, header http.Header) string {
+	return strings.Join([]string{
+		url,
+		header.Get("Accept"),
+		header.Get("Accept-Language"),
+		header.Get("Range"),
+	}, "\n")
+}
@@ -0,0 +1,296 @@
+/*
+Package client implements a client for REST services built with rst.
+
+The client speaks the conventions of the framework: GET requests are
+revalidated with the ETag of a local cache, collections supporting range
+requests can be iterated page by page, payloads are negotiated and decoded
+with the registered decoders, errors are decoded into an *Error which unwraps
+to an *rst.Error, and requests rejected with a Retry-After header are retried.
+
+	c := client.New("https://api.example.com")
+
+	person := &Person{}
+	if _, err := c.Get("/people/1", person); err != nil {
+		if e, ok := err.(*client.Error); ok && e.StatusCode == http.StatusNotFound {
+			// ...
+		}
+		return err
+	}
+*/
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mohamedattahri/rst"
+)
+
+// Defaults of a Client. DefaultAccept is the Accept header of the built-in
+// decoders.
+const (
+	DefaultAccept       = "application/json, application/xml;q=0.9, text/plain;q=0.8"
+	DefaultMaxRetries   = 3
+	DefaultMaxRetryWait = 30 * time.Second
+)
+
+// Client sends requests to a REST service built with rst.
+type Client struct {
+	// BaseURL is prepended to the paths of requests.
+	BaseURL string
+
+	// HTTPClient sends the requests. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	// Header contains the headers added to all requests, such as
+	// Authorization.
+	Header http.Header
+
+	// Cache stores the responses to GET requests to revalidate them with
+	// If-None-Match. A nil value disables caching.
+	Cache Cache
+
+	// MaxRetries is the number of times a request responded to with a
+	// Retry-After header is retried.
+	MaxRetries int
+
+	// MaxRetryWait is the longest duration the client accepts to wait before
+	// retrying a request.
+	MaxRetryWait time.Duration
+}
+
+// New returns a client for the service at baseURL, with an in-memory cache.
+// Its Accept header lists the media types of the registered decoders.
+func New(baseURL string) *Client {
+	header := make(http.Header)
+	header.Set("Accept", Accept())
+	return &Client{
+		BaseURL:      strings.TrimRight(baseURL, "/"),
+		Header:       header,
+		Cache:        NewCache(),
+		MaxRetries:   DefaultMaxRetries,
+		MaxRetryWait: DefaultMaxRetryWait,
+	}
+}
+
+// Response describes the response to a request.
+type Response struct {
+	StatusCode   int
+	Header       http.Header
+	ETag         string
+	LastModified time.Time
+	ContentRange *rst.ContentRange // Set for partial responses.
+	Cached       bool              // True if the payload was read from the cache.
+	Body         []byte
+}
+
+func newResponse(resp *http.Response, body []byte) *Response {
+	r := &Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		ETag:       resp.Header.Get("ETag"),
+		Body:       body,
+	}
+	r.LastModified, _ = http.ParseTime(resp.Header.Get("Last-Modified"))
+	if cr := resp.Header.Get("Content-Range"); cr != "" {
+		r.ContentRange, _ = rst.ParseContentRange(cr)
+	}
+	return r
+}
+
+// Decode decodes the payload of r in v with the decoder registered for its
+// Content-Type.
+//
+// JSON and XML payloads are decoded with encoding/json and encoding/xml by
+// default. Plain text payloads can be decoded in a *string or a *[]byte.
+func (r *Response) Decode(v interface{}) error {
+	if v == nil || len(r.Body) == 0 {
+		return nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if decode := lookupDecoder(mediaType); decode != nil {
+		return decode(r.Body, v)
+	}
+	return fmt.Errorf("client: can't decode %s payload in %T", mediaType, v)
+}
+
+// Get retrieves the resource at path and decodes it in v, which can be nil.
+// The response is revalidated with the version stored in the cache, if any.
+func (c *Client) Get(path string, v interface{}) (*Response, error) {
+	return c.Do(rst.Get, path, nil, nil, v)
+}
+
+// Post encodes body in JSON, posts it to path, and decodes the response in v.
+func (c *Client) Post(path string, body, v interface{}) (*Response, error) {
+	return c.send(rst.Post, path, body, v)
+}
+
+// Put encodes body in JSON, puts it at path, and decodes the response in v.
+func (c *Client) Put(path string, body, v interface{}) (*Response, error) {
+	return c.send(rst.Put, path, body, v)
+}
+
+// Patch encodes body in JSON, sends it to path, and decodes the response in
+// v.
+func (c *Client) Patch(path string, body, v interface{}) (*Response, error) {
+	return c.send(rst.Patch, path, body, v)
+}
+
+// Delete deletes the resource at path.
+func (c *Client) Delete(path string) (*Response, error) {
+	return c.Do(rst.Delete, path, nil, nil, nil)
+}
+
+func (c *Client) send(method, path string, body, v interface{}) (*Response, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+	return c.Do(method, path, header, b, v)
+}
+
+/*
+Do sends a request with the given method, headers and body to path, and
+decodes the payload of the response in v, which can be nil.
+
+GET requests are revalidated with the cache of the client. Responses with a
+status code >= 400 are returned as an *Error. Requests receiving status code
+429 Too Many Requests, or 503 Service Unavailable for idempotent methods, are
+retried after the duration of their Retry-After header.
+*/
+func (c *Client) Do(method, path string, header http.Header, body []byte, v interface{}) (*Response, error) {
+	url := c.BaseURL + path
+	key := cacheKey(url, c.header(header))
+	caching := method == rst.Get && c.Cache != nil
+
+	var cached *Response
+	if caching {
+		if cached, _ = c.Cache.Get(key); cached != nil {
+			if expires, err := http.ParseTime(cached.Header.Get("Expires")); err == nil && time.Now().Before(expires) {
+				resp := *cached
+				resp.Cached = true
+				return &resp, resp.Decode(v)
+			}
+			header = mergeHeader(header, nil)
+			if cached.ETag != "" {
+				header.Set("If-None-Match", cached.ETag)
+			} else if !cached.LastModified.IsZero() {
+				header.Set("If-Modified-Since", cached.LastModified.UTC().Format(http.TimeFormat))
+			}
+		}
+	}
+
+	resp, err := c.do(method, url, header, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		refreshed := *cached
+		refreshed.Header = mergeHeader(cached.Header, resp.Header)
+		c.Cache.Set(key, &refreshed)
+
+		result := refreshed
+		result.Cached = true
+		return &result, result.Decode(v)
+	}
+	if resp.StatusCode >= 400 {
+		return resp, decodeError(resp)
+	}
+	if caching && resp.StatusCode == http.StatusOK && resp.ETag != "" {
+		c.Cache.Set(key, resp)
+	}
+	return resp, resp.Decode(v)
+}
+
+// header returns the headers of c merged with header.
+func (c *Client) header(header http.Header) http.Header {
+	return mergeHeader(c.Header, header)
+}
+
+// mergeHeader returns a copy of a in which the values of b have been set.
+func mergeHeader(a, b http.Header) http.Header {
+	merged := make(http.Header)
+	for key, values := range a {
+		merged[key] = append([]string(nil), values...)
+	}
+	for key, values := range b {
+		merged[key] = append([]string(nil), values...)
+	}
+	return merged
+}
+
+// do sends the request, and retries it when the response has a Retry-After
+// header.
+func (c *Client) do(method, url string, header http.Header, body []byte) (*Response, error) {
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequest(method, url, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		req.Header = c.header(header)
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		b, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		wait, retry := retryAfter(resp, method)
+		if !retry {
+			return newResponse(resp, b), nil
+		}
+		if attempt >= c.MaxRetries || wait > c.MaxRetryWait {
+			return newResponse(resp, b), nil
+		}
+		time.Sleep(wait)
+	}
+}
+
+// retryAfter returns the duration to wait before retrying the request which
+// received resp, and true if it can be retried.
+func retryAfter(resp *http.Response, method string) (time.Duration, bool) {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+	case http.StatusServiceUnavailable:
+		if method == rst.Post || method == rst.Patch {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	raw := resp.Header.Get("Retry-After")
+	if raw == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(raw); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(raw); err == nil {
+		if wait := date.Sub(time.Now()); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
@@ -0,0 +1,110 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mohamedattahri/rst"
+)
+
+type person struct {
+	ID   string `json:"id" xml:"ID"`
+	Name string `json:"name" xml:"Name"`
+}
+
+func TestGetRevalidation(t *testing.T) {
+	var requests, notModified int32
+	mux := rst.NewMux()
+	mux.Get("/people/{id}", func(vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+		atomic.AddInt32(&requests, 1)
+		if r.Header.Get("If-None-Match") == "v1" {
+			atomic.AddInt32(&notModified, 1)
+		}
+		if vars.Get("id") != "1" {
+			return nil, rst.NotFound()
+		}
+		modified := time.Date(2015, time.January, 2, 3, 4, 5, 0, time.UTC)
+		return rst.NewEnvelope(&person{"1", "Francis Underwood"}, modified, "v1", 0), nil
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c := New(server.URL)
+	for i := 0; i < 2; i++ {
+		p := &person{}
+		resp, err := c.Get("/people/1", p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.Name != "Francis Underwood" {
+			t.Errorf("Got: %s Wanted: Francis Underwood", p.Name)
+		}
+		if resp.Cached != (i == 1) {
+			t.Errorf("request %d: Got cached: %t", i, resp.Cached)
+		}
+	}
+	if requests != 2 || notModified != 1 {
+		t.Errorf("Got %d requests, %d with If-None-Match. Wanted 2 and 1", requests, notModified)
+	}
+
+	_, err := c.Get("/people/2", nil)
+	e, ok := err.(*Error)
+	if !ok || e.StatusCode != http.StatusNotFound || e.Message != "Not Found" {
+		t.Errorf("Got: %#v Wanted a 404 *Error", err)
+	}
+}
+
+func TestNegotiation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+		w.Write([]byte(`<person><ID>1</ID><Name>Francis Underwood</Name></person>`))
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	p := &person{}
+	if _, err := c.Get("/people/1", p); err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "Francis Underwood" {
+		t.Errorf("Got: %s Wanted: Francis Underwood", p.Name)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	resp, err := c.Delete("/people/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusNoContent || attempts != 3 {
+		t.Errorf("Got: %d after %d attempts. Wanted: 204 after 3", resp.StatusCode, attempts)
+	}
+
+	// POST requests are not retried on 503.
+	attempts = 0
+	if _, err := c.Post("/people", &person{}, nil); err == nil || attempts != 1 {
+		t.Errorf("Got: %v after %d attempts. Wanted an error after 1", err, attempts)
+	}
+
+	// Give up after MaxRetries.
+	attempts = -10
+	c.MaxRetries = 2
+	if _, err := c.Delete("/people/1"); err == nil || attempts != -7 {
+		t.Errorf("Got: %v after %d attempts. Wanted an error after 3", err, attempts+10)
+	}
+}
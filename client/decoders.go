@@ -0,0 +1,124 @@
+package client
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DecoderFunc decodes b, a payload, in v.
+type DecoderFunc func(b []byte, v interface{}) error
+
+type decoder struct {
+	mediaType string
+	decode    DecoderFunc
+}
+
+var (
+	decodersMu sync.RWMutex
+	decoders   = []*decoder{
+		{"application/json", json.Unmarshal},
+		{"application/xml", xml.Unmarshal},
+		{"text/plain", decodeText},
+	}
+)
+
+/*
+RegisterDecoder registers fn to decode the payloads of mediaType, such as a
+vendor type registered on the service with rst.RegisterEncoder.
+
+	func init() {
+		client.RegisterDecoder("application/msgpack", msgpack.Unmarshal)
+	}
+
+The Accept header of the clients returned by New lists the media types of the
+decoders by order of registration, with a decreasing quality. Registering a
+decoder for a media type replaces the previous one, including the built-in
+decoders of application/json, application/xml and text/plain. A nil fn removes
+the decoder of mediaType.
+
+Payloads of types with a +json or +xml suffix, and text/xml, are decoded by the
+decoders of application/json and application/xml when they have none of their
+own. Other text types are decoded by the decoder of text/plain.
+*/
+func RegisterDecoder(mediaType string, fn DecoderFunc) {
+	mediaType = strings.ToLower(mediaType)
+	decodersMu.Lock()
+	defer decodersMu.Unlock()
+
+	for i, d := range decoders {
+		if d.mediaType != mediaType {
+			continue
+		}
+		if fn == nil {
+			decoders = append(decoders[:i:i], decoders[i+1:]...)
+			return
+		}
+		decoders[i] = &decoder{mediaType, fn}
+		return
+	}
+	if fn != nil {
+		decoders = append(decoders, &decoder{mediaType, fn})
+	}
+}
+
+// Accept returns the value of the Accept header listing the media types of the
+// registered decoders, such as DefaultAccept for the built-in ones.
+func Accept() string {
+	decodersMu.RLock()
+	defer decodersMu.RUnlock()
+
+	clauses := make([]string, 0, len(decoders))
+	for i, d := range decoders {
+		clause := d.mediaType
+		if q := 10 - i; i > 0 && q > 0 {
+			clause += ";q=0." + strconv.Itoa(q)
+		} else if i > 0 {
+			clause += ";q=0.1"
+		}
+		clauses = append(clauses, clause)
+	}
+	return strings.Join(clauses, ", ")
+}
+
+// lookupDecoder returns the decoder of mediaType, or nil.
+func lookupDecoder(mediaType string) DecoderFunc {
+	decodersMu.RLock()
+	defer decodersMu.RUnlock()
+
+	var fallback string
+	switch {
+	case strings.HasSuffix(mediaType, "+json"):
+		fallback = "application/json"
+	case mediaType == "text/xml" || strings.HasSuffix(mediaType, "+xml"):
+		fallback = "application/xml"
+	case strings.HasPrefix(mediaType, "text/"):
+		fallback = "text/plain"
+	}
+	var found DecoderFunc
+	for _, d := range decoders {
+		if d.mediaType == mediaType {
+			return d.decode
+		}
+		if d.mediaType == fallback {
+			found = d.decode
+		}
+	}
+	return found
+}
+
+// decodeText decodes a text payload in a *string or a *[]byte.
+func decodeText(b []byte, v interface{}) error {
+	switch p := v.(type) {
+	case *string:
+		*p = string(b)
+		return nil
+	case *[]byte:
+		*p = b
+		return nil
+	}
+	return fmt.Errorf("client: can't decode text payload in %T", v)
+}
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRegisterDecoder(t *testing.T) {
+	if accept := Accept(); accept != DefaultAccept {
+		t.Errorf("Accept is %q, wanted %q", accept, DefaultAccept)
+	}
+
+	const vendor = "application/vnd.example"
+	RegisterDecoder(vendor, func(b []byte, v interface{}) error {
+		*v.(*string) = strings.ToUpper(string(b))
+		return nil
+	})
+	defer RegisterDecoder(vendor, nil)
+
+	var accept string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accept = r.Header.Get("Accept")
+		w.Header().Set("Content-Type", vendor)
+		w.Write([]byte("francis"))
+	}))
+	defer server.Close()
+
+	var name string
+	if _, err := New(server.URL).Get("/people/1", &name); err != nil {
+		t.Fatal(err)
+	}
+	if name != "FRANCIS" {
+		t.Errorf("Got: %s Wanted: FRANCIS", name)
+	}
+	if accept != DefaultAccept+", "+vendor+";q=0.7" {
+		t.Errorf("Accept is %q", accept)
+	}
+
+	RegisterDecoder(vendor, nil)
+	if Accept() != DefaultAccept || lookupDecoder(vendor) != nil {
+		t.Errorf("Decoder of %s was not removed", vendor)
+	}
+	if lookupDecoder("application/problem+json") == nil || lookupDecoder("text/csv") == nil {
+		t.Error("Suffixed and text types have no decoder")
+	}
+}
@@ -0,0 +1,117 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/mohamedattahri/rst"
+)
+
+/*
+Error is returned when the service responds with a status code >= 400.
+
+The payload of the error is decoded from the error format of rst, from its
+JSON envelope, or from an RFC 7807 problem document, including the fields of
+validation errors. Error unwraps to the matching *rst.Error, so that clients
+can inspect errors with the types of the service:
+
+	var e *rst.Error
+	if errors.As(err, &e) && e.Code == http.StatusUnprocessableEntity {
+		for _, field := range e.Fields {
+			// ...
+		}
+	}
+*/
+type Error struct {
+	StatusCode  int
+	Header      http.Header
+	Message     string            // Reason of an rst error, or title of a problem.
+	Description string            // Description of an rst error, or detail of a problem.
+	Type        string            // Type of a problem.
+	Instance    string            // Instance of a problem.
+	Fields      []*rst.FieldError // Fields of a validation error.
+	Body        []byte
+}
+
+func (e *Error) Error() string {
+	s := fmt.Sprintf("%d (%s)", e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Message != "" {
+		s += " - " + e.Message
+	}
+	return s
+}
+
+// Unwrap returns e as an *rst.Error.
+func (e *Error) Unwrap() error {
+	return e.RST()
+}
+
+// RST returns e as an *rst.Error, or nil if its status code isn't an error.
+func (e *Error) RST() *rst.Error {
+	if e.StatusCode < 400 {
+		return nil
+	}
+	message := e.Message
+	if message == "" {
+		message = http.StatusText(e.StatusCode)
+	}
+	err := rst.NewError(e.StatusCode, message, e.Description)
+	for key, values := range e.Header {
+		err.Header[key] = append([]string(nil), values...)
+	}
+	err.Fields = e.Fields
+	return err
+}
+
+// errorPayload holds the fields of the error formats decoded by the client.
+type errorPayload struct {
+	// rst
+	Message     string            `json:"message"`
+	Description string            `json:"description"`
+	Fields      []*rst.FieldError `json:"fields"`
+	Field       string            `json:"field"`
+	Code        string            `json:"code"`
+
+	// RFC 7807
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Detail   string `json:"detail"`
+	Instance string `json:"instance"`
+
+	// Envelope
+	Errors []*errorPayload `json:"errors"`
+}
+
+// decodeError returns the error described by resp.
+func decodeError(resp *Response) *Error {
+	e := &Error{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       resp.Body,
+	}
+
+	payload := &errorPayload{}
+	if err := json.Unmarshal(resp.Body, payload); err != nil {
+		return e
+	}
+	// Problem documents list the fields of validation errors in errors, and
+	// envelopes list errors.
+	if len(payload.Errors) > 0 && payload.Errors[0].Field != "" {
+		for _, field := range payload.Errors {
+			payload.Fields = append(payload.Fields, &rst.FieldError{Field: field.Field, Code: field.Code, Message: field.Message})
+		}
+	} else if len(payload.Errors) > 0 {
+		payload = payload.Errors[0]
+	}
+
+	e.Message, e.Description, e.Fields = payload.Message, payload.Description, payload.Fields
+	if payload.Title != "" {
+		e.Message = payload.Title
+	}
+	if payload.Detail != "" {
+		e.Description = payload.Detail
+	}
+	e.Type, e.Instance = payload.Type, payload.Instance
+	return e
+}
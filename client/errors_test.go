@@ -0,0 +1,56 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mohamedattahri/rst"
+)
+
+func TestDecodeError(t *testing.T) {
+	var test = func(body, message, description, kind string) {
+		e := decodeError(&Response{StatusCode: http.StatusBadRequest, Header: make(http.Header), Body: []byte(body)})
+		if e.Message != message || e.Description != description || e.Type != kind {
+			t.Errorf("%s: Got: %q %q %q", body, e.Message, e.Description, e.Type)
+		}
+	}
+
+	test(`{"message":"Invalid name","description":"Names can't be empty."}`, "Invalid name", "Names can't be empty.", "")
+	test(`{"errors":[{"status":400,"message":"Invalid name"}]}`, "Invalid name", "", "")
+	test(`{"type":"https://example.com/invalid-name","title":"Invalid name","status":400,"detail":"Names can't be empty."}`, "Invalid name", "Names can't be empty.", "https://example.com/invalid-name")
+	test(`<html></html>`, "", "", "")
+}
+
+func TestDecodeValidationError(t *testing.T) {
+	var test = func(body string) {
+		e := decodeError(&Response{StatusCode: http.StatusUnprocessableEntity, Header: make(http.Header), Body: []byte(body)})
+		if e.Message != "Invalid input" || len(e.Fields) != 1 || e.Fields[0].Field != "name" || e.Fields[0].Code != "required" {
+			t.Errorf("%s: Got: %q %+v", body, e.Message, e.Fields)
+		}
+	}
+
+	test(`{"message":"Invalid input","fields":[{"field":"name","code":"required","message":"Name is required."}]}`)
+	test(`{"title":"Invalid input","status":422,"errors":[{"field":"name","code":"required","message":"Name is required."}]}`)
+}
+
+func TestErrorUnwrap(t *testing.T) {
+	mux := rst.NewMux()
+	mux.Post("/people", func(vars rst.RouteVars, r *http.Request) (rst.Resource, string, error) {
+		v := &rst.ValidationError{}
+		v.Add("name", "required", "Name is required.")
+		return nil, "", v.Err()
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	_, err := New(server.URL).Post("/people", map[string]string{}, nil)
+	var e *rst.Error
+	if !errors.As(err, &e) || e.Code != http.StatusUnprocessableEntity || len(e.Fields) != 1 || e.Fields[0].Field != "name" {
+		t.Fatalf("Got: %#v Wanted an *rst.Error with the field name", err)
+	}
+	if e.Header.Get("Content-Type") == "" {
+		t.Errorf("Headers of the error are %v", e.Header)
+	}
+}
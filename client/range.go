@@ -0,0 +1,87 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/mohamedattahri/rst"
+)
+
+/*
+Iterator reads a collection supporting range requests page by page.
+
+	it := c.Range("/people", "resources", 25)
+	for {
+		var page []*Person
+		if !it.Next(&page) {
+			break
+		}
+		// ...
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+*/
+type Iterator struct {
+	client *Client
+	path   string
+	unit   string
+	size   uint64
+	offset uint64
+	total  uint64
+	done   bool
+	err    error
+}
+
+// Range returns an iterator reading the collection at path in pages of size
+// units.
+func (c *Client) Range(path, unit string, size uint64) *Iterator {
+	if size == 0 {
+		size = 1
+	}
+	return &Iterator{client: c, path: path, unit: unit, size: size}
+}
+
+// Next fetches the next page of the collection and decodes it in v. It returns
+// false when there are no more pages, or if an error occurred.
+func (it *Iterator) Next(v interface{}) bool {
+	if it.done || it.err != nil {
+		return false
+	}
+
+	header := make(http.Header)
+	header.Set("Range", fmt.Sprintf("%s=%d-%d", it.unit, it.offset, it.offset+it.size-1))
+	resp, err := it.client.Do(rst.Get, it.path, header, nil, v)
+	if err != nil {
+		if e, ok := err.(*Error); ok && e.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+			it.done = true
+			return false
+		}
+		it.err = err
+		return false
+	}
+
+	// The whole collection was returned.
+	if resp.StatusCode != http.StatusPartialContent || resp.ContentRange == nil {
+		it.done = true
+		return resp.StatusCode != http.StatusNoContent
+	}
+
+	it.total = resp.ContentRange.Total
+	it.offset = resp.ContentRange.To + 1
+	if it.total != 0 && it.offset >= it.total {
+		it.done = true
+	}
+	return true
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Total returns the total number of units in the collection, as reported by
+// the last page. It returns 0 if it's unknown.
+func (it *Iterator) Total() uint64 {
+	return it.total
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mohamedattahri/rst"
+)
+
+type numbers []int
+
+func (n numbers) ETag() string            { return "numbers" }
+func (n numbers) LastModified() time.Time { return time.Time{} }
+func (n numbers) TTL() time.Duration      { return 0 }
+func (n numbers) Units() []string         { return []string{"items"} }
+func (n numbers) Count() uint64           { return uint64(len(n)) }
+func (n numbers) Range(rg *rst.Range) (*rst.ContentRange, rst.Resource, error) {
+	return &rst.ContentRange{Range: rg, Total: n.Count()}, n[rg.From : rg.To+1], nil
+}
+
+func TestIterator(t *testing.T) {
+	collection := make(numbers, 23)
+	for i := range collection {
+		collection[i] = i
+	}
+	mux := rst.NewMux()
+	mux.Get("/numbers", func(vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+		return collection, nil
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c := New(server.URL)
+	it := c.Range("/numbers", "items", 10)
+	var (
+		all   []int
+		pages int
+	)
+	for {
+		var page []int
+		if !it.Next(&page) {
+			break
+		}
+		pages++
+		all = append(all, page...)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if pages != 3 || len(all) != 23 || all[22] != 22 {
+		t.Errorf("Got %d pages and %d items. Wanted 3 pages and 23 items", pages, len(all))
+	}
+	if it.Total() != 23 {
+		t.Errorf("Got total: %d Wanted: 23", it.Total())
+	}
+}
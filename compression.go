@@ -26,29 +26,132 @@ var (
 	// required in unknown.
 	errUnknownCompressionFormat = errors.New("unsupported compression format")
 
-	// gZipCompressorPool allows rst to recyle gzip writers.
-	gZipCompressorPool = sync.Pool{
-		New: func() interface{} {
-			return gzip.NewWriter(nil)
-		},
-	}
-	// flateCompressorPool allows rst to recyle flate writers.
-	flateCompressorPool = sync.Pool{
-		New: func() interface{} {
-			writer, _ := flate.NewWriter(nil, 0)
-			return writer
-		},
-	}
+	// compressorPools allow rst to recycle the writers of each compression
+	// format and level.
+	compressorPoolsMu sync.Mutex
+	compressorPools   = make(map[compressorPoolKey]*sync.Pool)
 )
 
+/*
+Compressible is implemented by resources to control whether their
+representation can be compressed. Payloads that are already compressed, such
+as images, archives or pre-gzipped exports, should return false to avoid
+wasting CPU cycles recompressing them.
+
+	func (f *File) Compressible() bool {
+		return !strings.HasPrefix(f.ContentType, "image/")
+	}
+
+Responses whose Content-Encoding header has already been set are never
+compressed again.
+*/
+type Compressible interface {
+	Compressible() bool
+}
+
+/*
+Compression sets how the responses of a mux are compressed.
+
+	mux.SetCompression(&rst.Compression{
+		MinSize:       1400,
+		Level:         gzip.BestSpeed,
+		ExcludedTypes: []string{"image/*", "video/*", "application/zip"},
+	})
+
+Types and ExcludedTypes are lists of media types, which can end with a
+wildcard subtype, such as text/*. Representations whose media type is in
+ExcludedTypes, or not in Types when it isn't empty, are never compressed.
+*/
+type Compression struct {
+	MinSize       int      // Optional. Size of the smallest payload compressed. CompressionThreshold by default.
+	Level         int      // Optional. Level of compression, between gzip.BestSpeed and gzip.BestCompression, or gzip.HuffmanOnly. gzip.DefaultCompression by default.
+	Types         []string // Optional. Media types compressed, all of them by default.
+	ExcludedTypes []string // Optional. Media types never compressed.
+}
+
+// SetCompression sets how the responses of the mux are compressed. A nil value
+// restores the defaults.
+func (s *Mux) SetCompression(c *Compression) {
+	s.compression = c
+}
+
+// compressionOf returns the compression settings of the mux serving r.
+func compressionOf(r *http.Request) *Compression {
+	if c := getMux(r).compression; c != nil {
+		return c
+	}
+	return &Compression{}
+}
+
+func (c *Compression) minSize() int {
+	if c.MinSize <= 0 {
+		return CompressionThreshold
+	}
+	return c.MinSize
+}
+
+func (c *Compression) level() int {
+	if c.Level == 0 || c.Level < flate.HuffmanOnly || c.Level > flate.BestCompression {
+		return flate.DefaultCompression
+	}
+	return c.Level
+}
+
+// allows returns true if representations of contentType can be compressed.
+func (c *Compression) allows(contentType string) bool {
+	mediaType := strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+	for _, excluded := range c.ExcludedTypes {
+		if matchMediaType(excluded, mediaType) {
+			return false
+		}
+	}
+	if len(c.Types) == 0 {
+		return true
+	}
+	for _, allowed := range c.Types {
+		if matchMediaType(allowed, mediaType) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchMediaType returns true if mediaType matches pattern, which can end with
+// a wildcard subtype.
+func matchMediaType(pattern, mediaType string) bool {
+	pattern = strings.ToLower(pattern)
+	if pattern == "*/*" || pattern == mediaType {
+		return true
+	}
+	return strings.HasSuffix(pattern, "/*") && strings.HasPrefix(mediaType, pattern[:len(pattern)-1])
+}
+
+// compressible returns true if the representation of resource of size bytes,
+// written in a response with header, can be compressed in response to r.
+func compressible(resource interface{}, header http.Header, size int, r *http.Request) bool {
+	if header.Get("Content-Encoding") != "" {
+		return false
+	}
+	if c, implemented := resource.(Compressible); implemented && !c.Compressible() {
+		return false
+	}
+	c := compressionOf(r)
+	return size >= c.minSize() && c.allows(header.Get("Content-Type"))
+}
+
 // getCompressionFormat returns the compression for that will be used for b as
 // a payload in the response to r. The returned string is either empty, gzip, or
 // deflate.
 func getCompressionFormat(b []byte, r *http.Request) string {
-	if b == nil || len(b) < CompressionThreshold {
+	if b == nil || len(b) < compressionOf(r).minSize() {
 		return ""
 	}
+	return acceptedCompression(r)
+}
 
+// acceptedCompression returns the compression format accepted by r, which is
+// either empty, gzip, or deflate.
+func acceptedCompression(r *http.Request) string {
 	encoding := r.Header.Get("Accept-Encoding")
 	if strings.Contains(encoding, gzipCompression) {
 		return gzipCompression
@@ -66,19 +169,46 @@ type compressor interface {
 	Reset(io.Writer)
 }
 
-// getCompressor returns a writer that can compress data written to it.
-func compress(format string, dest io.Writer, b []byte) (int, error) {
-	var writer compressor
-	switch format {
-	case gzipCompression:
-		writer = gZipCompressorPool.Get().(*gzip.Writer)
-		defer gZipCompressorPool.Put(writer)
-	case flateCompression:
-		writer = flateCompressorPool.Get().(*flate.Writer)
-		defer flateCompressorPool.Put(writer)
-	default:
+type compressorPoolKey struct {
+	format string
+	level  int
+}
+
+// compressorPool returns the pool of writers compressing data in format at the
+// given level, or nil if format isn't supported.
+func compressorPool(format string, level int) *sync.Pool {
+	if format != gzipCompression && format != flateCompression {
+		return nil
+	}
+
+	compressorPoolsMu.Lock()
+	defer compressorPoolsMu.Unlock()
+	key := compressorPoolKey{format, level}
+	pool, found := compressorPools[key]
+	if !found {
+		pool = &sync.Pool{
+			New: func() interface{} {
+				if format == gzipCompression {
+					writer, _ := gzip.NewWriterLevel(nil, level)
+					return writer
+				}
+				writer, _ := flate.NewWriter(nil, level)
+				return writer
+			},
+		}
+		compressorPools[key] = pool
+	}
+	return pool
+}
+
+// compress writes b compressed in format at the given level in dest.
+func compress(format string, level int, dest io.Writer, b []byte) (int, error) {
+	pool := compressorPool(format, level)
+	if pool == nil {
 		return 0, errUnknownCompressionFormat
 	}
+	writer := pool.Get().(compressor)
+	defer pool.Put(writer)
 
 	writer.Reset(dest)
 	n, err := writer.Write(b)
@@ -6,8 +6,11 @@ import (
 	"compress/gzip"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func decompress(src io.ReadCloser, format string) ([]byte, error) {
@@ -129,3 +132,95 @@ func TestResponseCompression(t *testing.T) {
 		t.Fatal("deflate Accept-Encoding value: data was decompressed but did not match the expected value")
 	}
 }
+
+type precompressedResource struct {
+	echoResource
+}
+
+func (p *precompressedResource) Compressible() bool {
+	return false
+}
+
+func TestCompressible(t *testing.T) {
+	var test = func(resource Resource, expected string) {
+		r, _ := http.NewRequest(Get, "http://example.com", nil)
+		r.Header.Set("Accept-Encoding", "gzip")
+		w := httptest.NewRecorder()
+		GetFunc(func(vars RouteVars, r *http.Request) (Resource, error) {
+			return resource, nil
+		}).ServeHTTP(newResponseWriter(w, flate.DefaultCompression), r)
+		if encoding := w.Header().Get("Content-Encoding"); encoding != expected {
+			t.Errorf("%T: Got: %q Wanted: %q", resource, encoding, expected)
+		}
+		if expected == "" && !bytes.Equal(w.Body.Bytes(), testMBText) {
+			t.Errorf("%T: body should not be compressed", resource)
+		}
+	}
+
+	test(&echoResource{testMBText}, "gzip")
+	test(&precompressedResource{echoResource{testMBText}}, "")
+}
+
+func TestCompressionPolicy(t *testing.T) {
+	mux := NewMux()
+	mux.Get("/text", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return &echoResource{testMBText[:100]}, nil
+	})
+	mux.Get("/json", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(&viewResource{string(testMBText[:100])}, time.Now(), "etag", 0), nil
+	})
+
+	var test = func(path, expected string) {
+		r, _ := http.NewRequest(Get, "http://example.com"+path, nil)
+		r.Header.Set("Accept", "application/json")
+		r.Header.Set("Accept-Encoding", "gzip")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if encoding := w.Header().Get("Content-Encoding"); encoding != expected {
+			t.Errorf("%s: Got: %q Wanted: %q", path, encoding, expected)
+		}
+		if expected == "" {
+			return
+		}
+		if decompressed, err := decompress(ioutil.NopCloser(w.Body), expected); err != nil {
+			t.Error(err)
+		} else if !bytes.Contains(decompressed, testMBText[:50]) {
+			t.Errorf("%s: data was decompressed but did not match the expected value", path)
+		}
+	}
+
+	// Payloads are smaller than CompressionThreshold.
+	test("/text", "")
+	test("/json", "")
+
+	mux.SetCompression(&Compression{MinSize: 50, Level: gzip.BestSpeed})
+	test("/text", "gzip")
+	test("/json", "gzip")
+
+	mux.SetCompression(&Compression{MinSize: 50, ExcludedTypes: []string{"text/*"}})
+	test("/text", "")
+	test("/json", "gzip")
+
+	mux.SetCompression(&Compression{MinSize: 50, Types: []string{"text/plain"}})
+	test("/text", "gzip")
+	test("/json", "")
+
+	mux.SetCompression(nil)
+	test("/text", "")
+}
+
+func TestCompressionLevels(t *testing.T) {
+	for _, format := range []string{"gzip", "deflate"} {
+		for _, level := range []int{flate.HuffmanOnly, flate.BestSpeed, flate.DefaultCompression, flate.BestCompression} {
+			var buffer bytes.Buffer
+			if _, err := compress(format, level, &buffer, testMBText); err != nil {
+				t.Fatal(err)
+			}
+			if decompressed, err := decompress(ioutil.NopCloser(&buffer), format); err != nil {
+				t.Fatal(err)
+			} else if !bytes.Equal(decompressed, testMBText) {
+				t.Errorf("%s %d: data was decompressed but did not match the expected value", format, level)
+			}
+		}
+	}
+}
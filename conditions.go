@@ -0,0 +1,201 @@
+package rst
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+/*
+Validator is implemented by endpoints which can return the validators of the
+current version of a resource more cheaply than by fetching it with Get.
+
+	func (ep *PersonEP) Validators(vars rst.RouteVars, r *http.Request) (string, time.Time, error) {
+		etag, modified, err := database.Version("people", vars.Get("id"))
+		if err == database.ErrNotFound {
+			return "", time.Time{}, rst.NotFound()
+		}
+		return etag, modified, err
+	}
+
+Before PUT, PATCH and DELETE requests with an If-Match or an
+If-Unmodified-Since header are dispatched to an endpoint, the current version
+of the resource is compared with the conditions of the request, and requests
+whose conditions fail are responded to with status code 412 Precondition
+Failed. Endpoints which don't implement Validator have their current version
+returned by Get. A NotFound or Gone error means that the resource doesn't
+exist, in which case If-Match fails.
+*/
+type Validator interface {
+	Validators(vars RouteVars, r *http.Request) (etag string, lastModified time.Time, err error)
+}
+
+// errNoValidators is returned by currentValidators for endpoints which can't
+// return their current version.
+var errNoValidators = errors.New("rst: the endpoint can't return its current version")
+
+// currentValidators returns the validators of the current version of the
+// resource served by endpoint for r, and false if it doesn't exist.
+func currentValidators(endpoint Endpoint, vars RouteVars, r *http.Request) (string, time.Time, bool, error) {
+	var (
+		etag     string
+		modified time.Time
+		err      error
+	)
+	if validator, implemented := endpoint.(Validator); implemented {
+		etag, modified, err = validator.Validators(vars, r)
+	} else if getter := getterOf(endpoint); getter != nil {
+		copied := withMethod(r, Get)
+		defer delVars(copied)
+		var resource Resource
+		if resource, err = getter.Get(vars, copied); err == nil {
+			if resource == nil {
+				return "", time.Time{}, false, nil
+			}
+			etag, modified = resource.ETag(), resource.LastModified()
+		}
+	} else {
+		return "", time.Time{}, false, errNoValidators
+	}
+
+	if e, ok := err.(*Error); ok && (e.Code == http.StatusNotFound || e.Code == http.StatusGone) {
+		return "", time.Time{}, false, nil
+	}
+	if _, ok := err.(*Tombstone); ok {
+		return "", time.Time{}, false, nil
+	}
+	return etag, modified, err == nil, err
+}
+
+// PreconditionPolicy is implemented by endpoints to override the
+// RequirePrecondition setting of the mux.
+type PreconditionPolicy interface {
+	// PreconditionRequired returns true if the PUT, PATCH and DELETE requests
+	// of the endpoint must have an If-Match header.
+	PreconditionRequired() bool
+}
+
+// preconditionRequired returns true if the unsafe requests of endpoint must
+// have an If-Match header.
+func preconditionRequired(endpoint Endpoint, r *http.Request) bool {
+	if policy, implemented := policyEndpoint(endpoint).(PreconditionPolicy); implemented {
+		return policy.PreconditionRequired()
+	}
+	return getMux(r).RequirePrecondition
+}
+
+// validatorsOf returns the validators of the current version of the resource
+// served by endpoint, for the endpoints wrapping it. A NotFound error means
+// the resource doesn't exist.
+func validatorsOf(endpoint Endpoint, vars RouteVars, r *http.Request) (string, time.Time, error) {
+	etag, modified, exists, err := currentValidators(endpoint, vars, r)
+	if err == nil && !exists {
+		err = NotFound()
+	}
+	return etag, modified, err
+}
+
+// checkPreconditions returns PreconditionFailed if the If-Match or the
+// If-Unmodified-Since header of r don't match the current version of the
+// resource served by endpoint, PreconditionRequired if r must have an If-Match
+// header, or the error returned while fetching the resource.
+func checkPreconditions(endpoint Endpoint, r *http.Request) error {
+	switch strings.ToUpper(r.Method) {
+	case Put, Patch, Delete:
+	default:
+		return nil
+	}
+	if r.Header.Get("If-Match") == "" {
+		if preconditionRequired(endpoint, r) {
+			return PreconditionRequired()
+		}
+		if r.Header.Get("If-Unmodified-Since") == "" {
+			return nil
+		}
+	}
+
+	etag, modified, exists, err := currentValidators(endpoint, getVars(r), r)
+	if err == errNoValidators {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if preconditionsFail(etag, modified, exists, r) {
+		return PreconditionFailed()
+	}
+	return nil
+}
+
+// preconditionsFail returns true if the If-Match or the If-Unmodified-Since
+// header of r don't match the validators of a resource. If-Match matches the
+// ETag of the resource, or the one of any of its variants. If-Unmodified-Since
+// is ignored when the resource doesn't exist.
+func preconditionsFail(etag string, modified time.Time, exists bool, r *http.Request) bool {
+	if ifMatch := r.Header.Get("If-Match"); ifMatch != "" {
+		matched := false
+		for _, tag := range representationETags(etag, r) {
+			if matched = matchETag(ifMatch, tag, exists); matched {
+				break
+			}
+		}
+		if !matched {
+			return true
+		}
+	}
+	if d, err := time.Parse(rfc1123, r.Header.Get("If-Unmodified-Since")); err == nil && exists {
+		return modified.Truncate(time.Second).After(d)
+	}
+	return false
+}
+
+// matchETag returns true if the list of entity tags of an If-Match header
+// matches etag with the strong comparison defined by RFC 7232.
+func matchETag(ifMatch, etag string, exists bool) bool {
+	if !exists {
+		return false
+	}
+	if strings.TrimSpace(ifMatch) == "*" {
+		return true
+	}
+	for _, tag := range strings.Split(ifMatch, ",") {
+		// Weak tags never match.
+		if tag = strings.TrimSpace(tag); !strings.HasPrefix(tag, "W/") && strings.Trim(tag, `"`) == strings.Trim(etag, `"`) {
+			return true
+		}
+	}
+	return false
+}
+
+// ifRange returns true if the range of r can be served for the representation
+// with etag and lastModified: either r has no If-Range header, or its
+// validator matches the representation with the strong comparison defined by
+// RFC 7233. A date only matches a Last-Modified date equal to the second.
+func ifRange(etag string, lastModified time.Time, r *http.Request) bool {
+	raw := strings.TrimSpace(r.Header.Get("If-Range"))
+	if raw == "" {
+		return true
+	}
+	if !strings.HasPrefix(raw, `"`) && !strings.HasPrefix(raw, "W/") {
+		if date, err := http.ParseTime(raw); err == nil {
+			return !lastModified.IsZero() && date.Equal(lastModified.Truncate(time.Second))
+		}
+	}
+	if raw == "*" || etag == "" || strings.HasPrefix(etag, "W/") {
+		return false
+	}
+	return matchETag(raw, etag, true)
+}
+
+// withPreconditions returns a handler responding with the error of
+// checkPreconditions, or serving the request with handler.
+func withPreconditions(endpoint Endpoint, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := checkPreconditions(endpoint, r); err != nil {
+			writeError(err, w, r)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,183 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type conditionalEndpoint struct {
+	etag     string
+	modified time.Time
+	gets     int
+	writes   int
+}
+
+func (e *conditionalEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	e.gets++
+	if vars.Get("id") != "1" {
+		return nil, NotFound()
+	}
+	return NewEnvelope(&viewResource{"Francis"}, e.modified, e.etag, 0), nil
+}
+
+func (e *conditionalEndpoint) Put(vars RouteVars, r *http.Request) (Resource, error) {
+	e.writes++
+	return nil, nil
+}
+
+func (e *conditionalEndpoint) Delete(vars RouteVars, r *http.Request) error {
+	e.writes++
+	return nil
+}
+
+type validatedEndpoint struct {
+	conditionalEndpoint
+}
+
+func (e *validatedEndpoint) Validators(vars RouteVars, r *http.Request) (string, time.Time, error) {
+	if vars.Get("id") != "1" {
+		return "", time.Time{}, NotFound()
+	}
+	return e.etag, e.modified, nil
+}
+
+func TestPreconditions(t *testing.T) {
+	modified := time.Date(2015, 1, 2, 3, 4, 5, 0, time.UTC)
+	versioned := &conditionalEndpoint{etag: "v2", modified: modified}
+	validated := &validatedEndpoint{conditionalEndpoint{etag: "v2", modified: modified}}
+
+	mux := NewMux()
+	mux.HandleEndpoint("/versioned/{id}", versioned)
+	mux.HandleEndpoint("/validated/{id}", validated)
+	mux.HandleBreaker("/breaker/{id}", validated, &Breaker{})
+
+	var test = func(method, path string, header http.Header, status int) {
+		r, _ := http.NewRequest(method, "http://example.com"+path, nil)
+		r.Header = header
+		r.Header.Set("Accept", "application/json")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Errorf("%s %s %v: Got: %d Wanted: %d", method, path, header, w.Code, status)
+		}
+	}
+
+	before := modified.Add(-time.Hour).Format(rfc1123)
+	after := modified.Add(time.Hour).Format(rfc1123)
+	for _, prefix := range []string{"/versioned", "/validated", "/breaker"} {
+		test(Put, prefix+"/1", http.Header{}, http.StatusOK)
+		test(Put, prefix+"/1", http.Header{"If-Match": {`"v2"`}}, http.StatusOK)
+		test(Put, prefix+"/1", http.Header{"If-Match": {`"v1", "v2"`}}, http.StatusOK)
+		test(Put, prefix+"/1", http.Header{"If-Match": {"*"}}, http.StatusOK)
+		test(Put, prefix+"/1", http.Header{"If-Match": {"v1"}}, http.StatusPreconditionFailed)
+		test(Put, prefix+"/1", http.Header{"If-Match": {`W/"v2"`}}, http.StatusPreconditionFailed)
+		test(Delete, prefix+"/1", http.Header{"If-Unmodified-Since": {after}}, http.StatusNoContent)
+		test(Delete, prefix+"/1", http.Header{"If-Unmodified-Since": {before}}, http.StatusPreconditionFailed)
+
+		// If-Match fails when the resource doesn't exist.
+		test(Put, prefix+"/2", http.Header{"If-Match": {"*"}}, http.StatusPreconditionFailed)
+		test(Put, prefix+"/2", http.Header{"If-Unmodified-Since": {before}}, http.StatusOK)
+	}
+
+	if versioned.writes != 6 || validated.writes != 12 {
+		t.Errorf("Got: %d and %d writes Wanted: 6 and 12", versioned.writes, validated.writes)
+	}
+	if validated.gets != 0 {
+		t.Errorf("Got: %d calls to Get Wanted: 0 with a Validator", validated.gets)
+	}
+}
+
+func TestPreconditionsExperiment(t *testing.T) {
+	mux := NewMux()
+	mux.HandleEndpoint("/versioned/{id}", &conditionalEndpoint{etag: "v2", modified: time.Now()})
+	mux.AddExperiment(&Experiment{Name: "shape", Variants: []string{"control", "compact"}})
+
+	var test = func(method string, header http.Header, status int) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(method, "http://example.com/versioned/1", nil)
+		r.Header = header
+		r.Header.Set("Accept", "application/json")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Fatalf("%s %v: Got: %d Wanted: %d", method, header, w.Code, status)
+		}
+		return w
+	}
+
+	// Variants are assigned randomly, so the PUT may be assigned another
+	// variant than the GET.
+	for i := 0; i < 10; i++ {
+		etag := test(Get, http.Header{}, http.StatusOK).Header().Get("ETag")
+		if etag == "v2" {
+			t.Fatal("Got the ETag of the resource instead of the one of a variant")
+		}
+		test(Put, http.Header{"If-Match": {etag}}, http.StatusOK)
+	}
+	test(Put, http.Header{"If-Match": {"v2"}}, http.StatusOK)
+	test(Put, http.Header{"If-Match": {"v2-00000000"}}, http.StatusPreconditionFailed)
+}
+
+type optimisticEndpoint struct {
+	conditionalEndpoint
+	required bool
+}
+
+func (e *optimisticEndpoint) PreconditionRequired() bool {
+	return e.required
+}
+
+func TestPreconditionRequired(t *testing.T) {
+	mux := NewMux()
+	mux.RequirePrecondition = true
+	modified := time.Now()
+	mux.HandleEndpoint("/strict/{id}", &conditionalEndpoint{etag: "v1", modified: modified})
+	mux.HandleEndpoint("/lenient/{id}", &optimisticEndpoint{conditionalEndpoint{etag: "v1", modified: modified}, false})
+
+	var test = func(method, path, ifMatch string, status int) {
+		r, _ := http.NewRequest(method, "http://example.com"+path, nil)
+		r.Header.Set("Accept", "application/json")
+		if ifMatch != "" {
+			r.Header.Set("If-Match", ifMatch)
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Errorf("%s %s %q: Got: %d Wanted: %d", method, path, ifMatch, w.Code, status)
+		}
+	}
+
+	test(Put, "/strict/1", "", http.StatusPreconditionRequired)
+	test(Delete, "/strict/1", "", http.StatusPreconditionRequired)
+	test(Get, "/strict/1", "", http.StatusOK)
+	test(Put, "/strict/1", "v1", http.StatusOK)
+	test(Put, "/strict/1", "v0", http.StatusPreconditionFailed)
+	test(Put, "/lenient/1", "", http.StatusOK)
+}
+
+func TestIfRange(t *testing.T) {
+	modified := time.Date(2015, 1, 2, 3, 4, 5, 600, time.UTC)
+	var test = func(etag, header string, expected bool) {
+		r, _ := http.NewRequest(Get, "http://example.com", nil)
+		if header != "" {
+			r.Header.Set("If-Range", header)
+		}
+		if got := ifRange(etag, modified, r); got != expected {
+			t.Errorf("%q %q: Got: %t Wanted: %t", etag, header, got, expected)
+		}
+	}
+
+	test("v1", "", true)
+	test("v1", "v1", true)
+	test("v1", `"v1"`, true)
+	test(`"v1"`, `"v1"`, true)
+	test("v1", `"v2"`, false)
+	test("v1", `W/"v1"`, false)
+	test(`W/"v1"`, `W/"v1"`, false)
+	test("v1", "*", false)
+	test("", `""`, false)
+	test("v1", modified.Format(http.TimeFormat), true)
+	test("v1", modified.Add(time.Second).Format(http.TimeFormat), false)
+	test("v1", modified.Add(-time.Second).Format(http.TimeFormat), false)
+}
@@ -0,0 +1,122 @@
+package rst
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/mohamedattahri/rst/internal/assets"
+)
+
+// consoleRoute describes a route of a Mux in the console.
+type consoleRoute struct {
+	Pattern string   `json:"pattern" xml:"Pattern"`
+	Methods []string `json:"methods" xml:"Method"`
+}
+
+// consoleRoutes returns the routes currently registered in s.
+func (s *Mux) consoleRoutes() []*consoleRoute {
+	var routes []*consoleRoute
+	for _, route := range s.Routes() {
+		routes = append(routes, &consoleRoute{Pattern: route.Pattern, Methods: route.Methods})
+	}
+	return routes
+}
+
+// consoleState describes the routes and the breakers of a Mux in the console.
+type consoleState struct {
+	XMLName  xml.Name        `json:"-" xml:"Console"`
+	Routes   []*consoleRoute `json:"routes" xml:"Route"`
+	Breakers []BreakerStats  `json:"breakers" xml:"Breaker"`
+}
+
+// consoleBreakers returns the stats of the breakers added to s.
+func (s *Mux) consoleBreakers() []BreakerStats {
+	breakers := []BreakerStats{}
+	for _, b := range s.Breakers() {
+		breakers = append(breakers, b.Stats())
+	}
+	return breakers
+}
+
+/*
+NewConsole returns an endpoint serving an interactive console for the routes
+registered in mux.
+
+Browsers negotiating text/html get a single page application which lists the
+routes and the state of the breakers of mux, and lets developers fill route
+variables, headers (including Authorization) and a body to invoke the routes.
+Other clients get the routes and the stats of the breakers encoded as usual.
+
+	{
+		"routes": [{"pattern": "/people/{id}", "methods": ["HEAD", "GET"]}],
+		"breakers": [{"name": "billing", "state": "open", "failures": 5, "trips": 1, ...}]
+	}
+
+	mux.Debug = true
+	mux.HandleEndpoint("/console", rst.NewConsole(mux))
+
+The console only lists routes, and does not bypass any access control
+implemented by the endpoints it invokes. It should not be exposed in
+production.
+*/
+func NewConsole(mux *Mux) Endpoint {
+	return &consoleEndpoint{mux}
+}
+
+type consoleEndpoint struct {
+	mux *Mux
+}
+
+// Get implements the Getter interface.
+func (c *consoleEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	state := &consoleState{Routes: c.mux.consoleRoutes(), Breakers: c.mux.consoleBreakers()}
+	return &console{state: state, date: time.Now()}, nil
+}
+
+// console is the resource returned by consoleEndpoint.
+type console struct {
+	state *consoleState
+	date  time.Time
+}
+
+// ETag is a hash of the routes and the stats of the breakers.
+func (c *console) ETag() string {
+	b, _ := json.Marshal(c.state)
+	h := fnv.New64a()
+	h.Write(b)
+	return fmt.Sprintf("%x", h.Sum64())
+}
+
+// LastModified returns the date at which the console was generated.
+func (c *console) LastModified() time.Time {
+	return c.date
+}
+
+// TTL implements the Resource interface. The console is never cached.
+func (c *console) TTL() time.Duration {
+	return 0
+}
+
+// MarshalRST returns the console page when text/html is negotiated, and the
+// routes and breakers otherwise.
+func (c *console) MarshalRST(r *http.Request) (string, []byte, error) {
+	accept := ParseAccept(r.Header.Get("Accept"))
+	if accept.Negotiate(append(encoderTypes(), htmlContentType)...) == htmlContentType {
+		return "text/html; charset=utf-8", consolePage, nil
+	}
+	return MarshalResource(c.state, r)
+}
+
+var consolePage []byte
+
+func init() {
+	var err error
+	if consolePage, err = assets.FS.ReadFile("console.html"); err != nil {
+		log.Fatal(err)
+	}
+}
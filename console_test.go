@@ -0,0 +1,67 @@
+package rst
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestConsole(t *testing.T) {
+	mux := NewMux()
+	mux.Handle("/people", EndpointHandler(&peopleCollection{}))
+	mux.Handle("/people/{id}", EndpointHandler(&personResource{}))
+	mux.HandleEndpoint("/console", NewConsole(mux))
+	mux.AddBreaker(&Breaker{Name: "billing", Threshold: 1})
+	mux.Breakers()[0].Do(func() error { return errors.New("failure") })
+
+	var test = func(accept string) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(Get, "http://www.example.com/console", nil)
+		r.Header.Set("Accept", accept)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: Got: %d Wanted: %d", accept, w.Code, http.StatusOK)
+		}
+		return w
+	}
+
+	html := test("text/html,application/xhtml+xml,*/*;q=0.8")
+	if ct := html.Header().Get("Content-Type"); !strings.Contains(ct, "text/html") {
+		t.Fatal("Got:", ct, "Wanted: text/html")
+	}
+	if !strings.Contains(html.Body.String(), "API Console") {
+		t.Fatal("console page was not returned")
+	}
+
+	var state struct {
+		Routes   []*consoleRoute
+		Breakers []struct {
+			Name  string
+			State string
+			Trips uint64
+		}
+	}
+	if err := json.Unmarshal(test("application/json").Body.Bytes(), &state); err != nil {
+		t.Fatal(err)
+	}
+	if len(state.Breakers) != 1 || state.Breakers[0].Name != "billing" || state.Breakers[0].State != "open" || state.Breakers[0].Trips != 1 {
+		t.Errorf("Got: %+v Wanted: the open billing breaker", state.Breakers)
+	}
+	routes := state.Routes
+	expected := map[string]string{
+		"/people":      strings.Join([]string{Head, Get, Post}, ", "),
+		"/people/{id}": strings.Join([]string{Head, Get, Delete}, ", "),
+		"/console":     strings.Join([]string{Head, Get}, ", "),
+	}
+	if len(routes) != len(expected) {
+		t.Fatal("Got:", len(routes), "routes Wanted:", len(expected))
+	}
+	for _, route := range routes {
+		if methods := strings.Join(route.Methods, ", "); methods != expected[route.Pattern] {
+			t.Errorf("%s: Got: %s Wanted: %s", route.Pattern, methods, expected[route.Pattern])
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package rst
+
+import (
+	"context"
+	"net/http"
+)
+
+/*
+ContextGetter is implemented by endpoints allowing the GET and HEAD methods,
+which need the context of the request. It's preferred to Getter.
+
+The context of the request is canceled when the client closes the connection,
+when the deadline set by TimeoutPolicy is exceeded, or once the response has
+been written, so that the work it governs can be aborted.
+
+	func (ep *endpoint) Get(ctx context.Context, vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+		row := database.QueryRowContext(ctx, "SELECT ...", vars.Get("id"))
+		...
+	}
+*/
+type ContextGetter interface {
+	Get(context.Context, RouteVars, *http.Request) (Resource, error)
+}
+
+// ContextPoster is implemented by endpoints allowing the POST method, which
+// need the context of the request. It's preferred to Poster.
+type ContextPoster interface {
+	Post(context.Context, RouteVars, *http.Request) (resource Resource, location string, err error)
+}
+
+// ContextPutter is implemented by endpoints allowing the PUT method, which
+// need the context of the request. It's preferred to Putter.
+type ContextPutter interface {
+	Put(context.Context, RouteVars, *http.Request) (Resource, error)
+}
+
+// ContextPatcher is implemented by endpoints allowing the PATCH method, which
+// need the context of the request. It's preferred to Patcher.
+type ContextPatcher interface {
+	Patch(context.Context, RouteVars, *http.Request) (Resource, error)
+}
+
+// ContextDeleter is implemented by endpoints allowing the DELETE method, which
+// need the context of the request. It's preferred to Deleter.
+type ContextDeleter interface {
+	Delete(context.Context, RouteVars, *http.Request) error
+}
+
+type contextGetter struct{ endpoint ContextGetter }
+
+func (e contextGetter) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	return e.endpoint.Get(r.Context(), vars, r)
+}
+
+type contextPoster struct{ endpoint ContextPoster }
+
+func (e contextPoster) Post(vars RouteVars, r *http.Request) (Resource, string, error) {
+	return e.endpoint.Post(r.Context(), vars, r)
+}
+
+type contextPutter struct{ endpoint ContextPutter }
+
+func (e contextPutter) Put(vars RouteVars, r *http.Request) (Resource, error) {
+	return e.endpoint.Put(r.Context(), vars, r)
+}
+
+type contextPatcher struct{ endpoint ContextPatcher }
+
+func (e contextPatcher) Patch(vars RouteVars, r *http.Request) (Resource, error) {
+	return e.endpoint.Patch(r.Context(), vars, r)
+}
+
+type contextDeleter struct{ endpoint ContextDeleter }
+
+func (e contextDeleter) Delete(vars RouteVars, r *http.Request) error {
+	return e.endpoint.Delete(r.Context(), vars, r)
+}
+
+// getterOf returns the Getter implemented by endpoint, or nil.
+func getterOf(endpoint Endpoint) Getter {
+	switch i := endpoint.(type) {
+	case ContextGetter:
+		return contextGetter{i}
+	case Getter:
+		return i
+	}
+	return nil
+}
+
+// posterOf returns the Poster implemented by endpoint, or nil.
+func posterOf(endpoint Endpoint) Poster {
+	switch i := endpoint.(type) {
+	case ContextPoster:
+		return contextPoster{i}
+	case Poster:
+		return i
+	}
+	return nil
+}
+
+// putterOf returns the Putter implemented by endpoint, or nil.
+func putterOf(endpoint Endpoint) Putter {
+	switch i := endpoint.(type) {
+	case ContextPutter:
+		return contextPutter{i}
+	case Putter:
+		return i
+	}
+	return nil
+}
+
+// patcherOf returns the Patcher implemented by endpoint, or nil.
+func patcherOf(endpoint Endpoint) Patcher {
+	switch i := endpoint.(type) {
+	case ContextPatcher:
+		return contextPatcher{i}
+	case Patcher:
+		return i
+	}
+	return nil
+}
+
+// deleterOf returns the Deleter implemented by endpoint, or nil.
+func deleterOf(endpoint Endpoint) Deleter {
+	switch i := endpoint.(type) {
+	case ContextDeleter:
+		return contextDeleter{i}
+	case Deleter:
+		return i
+	}
+	return nil
+}
+
+// withRequestContext returns a copy of r whose context is canceled when the
+// returned function is called, and carries the correlation headers of r.
+func withRequestContext(r *http.Request) (*http.Request, func()) {
+	ctx, cancel := context.WithCancel(r.Context())
+	if c := parseCorrelation(r.Header); c != nil {
+		ctx = withCorrelation(ctx, c)
+	}
+	copied := r.WithContext(ctx)
+	shareVars(r, copied)
+	return copied, cancel
+}
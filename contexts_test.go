@@ -0,0 +1,50 @@
+package rst
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type contextKey string
+
+type contextEndpoint struct {
+	ctx context.Context
+}
+
+func (e *contextEndpoint) Get(ctx context.Context, vars RouteVars, r *http.Request) (Resource, error) {
+	e.ctx = ctx
+	if v, _ := ctx.Value(contextKey("user")).(string); v != "alice" {
+		return nil, BadRequest("", "")
+	}
+	return nil, NotFound()
+}
+
+func (e *contextEndpoint) Delete(ctx context.Context, vars RouteVars, r *http.Request) error {
+	e.ctx = ctx
+	return nil
+}
+
+func TestContextEndpoint(t *testing.T) {
+	endpoint := &contextEndpoint{}
+	mux := NewMux()
+	mux.HandleEndpoint("/", endpoint)
+
+	r, _ := http.NewRequest(Get, "/", nil)
+	r = r.WithContext(context.WithValue(r.Context(), contextKey("user"), "alice"))
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("got %d, wanted %d", w.Code, http.StatusNotFound)
+	}
+
+	// The context is canceled once the response has been written.
+	if err := endpoint.ctx.Err(); err != context.Canceled {
+		t.Fatalf("got %v, wanted %v", err, context.Canceled)
+	}
+
+	if methods := AllowedMethods(endpoint); len(methods) != 3 {
+		t.Fatalf("got allowed methods %v, wanted HEAD, GET and DELETE", methods)
+	}
+}
@@ -0,0 +1,52 @@
+package rst
+
+import (
+	"net/http"
+	"strings"
+)
+
+/*
+ContinueValidator is implemented by endpoints validating requests sent with an
+"Expect: 100-continue" header before their body is transmitted, so that large
+uploads can be rejected without being sent.
+
+	func (ep *PhotosEP) ExpectContinue(vars rst.RouteVars, r *http.Request) error {
+		if r.ContentLength > 10<<20 {
+			return rst.RequestEntityTooLarge(10 << 20)
+		}
+		if !strings.HasPrefix(r.Header.Get("Content-Type"), "image/") {
+			return rst.UnsupportedMediaType("image/jpeg", "image/png")
+		}
+		return nil
+	}
+
+ExpectContinue is called once the request has been authenticated, before the
+body is read by anything. The error it returns is written in response to the
+request, and the client is told not to send the body. Otherwise, the interim
+response 100 Continue is sent to the client when the body is first read.
+
+ExpectContinue is only called for requests expecting 100 Continue: the methods
+of the endpoint must validate the requests sent without the header.
+*/
+type ContinueValidator interface {
+	ExpectContinue(vars RouteVars, r *http.Request) error
+}
+
+// expectsContinue returns true if r is waiting for 100 Continue before
+// sending its body.
+func expectsContinue(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Expect"), "100-continue") && r.ContentLength != 0
+}
+
+// expectContinue returns the error of the ContinueValidator of handler for r,
+// if any.
+func expectContinue(handler http.Handler, r *http.Request) error {
+	h, valid := handler.(*endpointHandler)
+	if !valid || !expectsContinue(r) {
+		return nil
+	}
+	if v, implemented := policyEndpoint(h.endpoint).(ContinueValidator); implemented {
+		return v.ExpectContinue(getVars(r), r)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package rst
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type continuedEndpoint struct {
+	posted bool
+}
+
+func (e *continuedEndpoint) Post(vars RouteVars, r *http.Request) (Resource, string, error) {
+	e.posted = true
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, "", err
+	}
+	return &echoResource{b}, "", nil
+}
+
+func (e *continuedEndpoint) ExpectContinue(vars RouteVars, r *http.Request) error {
+	if r.Header.Get("Content-Type") != "text/plain" {
+		return UnsupportedMediaType("text/plain")
+	}
+	return nil
+}
+
+// watchedBody is a request body recording whether it has been read.
+type watchedBody struct {
+	io.Reader
+	read bool
+}
+
+func (b *watchedBody) Read(p []byte) (int, error) {
+	b.read = true
+	return b.Reader.Read(p)
+}
+
+func TestExpectContinue(t *testing.T) {
+	endpoint := &continuedEndpoint{}
+	mux := NewMux()
+	mux.HandleEndpoint("/uploads", endpoint)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client := &http.Client{Transport: &http.Transport{ExpectContinueTimeout: 10 * time.Second}}
+
+	var test = func(contentType string, code int, read bool) {
+		endpoint.posted = false
+		body := &watchedBody{Reader: strings.NewReader("hello")}
+		r, _ := http.NewRequest(Post, server.URL+"/uploads", body)
+		r.ContentLength = 5
+		r.Header.Set("Content-Type", contentType)
+		r.Header.Set("Expect", "100-continue")
+		resp, err := client.Do(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != code {
+			t.Errorf("%s: status code is %d, expected %d", contentType, resp.StatusCode, code)
+		}
+		if body.read != read || endpoint.posted != read {
+			t.Errorf("%s: body read is %t and endpoint called is %t, expected %t", contentType, body.read, endpoint.posted, read)
+		}
+	}
+
+	test("application/json", http.StatusUnsupportedMediaType, false)
+	test("text/plain", http.StatusCreated, true)
+}
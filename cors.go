@@ -37,8 +37,8 @@ var PermissiveAccessControl = &AccessControlResponse{
 }
 
 /*
-Preflighter is implemented by endpoints wishing to customize the response to
-a CORS preflighted request.
+Preflighter is implemented by endpoints wishing to customize the CORS policy
+of their requests, overriding the policy of the mux.
 
 	func (e *endpoint) Preflight(req *rst.AccessControlRequest, vars rst.RouteVars, r *http.Request) *rst.AccessControlResponse {
 		if time.Now().Hour() < 12 {
@@ -53,6 +53,12 @@ a CORS preflighted request.
 			Methods: []string{"POST"},
 		}
 	}
+
+Preflight is called for preflighted requests, and for the actual cross-origin
+requests, so that the Access-Control-Allow-Origin and
+Access-Control-Expose-Headers of their responses match the preflight. The
+Method of req is the method of the actual request. A nil response falls back
+on the policy of the mux.
 */
 type Preflighter interface {
 	Preflight(*AccessControlRequest, RouteVars, *http.Request) *AccessControlResponse
@@ -85,10 +91,28 @@ func ParseAccessControlRequest(r *http.Request) *AccessControlRequest {
 	// TODO: remove duplicated headers before serving them back.
 }
 
-// AccessControlResponse defines the response headers to a CORS access control
-// request.
+/*
+AccessControlResponse defines the response headers to a CORS access control
+request.
+
+Origin is written as is in the Access-Control-Allow-Origin header. Origins
+allows a list of origins instead, which can contain wildcard patterns such as
+https://*.example.com, or * for any origin: the origin of the request is
+echoed when it's allowed, as required for requests with credentials, and no
+CORS header is written otherwise. A * can only replace the leading labels of
+a host, and a * origin never allows credentials: its responses have an
+Access-Control-Allow-Origin of * instead of the origin of the request.
+
+	mux.SetCORSPolicy(&rst.AccessControlResponse{
+		Origins:        []string{"https://example.com", "https://*.example.com"},
+		Credentials:    true,
+		ExposedHeaders: []string{"Etag"},
+		MaxAge:         time.Hour,
+	})
+*/
 type AccessControlResponse struct {
 	Origin         string
+	Origins        []string // Allowed origins, which take precedence over Origin.
 	ExposedHeaders []string
 	Methods        []string // Empty array means any, nil means none.
 	AllowedHeaders []string // Empty array means any, nil means none.
@@ -109,22 +133,30 @@ func newAccessControlHandler(endpoint Endpoint, ac *AccessControlResponse) *acce
 }
 
 func (h *accessControlHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Responses allowing a list of origins vary with the origin, even when the
+	// request has none.
+	if h.AccessControlResponse != nil && h.Origins != nil {
+		addVary(w.Header(), "Origin")
+	}
 	if _, exists := r.Header["Origin"]; !exists {
 		return
 	}
 
 	req := ParseAccessControlRequest(r)
 
+	if strings.ToUpper(r.Method) != Options {
+		req.Method = r.Method
+	}
+
 	var resp *AccessControlResponse
-	if h.endpoint == nil {
+	if preflighter, implemented := h.endpoint.(Preflighter); implemented {
+		resp = preflighter.Preflight(req, getVars(r), r)
+	}
+	if resp == nil {
 		resp = h.AccessControlResponse
-	} else {
-		if preflighter, implemented := h.endpoint.(Preflighter); implemented && strings.ToUpper(r.Method) == Options {
-			// If Options and endpoint implements Preflighter, call Preflight.
-			resp = preflighter.Preflight(req, getVars(r), r)
-		} else {
-			resp = h.AccessControlResponse
-		}
+	}
+	if resp == nil {
+		return
 	}
 
 	// Adding a vary if an origin is specified in the response.
@@ -135,10 +167,23 @@ func (h *accessControlHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	}()
 
 	// Writing response headers
-	if resp.Origin != "" {
+	credentials := resp.Credentials
+	if resp.Origins != nil {
+		if !matchOrigin(resp.Origins, req.Origin) {
+			return
+		}
+		// Echoing any origin with credentials would let every site read the
+		// responses to the requests of signed in users.
+		if credentials && anyOrigin(resp.Origins) {
+			w.Header().Set("Access-Control-Allow-Origin", "*")
+			credentials = false
+		} else {
+			w.Header().Set("Access-Control-Allow-Origin", req.Origin)
+		}
+	} else if resp.Origin != "" {
 		w.Header().Set("Access-Control-Allow-Origin", resp.Origin)
 	}
-	w.Header().Set("Access-Control-Allow-Credentials", strconv.FormatBool(resp.Credentials))
+	w.Header().Set("Access-Control-Allow-Credentials", strconv.FormatBool(credentials))
 
 	// Exposed headers
 	if len(resp.ExposedHeaders) > 0 {
@@ -172,3 +217,52 @@ func (h *accessControlHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		w.Header().Set("Access-Control-Allow-Headers", strings.Join(normalizeHeaderArray(headers), ", "))
 	}
 }
+
+// matchOrigin returns true if origin matches one of patterns. A * pattern
+// matches any origin, and a * can otherwise only replace the leading label of
+// a host, so that https://*.example.com matches the subdomains of
+// example.com. The opaque null origin only matches a null pattern.
+func matchOrigin(patterns []string, origin string) bool {
+	origin = strings.ToLower(origin)
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(pattern)
+		if pattern == origin || (pattern == "*" && origin != "null") {
+			return true
+		}
+		i := strings.Index(pattern, "://*.")
+		if i < 0 || strings.Count(pattern, "*") != 1 {
+			continue
+		}
+		prefix, suffix := pattern[:i+len("://")], pattern[i+len("://*"):]
+		if len(origin) > len(prefix)+len(suffix) && strings.HasPrefix(origin, prefix) && strings.HasSuffix(origin, suffix) &&
+			isSubdomain(origin[len(prefix):len(origin)-len(suffix)]) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSubdomain returns true if s is a sequence of dot separated host labels.
+func isSubdomain(s string) bool {
+	for _, label := range strings.Split(s, ".") {
+		if label == "" {
+			return false
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// anyOrigin returns true if patterns allow any origin.
+func anyOrigin(patterns []string) bool {
+	for _, pattern := range patterns {
+		if pattern == "*" {
+			return true
+		}
+	}
+	return false
+}
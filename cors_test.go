@@ -2,7 +2,10 @@ package rst
 
 import (
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 )
 
 var testCORSHeaders = []string{
@@ -202,3 +205,124 @@ func TestPreflightInterface(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+type morningEndpoint struct{}
+
+func (e *morningEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	return nil, nil
+}
+
+func (e *morningEndpoint) Preflight(req *AccessControlRequest, vars RouteVars, r *http.Request) *AccessControlResponse {
+	if req.Method != Get && req.Method != Head {
+		return nil
+	}
+	return &AccessControlResponse{Origin: "morning.example.com", ExposedHeaders: []string{"X-Sunrise"}}
+}
+
+func TestPreflighterActualRequests(t *testing.T) {
+	var test = func(mux *Mux, method, origin, exposed string) {
+		r, _ := http.NewRequest(method, "http://example.com/morning", nil)
+		r.Header.Set("Origin", "example.com")
+		r.Header.Set("Access-Control-Request-Method", Get)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if got := w.Header().Get("Access-Control-Allow-Origin"); got != origin {
+			t.Errorf("%s: Access-Control-Allow-Origin: Got: %q Wanted: %q", method, got, origin)
+		}
+		if got := w.Header().Get("Access-Control-Expose-Headers"); got != exposed {
+			t.Errorf("%s: Access-Control-Expose-Headers: Got: %q Wanted: %q", method, got, exposed)
+		}
+	}
+
+	// Endpoints have a CORS policy without a policy on the mux.
+	mux := NewMux()
+	mux.HandleEndpoint("/morning", &morningEndpoint{})
+	test(mux, Options, "morning.example.com", "X-Sunrise")
+	test(mux, Get, "morning.example.com", "X-Sunrise")
+	test(mux, Delete, "", "")
+
+	mux.SetCORSPolicy(&AccessControlResponse{Origin: "*"})
+	test(mux, Get, "morning.example.com", "X-Sunrise")
+	test(mux, Delete, "*", "")
+}
+
+func TestAccessControlOrigins(t *testing.T) {
+	mux := NewMux()
+	mux.SetCORSPolicy(&AccessControlResponse{
+		Origins:     []string{"https://example.com", "https://*.example.com"},
+		Credentials: true,
+		Methods:     []string{},
+		MaxAge:      time.Hour,
+	})
+	mux.Get("/people", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return nil, nil
+	})
+
+	var test = func(method, origin, allowed string) {
+		r, _ := http.NewRequest(method, "http://example.com/people", nil)
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+			r.Header.Set("Access-Control-Request-Method", Get)
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if got := w.Header().Get("Access-Control-Allow-Origin"); got != allowed {
+			t.Errorf("%s %s: Access-Control-Allow-Origin: Got: %q Wanted: %q", method, origin, got, allowed)
+		}
+		if got := w.Header().Get("Vary"); !strings.Contains(got, "Origin") {
+			t.Errorf("%s %s: Vary: Got: %q Wanted Origin", method, origin, got)
+		}
+		if allowed == "" {
+			if got := w.Header().Get("Access-Control-Allow-Credentials"); got != "" {
+				t.Errorf("%s %s: Access-Control-Allow-Credentials: Got: %q Wanted none", method, origin, got)
+			}
+			return
+		}
+		if got := w.Header().Get("Access-Control-Allow-Credentials"); got != "true" {
+			t.Errorf("%s %s: Access-Control-Allow-Credentials: Got: %q", method, origin, got)
+		}
+		if got := w.Header().Get("Access-Control-Max-Age"); method == Options && got != "3600" {
+			t.Errorf("%s %s: Access-Control-Max-Age: Got: %q", method, origin, got)
+		}
+	}
+
+	test(Get, "https://example.com", "https://example.com")
+	test(Get, "https://api.example.com", "https://api.example.com")
+	test(Options, "https://a.b.example.com", "https://a.b.example.com")
+	test(Get, "https://.example.com", "")
+	test(Get, "https://evil-example.com", "")
+	test(Get, "http://api.example.com", "")
+	test(Get, "null", "")
+	test(Get, "", "")
+
+	if !matchOrigin([]string{"*"}, "https://any.com") || matchOrigin([]string{"*"}, "null") || !matchOrigin([]string{"null"}, "null") {
+		t.Error("* should match any origin but null")
+	}
+	for _, origin := range []string{"https://evilexample.com", "https://evil.com#.example.com", "https://a..example.com"} {
+		if matchOrigin([]string{"https://*example.com", "https://*.example.com"}, origin) {
+			t.Errorf("%s should only match the subdomains of example.com", origin)
+		}
+	}
+	if matchOrigin([]string{"https://api.*.com", "https://*.*.com"}, "https://api.example.com") {
+		t.Error("* should only replace the leading labels of a host")
+	}
+}
+
+func TestAccessControlAnyOriginCredentials(t *testing.T) {
+	mux := NewMux()
+	mux.SetCORSPolicy(&AccessControlResponse{Origins: []string{"*"}, Credentials: true})
+	mux.Get("/people", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return nil, nil
+	})
+
+	r, _ := http.NewRequest(Get, "http://example.com/people", nil)
+	r.Header.Set("Origin", "https://evil.com")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if got := w.Header().Get("Access-Control-Allow-Origin"); got != "*" {
+		t.Errorf("Access-Control-Allow-Origin: Got: %q Wanted: *", got)
+	}
+	if got := w.Header().Get("Access-Control-Allow-Credentials"); got != "false" {
+		t.Errorf("Access-Control-Allow-Credentials: Got: %q Wanted: false", got)
+	}
+}
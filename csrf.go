@@ -0,0 +1,213 @@
+package rst
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/context"
+)
+
+// Defaults of a CSRF protection.
+const (
+	DefaultCSRFCookie = "csrf_token"
+	DefaultCSRFHeader = "X-CSRF-Token"
+	DefaultCSRFField  = "csrf_token"
+)
+
+/*
+CSRF protects the state-changing requests of browsers against cross-site
+request forgery with a double-submit cookie: POST, PUT, PATCH and DELETE
+requests, as well as the extension methods of the endpoints, must echo the
+token of the cookie in the X-CSRF-Token header, or in the csrf_token field of
+their form.
+
+	mux.SetCSRF(&rst.CSRF{Secure: true})
+
+Safe requests, such as GET, issue the cookie when the client doesn't have one.
+The token is also sent in the X-CSRF-Token header of their responses, which is
+exposed to the origins allowed by the CORS policy of the mux, so that
+single-page applications served from another origin can read it. Handlers
+rendering forms read it with CSRFToken.
+
+Requests failing the check are rejected with status code 403 Forbidden.
+Endpoints implementing CSRFPolicy use their own protection, or none.
+*/
+type CSRF struct {
+	Cookie string        // Optional. Name of the cookie holding the token, DefaultCSRFCookie by default.
+	Header string        // Optional. Header echoing the token, DefaultCSRFHeader by default.
+	Field  string        // Optional. Form field echoing the token, DefaultCSRFField by default.
+	Path   string        // Optional. Path of the cookie, / by default.
+	Domain string        // Optional. Domain of the cookie.
+	Secure bool          // Set to true to only send the cookie over HTTPS.
+	MaxAge time.Duration // Optional. Lifetime of the cookie, which lasts for the browser session by default.
+}
+
+// CSRFPolicy is implemented by endpoints protecting their requests with their
+// own settings. A nil CSRF exempts the endpoint from the protection of the
+// mux, such as a webhook receiving requests from other servers.
+type CSRFPolicy interface {
+	CSRF() *CSRF
+}
+
+// SetCSRF sets the CSRF protection of the requests served by the mux, unless
+// their endpoint implements CSRFPolicy. A nil value disables it, which is the
+// default.
+func (s *Mux) SetCSRF(c *CSRF) {
+	s.csrf = c
+}
+
+// csrfOf returns the CSRF protection of the requests served by handler, or
+// nil.
+func (s *Mux) csrfOf(handler http.Handler) *CSRF {
+	if h, valid := handler.(*endpointHandler); valid {
+		if policy, implemented := policyEndpoint(h.endpoint).(CSRFPolicy); implemented {
+			return policy.CSRF()
+		}
+	}
+	return s.csrf
+}
+
+func (c *CSRF) cookie() string {
+	if c.Cookie == "" {
+		return DefaultCSRFCookie
+	}
+	return c.Cookie
+}
+
+func (c *CSRF) header() string {
+	if c.Header == "" {
+		return DefaultCSRFHeader
+	}
+	return c.Header
+}
+
+func (c *CSRF) field() string {
+	if c.Field == "" {
+		return DefaultCSRFField
+	}
+	return c.Field
+}
+
+// csrfSafeMethods are the methods which don't change the state of resources.
+var csrfSafeMethods = []string{Get, Head, Options, "TRACE"}
+
+// apply issues the token of r on safe requests, and checks it on the other
+// ones. It returns an error if the token of r is missing or invalid.
+func (c *CSRF) apply(w http.ResponseWriter, r *http.Request) error {
+	c.allowHeader(w.Header(), r)
+
+	token := ""
+	if cookie, err := r.Cookie(c.cookie()); err == nil && validCSRFToken(cookie.Value) {
+		token = cookie.Value
+	}
+
+	method := strings.ToUpper(r.Method)
+	for _, safe := range csrfSafeMethods {
+		if method != safe {
+			continue
+		}
+		if token == "" {
+			token = newCSRFToken()
+			cookie := &http.Cookie{
+				Name:     c.cookie(),
+				Value:    token,
+				Path:     c.Path,
+				Domain:   c.Domain,
+				Secure:   c.Secure,
+				HttpOnly: true,
+			}
+			if cookie.Path == "" {
+				cookie.Path = "/"
+			}
+			if c.MaxAge > 0 {
+				cookie.MaxAge = int(c.MaxAge.Seconds())
+				cookie.Expires = time.Now().Add(c.MaxAge)
+			}
+			http.SetCookie(w, cookie)
+		}
+		context.Set(r, csrfKey, token)
+		w.Header().Set(c.header(), token)
+		return nil
+	}
+
+	submitted := r.Header.Get(c.header())
+	if submitted == "" {
+		submitted = r.PostFormValue(c.field())
+	}
+	if token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(submitted)) != 1 {
+		return csrfFailed()
+	}
+	context.Set(r, csrfKey, token)
+	return nil
+}
+
+// allowHeader adds the header of c to the headers of the CORS policy written in
+// h, so that the origins it allows can read and send the token.
+func (c *CSRF) allowHeader(h http.Header, r *http.Request) {
+	if h.Get("Access-Control-Allow-Origin") == "" {
+		return
+	}
+	name := http.CanonicalHeaderKey(c.header())
+	if exposed := h.Get("Access-Control-Expose-Headers"); exposed == "" {
+		h.Set("Access-Control-Expose-Headers", name)
+	} else if !containsToken(exposed, name) {
+		h.Set("Access-Control-Expose-Headers", exposed+", "+name)
+	}
+
+	allowed := h.Get("Access-Control-Allow-Headers")
+	requested := r.Header.Get("Access-Control-Request-Headers")
+	if allowed != "" && containsToken(requested, name) && !containsToken(allowed, name) {
+		h.Set("Access-Control-Allow-Headers", allowed+", "+name)
+	}
+}
+
+// containsToken returns true if the comma-separated list contains name,
+// regardless of its case.
+func containsToken(list, name string) bool {
+	for _, token := range strings.Split(list, ",") {
+		if strings.EqualFold(strings.TrimSpace(token), name) {
+			return true
+		}
+	}
+	return false
+}
+
+const csrfTokenSize = 32
+
+func newCSRFToken() string {
+	b := make([]byte, csrfTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func validCSRFToken(token string) bool {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	return err == nil && len(b) == csrfTokenSize
+}
+
+// csrfFailed is returned when the CSRF token of a request is missing or
+// invalid.
+func csrfFailed() *Error {
+	err := Forbidden()
+	err.Reason = "CSRF token missing or invalid"
+	err.Description = "The request must include the CSRF token issued by the server."
+	return err
+}
+
+const csrfKey = "__rst__csrf"
+
+// CSRFToken returns the CSRF token of r, to embed in the forms rendered in
+// response to r. It returns an empty string if r isn't protected against
+// CSRF.
+func CSRFToken(r *http.Request) string {
+	if token, ok := context.Get(r, csrfKey).(string); ok {
+		return token
+	}
+	return ""
+}
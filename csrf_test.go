@@ -0,0 +1,99 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+type formEndpoint struct{}
+
+func (e *formEndpoint) Post(vars RouteVars, r *http.Request) (Resource, string, error) {
+	return nil, "", nil
+}
+
+type webhookEndpoint struct {
+	formEndpoint
+}
+
+func (e *webhookEndpoint) CSRF() *CSRF {
+	return nil
+}
+
+func TestCSRF(t *testing.T) {
+	mux := NewMux()
+	mux.SetCSRF(&CSRF{})
+	mux.SetCORSPolicy(&AccessControlResponse{
+		Origin:         "https://app.example.com",
+		Credentials:    true,
+		Methods:        []string{},
+		AllowedHeaders: []string{"Content-Type"},
+	})
+	var token string
+	mux.HandleEndpoint("/people", &formEndpoint{})
+	mux.Get("/form", func(vars RouteVars, r *http.Request) (Resource, error) {
+		token = CSRFToken(r)
+		return nil, nil
+	})
+	mux.HandleEndpoint("/webhook", &webhookEndpoint{})
+
+	send := func(method, path string, header http.Header, body string) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(method, "http://example.com"+path, strings.NewReader(body))
+		for name, values := range header {
+			for _, value := range values {
+				r.Header.Add(name, value)
+			}
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		return w
+	}
+
+	// Safe requests issue a token.
+	w := send(Get, "/form", nil, "")
+	cookies := (&http.Response{Header: w.Header()}).Cookies()
+	if len(cookies) != 1 || cookies[0].Name != DefaultCSRFCookie || !cookies[0].HttpOnly || cookies[0].Value != token {
+		t.Fatalf("got cookies %v, wanted %s=%s", cookies, DefaultCSRFCookie, token)
+	}
+	if got := w.Header().Get(DefaultCSRFHeader); got != token {
+		t.Fatalf("got %s %q, wanted %q", DefaultCSRFHeader, got, token)
+	}
+	cookie := DefaultCSRFCookie + "=" + token
+
+	// Clients with a token keep it.
+	w = send(Get, "/form", http.Header{"Cookie": {cookie}}, "")
+	if w.Header().Get("Set-Cookie") != "" || token != cookies[0].Value {
+		t.Fatalf("got Set-Cookie %q, wanted none", w.Header().Get("Set-Cookie"))
+	}
+
+	var test = func(name string, header http.Header, body string, status int) {
+		if w := send(Post, "/people", header, body); w.Code != status {
+			t.Errorf("%s: got %d, wanted %d", name, w.Code, status)
+		}
+	}
+	test("no cookie", http.Header{DefaultCSRFHeader: {token}}, "", http.StatusForbidden)
+	test("no token", http.Header{"Cookie": {cookie}}, "", http.StatusForbidden)
+	test("wrong token", http.Header{"Cookie": {cookie}, DefaultCSRFHeader: {newCSRFToken()}}, "", http.StatusForbidden)
+	test("header", http.Header{"Cookie": {cookie}, DefaultCSRFHeader: {token}}, "", http.StatusCreated)
+	form := url.Values{DefaultCSRFField: {token}}.Encode()
+	test("form", http.Header{"Cookie": {cookie}, "Content-Type": {"application/x-www-form-urlencoded"}}, form, http.StatusCreated)
+
+	if w := send(Post, "/webhook", nil, ""); w.Code != http.StatusCreated {
+		t.Errorf("webhook: got %d, wanted it exempted", w.Code)
+	}
+
+	// The token header is allowed and exposed to the origins of the CORS policy.
+	w = send(Options, "/people", http.Header{
+		"Origin":                         {"https://app.example.com"},
+		"Access-Control-Request-Method":  {Post},
+		"Access-Control-Request-Headers": {"content-type, x-csrf-token"},
+	}, "")
+	if got := w.Header().Get("Access-Control-Allow-Headers"); got != "Content-Type, X-Csrf-Token" {
+		t.Errorf("got Access-Control-Allow-Headers %q", got)
+	}
+	if got := w.Header().Get("Access-Control-Expose-Headers"); got != "X-Csrf-Token" {
+		t.Errorf("got Access-Control-Expose-Headers %q", got)
+	}
+}
@@ -0,0 +1,214 @@
+package rst
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// FormType is the media type of URL-encoded forms.
+const FormType = "application/x-www-form-urlencoded"
+
+// DecoderFunc decodes b, the body of the request r, in v.
+type DecoderFunc func(b []byte, v interface{}, r *http.Request) error
+
+// decoder associates a DecoderFunc with a media type.
+type decoder struct {
+	mediaType string
+	decode    DecoderFunc
+}
+
+var (
+	decodersMu sync.RWMutex
+	decoders   = []*decoder{
+		{"application/json", decodeJSONBody},
+		{"application/xml", decodeXMLBody},
+		{"text/xml", decodeXMLBody},
+		{MsgPackType, decodeMsgPackBody},
+		{FormType, decodeFormBody},
+	}
+)
+
+/*
+RegisterDecoder associates fn with mediaType in the registry of decoders
+consulted by Bind.
+
+	func init() {
+		rst.RegisterDecoder("application/vnd.myapp+json", func(b []byte, v interface{}, r *http.Request) error {
+			return json.Unmarshal(b, &vendorEnvelope{Data: v})
+		})
+	}
+
+Registering a decoder for a media type replaces the previous one, including
+the built-in decoders of application/json, application/xml, text/xml,
+application/msgpack and application/x-www-form-urlencoded. A nil fn removes the
+decoder of mediaType.
+*/
+func RegisterDecoder(mediaType string, fn DecoderFunc) {
+	decodersMu.Lock()
+	defer decodersMu.Unlock()
+
+	for i, d := range decoders {
+		if d.mediaType != mediaType {
+			continue
+		}
+		if fn == nil {
+			decoders = append(decoders[:i:i], decoders[i+1:]...)
+			return
+		}
+		decoders[i] = &decoder{mediaType, fn}
+		return
+	}
+	if fn != nil {
+		decoders = append(decoders, &decoder{mediaType, fn})
+	}
+}
+
+// lookupDecoder returns the decoder registered for mediaType, or the one of
+// JSON or XML for media types with a +json or +xml suffix. It returns nil if
+// there's none.
+func lookupDecoder(mediaType string) *decoder {
+	decodersMu.RLock()
+	defer decodersMu.RUnlock()
+	fallback := ""
+	switch {
+	case strings.HasSuffix(mediaType, "+json"):
+		fallback = "application/json"
+	case strings.HasSuffix(mediaType, "+xml"):
+		fallback = "application/xml"
+	}
+	var found *decoder
+	for _, d := range decoders {
+		if d.mediaType == mediaType {
+			return d
+		}
+		if d.mediaType == fallback {
+			found = d
+		}
+	}
+	return found
+}
+
+// decoderTypes returns the media types of the registered decoders.
+func decoderTypes() []string {
+	decodersMu.RLock()
+	defer decodersMu.RUnlock()
+	types := make([]string, 0, len(decoders))
+	for _, d := range decoders {
+		types = append(types, d.mediaType)
+	}
+	return types
+}
+
+/*
+Bind decodes the body of r in v, with the decoder registered for its
+Content-Type.
+
+	func (ep *PeopleEP) Post(vars rst.RouteVars, r *http.Request) (rst.Resource, string, error) {
+		person := &Person{}
+		if err := rst.Bind(r, person); err != nil {
+			return nil, "", err
+		}
+		...
+	}
+
+JSON bodies are decoded by the JSON engine of the mux, XML bodies by
+encoding/xml, and MessagePack bodies like JSON documents, so that they share
+the json tags of v. URL-encoded forms are decoded in *url.Values, or in the
+fields of structs annotated with a form tag, with the types, defaults and
+bounds supported by BindQuery. Bind panics if a form is decoded in another
+type.
+
+The errors returned can be returned by endpoints as is: bodies of a type
+without a decoder respond with status code 415 Unsupported Media Type listing
+the types supported, missing and malformed bodies with 400 Bad Request, and
+bodies exceeding the MaxRequestBodyBytes of the mux with 413 Request Entity Too
+Large.
+*/
+func Bind(r *http.Request, v interface{}) error {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	var d *decoder
+	if err == nil {
+		d = lookupDecoder(strings.ToLower(mediaType))
+	}
+	if d == nil {
+		return UnsupportedMediaType(decoderTypes()...)
+	}
+
+	var b []byte
+	if r.Body != nil {
+		if b, err = ioutil.ReadAll(r.Body); err != nil {
+			var e *Error
+			if errors.As(err, &e) {
+				return e
+			}
+			return err
+		}
+	}
+	if len(b) == 0 {
+		return BadRequest("Missing body", "The request must have a body.")
+	}
+
+	if err := d.decode(b, v, r); err != nil {
+		var e *Error
+		if errors.As(err, &e) {
+			return e
+		}
+		malformed := BadRequest("Malformed body", fmt.Sprintf("The body of the request is not valid %s.", mediaType))
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) && typeErr.Field != "" {
+			malformed.Fields = []*FieldError{{
+				Field:   typeErr.Field,
+				Code:    "invalid",
+				Message: fmt.Sprintf("The %s field can't be a %s.", typeErr.Field, typeErr.Value),
+			}}
+		}
+		return malformed
+	}
+	return nil
+}
+
+func decodeJSONBody(b []byte, v interface{}, r *http.Request) error {
+	return getJSONEngine(r).Unmarshal(b, v)
+}
+
+func decodeXMLBody(b []byte, v interface{}, r *http.Request) error {
+	return xml.Unmarshal(b, v)
+}
+
+func decodeMsgPackBody(b []byte, v interface{}, r *http.Request) error {
+	doc, err := readMsgPack(b)
+	if err != nil {
+		return err
+	}
+	if b, err = json.Marshal(doc); err != nil {
+		return err
+	}
+	return getJSONEngine(r).Unmarshal(b, v)
+}
+
+func decodeFormBody(b []byte, v interface{}, r *http.Request) error {
+	values, err := url.ParseQuery(string(b))
+	if err != nil {
+		return err
+	}
+	if form, ok := v.(*url.Values); ok {
+		*form = values
+		return nil
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		panic(fmt.Errorf("rst: can't decode a form in %T", v))
+	}
+	binder := &valueBinder{tag: "form", noun: "field", verr: &ValidationError{}}
+	binder.bind(values, rv.Elem())
+	return binder.err("Invalid form", "of the form")
+}
@@ -0,0 +1,168 @@
+package rst
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type boundPerson struct {
+	Name string   `json:"name" xml:"name" form:"name,required"`
+	Age  int      `json:"age" xml:"age" form:"age" min:"0"`
+	Tags []string `json:"tags" xml:"tag" form:"tags"`
+}
+
+func TestBind(t *testing.T) {
+	expected := &boundPerson{Name: "Francis", Age: 42, Tags: []string{"a", "b"}}
+
+	var test = func(contentType, body string, status int) {
+		r, _ := http.NewRequest(Post, "http://example.com/people", strings.NewReader(body))
+		r.Header.Set("Content-Type", contentType)
+		p := &boundPerson{}
+		err := Bind(r, p)
+		if status == 0 {
+			if err != nil {
+				t.Fatalf("%s: %v", contentType, err)
+			}
+			if !reflect.DeepEqual(p, expected) {
+				t.Errorf("%s: Got: %+v Wanted: %+v", contentType, p, expected)
+			}
+			return
+		}
+		if e, ok := err.(*Error); !ok || e.Code != status {
+			t.Errorf("%s %q: Got: %v Wanted status code %d", contentType, body, err, status)
+		}
+	}
+
+	msgpack, _ := hex.DecodeString("83a46e616d65a74672616e636973a3616765" + "2a" + "a47461677392a161a162")
+	test("application/json; charset=utf-8", `{"name":"Francis","age":42,"tags":["a","b"]}`, 0)
+	test("application/vnd.example+json", `{"name":"Francis","age":42,"tags":["a","b"]}`, 0)
+	test("application/xml", `<person><name>Francis</name><age>42</age><tag>a</tag><tag>b</tag></person>`, 0)
+	test(MsgPackType, string(msgpack), 0)
+	test(FormType, "name=Francis&age=42&tags=a,b", 0)
+
+	test("", `{}`, http.StatusUnsupportedMediaType)
+	test("text/csv", "name\nFrancis", http.StatusUnsupportedMediaType)
+	test("application/json", "", http.StatusBadRequest)
+	test("application/json", `{"name":`, http.StatusBadRequest)
+	test(MsgPackType, "\xc1", http.StatusBadRequest)
+	test(MsgPackType, string(msgpack[:10]), http.StatusBadRequest)
+	test(FormType, "age=-1", http.StatusBadRequest)
+
+	// Type mismatches are reported as invalid fields.
+	r, _ := http.NewRequest(Post, "http://example.com/people", strings.NewReader(`{"age":"old"}`))
+	r.Header.Set("Content-Type", "application/json")
+	if e, ok := Bind(r, &boundPerson{}).(*Error); !ok || len(e.Fields) != 1 || e.Fields[0].Field != "age" {
+		t.Errorf("Got: %#v Wanted an invalid age field", e)
+	}
+
+	// Forms can also be decoded in url.Values.
+	r, _ = http.NewRequest(Post, "http://example.com/people", strings.NewReader("name=Francis"))
+	r.Header.Set("Content-Type", FormType)
+	var values url.Values
+	if err := Bind(r, &values); err != nil || values.Get("name") != "Francis" {
+		t.Errorf("Got: %v %v", values, err)
+	}
+}
+
+// boundPeople is an endpoint creating the people bound from the body of POST
+// requests.
+type boundPeople struct{}
+
+func (ep *boundPeople) Post(vars RouteVars, r *http.Request) (Resource, string, error) {
+	p := &boundPerson{}
+	if err := Bind(r, p); err != nil {
+		return nil, "", err
+	}
+	return NewEnvelope(p, testTimeReference, "v1", 0), "/people/" + p.Name, nil
+}
+
+func TestBindEndpoint(t *testing.T) {
+	mux := NewMux()
+	mux.HandleEndpoint("/people", &boundPeople{})
+
+	var test = func(contentType, body string, status int) {
+		r, _ := http.NewRequest(Post, "/people", strings.NewReader(body))
+		r.Header.Set("Accept", "application/json")
+		r.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Fatalf("%s: Got: %d Wanted: %d", contentType, w.Code, status)
+		}
+		if expected := `{"name":"Francis","age":42,"tags":["a"]}`; status == http.StatusCreated && w.Body.String() != expected {
+			t.Errorf("%s: Got: %s Wanted: %s", contentType, w.Body.String(), expected)
+		}
+	}
+	test("application/json", `{"name":"Francis","age":42,"tags":["a"]}`, http.StatusCreated)
+	test(FormType, "name=Francis&age=42&tags=a", http.StatusCreated)
+	test("text/csv", "name\nFrancis", http.StatusUnsupportedMediaType)
+	test(FormType, "age=42", http.StatusBadRequest)
+}
+
+func TestBindBodyLimit(t *testing.T) {
+	r, _ := http.NewRequest(Post, "http://example.com/people", bytes.NewReader(bytes.Repeat([]byte(" "), 100)))
+	r.Header.Set("Content-Type", "application/json")
+	r.ContentLength = -1
+	limitBody(r, 50)
+	if e, ok := Bind(r, &boundPerson{}).(*Error); !ok || e.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("Got: %v Wanted status code 413", e)
+	}
+}
+
+func TestRegisterDecoder(t *testing.T) {
+	const vendor = "application/vnd.example"
+	RegisterDecoder(vendor, func(b []byte, v interface{}, r *http.Request) error {
+		v.(*boundPerson).Name = strings.ToUpper(string(b))
+		return nil
+	})
+	defer RegisterDecoder(vendor, nil)
+
+	r, _ := http.NewRequest(Post, "http://example.com/people", strings.NewReader("francis"))
+	r.Header.Set("Content-Type", vendor)
+	p := &boundPerson{}
+	if err := Bind(r, p); err != nil || p.Name != "FRANCIS" {
+		t.Errorf("Got: %+v %v", p, err)
+	}
+
+	RegisterDecoder(vendor, nil)
+	r, _ = http.NewRequest(Post, "http://example.com/people", strings.NewReader("francis"))
+	r.Header.Set("Content-Type", vendor)
+	if e, ok := Bind(r, p).(*Error); !ok || e.Code != http.StatusUnsupportedMediaType {
+		t.Errorf("Got: %v Wanted status code 415", e)
+	}
+}
+
+func TestReadMsgPack(t *testing.T) {
+	var test = func(doc string) {
+		var v interface{}
+		if err := decodeJSON([]byte(doc), &v); err != nil {
+			t.Fatal(err)
+		}
+		buffer := &bytes.Buffer{}
+		writeMsgPack(buffer, v)
+		read, err := readMsgPack(buffer.Bytes())
+		if err != nil {
+			t.Fatalf("%s: %v", doc, err)
+		}
+		// Numbers are written from JSON documents.
+		b, _ := json.Marshal(read)
+		var decoded interface{}
+		decodeJSON(b, &decoded)
+		written := &bytes.Buffer{}
+		writeMsgPack(written, decoded)
+		if !bytes.Equal(written.Bytes(), buffer.Bytes()) {
+			t.Errorf("%s: Got: %x Wanted: %x", doc, written.Bytes(), buffer.Bytes())
+		}
+	}
+
+	test(`null`)
+	test(`[true,false,1,-1,-33,200,1000,-200,100000,-100000,5000000000,1.5]`)
+	test(`{"b":[true,null,"x"],"a":{"c":"` + strings.Repeat("x", 300) + `"}}`)
+}
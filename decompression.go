@@ -0,0 +1,128 @@
+package rst
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// DefaultMaxDecompressedSize is the maximum size of decompressed request
+// bodies by default.
+const DefaultMaxDecompressedSize = 32 << 20
+
+// requestEncodings are the content codings of the request bodies decompressed
+// by the mux.
+const requestEncodings = "gzip, deflate"
+
+func (s *Mux) maxDecompressedSize() int64 {
+	if s.MaxDecompressedSize <= 0 {
+		return DefaultMaxDecompressedSize
+	}
+	return s.MaxDecompressedSize
+}
+
+// decompressBody replaces the body of r, compressed with the codings listed in
+// its Content-Encoding header, by its decompressed content, limited to limit
+// bytes. It returns an UnsupportedMediaType error if a coding isn't supported.
+func decompressBody(r *http.Request, limit int64) error {
+	header := strings.Join(r.Header["Content-Encoding"], ",")
+	if header == "" || r.Body == nil || r.Body == http.NoBody {
+		return nil
+	}
+
+	var codings []string
+	for _, coding := range strings.Split(header, ",") {
+		switch coding = strings.ToLower(strings.TrimSpace(coding)); coding {
+		case "", "identity":
+		case gzipCompression, "x-gzip", flateCompression:
+			codings = append(codings, coding)
+		default:
+			err := UnsupportedMediaType()
+			err.Description = fmt.Sprintf("The body of the request is encoded with %s. Supported encodings: %s", coding, requestEncodings)
+			err.Header.Set("Accept-Encoding", requestEncodings)
+			return err
+		}
+	}
+	r.Header.Del("Content-Encoding")
+	if len(codings) == 0 {
+		return nil
+	}
+
+	r.Header.Del("Content-Length")
+	r.ContentLength = -1
+	r.Body = &limitedBody{&decompressedBody{body: r.Body, codings: codings}, limit, limit}
+	return nil
+}
+
+// decompressedBody decompresses a request body on its first read.
+type decompressedBody struct {
+	body    io.ReadCloser
+	codings []string // Codings of body, in the order they were applied.
+	reader  io.Reader
+	closers []io.Closer
+	err     error
+}
+
+func (d *decompressedBody) Read(p []byte) (int, error) {
+	if d.err != nil {
+		return 0, d.err
+	}
+	if d.reader == nil {
+		d.reader = d.body
+		for i := len(d.codings) - 1; i >= 0; i-- {
+			var rc io.ReadCloser
+			var err error
+			if d.codings[i] == flateCompression {
+				rc, err = newInflater(d.reader)
+			} else {
+				rc, err = gzip.NewReader(d.reader)
+			}
+			if err != nil {
+				d.err = malformedEncoding(err, d.codings[i])
+				return 0, d.err
+			}
+			d.reader = rc
+			d.closers = append(d.closers, rc)
+		}
+	}
+
+	n, err := d.reader.Read(p)
+	if err != nil && err != io.EOF {
+		d.err = malformedEncoding(err, strings.Join(d.codings, ", "))
+		return n, d.err
+	}
+	return n, err
+}
+
+func (d *decompressedBody) Close() error {
+	for _, c := range d.closers {
+		c.Close()
+	}
+	return d.body.Close()
+}
+
+// malformedEncoding returns the error of the mux matching err, an error
+// decompressing a body encoded with coding.
+func malformedEncoding(err error, coding string) error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	return BadRequest("Malformed body", fmt.Sprintf("The body of the request is not valid %s.", coding))
+}
+
+// newInflater returns a reader of the deflate stream of r, in the zlib format
+// required by HTTP, or in the raw format written by some clients and by the mux.
+func newInflater(r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	if h, _ := br.Peek(2); len(h) == 2 && h[0]&0x0f == 8 && (uint16(h[0])<<8|uint16(h[1]))%31 == 0 {
+		return zlib.NewReader(br)
+	}
+	return flate.NewReader(br), nil
+}
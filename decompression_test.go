@@ -0,0 +1,96 @@
+package rst
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func compressedBody(t *testing.T, coding string, b []byte) []byte {
+	var buffer bytes.Buffer
+	var w io.WriteCloser
+	switch coding {
+	case "gzip":
+		w = gzip.NewWriter(&buffer)
+	case "zlib":
+		w = zlib.NewWriter(&buffer)
+	case "deflate":
+		w, _ = flate.NewWriter(&buffer, flate.DefaultCompression)
+	}
+	if _, err := w.Write(b); err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+	return buffer.Bytes()
+}
+
+func TestDecompressRequests(t *testing.T) {
+	mux := NewMux()
+	mux.DecompressRequests = true
+	mux.MaxDecompressedSize = 1 << 10
+	mux.Post("/people", func(vars RouteVars, r *http.Request) (Resource, string, error) {
+		p := &boundPerson{}
+		if err := Bind(r, p); err != nil {
+			return nil, "", err
+		}
+		if r.Header.Get("Content-Encoding") != "" {
+			t.Errorf("Content-Encoding is %q", r.Header.Get("Content-Encoding"))
+		}
+		return nil, "/people/" + p.Name, nil
+	})
+
+	body := []byte(`{"name":"Francis","age":42}`)
+	var test = func(encoding string, b []byte, status int) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(Post, "http://example.com/people", bytes.NewReader(b))
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("Content-Encoding", encoding)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Errorf("%s: status code is %d, wanted %d: %s", encoding, w.Code, status, w.Body.String())
+		}
+		return w
+	}
+
+	if w := test("gzip", compressedBody(t, "gzip", body), http.StatusCreated); w.Header().Get("Location") != "/people/Francis" {
+		t.Errorf("Location is %q", w.Header().Get("Location"))
+	}
+	test("deflate", compressedBody(t, "zlib", body), http.StatusCreated)
+	test("deflate", compressedBody(t, "deflate", body), http.StatusCreated)
+	test("identity", body, http.StatusCreated)
+	test("gzip, gzip", compressedBody(t, "gzip", compressedBody(t, "gzip", body)), http.StatusCreated)
+
+	test("gzip", body, http.StatusBadRequest)
+	test("gzip", compressedBody(t, "gzip", body)[:20], http.StatusBadRequest)
+	bomb := `{"name":"` + strings.Repeat("x", 2<<10) + `"}`
+	test("gzip", compressedBody(t, "gzip", []byte(bomb)), http.StatusRequestEntityTooLarge)
+	if w := test("br", body, http.StatusUnsupportedMediaType); w.Header().Get("Accept-Encoding") != requestEncodings {
+		t.Errorf("Accept-Encoding is %q", w.Header().Get("Accept-Encoding"))
+	}
+
+	// Bodies are left untouched unless DecompressRequests is set.
+	mux.DecompressRequests = false
+	test("br", compressedBody(t, "gzip", body), http.StatusBadRequest)
+}
+
+func TestDecompressedBodyClose(t *testing.T) {
+	r, _ := http.NewRequest(Post, "http://example.com/", bytes.NewReader(compressedBody(t, "gzip", []byte("hello"))))
+	r.Header.Set("Content-Encoding", "gzip")
+	if err := decompressBody(r, 1<<10); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil || string(b) != "hello" {
+		t.Errorf("Got: %q %v", b, err)
+	}
+	if err := r.Body.Close(); err != nil {
+		t.Error(err)
+	}
+}
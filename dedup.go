@@ -0,0 +1,233 @@
+package rst
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ReplayedHeader is set in responses replayed by a Deduplication.
+const ReplayedHeader = "X-Replayed-Response"
+
+// DefaultDeduplicationMaxBodySize is the size of the largest body hashed by a
+// Deduplication.
+const DefaultDeduplicationMaxBodySize = 1 << 20
+
+/*
+Deduplication suppresses identical POST and PATCH requests submitted within a
+window of time, to protect against double-clicks and retry storms from clients
+that don't send idempotency keys.
+
+	mux.SetDeduplication(&rst.Deduplication{
+		Window: 10 * time.Second,
+		Principal: func(r *http.Request) string {
+			user, _, _ := r.BasicAuth()
+			return user
+		},
+		Replay: true,
+	})
+
+Requests are identical when they share the same method, URI, tenant,
+principal, Accept and Accept-Encoding headers and body, so that replayed
+responses are always in a representation and an encoding the duplicate
+accepts. Duplicates are rejected with status code 409 Conflict, or
+receive a copy of the response to the first request when Replay is true and
+that response is available, without its Set-Cookie headers. Requests which
+failed aren't remembered, so that they can be retried.
+
+Without a Principal function, the principal of a request is identified by its
+Authorization and Cookie headers, so that the requests of different users are
+never duplicates of each other.
+*/
+type Deduplication struct {
+	Window      time.Duration                // Duration during which a request is remembered.
+	Principal   func(r *http.Request) string // Optional. Returns the principal of a request, which defaults to its Authorization and Cookie headers.
+	Replay      bool                         // Set to true to replay the response to the first request to duplicates.
+	MaxBodySize int64                        // Requests with larger bodies aren't deduplicated. Defaults to DefaultDeduplicationMaxBodySize.
+
+	mu      sync.Mutex
+	entries map[string]*dedupEntry
+	swept   time.Time
+}
+
+type dedupEntry struct {
+	expires time.Time
+	done    bool
+	code    int
+	header  http.Header
+	body    []byte
+}
+
+// SetDeduplication sets the deduplication of unsafe requests served by the
+// mux. A nil value disables it, which is the default.
+func (s *Mux) SetDeduplication(d *Deduplication) {
+	s.deduplication = d
+}
+
+// key returns the key identifying r, or false if r isn't deduplicated.
+func (d *Deduplication) key(r *http.Request) (string, bool) {
+	if r.Method != Post && r.Method != Patch {
+		return "", false
+	}
+
+	maxSize := d.MaxBodySize
+	if maxSize <= 0 {
+		maxSize = DefaultDeduplicationMaxBodySize
+	}
+	var body []byte
+	if r.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(io.LimitReader(r.Body, maxSize+1))
+		r.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+		if err != nil || int64(len(body)) > maxSize {
+			return "", false
+		}
+	}
+
+	principal := r.Header.Get("Authorization") + "\x00" + strings.Join(r.Header["Cookie"], "; ")
+	if d.Principal != nil {
+		principal = d.Principal(r)
+	}
+	h := sha256.New()
+	for _, s := range []string{r.Method, r.URL.RequestURI(), Tenant(r), principal, r.Header.Get("Accept"), r.Header.Get("Accept-Encoding")} {
+		io.WriteString(h, s)
+		h.Write([]byte{0})
+	}
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil)), true
+}
+
+// apply returns the writer with which the response to r must be written, and
+// a function to defer until it's written. It returns a nil writer if r was a
+// duplicate whose response has already been written.
+func (d *Deduplication) apply(w http.ResponseWriter, r *http.Request) (http.ResponseWriter, func()) {
+	key, ok := d.key(r)
+	if !ok {
+		return w, func() {}
+	}
+
+	now := time.Now()
+	d.mu.Lock()
+	if d.entries == nil {
+		d.entries = make(map[string]*dedupEntry)
+	}
+	if now.Sub(d.swept) > d.Window {
+		for k, entry := range d.entries {
+			if entry.done && !now.Before(entry.expires) {
+				delete(d.entries, k)
+			}
+		}
+		d.swept = now
+	}
+	entry, found := d.entries[key]
+	if found && (!entry.done || now.Before(entry.expires)) {
+		d.mu.Unlock()
+		if d.Replay && entry.done {
+			entry.replay(w)
+		} else {
+			DuplicateRequest().ServeHTTP(w, r)
+		}
+		return nil, nil
+	}
+	entry = &dedupEntry{}
+	d.entries[key] = entry
+	d.mu.Unlock()
+
+	recorder := &recordingWriter{ResponseWriter: w, record: d.Replay}
+	return recorder, func() {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		if err := recover(); err != nil {
+			// The request will be answered with an error.
+			delete(d.entries, key)
+			panic(err)
+		}
+		code := recorder.code
+		if code == 0 {
+			code = http.StatusOK
+		}
+		if code >= 400 {
+			delete(d.entries, key)
+			return
+		}
+		entry.done = true
+		entry.expires = time.Now().Add(d.Window)
+		if d.Replay {
+			entry.code, entry.header, entry.body = code, cloneHeader(w.Header()), recorder.body.Bytes()
+			// Cookies are never shared with other requests.
+			entry.header.Del("Set-Cookie")
+		}
+	}
+}
+
+// replay writes the recorded response in w.
+func (e *dedupEntry) replay(w http.ResponseWriter) {
+	for key, values := range e.header {
+		w.Header()[key] = values
+	}
+	w.Header().Set(ReplayedHeader, "true")
+	w.WriteHeader(e.code)
+	w.Write(e.body)
+}
+
+// DuplicateRequest is returned when a request is identical to a request that
+// was recently submitted.
+func DuplicateRequest() *Error {
+	return NewError(
+		http.StatusConflict,
+		"Duplicate request",
+		"An identical request was recently submitted, and will not be processed again.",
+	)
+}
+
+// recordingWriter records the status code, and optionally the body, of a
+// response.
+type recordingWriter struct {
+	http.ResponseWriter
+	record bool
+	code   int
+	body   bytes.Buffer
+}
+
+func (w *recordingWriter) WriteHeader(code int) {
+	if w.code == 0 && code >= http.StatusOK {
+		w.code = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *recordingWriter) Write(b []byte) (int, error) {
+	if w.code == 0 {
+		w.code = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	if w.record {
+		w.body.Write(b[:n])
+	}
+	return n, err
+}
+
+// Flush implements the http.Flusher interface.
+func (w *recordingWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// cloneHeader returns a deep copy of header.
+func cloneHeader(header http.Header) http.Header {
+	clone := make(http.Header, len(header))
+	for key, values := range header {
+		clone[key] = append([]string(nil), values...)
+	}
+	return clone
+}
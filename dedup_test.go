@@ -0,0 +1,121 @@
+package rst
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDeduplication(t *testing.T) {
+	for _, replay := range []bool{false, true} {
+		var calls int
+		mux := NewMux()
+		mux.SetDeduplication(&Deduplication{
+			Window: time.Minute,
+			Principal: func(r *http.Request) string {
+				return r.Header.Get("X-User")
+			},
+			Replay: replay,
+		})
+		mux.Handle("/orders", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if r.Header.Get("X-Fail") != "" {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, "order %d", calls)
+		}))
+
+		post := func(user, body string, fail bool, header ...string) *httptest.ResponseRecorder {
+			r, _ := http.NewRequest(Post, "/orders", strings.NewReader(body))
+			r.Header.Set("X-User", user)
+			for i := 0; i+1 < len(header); i += 2 {
+				r.Header.Set(header[i], header[i+1])
+			}
+			if fail {
+				r.Header.Set("X-Fail", "true")
+			}
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, r)
+			return w
+		}
+
+		if w := post("a", "{}", false); w.Code != http.StatusCreated || w.Body.String() != "order 1" {
+			t.Fatalf("replay=%t: got %d %q", replay, w.Code, w.Body.String())
+		}
+
+		w := post("a", "{}", false)
+		if replay {
+			if w.Code != http.StatusCreated || w.Body.String() != "order 1" || w.Header().Get(ReplayedHeader) != "true" {
+				t.Fatalf("replay=%t: got %d %q", replay, w.Code, w.Body.String())
+			}
+		} else if w.Code != http.StatusConflict {
+			t.Fatalf("replay=%t: got %d, wanted %d", replay, w.Code, http.StatusConflict)
+		}
+
+		// Other principals and bodies aren't duplicates.
+		if w := post("b", "{}", false); w.Code != http.StatusCreated {
+			t.Fatalf("replay=%t: got %d for another principal", replay, w.Code)
+		}
+		if w := post("a", `{"n":1}`, false); w.Code != http.StatusCreated {
+			t.Fatalf("replay=%t: got %d for another body", replay, w.Code)
+		}
+
+		// Nor are requests accepting other representations or encodings.
+		if w := post("a", "{}", false, "Accept", "application/xml"); w.Code != http.StatusCreated {
+			t.Fatalf("replay=%t: got %d for another Accept header", replay, w.Code)
+		}
+		if w := post("a", "{}", false, "Accept-Encoding", "gzip"); w.Code != http.StatusCreated {
+			t.Fatalf("replay=%t: got %d for another Accept-Encoding header", replay, w.Code)
+		}
+
+		// Failed requests can be retried.
+		post("c", "{}", true)
+		if w := post("c", "{}", false); w.Code != http.StatusCreated {
+			t.Fatalf("replay=%t: got %d for a retry", replay, w.Code)
+		}
+		if calls != 7 {
+			t.Fatalf("replay=%t: handler called %d times, wanted 7", replay, calls)
+		}
+	}
+}
+
+func TestDeduplicationDefaults(t *testing.T) {
+	var calls int
+	mux := NewMux()
+	mux.SetDeduplication(&Deduplication{Window: time.Minute, Replay: true})
+	mux.Handle("/messages", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: r.Header.Get("Authorization")})
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, "message %d", calls)
+	}))
+
+	post := func(uri, authorization string) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(Post, uri, strings.NewReader("hello"))
+		r.Header.Set("Authorization", authorization)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		return w
+	}
+
+	post("/messages?to=alice", "Bearer a")
+	if w := post("/messages?to=bob", "Bearer a"); w.Body.String() != "message 2" {
+		t.Fatalf("another query string: got %d %q", w.Code, w.Body.String())
+	}
+	if w := post("/messages?to=alice", "Bearer b"); w.Body.String() != "message 3" {
+		t.Fatalf("another user: got %d %q", w.Code, w.Body.String())
+	}
+
+	w := post("/messages?to=alice", "Bearer a")
+	if w.Body.String() != "message 1" || w.Header().Get(ReplayedHeader) != "true" {
+		t.Fatalf("duplicate: got %d %q", w.Code, w.Body.String())
+	}
+	if cookie := w.Header().Get("Set-Cookie"); cookie != "" {
+		t.Fatalf("duplicate: got Set-Cookie %q", cookie)
+	}
+}
@@ -0,0 +1,81 @@
+package rst
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Deprecation is the deprecation of a route.
+type Deprecation struct {
+	Sunset time.Time // Date after which the route may stop responding. Zero if unknown.
+	Link   string    // URL of the documentation of the deprecation, such as a migration guide. Optional.
+}
+
+/*
+Deprecate marks the routes registered for pattern as deprecated. Their
+responses automatically carry a Deprecation header, a Sunset header if sunset
+isn't zero, and a Link header with the relation "deprecation" if link isn't
+empty.
+
+	mux.Deprecate("/v1/people/{id}", time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC), "https://example.com/docs/v2-migration")
+
+	Deprecation: true
+	Sunset: Fri, 01 Jan 2027 00:00:00 GMT
+	Link: <https://example.com/docs/v2-migration>; rel="deprecation"; type="text/html"
+
+Deprecated routes are also marked as deprecated in the OpenAPI document of the
+mux, and the hooks registered with OnDeprecatedRequest are called with each of
+their requests to measure their remaining traffic.
+*/
+func (s *Mux) Deprecate(pattern string, sunset time.Time, link string) {
+	s.deprecationsMu.Lock()
+	defer s.deprecationsMu.Unlock()
+	if s.deprecations == nil {
+		s.deprecations = make(map[string]*Deprecation)
+	}
+	s.deprecations[entryKey(pattern, nil)] = &Deprecation{Sunset: sunset, Link: link}
+}
+
+// OnDeprecatedRequest registers hook to be called with the pattern of the
+// deprecated route matched by each request, before it's served.
+//
+//	mux.OnDeprecatedRequest(func(pattern string, r *http.Request) {
+//		deprecatedRequests.WithLabelValues(pattern).Inc()
+//	})
+func (s *Mux) OnDeprecatedRequest(hook func(pattern string, r *http.Request)) {
+	s.deprecationsMu.Lock()
+	defer s.deprecationsMu.Unlock()
+	s.deprecationHooks = append(s.deprecationHooks, hook)
+}
+
+// deprecation returns the deprecation of the routes registered with key, or
+// nil.
+func (s *Mux) deprecation(key string) *Deprecation {
+	s.deprecationsMu.RLock()
+	defer s.deprecationsMu.RUnlock()
+	return s.deprecations[key]
+}
+
+// deprecate sets the deprecation headers of the response to r, a request for
+// the route registered with key, and calls the hooks of deprecated requests.
+func (s *Mux) deprecate(key string, w http.ResponseWriter, r *http.Request) {
+	d := s.deprecation(key)
+	if d == nil {
+		return
+	}
+	w.Header().Set("Deprecation", "true")
+	if !d.Sunset.IsZero() {
+		w.Header().Set("Sunset", d.Sunset.UTC().Format(rfc1123))
+	}
+	if d.Link != "" {
+		w.Header().Add("Link", fmt.Sprintf(`<%s>; rel="deprecation"; type="text/html"`, d.Link))
+	}
+
+	s.deprecationsMu.RLock()
+	hooks := s.deprecationHooks
+	s.deprecationsMu.RUnlock()
+	for _, hook := range hooks {
+		hook(RoutePattern(r), r)
+	}
+}
@@ -0,0 +1,48 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDeprecate(t *testing.T) {
+	mux := NewMux()
+	mux.Get("/v1/people/{id}", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return testPeople[0], nil
+	})
+	mux.Get("/v2/people/{id}", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return testPeople[0], nil
+	})
+	sunset := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	mux.Deprecate("/v1/people/{id}", sunset, "https://example.com/migration")
+
+	var counted []string
+	mux.OnDeprecatedRequest(func(pattern string, r *http.Request) {
+		counted = append(counted, pattern)
+	})
+
+	var test = func(path, deprecation, sunset, link string) {
+		r, _ := http.NewRequest(Get, "http://www.example.com"+path, nil)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: Got: %d Wanted: %d", path, w.Code, http.StatusOK)
+		}
+		for header, expected := range map[string]string{"Deprecation": deprecation, "Sunset": sunset, "Link": link} {
+			if got := w.Header().Get(header); got != expected {
+				t.Errorf("%s: %s is %q, wanted %q", path, header, got, expected)
+			}
+		}
+	}
+	test("/v1/people/1", "true", "Fri, 01 Jan 2027 00:00:00 GMT", `<https://example.com/migration>; rel="deprecation"; type="text/html"`)
+	test("/v2/people/1", "", "", "")
+	if len(counted) != 1 || counted[0] != "/v1/people/{id}" {
+		t.Errorf("Got: %v Wanted: [/v1/people/{id}]", counted)
+	}
+
+	if get := mux.OpenAPI().Paths["/v1/people/{id}"]["get"]; get == nil || !get.Deprecated {
+		t.Errorf("GET /v1/people/{id} isn't deprecated in the OpenAPI document")
+	}
+}
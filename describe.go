@@ -0,0 +1,55 @@
+package rst
+
+/*
+Describable is implemented by endpoints documenting themselves, so that their
+documentation lives next to their code. Descriptions are consumed by OpenAPI
+and reported by Routes.
+
+	func (ep *PersonEP) Describe() *rst.Description {
+		return &rst.Description{
+			Summary: "A person",
+			Methods: map[string]*rst.MethodDescription{
+				rst.Get: {Summary: "Returns a person", ResponseExample: &Person{ID: "1", Name: "Francis Underwood"}},
+				rst.Delete: {Summary: "Removes a person", Deprecated: true},
+			},
+		}
+	}
+*/
+type Describable interface {
+	Describe() *Description
+}
+
+// Description documents an endpoint.
+type Description struct {
+	Summary     string                        // Short summary of the endpoint.
+	Description string                        // Optional. Longer description, which can be formatted in CommonMark.
+	Deprecated  bool                          // Set to true if all the methods of the endpoint are deprecated.
+	Methods     map[string]*MethodDescription // Optional. Descriptions of the methods of the endpoint, indexed by method.
+}
+
+// MethodDescription documents a method of an endpoint.
+type MethodDescription struct {
+	Summary         string      // Optional. Short summary of the method, the one of the endpoint by default.
+	Description     string      // Optional. Longer description, which can be formatted in CommonMark.
+	Deprecated      bool        // Set to true if the method is deprecated.
+	RequestExample  interface{} // Optional. Example of the body of the requests.
+	ResponseExample interface{} // Optional. Example of the resource returned by the method.
+}
+
+// describe returns the description of endpoint, or nil.
+func describe(endpoint Endpoint) *Description {
+	if describable, implemented := endpoint.(Describable); implemented {
+		return describable.Describe()
+	}
+	return nil
+}
+
+// method returns the description of method, or an empty description.
+func (d *Description) method(method string) *MethodDescription {
+	if d != nil {
+		if m := d.Methods[method]; m != nil {
+			return m
+		}
+	}
+	return &MethodDescription{}
+}
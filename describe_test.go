@@ -0,0 +1,54 @@
+package rst
+
+import (
+	"net/http"
+	"testing"
+)
+
+type describedEndpoint struct {
+	documentedEndpoint
+}
+
+func (e *describedEndpoint) Describe() *Description {
+	return &Description{
+		Summary:     "A person",
+		Description: "People of the *administration*.",
+		Methods: map[string]*MethodDescription{
+			Get: {Summary: "Returns a person", ResponseExample: &documentedPerson{ID: "1", Name: "Francis Underwood"}},
+			Put: {Deprecated: true, RequestExample: map[string]string{"name": "Claire Underwood"}},
+		},
+	}
+}
+
+func TestDescribable(t *testing.T) {
+	mux := NewMux()
+	mux.HandleEndpoint("/people/{id}", &describedEndpoint{})
+	mux.Get("/undocumented", func(vars RouteVars, r *http.Request) (Resource, error) { return nil, nil })
+
+	routes := mux.Routes()
+	if len(routes) != 2 || routes[0].Description == nil || routes[0].Description.Summary != "A person" || routes[1].Description != nil {
+		t.Fatalf("Routes are %+v, expected a description for /people/{id} only", routes)
+	}
+
+	item := mux.OpenAPI().Paths["/people/{id}"]
+	get, put := item["get"], item["put"]
+	if get.Summary != "Returns a person" || get.Description != "People of the *administration*." || get.Deprecated {
+		t.Errorf("GET is %+v", get)
+	}
+	if example, ok := get.Responses["200"].Content["application/json"].Example.(*documentedPerson); !ok || example.Name != "Francis Underwood" {
+		t.Errorf("Example of GET is %+v", get.Responses["200"].Content["application/json"].Example)
+	}
+	if put.Summary != "A person" || !put.Deprecated {
+		t.Errorf("PUT is %+v", put)
+	}
+	if example, ok := put.RequestBody.Content["application/json"].Example.(map[string]string); !ok || example["name"] != "Claire Underwood" {
+		t.Errorf("Request example of PUT is %+v", put.RequestBody.Content["application/json"].Example)
+	}
+	if put.RequestBody.Content["application/json"].Schema == nil {
+		t.Error("Request body of PUT lost its schema")
+	}
+
+	if undocumented := mux.OpenAPI().Paths["/undocumented"]["get"]; undocumented.Summary != "" || undocumented.Deprecated {
+		t.Errorf("GET /undocumented is %+v", undocumented)
+	}
+}
@@ -0,0 +1,92 @@
+package rst
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+/*
+Downloadable is implemented by resources whose representation is a document to
+save, such as CSV or PDF exports. Their responses have a Content-Disposition
+header, as defined by RFC 6266:
+
+	func (e *Export) Disposition() *rst.Disposition {
+		return &rst.Disposition{Filename: "Relevé de compte.csv"}
+	}
+
+	Content-Disposition: attachment; filename="Relev_ de compte.csv"; filename*=UTF-8''Relev%C3%A9%20de%20compte.csv
+
+The filename parameter holds an ASCII fallback for older clients, and the
+filename* parameter the name encoded in UTF-8 as defined by RFC 5987.
+*/
+type Downloadable interface {
+	Disposition() *Disposition
+}
+
+// Disposition is the Content-Disposition of a Downloadable resource.
+type Disposition struct {
+	Filename string // Optional. Name of the file suggested to the user agent.
+	Inline   bool   // Set to true to display the document in the browser instead of saving it.
+}
+
+// dispositionOf returns the disposition of resource, or of its projection if
+// resource is an envelope, or nil.
+func dispositionOf(resource interface{}) *Disposition {
+	if downloadable, implemented := resource.(Downloadable); implemented {
+		return downloadable.Disposition()
+	}
+	if envelope, valid := resource.(*Envelope); valid {
+		return dispositionOf(envelope.projection)
+	}
+	return nil
+}
+
+// setDisposition sets the Content-Disposition header of resource in h.
+func setDisposition(resource interface{}, h http.Header) {
+	if d := dispositionOf(resource); d != nil {
+		h.Set("Content-Disposition", d.String())
+	}
+}
+
+// String returns the value of the Content-Disposition header of d.
+func (d *Disposition) String() string {
+	value := "attachment"
+	if d.Inline {
+		value = "inline"
+	}
+	if d.Filename == "" {
+		return value
+	}
+	value += fmt.Sprintf(`; filename="%s"`, asciiFilename(d.Filename))
+	if encoded := encodeExtValue(d.Filename); encoded != d.Filename {
+		value += "; filename*=UTF-8''" + encoded
+	}
+	return value
+}
+
+// asciiFilename replaces the characters of name which can't be sent in a
+// quoted string by an underscore.
+func asciiFilename(name string) string {
+	return strings.Map(func(c rune) rune {
+		if c < 0x20 || c > 0x7e || c == '"' || c == '\\' || c == '%' {
+			return '_'
+		}
+		return c
+	}, name)
+}
+
+// encodeExtValue percent-encodes the UTF-8 bytes of s which aren't attr-chars,
+// as defined by RFC 5987.
+func encodeExtValue(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || strings.IndexByte("!#$&+-.^_`|~", c) >= 0 {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,65 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type downloadableExport struct {
+	disposition *Disposition
+}
+
+func (e *downloadableExport) Disposition() *Disposition {
+	return e.disposition
+}
+
+func (e *downloadableExport) MarshalText() ([]byte, error) {
+	return []byte("id,name\n1,Francis Underwood\n"), nil
+}
+
+func TestDisposition(t *testing.T) {
+	tests := []struct {
+		disposition *Disposition
+		expected    string
+	}{
+		{&Disposition{}, "attachment"},
+		{&Disposition{Filename: "people.csv"}, `attachment; filename="people.csv"`},
+		{&Disposition{Filename: "people.pdf", Inline: true}, `inline; filename="people.pdf"`},
+		{&Disposition{Filename: "all people.csv"}, `attachment; filename="all people.csv"; filename*=UTF-8''all%20people.csv`},
+		{&Disposition{Filename: "Relevé de compte.csv"}, `attachment; filename="Relev_ de compte.csv"; filename*=UTF-8''Relev%C3%A9%20de%20compte.csv`},
+		{&Disposition{Filename: `a"b\c%.txt`}, `attachment; filename="a_b_c_.txt"; filename*=UTF-8''a%22b%5Cc%25.txt`},
+	}
+	for _, test := range tests {
+		if got := test.disposition.String(); got != test.expected {
+			t.Errorf("%+v: Got %s, expected %s", test.disposition, got, test.expected)
+		}
+	}
+}
+
+func TestDownloadable(t *testing.T) {
+	mux := NewMux()
+	mux.Get("/export", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(&downloadableExport{&Disposition{Filename: "people.csv"}}, time.Now(), "v1", 0), nil
+	})
+	mux.Get("/plain", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(&downloadableExport{nil}, time.Now(), "v1", 0), nil
+	})
+
+	var test = func(path, accept string, code int, expected string) {
+		r, _ := http.NewRequest(Get, "http://example.com"+path, nil)
+		r.Header.Set("Accept", accept)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != code {
+			t.Errorf("%s, %s: status code is %d, expected %d", path, accept, w.Code, code)
+		}
+		if got := w.Header().Get("Content-Disposition"); got != expected {
+			t.Errorf("%s, %s: Content-Disposition is %q, expected %q", path, accept, got, expected)
+		}
+	}
+	test("/export", "text/plain", http.StatusOK, `attachment; filename="people.csv"`)
+	test("/export", "image/png", http.StatusNotAcceptable, "")
+	test("/plain", "text/plain", http.StatusOK, "")
+}
@@ -0,0 +1,57 @@
+package rst
+
+import (
+	"net/http"
+
+	"github.com/gorilla/context"
+)
+
+const earlyHintsKey = "__rst__hints"
+
+/*
+EarlyHints sends an informational response with status code 103 Early Hints to
+r, with the given values of the Link header, so that the client can preload
+resources while the endpoint is still preparing its response.
+
+	func (ep *PageEP) Get(vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+		rst.EarlyHints(r, "</app.css>; rel=preload; as=style", "</app.js>; rel=preload; as=script")
+		return ep.slowBackend.Page(r.Context(), vars.Get("id"))
+	}
+
+The early hints only carry the links: the headers already set in the response
+are sent with the final response alone. EarlyHints can be called several
+times, and returns false without sending anything when the response is already
+committed, when the client speaks HTTP/1.0, which doesn't support informational
+responses, or when r isn't served by a mux.
+*/
+func EarlyHints(r *http.Request, links ...string) bool {
+	rw, _ := context.Get(r, earlyHintsKey).(*responseWriter)
+	if rw == nil || len(links) == 0 || !r.ProtoAtLeast(1, 1) {
+		return false
+	}
+	return rw.hint(links)
+}
+
+// hint writes a 103 Early Hints response with links, whose header only holds
+// the links. It returns false if the response is committed.
+func (rw *responseWriter) hint(links []string) bool {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	if rw.committed {
+		return false
+	}
+
+	header := rw.ResponseWriter.Header()
+	saved := make(http.Header, len(header))
+	for key, values := range header {
+		saved[key] = values
+		delete(header, key)
+	}
+	header["Link"] = links
+	rw.ResponseWriter.WriteHeader(http.StatusEarlyHints)
+	delete(header, "Link")
+	for key, values := range saved {
+		header[key] = values
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+package rst
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httptrace"
+	"net/textproto"
+	"testing"
+)
+
+func TestEarlyHints(t *testing.T) {
+	sent := make(chan bool, 2)
+	mux := NewMux()
+	mux.Get("/page", func(vars RouteVars, r *http.Request) (Resource, error) {
+		sent <- EarlyHints(r, "</app.css>; rel=preload; as=style")
+		return &echoResource{[]byte("page")}, nil
+	})
+	mux.Handle("/committed", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Final", "true")
+		w.WriteHeader(http.StatusOK)
+		sent <- EarlyHints(r, "</app.css>; rel=preload; as=style")
+	}))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var hints []textproto.MIMEHeader
+	trace := &httptrace.ClientTrace{
+		Got1xxResponse: func(code int, header textproto.MIMEHeader) error {
+			if code == http.StatusEarlyHints {
+				hints = append(hints, header)
+			}
+			return nil
+		},
+	}
+	var get = func(path string) *http.Response {
+		r, _ := http.NewRequestWithContext(httptrace.WithClientTrace(context.Background(), trace), Get, server.URL+path, nil)
+		resp, err := http.DefaultClient.Do(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+
+	resp := get("/page")
+	if !<-sent || len(hints) != 1 || hints[0].Get("Link") != "</app.css>; rel=preload; as=style" {
+		t.Fatalf("Early hints are %v, expected a preload of /app.css", hints)
+	}
+	if hints[0].Get("Vary") != "" {
+		t.Errorf("Early hints carry the headers of the response: %v", hints[0])
+	}
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Link") != "" || resp.Header.Get("Vary") == "" {
+		t.Errorf("Response is %d with headers %v", resp.StatusCode, resp.Header)
+	}
+
+	hints = nil
+	if resp = get("/committed"); <-sent || len(hints) != 0 || resp.Header.Get("X-Final") != "true" {
+		t.Errorf("Early hints were sent after the response was committed")
+	}
+
+	r, _ := http.NewRequest(Get, "http://example.com/page", nil)
+	r.Proto, r.ProtoMajor, r.ProtoMinor = "HTTP/1.0", 1, 0
+	mux.ServeHTTP(httptest.NewRecorder(), r)
+	if <-sent {
+		t.Error("Early hints were sent to an HTTP/1.0 client")
+	}
+}
@@ -0,0 +1,123 @@
+package rst
+
+import (
+	"bytes"
+	"encoding"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// EncoderFunc encodes resource for the request r.
+type EncoderFunc func(resource interface{}, r *http.Request) ([]byte, error)
+
+// encoder associates an EncoderFunc with a media type, and the Content-Type of
+// the representations it returns.
+type encoder struct {
+	mediaType   string
+	contentType string
+	encode      EncoderFunc
+}
+
+var (
+	encodersMu sync.RWMutex
+	encoders   = []*encoder{
+		{"application/json", "application/json; charset=utf-8", encodeJSON},
+		{"text/javascript", "application/json; charset=utf-8", encodeJSON},
+		{"application/xml", "application/xml; charset=utf-8", encodeXML},
+		{"text/xml", "application/xml; charset=utf-8", encodeXML},
+		{"text/plain", "text/plain; charset=utf-8", encodeText},
+		{HALType, HALType + "; charset=utf-8", marshalHAL},
+		{JSONAPIType, JSONAPIType, marshalJSONAPI},
+		{MsgPackType, MsgPackType, binaryEncoder(writeMsgPack)},
+		{CBORType, CBORType, binaryEncoder(writeCBOR)},
+	}
+)
+
+/*
+RegisterEncoder associates fn with mediaType in the registry of encoders
+consulted by MarshalResource.
+
+	func init() {
+		rst.RegisterEncoder("application/vnd.myapp+json", func(resource interface{}, r *http.Request) ([]byte, error) {
+			return json.Marshal(&vendorEnvelope{Data: resource})
+		})
+	}
+
+The media type is matched against the Accept header of requests, by order of
+preference of the client. Encoders registered for a new media type are
+consulted after the existing ones when the client has no preference. The
+representations they return are served with mediaType as Content-Type.
+
+Registering an encoder for a media type replaces the previous one, including
+the built-in encoders of application/json, text/javascript, application/xml,
+text/xml, text/plain, application/hal+json, application/vnd.api+json,
+application/msgpack and application/cbor. A nil fn removes the encoder of
+mediaType.
+*/
+func RegisterEncoder(mediaType string, fn EncoderFunc) {
+	encodersMu.Lock()
+	defer encodersMu.Unlock()
+
+	for i, e := range encoders {
+		if e.mediaType != mediaType {
+			continue
+		}
+		if fn == nil {
+			encoders = append(encoders[:i:i], encoders[i+1:]...)
+			return
+		}
+		encoders[i] = &encoder{mediaType, mediaType, fn}
+		return
+	}
+	if fn != nil {
+		encoders = append(encoders, &encoder{mediaType, mediaType, fn})
+	}
+}
+
+// lookupEncoder returns the encoder registered for mediaType, or nil.
+func lookupEncoder(mediaType string) *encoder {
+	encodersMu.RLock()
+	defer encodersMu.RUnlock()
+	for _, e := range encoders {
+		if e.mediaType == mediaType {
+			return e
+		}
+	}
+	return nil
+}
+
+// encoderTypes returns the media types of the registered encoders, followed by
+// */*.
+func encoderTypes() []string {
+	encodersMu.RLock()
+	defer encodersMu.RUnlock()
+	types := make([]string, 0, len(encoders)+1)
+	for _, e := range encoders {
+		types = append(types, e.mediaType)
+	}
+	return append(types, "*/*")
+}
+
+func encodeJSON(resource interface{}, r *http.Request) ([]byte, error) {
+	b, err := marshalJSON(resource, r)
+	if bytes.Equal(b, jsonNull) {
+		b = []byte{}
+	}
+	return b, err
+}
+
+func encodeXML(resource interface{}, r *http.Request) ([]byte, error) {
+	return marshalXML(resource)
+}
+
+// encodeText encodes resource with encoding.TextMarshaler or fmt.Stringer.
+func encodeText(resource interface{}, r *http.Request) ([]byte, error) {
+	if marshaler, implemented := resource.(encoding.TextMarshaler); implemented {
+		return marshaler.MarshalText()
+	}
+	if marshaler, implemented := resource.(fmt.Stringer); implemented {
+		return []byte(marshaler.String()), nil
+	}
+	return nil, NotAcceptable()
+}
@@ -0,0 +1,98 @@
+package rst
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const vendorType = "application/vnd.rst.test+json"
+
+func encodeVendor(resource interface{}, r *http.Request) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{"data": resource})
+}
+
+func TestRegisterEncoder(t *testing.T) {
+	RegisterEncoder(vendorType, encodeVendor)
+	defer RegisterEncoder(vendorType, nil)
+
+	var test = func(accept, contentType, body string) {
+		r, _ := http.NewRequest(Get, "http://www.example.com", nil)
+		r.Header.Set("Accept", accept)
+		ct, b, err := MarshalResource(&viewResource{"Francis"}, r)
+		if err != nil {
+			t.Fatalf("%s: %s", accept, err)
+		}
+		if ct != contentType {
+			t.Errorf("%s: Got: %s Wanted: %s", accept, ct, contentType)
+		}
+		if string(b) != body {
+			t.Errorf("%s: Got: %s Wanted: %s", accept, string(b), body)
+		}
+	}
+
+	test(vendorType, vendorType, `{"data":{"Name":"Francis"}}`)
+	test("application/json;q=0.5, "+vendorType, vendorType, `{"data":{"Name":"Francis"}}`)
+	test("application/json, "+vendorType+";q=0.5", "application/json; charset=utf-8", `{"Name":"Francis"}`)
+	test("*/*", "application/json; charset=utf-8", `{"Name":"Francis"}`)
+}
+
+func TestRegisterEncoderReplace(t *testing.T) {
+	RegisterEncoder("text/plain", func(resource interface{}, r *http.Request) ([]byte, error) {
+		return []byte("replaced"), nil
+	})
+	defer RegisterEncoder("text/plain", encodeText)
+
+	r, _ := http.NewRequest(Get, "http://www.example.com", nil)
+	r.Header.Set("Accept", "text/plain")
+	_, b, err := MarshalResource(&viewResource{"Francis"}, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "replaced" {
+		t.Errorf("Got: %s Wanted: replaced", string(b))
+	}
+}
+
+func TestRegisterEncoderRemove(t *testing.T) {
+	RegisterEncoder(vendorType, encodeVendor)
+	RegisterEncoder(vendorType, nil)
+
+	r, _ := http.NewRequest(Get, "http://www.example.com", nil)
+	r.Header.Set("Accept", vendorType)
+	if _, _, err := MarshalResource(&viewResource{"Francis"}, r); err == nil {
+		t.Fatal("expected a NotAcceptable error for a removed encoder")
+	}
+}
+
+func TestEncoderNegotiation(t *testing.T) {
+	RegisterEncoder(vendorType, encodeVendor)
+	defer RegisterEncoder(vendorType, nil)
+
+	mux := NewMux()
+	mux.Get("/", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(&viewResource{"Francis"}, time.Now(), "etag", 0), nil
+	})
+
+	var test = func(accept string, status int) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(Get, "/", nil)
+		r.Header.Set("Accept", accept)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Errorf("%s: Got: %d Wanted: %d", accept, w.Code, status)
+		}
+		return w
+	}
+
+	w := test(vendorType, http.StatusOK)
+	if ct := w.Header().Get("Content-Type"); ct != vendorType {
+		t.Errorf("Content-Type: Got: %s Wanted: %s", ct, vendorType)
+	}
+	if vary := w.Header().Get("Vary"); vary != "Accept" {
+		t.Errorf("Vary: Got: %s Wanted: Accept", vary)
+	}
+	test("application/vnd.rst.unknown+json", http.StatusNotAcceptable)
+}
@@ -2,24 +2,12 @@ package rst
 
 import (
 	"bytes"
-	"encoding"
-	"encoding/json"
 	"encoding/xml"
-	"fmt"
 	"net/http"
 	"reflect"
 	"strings"
 )
 
-var alternatives = []string{
-	"application/json",
-	"text/javascript",
-	"application/xml",
-	"text/xml",
-	"text/plain",
-	"*/*",
-}
-
 /*
 Marshaler is implemented by resources wishing to handle their encoding
 on their own.
@@ -50,17 +38,67 @@ type Marshaler interface {
 	MarshalRST(*http.Request) (contentType string, data []byte, err error)
 }
 
+/*
+LocalizedResource is implemented by resources whose representation depends on
+the language negotiated with the Accept-Language header of the request.
+
+	func (a *Article) Languages() []string {
+		return []string{"en", "fr", "fr-CA"}
+	}
+
+	func (a *Article) MarshalRSTFor(lang string, r *http.Request) (string, []byte, error) {
+		return rst.MarshalResource(a.Translations[lang], r)
+	}
+
+The Content-Language header is set to the negotiated language, and
+Accept-Language is added to the Vary header of the response.
+*/
+type LocalizedResource interface {
+	// Languages returns the tags of the languages available for the resource,
+	// by order of preference. The first tag is used when no language in the
+	// request can be matched.
+	Languages() []string
+
+	// MarshalRSTFor works like Marshaler.MarshalRST for the given language.
+	MarshalRSTFor(lang string, r *http.Request) (contentType string, data []byte, err error)
+}
+
+// negotiateLanguage returns the language of resource that is most appropriate
+// for r.
+func negotiateLanguage(resource LocalizedResource, r *http.Request) string {
+	return ParseAcceptLanguage(r.Header.Get("Accept-Language")).Negotiate(resource.Languages()...)
+}
+
+// setLanguageHeaders sets the Content-Language and Vary headers in header if
+// resource implements LocalizedResource.
+func setLanguageHeaders(resource interface{}, header http.Header, r *http.Request) {
+	localized, implemented := resource.(LocalizedResource)
+	if !implemented {
+		return
+	}
+	if lang := negotiateLanguage(localized, r); lang != "" {
+		header.Set("Content-Language", lang)
+	}
+	addVary(header, "Accept-Language")
+}
+
 var jsonNull = []byte("null")
 
 // MarshalResource negotiates contentType based on the Accept header in r, and returns
 // the encoded version of resource as an array of bytes.
 //
 // MarshalResource can encode a resource in JSON and XML, as well as text using either
-// encoding.TextMarshaler or fmt.Stringer.
+// encoding.TextMarshaler or fmt.Stringer. Encoders for other media types can be
+// added with RegisterEncoder.
 //
 // MarshalResource's XML marshaling will always return a valid XML document with a
 // header and a root object, which is not the case for the encoding/xml package.
 //
+// If a view was registered for the type of resource with RegisterView,
+// MarshalResource will render it when text/html is negotiated. Resources
+// implementing ProtoResource are encoded as protocol buffers when
+// application/x-protobuf is negotiated.
+//
 // MarshalResource can be called from Marshaler.MarshalRST on the same resource safely.
 func MarshalResource(resource interface{}, r *http.Request) (contentType string, encoded []byte, err error) {
 	accept := ParseAccept(r.Header.Get("Accept"))
@@ -73,24 +111,26 @@ func MarshalResource(resource interface{}, r *http.Request) (contentType string,
 		})
 	}
 
-	switch accept.Negotiate(alternatives...) {
-	case "application/json", "text/javascript":
-		b, err := json.Marshal(resource)
-		if bytes.Equal(b, jsonNull) {
-			b = []byte{}
+	if view := lookupView(resource); view != nil {
+		if accept.Negotiate(append(encoderTypes(), htmlContentType)...) == htmlContentType {
+			b, err := marshalView(view, resource)
+			return "text/html; charset=utf-8", b, err
 		}
-		return "application/json; charset=utf-8", b, err
-	case "application/xml", "text/xml":
-		b, err := marshalXML(resource)
-		return "application/xml; charset=utf-8", b, err
-	case "text/plain":
-		if marshaler, implemented := resource.(encoding.TextMarshaler); implemented {
-			b, err := marshaler.MarshalText()
-			return "text/plain; charset=utf-8", b, err
+	}
+
+	if b, negotiated, err := marshalProto(resource, accept, r); negotiated {
+		return ProtobufType, b, err
+	}
+
+	if e := lookupEncoder(accept.Negotiate(encoderTypes()...)); e != nil {
+		b, err := e.encode(resource, r)
+		if err == nil {
+			b, err = filterFields(resource, e.contentType, b, r)
 		}
-		if marshaler, implemented := resource.(fmt.Stringer); implemented {
-			return "text/plain; charset=utf-8", []byte(marshaler.String()), nil
+		if err == nil && isJSON(e.contentType) {
+			b, err = prettyJSON(b, r)
 		}
+		return e.contentType, b, err
 	}
 	return "", nil, NotAcceptable()
 }
@@ -98,36 +138,51 @@ func MarshalResource(resource interface{}, r *http.Request) (contentType string,
 // marshalXML adds an XML header and an envelope when needed to the result
 // obtained from calling xml.Marshal on resource.
 func marshalXML(resource interface{}) ([]byte, error) {
-	b, err := xml.Marshal(resource)
-	if err != nil {
+	buffer := getBuffer()
+	buffer.WriteString(xml.Header)
+	if err := xml.NewEncoder(buffer).Encode(resource); err != nil {
+		putBuffer(buffer)
 		return nil, err
 	}
 
+	b := buffer.Bytes()[len(xml.Header):]
 	if len(b) >= len(xml.Header) && bytes.Equal(b[:len(xml.Header)], []byte(xml.Header)) {
-		return b, err
+		b = append([]byte(nil), b...)
+		putBuffer(buffer)
+		return b, nil
 	}
 
 	// Arrays and slices need an envelope.
 	if t := reflect.TypeOf(resource); t.Kind() == reflect.Array || t.Kind() == reflect.Slice {
 		fqn := strings.Split(t.String(), ".")
 		name := fqn[len(fqn)-1] + "List"
-		prefix := []byte("<" + name + ">")
-		suffix := []byte("</" + name + ">")
-		b = bytes.Join([][]byte{prefix, b, suffix}, nil)
+		enveloped := getBuffer()
+		enveloped.Grow(len(xml.Header) + len(b) + 2*len(name) + 5)
+		enveloped.WriteString(xml.Header + "<" + name + ">")
+		enveloped.Write(b)
+		enveloped.WriteString("</" + name + ">")
+		putBuffer(buffer)
+		return detachBuffer(enveloped), nil
 	}
-
-	// Adding XML header
-	b = bytes.Join([][]byte{[]byte(xml.Header), b}, nil)
-
-	return b, err
+	return detachBuffer(buffer), nil
 }
 
 // Marshal negotiates contentType based on the Accept header in r, and returns
 // the encoded version of resource as an array of bytes.
 //
-// Marshal uses resource.MarshalRST if resource implements the Marshaler
-// interface, or MarshalResource method if it doesn't.
+// Marshal uses resource.MarshalRSTFor if resource implements the
+// LocalizedResource interface, resource.MarshalRST if resource implements the
+// Marshaler interface, or MarshalResource method if it doesn't. The
+// representation computed by AutoETag for r is reused.
 func Marshal(resource interface{}, r *http.Request) (contentType string, encoded []byte, err error) {
+	if m := memoizedRepresentation(resource, r); m != nil {
+		return m.contentType, m.encoded, nil
+	}
+
+	if localized, implemented := resource.(LocalizedResource); implemented {
+		return localized.MarshalRSTFor(negotiateLanguage(localized, r), r)
+	}
+
 	if marshaler, implemented := resource.(Marshaler); implemented {
 		return marshaler.MarshalRST(r)
 	}
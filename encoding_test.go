@@ -6,7 +6,10 @@ import (
 	"fmt"
 	"mime"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 )
 
 // Checking if marshalXML inserts a header and outputs a valid xml document
@@ -123,6 +126,31 @@ func TestMarshal(t *testing.T) {
 	}
 }
 
+type taggedPerson struct {
+	XMLName xml.Name `xml:"Person"`
+	ID      string   `xml:"id,attr"`
+	Name    string   `xml:"FullName"`
+	Secret  string   `xml:"-"`
+}
+
+// Testing whether XML negotiation respects the struct tags of resources.
+func TestMarshalXMLTags(t *testing.T) {
+	for _, accept := range []string{"application/xml", "text/xml"} {
+		r, _ := http.NewRequest(Get, "/", nil)
+		r.Header.Set("Accept", accept)
+		ct, b, err := MarshalResource(&taggedPerson{ID: "1", Name: "John Doe", Secret: "s"}, r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ct != "application/xml; charset=utf-8" {
+			t.Fatalf("%s: got Content-Type %q", accept, ct)
+		}
+		if wanted := xml.Header + `<Person id="1"><FullName>John Doe</FullName></Person>`; string(b) != wanted {
+			t.Fatalf("%s: got %s, wanted %s", accept, b, wanted)
+		}
+	}
+}
+
 // Testing whether marshalResource handles the Marshaler interface correctly.
 type customPerson person
 
@@ -142,3 +170,84 @@ func TestMarshalResource(t *testing.T) {
 		t.Fatal("Got:", string(b), "Wanted: hello, world!")
 	}
 }
+
+type localizedResource struct {
+	greetings map[string]string
+}
+
+func (l *localizedResource) Languages() []string {
+	return []string{"en", "fr", "fr-CA"}
+}
+
+func (l *localizedResource) MarshalRSTFor(lang string, r *http.Request) (string, []byte, error) {
+	return "text/plain; charset=utf-8", []byte(l.greetings[lang]), nil
+}
+
+func (l *localizedResource) LastModified() time.Time {
+	return testTimeReference
+}
+
+func (l *localizedResource) ETag() string {
+	return "localized"
+}
+
+func (l *localizedResource) TTL() time.Duration {
+	return 0
+}
+
+// Testing whether LocalizedResource representations are negotiated with the
+// Accept-Language header.
+func TestMarshalLocalized(t *testing.T) {
+	resource := &localizedResource{map[string]string{
+		"en":    "hello",
+		"fr":    "bonjour",
+		"fr-CA": "allô",
+	}}
+
+	var test = func(acceptLanguage, lang string) {
+		r, _ := http.NewRequest(Get, "http://www.example.com", nil)
+		r.Header.Set("Accept-Language", acceptLanguage)
+		w := httptest.NewRecorder()
+		writeResource(resource, w, r)
+		if got := w.Header().Get("Content-Language"); got != lang {
+			t.Errorf("%s: Got: %s Wanted: %s", acceptLanguage, got, lang)
+		}
+		if !strings.Contains(strings.Join(w.Header()["Vary"], ", "), "Accept-Language") {
+			t.Errorf("%s: Accept-Language missing from Vary header", acceptLanguage)
+		}
+		if body := w.Body.String(); body != resource.greetings[lang] {
+			t.Errorf("%s: Got: %s Wanted: %s", acceptLanguage, body, resource.greetings[lang])
+		}
+	}
+
+	test("fr-FR, en;q=0.5", "fr")
+	test("fr-CA", "fr-CA")
+	test("de", "en")
+	test("", "en")
+}
+
+// Testing whether the language of a LocalizedResource served by a mux is
+// negotiated, including for HEAD requests.
+func TestMuxLocalized(t *testing.T) {
+	resource := &localizedResource{map[string]string{
+		"en": "hello",
+		"fr": "bonjour",
+	}}
+	mux := NewMux()
+	mux.Get("/greeting", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return resource, nil
+	})
+
+	for _, method := range []string{Get, Head} {
+		r, _ := http.NewRequest(method, "http://www.example.com/greeting", nil)
+		r.Header.Set("Accept-Language", "fr-BE, en;q=0.5")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if got := w.Header().Get("Content-Language"); got != "fr" {
+			t.Errorf("%s: Got: %s Wanted: fr", method, got)
+		}
+		if !strings.Contains(strings.Join(w.Header()["Vary"], ", "), "Accept-Language") {
+			t.Errorf("%s: Accept-Language missing from Vary header", method)
+		}
+	}
+}
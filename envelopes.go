@@ -0,0 +1,163 @@
+package rst
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/context"
+)
+
+/*
+EnvelopePolicy is implemented by endpoints to override the EnvelopeJSON setting
+of the mux.
+
+When enabled, successful JSON responses are wrapped in an envelope holding the
+resource, its metadata and its links, for clients which can't read headers:
+
+	{
+		"data": [{"id": "a1-b2-c3-d4-e5-f6", "name": "Francis Underwood"}, ...],
+		"meta": {"etag": "1234", "lastModified": "2015-01-02T03:04:05Z", "ttl": 600, "count": 100, "range": "resources 0-9/100"},
+		"links": {"self": {"href": "/people"}, "next": {"href": "/people?page=2&per_page=10"}}
+	}
+
+The count is the total number of items of collections, from the X-Total-Count
+header of paginated responses or the Content-Range of partial ones, or the
+number of items of a JSON array. The links are the ones declared with Linker
+and the ones of the Link header, such as the pages of a paginated collection,
+indexed by relation like the _links of HAL documents.
+
+Clients can also request or decline an envelope with an envelope parameter in
+the JSON media range of their Accept header, which overrides the setting:
+
+	Accept: application/json; envelope=true
+
+and errors in a list:
+
+	{
+		"errors": [{"status": 404, "message": "Resource not found"}]
+	}
+
+Responses encoded in other formats, or without a body, are not affected.
+*/
+type EnvelopePolicy interface {
+	// EnvelopeJSON returns true if the JSON responses of the endpoint must be
+	// wrapped in an envelope.
+	EnvelopeJSON() bool
+}
+
+// envelopeResponses returns true if the JSON responses of handler must be
+// wrapped in an envelope.
+func (s *Mux) envelopeResponses(handler http.Handler) bool {
+	if h, valid := handler.(*endpointHandler); valid {
+		if policy, implemented := policyEndpoint(h.endpoint).(EnvelopePolicy); implemented {
+			return policy.EnvelopeJSON()
+		}
+	}
+	return s.EnvelopeJSON
+}
+
+const (
+	envelopeKey   = "__rst__envelope"
+	envelopeParam = "envelope"
+)
+
+// enveloped returns true if the JSON response to r must be wrapped in an
+// envelope.
+func enveloped(r *http.Request) bool {
+	for _, clause := range ParseAccept(r.Header.Get("Accept")) {
+		if value, found := clause.Params[envelopeParam]; found && isJSON(clause.Type+"/"+clause.SubType) {
+			return truthy(value)
+		}
+	}
+	if v := context.Get(r, envelopeKey); v != nil {
+		return v.(bool)
+	}
+	return getMux(r).EnvelopeJSON
+}
+
+// isJSON returns true if contentType is the media type of JSON.
+func isJSON(contentType string) bool {
+	return strings.HasPrefix(contentType, "application/json")
+}
+
+type envelopeMeta struct {
+	ETag         string    `json:"etag,omitempty"`
+	LastModified time.Time `json:"lastModified"`
+	TTL          int64     `json:"ttl"`
+	Count        *uint64   `json:"count,omitempty"`
+	Range        string    `json:"range,omitempty"`
+}
+
+type dataEnvelope struct {
+	Data  json.RawMessage `json:"data"`
+	Meta  *envelopeMeta   `json:"meta"`
+	Links json.RawMessage `json:"links,omitempty"`
+}
+
+// wrapData wraps b, the JSON encoding of resource, in an envelope with the
+// metadata and the links of resource.
+func wrapData(b []byte, resource Resource, header http.Header, r *http.Request) ([]byte, error) {
+	envelope := &dataEnvelope{
+		Data: json.RawMessage(b),
+		Meta: &envelopeMeta{
+			ETag:         resource.ETag(),
+			LastModified: resource.LastModified().UTC(),
+			TTL:          int64(resource.TTL() / time.Second),
+			Count:        envelopeCount(b, header),
+			Range:        header.Get("Content-Range"),
+		},
+	}
+	var links []Link
+	for _, value := range header.Values("Link") {
+		links = append(links, parseLinkHeader(value)...)
+	}
+	if links = append(links, linksOf(resource, r)...); len(links) > 0 {
+		envelope.Links = json.RawMessage(halLinks(links))
+	}
+	return marshalJSON(envelope, r)
+}
+
+// envelopeCount returns the total number of items of the collection encoded in
+// b, or nil if it isn't known.
+func envelopeCount(b []byte, header http.Header) *uint64 {
+	if total, err := strconv.ParseUint(header.Get(TotalCountHeader), 10, 64); err == nil {
+		return &total
+	}
+	if cr, err := ParseContentRange(header.Get("Content-Range")); err == nil {
+		if cr.Total == 0 {
+			return nil
+		}
+		return &cr.Total
+	}
+	if len(bytes.TrimSpace(b)) == 0 || bytes.TrimSpace(b)[0] != '[' {
+		return nil
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(b, &items); err != nil {
+		return nil
+	}
+	count := uint64(len(items))
+	return &count
+}
+
+type envelopeError struct {
+	Status int `json:"status"`
+	*Error
+}
+
+type errorsEnvelope struct {
+	Errors []*envelopeError `json:"errors"`
+}
+
+// wrapErrors returns the JSON encoding of errs wrapped in an envelope.
+func wrapErrors(r *http.Request, errs ...*Error) ([]byte, error) {
+	envelope := &errorsEnvelope{}
+	for _, e := range errs {
+		envelope.Errors = append(envelope.Errors, &envelopeError{e.Code, e})
+	}
+	return marshalJSON(envelope, r)
+}
@@ -0,0 +1,109 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type wrappedEndpoint struct {
+	enabled bool
+}
+
+func (e *wrappedEndpoint) EnvelopeJSON() bool {
+	return e.enabled
+}
+
+func (e *wrappedEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	if vars.Get("id") != "1" {
+		return nil, NotFound()
+	}
+	modified := time.Date(2015, time.January, 2, 3, 4, 5, 0, time.UTC)
+	return NewEnvelope(map[string]string{"id": "1"}, modified, "etag-1", 10*time.Minute), nil
+}
+
+func TestEnvelopeJSON(t *testing.T) {
+	mux := NewMux()
+	mux.Handle("/enabled/{id}", EndpointHandler(&wrappedEndpoint{true}))
+	mux.Handle("/disabled/{id}", EndpointHandler(&wrappedEndpoint{false}))
+	mux.Get("/default/{id}", (&wrappedEndpoint{}).Get)
+
+	var test = func(path, accept, expected string) {
+		r, _ := http.NewRequest(Get, "http://example.com"+path, nil)
+		r.Header.Set("Accept", accept)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Body.String() != expected {
+			t.Errorf("%s:\nGot:    %s\nWanted: %s", path, w.Body.String(), expected)
+		}
+	}
+
+	const (
+		data    = `{"id":"1"}`
+		wrapped = `{"data":{"id":"1"},"meta":{"etag":"etag-1","lastModified":"2015-01-02T03:04:05Z","ttl":600}}`
+		errors  = `{"errors":[{"status":404,"message":"Not Found","description":"No resource could be found at the requested URI."}]}`
+	)
+
+	test("/enabled/1", "application/json", wrapped)
+	test("/enabled/2", "application/json", errors)
+	test("/disabled/1", "application/json", data)
+	test("/default/1", "application/json", data)
+
+	mux.EnvelopeJSON = true
+	test("/default/1", "application/json", wrapped)
+	test("/default/2", "application/json", errors)
+	test("/unknown", "application/json", errors)
+	test("/disabled/1", "application/json", data)
+
+	// Other formats are not affected.
+	r, _ := http.NewRequest(Get, "http://example.com/default/2", nil)
+	r.Header.Set("Accept", "text/html")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if strings.Contains(w.Body.String(), `"errors"`) {
+		t.Error("HTML responses should not be wrapped in an envelope")
+	}
+}
+
+func TestEnvelopeMetadata(t *testing.T) {
+	mux := NewMux()
+	mux.SetPagination(&Pagination{PageSize: 2})
+	mux.Get("/numbers", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return newNumbers(5), nil
+	})
+	mux.Get("/list", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope([]int{1, 2, 3}, testTimeReference, "list", 0), nil
+	})
+
+	var test = func(path, accept, expected string) {
+		r, _ := http.NewRequest(Get, "http://example.com"+path, nil)
+		r.Header.Set("Accept", accept)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if got := strings.TrimSpace(w.Body.String()); got != expected {
+			t.Errorf("%s %s:\nGot:    %s\nWanted: %s", path, accept, got, expected)
+		}
+	}
+
+	test("/numbers?page=2", "application/json; envelope=true",
+		`{"data":[2,3],"meta":{"etag":"numbers","lastModified":"2014-04-14T10:00:00Z","ttl":0,"count":5},"links":{"first":{"href":"/numbers?page=1\u0026per_page=2"},"prev":{"href":"/numbers?page=1\u0026per_page=2"},"next":{"href":"/numbers?page=3\u0026per_page=2"},"last":{"href":"/numbers?page=3\u0026per_page=2"}}}`)
+	test("/list", "application/json; envelope=1", `{"data":[1,2,3],"meta":{"etag":"list","lastModified":"2014-04-14T10:00:00Z","ttl":0,"count":3}}`)
+	test("/list", "application/json", `[1,2,3]`)
+
+	mux.EnvelopeJSON = true
+	test("/list", "application/json; envelope=false", `[1,2,3]`)
+}
+
+func TestParseLinkHeader(t *testing.T) {
+	links := parseLinkHeader(`</people/1>; rel="self", <https://example.com/docs?a=1,2>; rel=help; title="Help, and more"; type="text/html", <malformed>`)
+	expected := []Link{
+		{Rel: "self", Href: "/people/1"},
+		{Rel: "help", Href: "https://example.com/docs?a=1,2", Title: "Help, and more", Type: "text/html"},
+	}
+	if !reflect.DeepEqual(links, expected) {
+		t.Errorf("Got: %+v Wanted: %+v", links, expected)
+	}
+}
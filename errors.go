@@ -1,14 +1,16 @@
 package rst
 
 import (
-	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"html/template"
-	"io/ioutil"
 	"log"
 	"net/http"
 	"runtime"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mohamedattahri/rst/internal/assets"
 )
@@ -19,12 +21,70 @@ func ErrorHandler(err error) http.Handler {
 	if e, ok := err.(*Error); ok {
 		return e
 	}
+	if t, ok := err.(*Tombstone); ok {
+		return t.httpError()
+	}
+	if v, ok := err.(*ValidationError); ok {
+		return v.httpError()
+	}
+	if rd, ok := err.(*Redirection); ok {
+		return rd
+	}
+	if p, ok := err.(*Problem); ok {
+		return p
+	}
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return requestTimeout()
+	case errors.Is(err, context.Canceled):
+		return clientGone
+	}
 	// panic will be intercepted in the main mux handler, and will write a
 	// response which may display debugging info or hide them depending on the
 	// Debug variable set in the mux.
 	panic(err)
 }
 
+/*
+OnError registers hook to be called with each error returned by the endpoints
+of the mux before it's written in response to r. The error returned by hook is
+written instead, which allows errors to be reported with their context,
+internal error types to be mapped to errors of rst, or the details of internal
+errors to be hidden from clients.
+
+	mux.OnError(func(err error, r *http.Request) error {
+		if err == sql.ErrNoRows {
+			return rst.NotFound()
+		}
+		if _, ok := err.(*rst.Error); !ok {
+			log.Printf("%s %s: %s", r.Method, r.URL, err)
+			return rst.InternalServerError("Unexpected failure", "The incident was reported.", false)
+		}
+		return err
+	})
+
+Hooks are called in the order of their registration, each with the error
+returned by the previous one. A nil error leaves the error unchanged.
+Redirections aren't passed to hooks.
+*/
+func (s *Mux) OnError(hook func(err error, r *http.Request) error) {
+	s.errorHooks = append(s.errorHooks, hook)
+}
+
+// handleError returns the error to write in response to r in place of err,
+// once transformed by the hooks of the mux.
+func (s *Mux) handleError(err error, r *http.Request) error {
+	if _, ok := err.(*Redirection); ok {
+		return err
+	}
+	for _, hook := range s.errorHooks {
+		if transformed := hook(err, r); transformed != nil {
+			err = transformed
+		}
+	}
+	return err
+}
+
 // BadRequest is returned when the request could not be understood by the
 // server due to malformed syntax.
 func BadRequest(reason, description string) *Error {
@@ -66,9 +126,72 @@ func NotFound() *Error {
 		http.StatusNotFound,
 		http.StatusText(http.StatusNotFound),
 		"No resource could be found at the requested URI.",
+	).standardize()
+}
+
+// Gone is returned when the resource identified by the request-URI is no
+// longer available, and will not be available again.
+func Gone() *Error {
+	return NewError(
+		http.StatusGone,
+		http.StatusText(http.StatusGone),
+		"The resource at the requested URI has been deleted, and is no longer available.",
 	)
 }
 
+/*
+Tombstone is the state of a resource that has been deleted. It's returned by
+endpoints as an error.
+
+Getters returning a tombstone respond with status code 410 Gone until the
+tombstone's sunset, and 404 Not Found afterwards. Deleters returning a
+tombstone respond as if the resource had just been deleted, which makes DELETE
+requests idempotent.
+
+	func (ep *endpoint) Get(vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+		resource := database.Find(vars.Get("id"))
+		if resource == nil {
+			return nil, rst.NotFound()
+		}
+		if resource.DeletedAt != nil {
+			return nil, rst.Deleted(*resource.DeletedAt, resource.DeletedAt.Add(30*24*time.Hour))
+		}
+		return resource, nil
+	}
+*/
+type Tombstone struct {
+	Date   time.Time // Date of the deletion.
+	Sunset time.Time // Date after which the deletion is forgotten. Zero means never.
+}
+
+// Deleted returns a tombstone for a resource deleted at date. A zero sunset
+// keeps the tombstone forever.
+func Deleted(date, sunset time.Time) *Tombstone {
+	return &Tombstone{Date: date, Sunset: sunset}
+}
+
+func (t *Tombstone) Error() string {
+	return t.httpError().Error()
+}
+
+// expired returns true if the sunset of t has passed.
+func (t *Tombstone) expired() bool {
+	return !t.Sunset.IsZero() && time.Now().After(t.Sunset)
+}
+
+// httpError returns the error written in responses to requests for the
+// resource.
+func (t *Tombstone) httpError() *Error {
+	if t.expired() {
+		return NotFound()
+	}
+	err := Gone()
+	if !t.Sunset.IsZero() {
+		err.Header.Set("Sunset", t.Sunset.UTC().Format(rfc1123))
+	}
+	return err
+}
+
 // MethodNotAllowed is returned when the method specified in a request is
 // not allowed by the resource identified by the request-URI.
 func MethodNotAllowed(forbidden string, allowed []string) *Error {
@@ -79,7 +202,7 @@ func MethodNotAllowed(forbidden string, allowed []string) *Error {
 		fmt.Sprintf("This ressource only allows the following methods: %s.", methods),
 	)
 	err.Header.Set("Allow", methods)
-	return err
+	return err.standardize()
 }
 
 // NotAcceptable is returned when the resource identified by the request
@@ -117,6 +240,17 @@ func PreconditionFailed() *Error {
 	return err
 }
 
+// PreconditionRequired is returned when a request modifying a resource must
+// be conditional, to prevent lost updates.
+func PreconditionRequired() *Error {
+	err := NewError(
+		http.StatusPreconditionRequired,
+		"Precondition required",
+		"The request must include an If-Match header with the ETag of the current version of the resource, to make sure it wasn't modified in the meantime.",
+	)
+	return err
+}
+
 // UnsupportedMediaType is returned when the entity in the request is in a format
 // not support by the server. The supported media MIME type strings can be passed
 // to improve the description of the error description.
@@ -130,7 +264,7 @@ func UnsupportedMediaType(mimes ...string) *Error {
 		"Entity inside request could not be processed",
 		description,
 	)
-	return err
+	return err.standardize()
 }
 
 // RequestedRangeNotSatisfiable is returned when the range in the Range header
@@ -146,6 +280,53 @@ func RequestedRangeNotSatisfiable(cr *ContentRange) *Error {
 	return err
 }
 
+// RequestEntityTooLarge is returned when the body of the request is larger
+// than the limit of the server. The connection is closed, since the rest of
+// the body won't be read.
+func RequestEntityTooLarge(limit int64) *Error {
+	err := NewError(
+		http.StatusRequestEntityTooLarge,
+		"Request body is too large",
+		fmt.Sprintf("The body of the request must not exceed %d bytes.", limit),
+	)
+	err.Header.Set("Connection", "close")
+	return err
+}
+
+// TooManyRequests is returned when the client has sent too many requests in a
+// given amount of time. A positive retryAfter is sent in the Retry-After header
+// of the response.
+func TooManyRequests(retryAfter time.Duration) *Error {
+	err := NewError(
+		http.StatusTooManyRequests,
+		http.StatusText(http.StatusTooManyRequests),
+		"Too many requests were sent. Please try again later.",
+	)
+	setRetryAfter(err, retryAfter)
+	return err
+}
+
+// ServiceUnavailable is returned when the server is temporarily unable to
+// handle the request, due to overload or maintenance. A positive retryAfter is
+// sent in the Retry-After header of the response.
+func ServiceUnavailable(retryAfter time.Duration) *Error {
+	err := NewError(
+		http.StatusServiceUnavailable,
+		http.StatusText(http.StatusServiceUnavailable),
+		"The service is temporarily unavailable. Please try again later.",
+	)
+	setRetryAfter(err, retryAfter)
+	return err
+}
+
+// setRetryAfter sets the Retry-After header of err to d, rounded up to the
+// second, if it's positive.
+func setRetryAfter(err *Error, d time.Duration) {
+	if d > 0 {
+		err.Header.Set("Retry-After", strconv.FormatInt(retryAfterSeconds(d), 10))
+	}
+}
+
 type stackRecord struct {
 	Filename string `json:"file" xml:"File"`
 	Line     int    `json:"line" xml:"Line"`
@@ -180,6 +361,9 @@ func InternalServerError(reason, description string, captureStack bool) *Error {
 		}
 		err.Stack = stack
 	}
+	if reason == http.StatusText(http.StatusInternalServerError) && description == "" {
+		err.standardize()
+	}
 	return err
 }
 
@@ -194,7 +378,10 @@ type Error struct {
 	Header      http.Header    `json:"-" xml:"-"`
 	Reason      string         `json:"message" xml:"Message"`
 	Description string         `json:"description,omitempty" xml:"Description,omitempty"`
+	Fields      []*FieldError  `json:"fields,omitempty" xml:"Fields>Field,omitempty"`
 	Stack       []*stackRecord `json:"stack,omitempty" xml:"Stack,omitempty"`
+
+	standard string // Message set by the constructor of a standard error.
 }
 
 func (e *Error) Error() string {
@@ -208,6 +395,10 @@ func (e *Error) String() string {
 		s += fmt.Sprintf("\n%s", e.Description)
 	}
 
+	for _, f := range e.Fields {
+		s += fmt.Sprintf("\n- %s: %s", f.Field, f.Message)
+	}
+
 	if e.Stack != nil && len(e.Stack) > 0 {
 		s += "\n"
 		for _, r := range e.Stack {
@@ -228,28 +419,98 @@ func (e *Error) MarshalRST(r *http.Request) (string, []byte, error) {
 	accept := ParseAccept(r.Header.Get("Accept"))
 	ct := accept.Negotiate("text/html", "*/*")
 	if strings.Contains(ct, "html") || ct == "*/*" {
-		buffer := &bytes.Buffer{}
+		buffer := getBuffer()
 		var data = struct {
 			Request *http.Request
 			*Error
 		}{Request: r, Error: e}
 		if err := errorTemplate.Execute(buffer, &data); err != nil {
+			putBuffer(buffer)
 			return "", nil, err
 		}
-		return "text/html; charset=utf-8", buffer.Bytes(), nil
+		return "text/html; charset=utf-8", detachBuffer(buffer), nil
 	}
 	return MarshalResource(e, r)
 }
 
+/*
+ErrorMarshalFunc encodes the body of the errors written in response to r. It
+returns the content type of the body, negotiated with the Accept header of r.
+
+	func(e *rst.Error, r *http.Request) (string, []byte, error) {
+		if rst.ParseAccept(r.Header.Get("Accept")).Negotiate("application/json") == "" {
+			return "", nil, rst.NotAcceptable()
+		}
+		b, err := json.Marshal(map[string]interface{}{
+			"error": map[string]interface{}{"status": e.Code, "title": e.Reason, "detail": e.Description},
+		})
+		return "application/json; charset=utf-8", b, err
+	}
+*/
+type ErrorMarshalFunc func(e *Error, r *http.Request) (string, []byte, error)
+
+/*
+SetErrorMarshaler sets the function encoding the errors written by the mux, in
+place of its own formats and envelopes, to keep an established error schema.
+
+Errors that fn fails to encode, or whose content type isn't accepted by the
+request, are written as if no function was set, so that clients asking for
+another format still get an error they can read. A nil value restores the
+default formats.
+*/
+func (s *Mux) SetErrorMarshaler(fn ErrorMarshalFunc) {
+	s.errorMarshaler = fn
+}
+
+// marshalError returns the encoding of e by the ErrorMarshalFunc of the mux,
+// and false if it has none or it failed.
+func marshalError(e *Error, r *http.Request) (string, []byte, bool) {
+	fn := getMux(r).errorMarshaler
+	if fn == nil {
+		return "", nil, false
+	}
+	ct, b, err := fn(e, r)
+	if err != nil || ct == "" {
+		return "", nil, false
+	}
+	mediaType := strings.TrimSpace(strings.SplitN(ct, ";", 2)[0])
+	if accept := r.Header.Get("Accept"); accept != "" && ParseAccept(accept).Negotiate(mediaType) == "" {
+		return "", nil, false
+	}
+	return ct, b, true
+}
+
 // ServeHTTP implements the http.Handler interface.
 func (e *Error) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e = e.localize(r)
+	if ct, b, ok := marshalError(e, r); ok {
+		writeErrorResponse(w, e.Code, e.Header, ct, b)
+		return
+	}
 	ct, b, err := Marshal(e, r)
+	if err == nil && isJSON(ct) && errorFormat(r) == ProblemJSON {
+		e.problem(r).ServeHTTP(w, r)
+		return
+	}
+	if err == nil && enveloped(r) && isJSON(ct) {
+		b, err = wrapErrors(r, e)
+	}
 	if err != nil {
 		ct = "text/plain; charset=utf-8"
 		b = []byte(e.String())
 	}
+	writeErrorResponse(w, e.Code, e.Header, ct, b)
+}
 
-	for key, values := range e.Header {
+// writeErrorResponse writes an error response with the given status code,
+// headers, content type and body.
+func writeErrorResponse(w http.ResponseWriter, code int, header http.Header, ct string, b []byte) {
+	// Directives of the representation the error replaces.
+	w.Header().Del("Cache-Control")
+	w.Header().Del("Surrogate-Control")
+	w.Header().Del("Content-Disposition")
+	w.Header().Del("Content-Language")
+	for key, values := range header {
 		for _, value := range values {
 			w.Header().Add(key, value)
 		}
@@ -263,10 +524,10 @@ func (e *Error) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	w.Header().Set("Content-Type", ct)
 	addVary(w.Header(), "Accept")
-	if e.Code != http.StatusNotFound && e.Code != http.StatusGone {
+	if code != http.StatusNotFound && code != http.StatusGone {
 		w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
 	}
-	w.WriteHeader(e.Code)
+	w.WriteHeader(code)
 	w.Write(b)
 }
 
@@ -287,13 +548,7 @@ func NewError(code int, reason, description string) *Error {
 var errorTemplate *template.Template
 
 func init() {
-	// errorTemplate is based on data embedded in interal/assets/assets.go
-	// using go generate and https://github.com/mjibson/esc.
-	f, err := assets.FS(false).Open("/internal/assets/error.html")
-	if err != nil {
-		log.Fatal(err)
-	}
-	b, err := ioutil.ReadAll(f)
+	b, err := assets.FS.ReadFile("error.html")
 	if err != nil {
 		log.Fatal(err)
 	}
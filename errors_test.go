@@ -2,11 +2,14 @@ package rst
 
 import (
 	"bytes"
+	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 )
 
 // TestInternalServerErrorStack tests whether the stack is only visible when
@@ -57,3 +60,146 @@ func TestInternalServerErrorStackDisplay(t *testing.T) {
 		t.Fatalf("provoked panic with Debug=False did not log message correctly: %s", buffer.String())
 	}
 }
+
+func TestTombstone(t *testing.T) {
+	var test = func(tombstone *Tombstone, method string, expected int) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(method, "http://www.example.com/people/1", nil)
+		w := httptest.NewRecorder()
+		switch method {
+		case Get:
+			GetFunc(func(vars RouteVars, r *http.Request) (Resource, error) {
+				return nil, tombstone
+			}).ServeHTTP(w, r)
+		case Delete:
+			DeleteFunc(func(vars RouteVars, r *http.Request) error {
+				return tombstone
+			}).ServeHTTP(w, r)
+		}
+		if w.Code != expected {
+			t.Errorf("%s: Got: %d Wanted: %d", method, w.Code, expected)
+		}
+		return w
+	}
+
+	deleted := testTimeReference
+	sunset := time.Now().Add(time.Hour)
+
+	w := test(Deleted(deleted, sunset), Get, http.StatusGone)
+	if got := w.Header().Get("Sunset"); got != sunset.UTC().Format(rfc1123) {
+		t.Error("Got Sunset:", got, "Wanted:", sunset.UTC().Format(rfc1123))
+	}
+	if got := w.Header().Get("Cache-Control"); got != "" {
+		t.Error("410 Gone should be cacheable. Got Cache-Control:", got)
+	}
+	if w := test(Deleted(deleted, time.Time{}), Get, http.StatusGone); w.Header().Get("Sunset") != "" {
+		t.Error("Sunset header not expected without a sunset")
+	}
+	test(Deleted(deleted, time.Now().Add(-time.Hour)), Get, http.StatusNotFound)
+	test(Deleted(deleted, sunset), Delete, http.StatusNoContent)
+}
+
+func TestRetryAfterErrors(t *testing.T) {
+	var test = func(err *Error, code int, retryAfter string) {
+		r, _ := http.NewRequest(Get, "http://www.example.com/people/1", nil)
+		r.Header.Set("Accept", "application/json")
+		w := httptest.NewRecorder()
+		GetFunc(func(vars RouteVars, r *http.Request) (Resource, error) {
+			return nil, err
+		}).ServeHTTP(w, r)
+		if w.Code != code {
+			t.Errorf("Got: %d Wanted: %d", w.Code, code)
+		}
+		if got := w.Header().Get("Retry-After"); got != retryAfter {
+			t.Errorf("Retry-After: Got: %q Wanted: %q", got, retryAfter)
+		}
+		if !strings.Contains(w.Body.String(), err.Reason) {
+			t.Errorf("Body doesn't contain the reason of the error: %s", w.Body.String())
+		}
+	}
+
+	test(TooManyRequests(1500*time.Millisecond), http.StatusTooManyRequests, "2")
+	test(TooManyRequests(0), http.StatusTooManyRequests, "")
+	test(ServiceUnavailable(time.Minute), http.StatusServiceUnavailable, "60")
+	test(ServiceUnavailable(-time.Second), http.StatusServiceUnavailable, "")
+}
+
+// errDatabase is an internal error mapped by the hooks of TestOnError.
+var errDatabase = fmt.Errorf("connection refused")
+
+func TestOnError(t *testing.T) {
+	mux := NewMux()
+	mux.Get("/people/{id}", func(vars RouteVars, r *http.Request) (Resource, error) {
+		switch vars.Get("id") {
+		case "db":
+			return nil, errDatabase
+		case "conflict":
+			return nil, Conflict()
+		}
+		return nil, Redirect(http.StatusFound, "/people/1")
+	})
+
+	var observed []error
+	mux.OnError(func(err error, r *http.Request) error {
+		observed = append(observed, err)
+		if err == errDatabase {
+			return ServiceUnavailable(0)
+		}
+		return nil
+	})
+	mux.OnError(func(err error, r *http.Request) error {
+		if e, ok := err.(*Error); ok && e.Code == http.StatusConflict {
+			e.Description = ""
+		}
+		return err
+	})
+
+	var test = func(path string, code int) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(Get, "http://www.example.com"+path, nil)
+		r.Header.Set("Accept", "application/json")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != code {
+			t.Errorf("%s: Got: %d Wanted: %d", path, w.Code, code)
+		}
+		return w
+	}
+	test("/people/db", http.StatusServiceUnavailable)
+	if w := test("/people/conflict", http.StatusConflict); strings.Contains(w.Body.String(), Conflict().Description) {
+		t.Errorf("description of the error wasn't removed: %s", w.Body.String())
+	}
+	test("/people/moved", http.StatusFound)
+	if len(observed) != 2 || observed[0] != errDatabase {
+		t.Errorf("Got: %v Wanted: [%v 409]", observed, errDatabase)
+	}
+}
+
+func TestErrorMarshaler(t *testing.T) {
+	mux := NewMux()
+	mux.Get("/people/{id}", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return nil, NotFound()
+	})
+	mux.SetErrorMarshaler(func(e *Error, r *http.Request) (string, []byte, error) {
+		if ParseAccept(r.Header.Get("Accept")).Negotiate("application/vnd.acme.error+json") == "" {
+			return "", nil, NotAcceptable()
+		}
+		return "application/vnd.acme.error+json; charset=utf-8", []byte(fmt.Sprintf(`{"error":%d}`, e.Code)), nil
+	})
+
+	var test = func(accept, contentType, body string) {
+		r, _ := http.NewRequest(Get, "http://www.example.com/people/1", nil)
+		r.Header.Set("Accept", accept)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != http.StatusNotFound {
+			t.Errorf("%s: Got: %d Wanted: %d", accept, w.Code, http.StatusNotFound)
+		}
+		if ct := w.Header().Get("Content-Type"); ct != contentType {
+			t.Errorf("%s: Content-Type is %q, wanted %q", accept, ct, contentType)
+		}
+		if body != "" && w.Body.String() != body {
+			t.Errorf("%s: Got: %s Wanted: %s", accept, w.Body.String(), body)
+		}
+	}
+	test("application/vnd.acme.error+json", "application/vnd.acme.error+json; charset=utf-8", `{"error":404}`)
+	test("text/html", "text/html; charset=utf-8", "")
+}
@@ -0,0 +1,81 @@
+package rst
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"reflect"
+
+	"github.com/gorilla/context"
+)
+
+/*
+AutoETag returns a strong ETag computed from the representation of resource
+negotiated for r, for the resources which have no version of their own.
+
+	func (ep *endpoint) Get(vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+		person, err := db.Find(vars.Get("id"))
+		if err != nil {
+			return nil, err
+		}
+		return rst.NewEnvelope(person, person.Modified, rst.AutoETag(person, r), 0), nil
+	}
+
+When resource is a pointer, its representation is kept for the duration of r,
+so that it's only encoded once when written in the response; it must not be
+modified in between. The ETag is compared with If-None-Match and If-Match like
+any other. Since it's derived from the bytes sent to the client, it changes
+with the media type and language negotiated for r.
+
+AutoETag returns an empty string if resource can't be marshaled.
+*/
+func AutoETag(resource interface{}, r *http.Request) string {
+	m := memoizedRepresentation(resource, r)
+	if m == nil {
+		contentType, b, err := Marshal(resource, r)
+		if err != nil {
+			return ""
+		}
+		m = &representation{resource, contentType, b}
+		if memoizable(resource) {
+			context.Set(r, representationsKey, append(memoizedRepresentations(r), m))
+		}
+	}
+	sum := sha256.Sum256(m.encoded)
+	return base64.RawURLEncoding.EncodeToString(sum[:16])
+}
+
+const representationsKey = "__rst__representations"
+
+// representation is the result of Marshal for a resource, kept for the duration
+// of a request.
+type representation struct {
+	resource    interface{}
+	contentType string
+	encoded     []byte
+}
+
+// memoizedRepresentation returns the representation of resource kept for r,
+// or nil.
+func memoizedRepresentation(resource interface{}, r *http.Request) *representation {
+	if r == nil || !memoizable(resource) {
+		return nil
+	}
+	for _, m := range memoizedRepresentations(r) {
+		if m.resource == resource {
+			return m
+		}
+	}
+	return nil
+}
+
+func memoizedRepresentations(r *http.Request) []*representation {
+	memos, _ := context.Get(r, representationsKey).([]*representation)
+	return memos
+}
+
+// memoizable returns true if the representation of resource can be looked up
+// by identity, which is the case of pointers.
+func memoizable(resource interface{}) bool {
+	return resource != nil && reflect.TypeOf(resource).Kind() == reflect.Ptr
+}
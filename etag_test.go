@@ -0,0 +1,90 @@
+package rst
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// countedResource counts the number of times it's marshaled.
+type countedResource struct {
+	Name     string `json:"name"`
+	marshals int
+}
+
+func (c *countedResource) MarshalRST(r *http.Request) (string, []byte, error) {
+	c.marshals++
+	return MarshalResource(&countedPerson{Name: c.Name}, r)
+}
+
+type countedPerson struct {
+	XMLName xml.Name `json:"-" xml:"person"`
+	Name    string   `json:"name" xml:"name"`
+}
+
+type hashedEndpoint struct {
+	resource *countedResource
+	writes   int
+}
+
+func (e *hashedEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	return NewEnvelope(e.resource, time.Time{}, AutoETag(e.resource, r), 0), nil
+}
+
+func (e *hashedEndpoint) Put(vars RouteVars, r *http.Request) (Resource, error) {
+	e.writes++
+	return nil, nil
+}
+
+func TestAutoETag(t *testing.T) {
+	endpoint := &hashedEndpoint{resource: &countedResource{Name: "Francis"}}
+	mux := NewMux()
+	mux.HandleEndpoint("/people/1", endpoint)
+
+	var test = func(method string, header http.Header) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(method, "http://example.com/people/1", nil)
+		r.Header = header
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		return w
+	}
+
+	w := test(Get, http.Header{"Accept": {"application/json"}})
+	etag := w.Header().Get("ETag")
+	if w.Code != http.StatusOK || etag == "" {
+		t.Fatalf("Got: %d %q Wanted: 200 and an ETag", w.Code, etag)
+	}
+	if endpoint.resource.marshals != 1 {
+		t.Errorf("Marshals: Got: %d Wanted: 1", endpoint.resource.marshals)
+	}
+
+	if got := test(Get, http.Header{"Accept": {"application/json"}}).Header().Get("ETag"); got != etag {
+		t.Errorf("Stable: Got: %q Wanted: %q", got, etag)
+	}
+	if got := test(Get, http.Header{"Accept": {"application/xml"}}).Header().Get("ETag"); got == etag || got == "" {
+		t.Errorf("XML: Got: %q Wanted: a different ETag than %q", got, etag)
+	}
+
+	if w := test(Get, http.Header{"Accept": {"application/json"}, "If-None-Match": {etag}}); w.Code != http.StatusNotModified {
+		t.Errorf("If-None-Match: Got: %d Wanted: %d", w.Code, http.StatusNotModified)
+	}
+
+	endpoint.resource.Name = "Francis Underwood"
+	if w := test(Get, http.Header{"Accept": {"application/json"}, "If-None-Match": {etag}}); w.Code != http.StatusOK {
+		t.Errorf("Modified: Got: %d Wanted: %d", w.Code, http.StatusOK)
+	}
+	if w := test(Put, http.Header{"Accept": {"application/json"}, "If-Match": {etag}}); w.Code != http.StatusPreconditionFailed {
+		t.Errorf("If-Match: Got: %d Wanted: %d", w.Code, http.StatusPreconditionFailed)
+	}
+	if endpoint.writes != 0 {
+		t.Errorf("Writes: Got: %d Wanted: 0", endpoint.writes)
+	}
+
+	r, _ := http.NewRequest(Get, "http://example.com/", nil)
+	r.Header.Set("Accept", "application/json")
+	if got := AutoETag(func() {}, r); got != "" {
+		t.Errorf("Unmarshalable: Got: %q Wanted: empty", got)
+	}
+}
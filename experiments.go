@@ -0,0 +1,139 @@
+package rst
+
+import (
+	"math/rand"
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/context"
+)
+
+// ExperimentHeader is the response header in which the variants assigned to a
+// request are recorded, as name=variant pairs.
+const ExperimentHeader = "X-Experiment"
+
+const experimentsKey = "__rst__experiments"
+
+/*
+Experiment assigns one of its variants to each request served by the mux, so
+that endpoints and resources can A/B test the shape of their representations.
+
+	mux.AddExperiment(&rst.Experiment{
+		Name:     "compact-person",
+		Variants: []string{"control", "compact"},
+		Weights:  []float64{0.9, 0.1},
+		Principal: func(r *http.Request) string {
+			user, _, _ := r.BasicAuth()
+			return user
+		},
+	})
+
+	func (p *Person) MarshalRST(r *http.Request) (string, []byte, error) {
+		if rst.Variant(r, "compact-person") == "compact" {
+			return rst.MarshalResource(p.Compact(), r)
+		}
+		return rst.MarshalResource(p.Full(), r)
+	}
+
+Requests sharing the same principal are consistently assigned the same
+variant. Requests without a principal are assigned a random variant.
+
+The ETag of the representations served to a request depends on its variants,
+so that conditional requests never validate the representation of another
+variant. The If-Match header of writes matches the ETag of any variant. The variants are also reported to the access logger of the mux.
+*/
+type Experiment struct {
+	Name      string                       // Name of the experiment.
+	Variants  []string                     // Variants of the experiment.
+	Weights   []float64                    // Optional. Relative weights of the variants, which are equal by default.
+	Principal func(r *http.Request) string // Optional. Returns the key of sticky assignments.
+
+	mu     sync.Mutex
+	counts map[string]uint64
+}
+
+// Counts returns the number of requests assigned to each variant.
+func (e *Experiment) Counts() map[string]uint64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	counts := make(map[string]uint64, len(e.counts))
+	for variant, count := range e.counts {
+		counts[variant] = count
+	}
+	return counts
+}
+
+// assign returns the variant of r, and records it.
+func (e *Experiment) assign(r *http.Request) string {
+	n := rand.Float64()
+	if e.Principal != nil {
+		if principal := e.Principal(r); principal != "" {
+			n = bucket(e.Name + "\x00" + principal)
+		}
+	}
+
+	var total float64
+	for i := range e.Variants {
+		total += e.weight(i)
+	}
+	variant := e.Variants[len(e.Variants)-1]
+	for i, cumulative := 0, 0.0; i < len(e.Variants); i++ {
+		cumulative += e.weight(i) / total
+		if n < cumulative {
+			variant = e.Variants[i]
+			break
+		}
+	}
+
+	e.mu.Lock()
+	e.counts[variant]++
+	e.mu.Unlock()
+	return variant
+}
+
+func (e *Experiment) weight(i int) float64 {
+	if i < len(e.Weights) {
+		return e.Weights[i]
+	}
+	return 1
+}
+
+// AddExperiment registers an experiment whose variants will be assigned to the
+// requests served by the mux. It panics if the experiment has no name or no
+// variants.
+func (s *Mux) AddExperiment(e *Experiment) {
+	if e.Name == "" || len(e.Variants) == 0 {
+		panic("rst: experiment must have a name and at least one variant")
+	}
+	e.counts = make(map[string]uint64)
+	s.experiments = append(s.experiments, e)
+}
+
+// assignVariants assigns the variants of the experiments of the mux to r, and
+// records them in the headers of w.
+func (s *Mux) assignVariants(w http.ResponseWriter, r *http.Request) {
+	if len(s.experiments) == 0 {
+		return
+	}
+	variants := make(map[string]string, len(s.experiments))
+	for _, e := range s.experiments {
+		variant := e.assign(r)
+		variants[e.Name] = variant
+		w.Header().Add(ExperimentHeader, e.Name+"="+variant)
+	}
+	context.Set(r, experimentsKey, variants)
+}
+
+// Variant returns the variant of the experiment with the given name assigned
+// to r, or an empty string.
+func Variant(r *http.Request, experiment string) string {
+	return variantsOf(r)[experiment]
+}
+
+// variantsOf returns the variants assigned to r by name of experiment, or nil.
+func variantsOf(r *http.Request) map[string]string {
+	if v := context.Get(r, experimentsKey); v != nil {
+		return v.(map[string]string)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExperiment(t *testing.T) {
+	experiment := &Experiment{
+		Name:     "shape",
+		Variants: []string{"control", "compact"},
+		Weights:  []float64{1, 1},
+		Principal: func(r *http.Request) string {
+			return r.Header.Get("X-User")
+		},
+	}
+	mux := NewMux()
+	mux.AddExperiment(experiment)
+
+	var assigned string
+	mux.Handle("/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assigned = Variant(r, "shape")
+	}))
+
+	get := func(user string) (string, string) {
+		r, _ := http.NewRequest(Get, "/", nil)
+		r.Header.Set("X-User", user)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		return assigned, w.Header().Get(ExperimentHeader)
+	}
+
+	seen := make(map[string]bool)
+	for _, user := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"} {
+		variant, header := get(user)
+		if variant != "control" && variant != "compact" {
+			t.Fatalf("%s: got variant %q", user, variant)
+		}
+		if header != "shape="+variant {
+			t.Fatalf("%s: got header %q, wanted %q", user, header, "shape="+variant)
+		}
+		if again, _ := get(user); again != variant {
+			t.Fatalf("%s: assignment isn't sticky: got %q, then %q", user, variant, again)
+		}
+		seen[variant] = true
+	}
+	if len(seen) != 2 {
+		t.Fatalf("all users were assigned the same variant")
+	}
+
+	counts := experiment.Counts()
+	if counts["control"]+counts["compact"] != 20 {
+		t.Fatalf("got counts %v, wanted a total of 20", counts)
+	}
+
+	r, _ := http.NewRequest(Get, "/", nil)
+	if v := Variant(r, "shape"); v != "" {
+		t.Fatalf("got variant %q outside of the mux", v)
+	}
+}
+
+func TestExperimentETag(t *testing.T) {
+	mux := NewMux()
+	mux.AddExperiment(&Experiment{
+		Name:     "shape",
+		Variants: []string{"control", "compact"},
+		Principal: func(r *http.Request) string {
+			return r.Header.Get("X-User")
+		},
+	})
+	var entry *AccessEntry
+	mux.AccessLogger = AccessLogFunc(func(e *AccessEntry) { entry = e })
+	mux.Get("/", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(Variant(r, "shape"), testTimeReference, "v1", 0), nil
+	})
+
+	get := func(user, etag string) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(Get, "/", nil)
+		r.Header.Set("X-User", user)
+		if etag != "" {
+			r.Header.Set("If-None-Match", etag)
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		return w
+	}
+
+	etags := make(map[string]string)
+	for _, user := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"} {
+		w := get(user, "")
+		variant := entry.Variants["shape"]
+		if header := w.Header().Get(ExperimentHeader); header != "shape="+variant {
+			t.Fatalf("%s: got variant %q in the access log, and %q in the response", user, variant, header)
+		}
+		etags[variant] = w.Header().Get("ETag")
+		if w := get(user, etags[variant]); w.Code != http.StatusNotModified {
+			t.Fatalf("%s: got %d with the ETag of the variant, wanted 304", user, w.Code)
+		}
+	}
+	if len(etags) != 2 || etags["control"] == etags["compact"] || etags["control"] == "v1" {
+		t.Fatalf("got ETags %v, wanted one per variant", etags)
+	}
+}
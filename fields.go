@@ -0,0 +1,314 @@
+package rst
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gorilla/context"
+)
+
+/*
+SparseFieldsPolicy is implemented by endpoints to override the SparseFields
+setting of the mux.
+
+When enabled, requests with a fields parameter in their query string receive a
+representation of the resource limited to the listed fields. Nested fields are
+separated by dots, and apply to each item of collections:
+
+	GET /people?fields=firstname,lastname,employer.company
+
+	[{"firstname":"Francis","lastname":"Underwood","employer":{"company":"The White House"}}]
+
+JSON and XML representations are filtered after they're encoded, so that the
+names of the fields are the ones of the representation. Elements of XML
+representations are matched regardless of their case.
+
+The ETag of filtered representations depends on the requested fields, so that
+caches don't mix them up with the full representation.
+*/
+type SparseFieldsPolicy interface {
+	// SparseFields returns true if the endpoint supports the fields
+	// parameter.
+	SparseFields() bool
+}
+
+// sparseFieldsAllowed returns true if handler supports the fields parameter.
+func (s *Mux) sparseFieldsAllowed(handler http.Handler) bool {
+	if h, valid := handler.(*endpointHandler); valid {
+		if policy, implemented := policyEndpoint(h.endpoint).(SparseFieldsPolicy); implemented {
+			return policy.SparseFields()
+		}
+	}
+	return s.SparseFields
+}
+
+const sparseFieldsKey = "__rst__fields"
+
+// fieldTree is the tree of the fields requested in a fields parameter. Leaves
+// are empty trees, whose value is kept entirely.
+type fieldTree map[string]fieldTree
+
+// requestedFields returns the fields requested by r, or nil if r doesn't
+// request sparse fields.
+func requestedFields(r *http.Request) []string {
+	if v := context.Get(r, sparseFieldsKey); v == nil || !v.(bool) {
+		return nil
+	}
+	var fields []string
+	for _, field := range strings.Split(r.URL.Query().Get("fields"), ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// newFieldTree returns the tree of fields.
+func newFieldTree(fields []string) fieldTree {
+	tree := make(fieldTree)
+	for _, field := range fields {
+		node := tree
+		for _, name := range strings.Split(field, ".") {
+			child, found := node[name]
+			if !found {
+				child = make(fieldTree)
+				node[name] = child
+			}
+			node = child
+		}
+	}
+	return tree
+}
+
+// lookup returns the subtree of name, ignoring its case if fold is true.
+func (t fieldTree) lookup(name string, fold bool) (fieldTree, bool) {
+	if sub, found := t[name]; found || !fold {
+		return sub, found
+	}
+	for key, sub := range t {
+		if strings.EqualFold(key, name) {
+			return sub, true
+		}
+	}
+	return nil, false
+}
+
+// representationETag returns etag, or a variant of etag depending on the
+// fields requested by r and on the variants of the experiments assigned to r.
+func representationETag(etag string, r *http.Request) string {
+	if etag == "" {
+		return etag
+	}
+	if fields := requestedFields(r); len(fields) > 0 {
+		etag += "-" + etagSuffix(fields)
+	}
+	return variantETag(etag, variantsOf(r))
+}
+
+// variantETag returns etag, or a variant of etag depending on the variants of
+// experiments.
+func variantETag(etag string, variants map[string]string) string {
+	if len(variants) == 0 {
+		return etag
+	}
+	pairs := make([]string, 0, len(variants))
+	for name, variant := range variants {
+		pairs = append(pairs, name+"="+variant)
+	}
+	return etag + "-" + etagSuffix(pairs)
+}
+
+// representationETags returns the ETags with which the representations of a
+// resource whose ETag is etag can have been served to the client of r: etag,
+// and its variants for every combination of the variants of the experiments
+// of the mux, since variants can be assigned randomly.
+func representationETags(etag string, r *http.Request) []string {
+	etags := []string{etag}
+	experiments := getMux(r).experiments
+	if etag == "" || len(experiments) == 0 {
+		return etags
+	}
+	variants := make(map[string]string, len(experiments))
+	var combine func(i int)
+	combine = func(i int) {
+		if i == len(experiments) {
+			etags = append(etags, variantETag(etag, variants))
+			return
+		}
+		for _, variant := range experiments[i].Variants {
+			variants[experiments[i].Name] = variant
+			combine(i + 1)
+		}
+	}
+	combine(0)
+	return etags
+}
+
+// etagSuffix returns a short hash of values, whatever their order.
+func etagSuffix(values []string) string {
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	sum := sha256.Sum256([]byte(strings.Join(sorted, ",")))
+	return hex.EncodeToString(sum[:4])
+}
+
+// filterFields limits b, the representation of resource encoded in
+// contentType, to the fields requested by r.
+func filterFields(resource interface{}, contentType string, b []byte, r *http.Request) ([]byte, error) {
+	fields := requestedFields(r)
+	if len(fields) == 0 || len(b) == 0 {
+		return b, nil
+	}
+	tree := newFieldTree(fields)
+	switch {
+	case isJSON(contentType):
+		return filterJSON(b, tree)
+	case strings.HasPrefix(contentType, "application/xml"):
+		v := reflect.Indirect(reflect.ValueOf(resource))
+		return filterXML(b, tree, v.Kind() == reflect.Slice || v.Kind() == reflect.Array)
+	}
+	return b, nil
+}
+
+// filterJSON limits the JSON objects of b to the fields of tree, keeping their
+// order.
+func filterJSON(b []byte, tree fieldTree) ([]byte, error) {
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 {
+		return b, nil
+	}
+
+	var buffer bytes.Buffer
+	switch b[0] {
+	case '{':
+		decoder := json.NewDecoder(bytes.NewReader(b))
+		if _, err := decoder.Token(); err != nil {
+			return nil, err
+		}
+		buffer.WriteByte('{')
+		for decoder.More() {
+			token, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			var value json.RawMessage
+			if err := decoder.Decode(&value); err != nil {
+				return nil, err
+			}
+			key, _ := token.(string)
+			sub, found := tree[key]
+			if !found {
+				continue
+			}
+			if len(sub) > 0 {
+				if value, err = filterJSON(value, sub); err != nil {
+					return nil, err
+				}
+			}
+			if buffer.Len() > 1 {
+				buffer.WriteByte(',')
+			}
+			name, _ := json.Marshal(key)
+			buffer.Write(name)
+			buffer.WriteByte(':')
+			buffer.Write(value)
+		}
+		buffer.WriteByte('}')
+	case '[':
+		var items []json.RawMessage
+		if err := json.Unmarshal(b, &items); err != nil {
+			return nil, err
+		}
+		buffer.WriteByte('[')
+		for i, item := range items {
+			filtered, err := filterJSON(item, tree)
+			if err != nil {
+				return nil, err
+			}
+			if i > 0 {
+				buffer.WriteByte(',')
+			}
+			buffer.Write(filtered)
+		}
+		buffer.WriteByte(']')
+	default:
+		return b, nil
+	}
+	return buffer.Bytes(), nil
+}
+
+// filterXML limits the children of the root element of b, or of each of its
+// children if b is a collection, to the fields of tree.
+func filterXML(b []byte, tree fieldTree, collection bool) ([]byte, error) {
+	var buffer bytes.Buffer
+	decoder := xml.NewDecoder(bytes.NewReader(b))
+	encoder := xml.NewEncoder(&buffer)
+
+	// Trees of the open elements, where nil keeps all the children.
+	var stack []fieldTree
+	for {
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			var current fieldTree
+			switch {
+			case len(stack) == 0 && !collection, len(stack) == 1 && collection:
+				current = tree
+			case len(stack) > 0 && stack[len(stack)-1] != nil:
+				sub, found := stack[len(stack)-1].lookup(t.Name.Local, true)
+				if !found {
+					if err := skipXML(decoder); err != nil {
+						return nil, err
+					}
+					continue
+				}
+				if len(sub) > 0 {
+					current = sub
+				}
+			}
+			stack = append(stack, current)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+		if err := encoder.EncodeToken(xml.CopyToken(token)); err != nil {
+			return nil, err
+		}
+	}
+	if err := encoder.Flush(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// skipXML reads the tokens of decoder until the end of the element whose start
+// was just read.
+func skipXML(decoder *xml.Decoder) error {
+	for depth := 1; depth > 0; {
+		token, err := decoder.RawToken()
+		if err != nil {
+			return err
+		}
+		switch token.(type) {
+		case xml.StartElement:
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+	return nil
+}
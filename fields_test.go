@@ -0,0 +1,85 @@
+package rst
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type sparseEmployer struct {
+	Company string `json:"company" xml:"company"`
+	City    string `json:"city" xml:"city"`
+}
+
+type sparsePerson struct {
+	XMLName   xml.Name        `json:"-" xml:"person"`
+	Firstname string          `json:"firstname" xml:"firstname"`
+	Lastname  string          `json:"lastname" xml:"lastname"`
+	Age       int             `json:"age" xml:"age"`
+	Employer  *sparseEmployer `json:"employer" xml:"employer"`
+}
+
+var testSparsePerson = &sparsePerson{
+	Firstname: "Francis",
+	Lastname:  "Underwood",
+	Age:       55,
+	Employer:  &sparseEmployer{"The White House", "Washington"},
+}
+
+type sparseEndpoint struct {
+	allowed bool
+}
+
+func (e *sparseEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	return NewEnvelope(testSparsePerson, time.Now(), "v1", 0), nil
+}
+
+func (e *sparseEndpoint) SparseFields() bool {
+	return e.allowed
+}
+
+func TestSparseFields(t *testing.T) {
+	mux := NewMux()
+	mux.SparseFields = true
+	mux.Get("/people/1", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(testSparsePerson, time.Now(), "v1", 0), nil
+	})
+	mux.Get("/people", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope([]*sparsePerson{testSparsePerson, testSparsePerson}, time.Now(), "v1", 0), nil
+	})
+	mux.HandleEndpoint("/disabled", &sparseEndpoint{false})
+
+	var test = func(path, accept, body string) string {
+		r, _ := http.NewRequest(Get, "http://example.com"+path, nil)
+		r.Header.Set("Accept", accept)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != http.StatusOK {
+			t.Errorf("%s %s: Got: %d Wanted: %d", path, accept, w.Code, http.StatusOK)
+		}
+		if got := strings.TrimSpace(w.Body.String()); got != body {
+			t.Errorf("%s %s: Got: %s Wanted: %s", path, accept, got, body)
+		}
+		return w.Header().Get("ETag")
+	}
+
+	full := test("/people/1", "application/json", `{"firstname":"Francis","lastname":"Underwood","age":55,"employer":{"company":"The White House","city":"Washington"}}`)
+	sparse := test("/people/1?fields=lastname,firstname,employer.company", "application/json", `{"firstname":"Francis","lastname":"Underwood","employer":{"company":"The White House"}}`)
+	if full != "v1" || sparse == full {
+		t.Errorf("ETag: Got: %q and %q Wanted: v1 and a different ETag", full, sparse)
+	}
+	if reordered := test("/people/1?fields=employer.company,firstname,lastname", "application/json", `{"firstname":"Francis","lastname":"Underwood","employer":{"company":"The White House"}}`); reordered != sparse {
+		t.Errorf("ETag: Got: %q Wanted: %q", reordered, sparse)
+	}
+	test("/people/1?fields=employer", "application/json", `{"employer":{"company":"The White House","city":"Washington"}}`)
+	test("/people/1?fields=unknown", "application/json", `{}`)
+	test("/people?fields=age", "application/json", `[{"age":55},{"age":55}]`)
+	test("/people/1?fields=Lastname,employer.city", "application/xml",
+		xml.Header+`<person><lastname>Underwood</lastname><employer><city>Washington</city></employer></person>`)
+	test("/people?fields=age", "application/xml",
+		xml.Header+`<sparsePersonList><person><age>55</age></person><person><age>55</age></person></sparsePersonList>`)
+	test("/disabled?fields=age", "application/json", `{"firstname":"Francis","lastname":"Underwood","age":55,"employer":{"company":"The White House","city":"Washington"}}`)
+}
@@ -0,0 +1,291 @@
+package rst
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+)
+
+// sniffLength is the number of bytes read to detect the content type of files.
+const sniffLength = 512
+
+/*
+FileResource is a file of an fs.FS served through the pipeline of the mux, so
+that its errors are written like the ones of any other resource.
+
+	func (ep *ReportEP) Get(vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+		return rst.NewFileResource(os.DirFS("reports"), vars.Get("name")+".pdf", time.Hour)
+	}
+
+The ETag of a file is derived from its modification time and its size, which
+is also its Last-Modified date. Its content type is the one of its extension,
+or is detected from its first bytes. Files support range requests in bytes,
+which are answered with status code 206 Partial Content by seeking in the file
+when it implements io.Seeker.
+*/
+type FileResource struct {
+	fsys fs.FS
+	name string
+	info fs.FileInfo
+	ttl  time.Duration
+}
+
+// NewFileResource returns the file name of fsys, which can't be a directory.
+// It returns a NotFound error if the file doesn't exist.
+func NewFileResource(fsys fs.FS, name string, ttl time.Duration) (*FileResource, error) {
+	info, err := statFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, NotFound()
+	}
+	return &FileResource{fsys, name, info, ttl}, nil
+}
+
+// statFile returns the info of name in fsys, or the error of the mux matching
+// the failure.
+func statFile(fsys fs.FS, name string) (fs.FileInfo, error) {
+	if !fs.ValidPath(name) {
+		return nil, NotFound()
+	}
+	info, err := fs.Stat(fsys, name)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return nil, NotFound()
+	case errors.Is(err, fs.ErrPermission):
+		return nil, Forbidden()
+	}
+	return info, err
+}
+
+// ETag implements the Resource interface.
+func (f *FileResource) ETag() string {
+	return fmt.Sprintf("%x-%x", f.info.ModTime().UnixNano(), f.info.Size())
+}
+
+// LastModified implements the Resource interface.
+func (f *FileResource) LastModified() time.Time {
+	return f.info.ModTime()
+}
+
+// TTL implements the Resource interface.
+func (f *FileResource) TTL() time.Duration {
+	return f.ttl
+}
+
+// Units implements the Ranger interface.
+func (f *FileResource) Units() []string {
+	return []string{"bytes"}
+}
+
+// Count implements the Ranger interface.
+func (f *FileResource) Count() uint64 {
+	return uint64(f.info.Size())
+}
+
+// Range implements the Ranger interface.
+func (f *FileResource) Range(rg *Range) (*ContentRange, Resource, error) {
+	return &ContentRange{rg, f.Count()}, &fileSection{f, int64(rg.From), int64(rg.To-rg.From) + 1}, nil
+}
+
+// StreamRST implements the StreamedResource interface.
+func (f *FileResource) StreamRST(r *http.Request) (string, io.ReadCloser, int64, error) {
+	return f.open(0, f.info.Size())
+}
+
+// open returns the content type of f, and a reader of length bytes from
+// offset.
+func (f *FileResource) open(offset, length int64) (string, io.ReadCloser, int64, error) {
+	file, err := f.fsys.Open(f.name)
+	if err != nil {
+		return "", nil, 0, err
+	}
+
+	contentType := mime.TypeByExtension(path.Ext(f.name))
+	if contentType == "" {
+		b := make([]byte, sniffLength)
+		n, err := io.ReadFull(file, b)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			file.Close()
+			return "", nil, 0, err
+		}
+		contentType = http.DetectContentType(b[:n])
+		if err := rewind(&file, f.fsys, f.name); err != nil {
+			return "", nil, 0, err
+		}
+	}
+
+	if offset > 0 {
+		if seeker, ok := file.(io.Seeker); ok {
+			_, err = seeker.Seek(offset, io.SeekStart)
+		} else {
+			_, err = io.CopyN(io.Discard, file, offset)
+		}
+		if err != nil {
+			file.Close()
+			return "", nil, 0, err
+		}
+	}
+	return contentType, &fileReader{io.LimitReader(file, length), file}, length, nil
+}
+
+// rewind moves file back to its first byte, or opens it again if it doesn't
+// implement io.Seeker.
+func rewind(file *fs.File, fsys fs.FS, name string) error {
+	if seeker, ok := (*file).(io.Seeker); ok {
+		if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+			(*file).Close()
+			return err
+		}
+		return nil
+	}
+	(*file).Close()
+	reopened, err := fsys.Open(name)
+	if err != nil {
+		return err
+	}
+	*file = reopened
+	return nil
+}
+
+// fileReader reads a part of a file, which is closed with the reader.
+type fileReader struct {
+	io.Reader
+	io.Closer
+}
+
+// fileSection is the part of a file requested by a range request.
+type fileSection struct {
+	*FileResource
+	offset, length int64
+}
+
+// StreamRST implements the StreamedResource interface.
+func (s *fileSection) StreamRST(r *http.Request) (string, io.ReadCloser, int64, error) {
+	return s.open(s.offset, s.length)
+}
+
+/*
+DirEndpoint serves the files and the directories of an fs.FS, with the path
+held by a route variable.
+
+	mux.HandleEndpoint("/static/{path:.*}", &rst.DirEndpoint{FS: os.DirFS("public")})
+
+Files are served as FileResource. Directories are served as a DirIndex listing
+their entries, which is encoded like any other resource, unless they contain
+the Index file. Missing files respond with status code 404 Not Found in the
+error format of the mux.
+
+DirEndpoint implements PaginationPolicy to exempt its files from the
+pagination of the mux.
+*/
+type DirEndpoint struct {
+	FS    fs.FS
+	Param string        // Optional. Route variable holding the path of the files, "path" by default.
+	Index string        // Optional. File served in place of the index of the directories containing it, such as index.html.
+	TTL   time.Duration // Optional. TTL of the files and directories.
+}
+
+// Get implements the Getter interface.
+func (e *DirEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	param := e.Param
+	if param == "" {
+		param = "path"
+	}
+	name := strings.Trim(path.Clean("/"+vars.Get(param)), "/")
+	if name == "" {
+		name = "."
+	}
+
+	info, err := statFile(e.FS, name)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return NewFileResource(e.FS, name, e.TTL)
+	}
+	if e.Index != "" {
+		if index, err := NewFileResource(e.FS, path.Join(name, e.Index), e.TTL); err == nil {
+			return index, nil
+		}
+	}
+	return newDirIndex(e.FS, name, info, e.TTL)
+}
+
+// Pagination implements the PaginationPolicy interface.
+func (e *DirEndpoint) Pagination() *Pagination {
+	return nil
+}
+
+// DirIndex is the list of the entries of a directory served by a DirEndpoint.
+type DirIndex struct {
+	XMLName xml.Name    `json:"-" xml:"directory"`
+	Path    string      `json:"path" xml:"path,attr"`
+	Entries []*DirEntry `json:"entries" xml:"entry"`
+
+	etag         string
+	lastModified time.Time
+	ttl          time.Duration
+}
+
+// DirEntry is an entry of a DirIndex.
+type DirEntry struct {
+	Name    string    `json:"name" xml:"name,attr"`
+	Dir     bool      `json:"dir,omitempty" xml:"dir,attr,omitempty"`
+	Size    int64     `json:"size" xml:"size,attr"`
+	ModTime time.Time `json:"modTime" xml:"modTime,attr"`
+}
+
+// newDirIndex returns the index of the directory name of fsys, whose info is
+// info.
+func newDirIndex(fsys fs.FS, name string, info fs.FileInfo, ttl time.Duration) (*DirIndex, error) {
+	entries, err := fs.ReadDir(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+
+	index := &DirIndex{Path: "/" + strings.TrimPrefix(name, "."), lastModified: info.ModTime(), ttl: ttl}
+	hash := sha256.New()
+	for _, entry := range entries {
+		entryInfo, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		e := &DirEntry{Name: entry.Name(), Dir: entry.IsDir(), ModTime: entryInfo.ModTime().UTC()}
+		if !e.Dir {
+			e.Size = entryInfo.Size()
+		}
+		index.Entries = append(index.Entries, e)
+		if e.ModTime.After(index.lastModified) {
+			index.lastModified = e.ModTime
+		}
+		fmt.Fprintf(hash, "%s\x00%t\x00%d\x00%d\n", e.Name, e.Dir, e.Size, e.ModTime.UnixNano())
+	}
+	index.etag = base64.RawURLEncoding.EncodeToString(hash.Sum(nil)[:16])
+	return index, nil
+}
+
+// ETag implements the Resource interface.
+func (d *DirIndex) ETag() string {
+	return d.etag
+}
+
+// LastModified implements the Resource interface.
+func (d *DirIndex) LastModified() time.Time {
+	return d.lastModified
+}
+
+// TTL implements the Resource interface.
+func (d *DirIndex) TTL() time.Duration {
+	return d.ttl
+}
@@ -0,0 +1,78 @@
+package rst
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+)
+
+var testFS = fstest.MapFS{
+	"hello.txt":          {Data: []byte("Hello, World!"), ModTime: testTimeReference},
+	"data":               {Data: []byte("<html><body>sniffed</body></html>"), ModTime: testTimeReference},
+	"docs/index.html":    {Data: []byte("<h1>Docs</h1>"), ModTime: testTimeReference},
+	"assets/app.js":      {Data: []byte("alert(1);"), ModTime: testTimeReference},
+	"assets/css/app.css": {Data: []byte("body{}"), ModTime: testTimeReference},
+}
+
+func TestDirEndpoint(t *testing.T) {
+	mux := NewMux()
+	mux.SetPagination(&Pagination{})
+	mux.HandleEndpoint("/static/{path:.*}", &DirEndpoint{FS: testFS, Index: "index.html"})
+
+	var test = func(path string, header http.Header, code int, contentType, body string) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(Get, "http://example.com"+path, nil)
+		for name, values := range header {
+			r.Header[name] = values
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != code {
+			t.Fatalf("%s %v: status code is %d, expected %d", path, header, w.Code, code)
+		}
+		if contentType != "" && w.Header().Get("Content-Type") != contentType {
+			t.Errorf("%s: Content-Type is %q, expected %q", path, w.Header().Get("Content-Type"), contentType)
+		}
+		if body != "" && w.Body.String() != body {
+			t.Errorf("%s %v: body is %q, expected %q", path, header, w.Body.String(), body)
+		}
+		return w
+	}
+
+	w := test("/static/hello.txt", nil, http.StatusOK, "text/plain; charset=utf-8", "Hello, World!")
+	etag := w.Header().Get("ETag")
+	if etag == "" || w.Header().Get("Accept-Ranges") != "bytes" || w.Header().Get("Content-Length") != "13" {
+		t.Errorf("Headers are %v", w.Header())
+	}
+	if lastModified := w.Header().Get("Last-Modified"); lastModified != testTimeReference.UTC().Format(rfc1123) {
+		t.Errorf("Last-Modified is %q", lastModified)
+	}
+
+	w = test("/static/hello.txt", http.Header{"Range": {"bytes=7-11"}}, http.StatusPartialContent, "", "World")
+	if cr := w.Header().Get("Content-Range"); cr != "bytes 7-11/13" {
+		t.Errorf("Content-Range is %q", cr)
+	}
+	test("/static/hello.txt", http.Header{"Range": {"bytes=-6"}}, http.StatusPartialContent, "", "World!")
+	test("/static/hello.txt", http.Header{"Range": {"bytes=7-11"}, "If-Range": {etag}}, http.StatusPartialContent, "", "World")
+	test("/static/hello.txt", http.Header{"Range": {"bytes=7-11"}, "If-Range": {"stale"}}, http.StatusOK, "", "Hello, World!")
+	test("/static/hello.txt", http.Header{"Range": {"bytes=20-"}}, http.StatusRequestedRangeNotSatisfiable, "", "")
+	test("/static/hello.txt", http.Header{"If-None-Match": {etag}}, http.StatusNotModified, "", "")
+
+	test("/static/data", nil, http.StatusOK, "text/html; charset=utf-8", "<html><body>sniffed</body></html>")
+	test("/static/docs", nil, http.StatusOK, "text/html; charset=utf-8", "<h1>Docs</h1>")
+	test("/static/missing.txt", nil, http.StatusNotFound, "", "")
+	test("/static/../hello.txt", nil, http.StatusOK, "", "Hello, World!")
+
+	w = test("/static/assets", http.Header{"Accept": {"application/json"}}, http.StatusOK, "application/json; charset=utf-8", "")
+	var index DirIndex
+	if err := json.Unmarshal(w.Body.Bytes(), &index); err != nil {
+		t.Fatal(err)
+	}
+	if index.Path != "/assets" || len(index.Entries) != 2 || index.Entries[0].Name != "app.js" || index.Entries[0].Size != 9 || !index.Entries[1].Dir {
+		t.Errorf("Index is %s", w.Body.String())
+	}
+	if w.Header().Get("ETag") == "" {
+		t.Error("Index has no ETag")
+	}
+}
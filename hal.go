@@ -0,0 +1,284 @@
+package rst
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// HALType is the media type of HAL documents.
+const HALType = "application/hal+json"
+
+// Link is a hypermedia link from a resource to another.
+type Link struct {
+	Rel       string // Relation of the link, such as self, next or an URL.
+	Href      string // URL of the linked resource.
+	Title     string // Optional. Human-readable title of the link.
+	Type      string // Optional. Media type of the linked resource.
+	Templated bool   // Set to true if Href is an URI template, as defined by RFC 6570.
+}
+
+/*
+Linker is implemented by resources declaring links to themselves and to
+related resources.
+
+	func (p *Person) Links(r *http.Request) []rst.Link {
+		return []rst.Link{
+			{Rel: "self", Href: "/people/" + p.ID},
+			{Rel: "employer", Href: "/companies/" + p.EmployerID},
+		}
+	}
+
+When application/hal+json is negotiated, the links are rendered in the _links
+section of the HAL document of the resource:
+
+	{"_links":{"self":{"href":"/people/1"},"employer":{"href":"/companies/2"}},"id":"1","name":"Francis Underwood"}
+
+Links sharing a relation are rendered as an array. Collections are rendered as
+a document embedding their items, each with its own links, in the items
+relation of its _embedded section.
+
+Other JSON representations carry the links in a Link header instead, as
+defined by RFC 5988, except for the templated ones.
+
+	Link: </people/1>; rel="self", </companies/2>; rel="employer"
+*/
+type Linker interface {
+	Links(r *http.Request) []Link
+}
+
+// Embedder is implemented by resources embedding related resources in the
+// _embedded section of their HAL document, indexed by relation. Values can be
+// resources or collections, which are rendered as HAL documents.
+type Embedder interface {
+	Embedded(r *http.Request) map[string]interface{}
+}
+
+// linksOf returns the links of resource, or of its projection if resource is
+// an envelope.
+func linksOf(resource interface{}, r *http.Request) []Link {
+	if linker, implemented := resource.(Linker); implemented {
+		return linker.Links(r)
+	}
+	if envelope, valid := resource.(*Envelope); valid {
+		return linksOf(envelope.projection, r)
+	}
+	return nil
+}
+
+// setLinkHeader adds the links of resource to the Link header of h.
+func setLinkHeader(resource interface{}, h http.Header, r *http.Request) {
+	var values []string
+	if value := h.Get("Link"); value != "" {
+		values = append(values, value)
+	}
+	for _, link := range linksOf(resource, r) {
+		if link.Templated {
+			continue
+		}
+		value := fmt.Sprintf("<%s>; rel=%q", link.Href, link.Rel)
+		if link.Title != "" {
+			value += fmt.Sprintf("; title=%q", link.Title)
+		}
+		if link.Type != "" {
+			value += fmt.Sprintf("; type=%q", link.Type)
+		}
+		values = append(values, value)
+	}
+	if len(values) > 0 {
+		h.Set("Link", strings.Join(values, ", "))
+	}
+}
+
+// parseLinkHeader returns the links of value, the value of a Link header as
+// defined by RFC 5988. Malformed links are ignored.
+func parseLinkHeader(value string) []Link {
+	var links []Link
+	for value != "" {
+		start := strings.IndexByte(value, '<')
+		end := strings.IndexByte(value, '>')
+		if start < 0 || end < start {
+			break
+		}
+		link := Link{Href: value[start+1 : end]}
+		value = value[end+1:]
+
+		// Parameters run until the comma following the link, unless it's
+		// quoted.
+		quoted, i := false, 0
+		for ; i < len(value) && (quoted || value[i] != ','); i++ {
+			if value[i] == '"' {
+				quoted = !quoted
+			}
+		}
+		params := value[:i]
+		if i < len(value) {
+			value = value[i+1:]
+		} else {
+			value = ""
+		}
+		for _, param := range strings.Split(params, ";") {
+			key, v, found := cut(param, '=')
+			if !found {
+				continue
+			}
+			v = strings.Trim(strings.TrimSpace(v), `"`)
+			switch strings.ToLower(strings.TrimSpace(key)) {
+			case "rel":
+				link.Rel = v
+			case "title":
+				link.Title = v
+			case "type":
+				link.Type = v
+			}
+		}
+		if link.Rel != "" {
+			links = append(links, link)
+		}
+	}
+	return links
+}
+
+// halLink is the JSON encoding of a Link in a HAL document.
+type halLink struct {
+	Href      string `json:"href"`
+	Title     string `json:"title,omitempty"`
+	Type      string `json:"type,omitempty"`
+	Templated bool   `json:"templated,omitempty"`
+}
+
+// marshalHAL encodes resource as a HAL document, which embeds its items if
+// it's a collection.
+func marshalHAL(resource interface{}, r *http.Request) ([]byte, error) {
+	if v := reflect.Indirect(reflect.ValueOf(resource)); v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+		items, err := marshalHALItems(v, r)
+		if err != nil {
+			return nil, err
+		}
+		return halDocument([]byte("{}"), linksOf(resource, r), []halEmbedded{{"items", items}})
+	}
+
+	b, err := marshalJSON(resource, r)
+	if err != nil {
+		return nil, err
+	}
+	var embedded []halEmbedded
+	if embedder, implemented := resource.(Embedder); implemented {
+		resources := embedder.Embedded(r)
+		rels := make([]string, 0, len(resources))
+		for rel := range resources {
+			rels = append(rels, rel)
+		}
+		sort.Strings(rels)
+		for _, rel := range rels {
+			doc, err := marshalHAL(resources[rel], r)
+			if err != nil {
+				return nil, err
+			}
+			embedded = append(embedded, halEmbedded{rel, doc})
+		}
+	}
+	return halDocument(b, linksOf(resource, r), embedded)
+}
+
+// marshalHALItems returns a JSON array of the HAL documents of the items of
+// the collection v.
+func marshalHALItems(v reflect.Value, r *http.Request) ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.WriteByte('[')
+	for i := 0; i < v.Len(); i++ {
+		if i > 0 {
+			buffer.WriteByte(',')
+		}
+		doc, err := marshalHAL(v.Index(i).Interface(), r)
+		if err != nil {
+			return nil, err
+		}
+		buffer.Write(doc)
+	}
+	buffer.WriteByte(']')
+	return buffer.Bytes(), nil
+}
+
+// halEmbedded is a relation of the _embedded section of a HAL document.
+type halEmbedded struct {
+	rel string
+	doc []byte
+}
+
+// halDocument adds the _links and _embedded sections to b, the JSON object
+// of a resource.
+func halDocument(b []byte, links []Link, embedded []halEmbedded) ([]byte, error) {
+	b = bytes.TrimSpace(b)
+	if len(b) < 2 || b[0] != '{' {
+		return nil, NotAcceptable()
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteByte('{')
+	if len(links) > 0 {
+		buffer.WriteString(`"_links":`)
+		buffer.Write(halLinks(links))
+	}
+	if len(embedded) > 0 {
+		if buffer.Len() > 1 {
+			buffer.WriteByte(',')
+		}
+		buffer.WriteString(`"_embedded":{`)
+		for i, e := range embedded {
+			if i > 0 {
+				buffer.WriteByte(',')
+			}
+			name, _ := json.Marshal(e.rel)
+			buffer.Write(name)
+			buffer.WriteByte(':')
+			buffer.Write(e.doc)
+		}
+		buffer.WriteByte('}')
+	}
+	if fields := bytes.TrimSpace(b[1 : len(b)-1]); len(fields) > 0 {
+		if buffer.Len() > 1 {
+			buffer.WriteByte(',')
+		}
+		buffer.Write(fields)
+	}
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
+
+// halLinks returns the _links section of links, keeping the order of their
+// relations.
+func halLinks(links []Link) []byte {
+	var rels []string
+	byRel := make(map[string][]halLink)
+	for _, link := range links {
+		if _, found := byRel[link.Rel]; !found {
+			rels = append(rels, link.Rel)
+		}
+		byRel[link.Rel] = append(byRel[link.Rel], halLink{link.Href, link.Title, link.Type, link.Templated})
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteByte('{')
+	for i, rel := range rels {
+		if i > 0 {
+			buffer.WriteByte(',')
+		}
+		name, _ := json.Marshal(rel)
+		buffer.Write(name)
+		buffer.WriteByte(':')
+		var value []byte
+		if group := byRel[rel]; len(group) == 1 {
+			value, _ = json.Marshal(group[0])
+		} else {
+			value, _ = json.Marshal(group)
+		}
+		buffer.Write(value)
+	}
+	buffer.WriteByte('}')
+	return buffer.Bytes()
+}
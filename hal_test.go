@@ -0,0 +1,96 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type linkedCompany struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (c *linkedCompany) Links(r *http.Request) []Link {
+	return []Link{{Rel: "self", Href: "/companies/" + c.ID}}
+}
+
+type linkedPerson struct {
+	ID       string         `json:"id"`
+	Name     string         `json:"name"`
+	Employer *linkedCompany `json:"-"`
+}
+
+func (p *linkedPerson) Links(r *http.Request) []Link {
+	return []Link{
+		{Rel: "self", Href: "/people/" + p.ID},
+		{Rel: "employer", Href: "/companies/" + p.Employer.ID, Title: p.Employer.Name},
+		{Rel: "friends", Href: "/people/" + p.ID + "/friends{?page}", Templated: true},
+	}
+}
+
+func (p *linkedPerson) Embedded(r *http.Request) map[string]interface{} {
+	return map[string]interface{}{"employer": p.Employer}
+}
+
+var testLinkedPerson = &linkedPerson{"1", "Francis Underwood", &linkedCompany{"2", "The White House"}}
+
+func TestHAL(t *testing.T) {
+	mux := NewMux()
+	mux.Get("/people/1", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(testLinkedPerson, time.Now(), "v1", 0), nil
+	})
+	mux.Get("/companies", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope([]*linkedCompany{testLinkedPerson.Employer}, time.Now(), "v1", 0), nil
+	})
+
+	var test = func(path, accept, contentType, link, body string) {
+		r, _ := http.NewRequest(Get, "http://example.com"+path, nil)
+		r.Header.Set("Accept", accept)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s, %s: status code is %d, expected 200 OK", path, accept, w.Code)
+		}
+		if got := w.Header().Get("Content-Type"); got != contentType {
+			t.Errorf("%s, %s: Content-Type is %q, expected %q", path, accept, got, contentType)
+		}
+		if got := w.Header().Get("Link"); got != link {
+			t.Errorf("%s, %s: Link is %q, expected %q", path, accept, got, link)
+		}
+		if got := w.Body.String(); got != body {
+			t.Errorf("%s, %s: body is\n%s\nexpected\n%s", path, accept, got, body)
+		}
+	}
+
+	test("/people/1", HALType, "application/hal+json; charset=utf-8", "",
+		`{"_links":{"self":{"href":"/people/1"},"employer":{"href":"/companies/2","title":"The White House"},"friends":{"href":"/people/1/friends{?page}","templated":true}},`+
+			`"_embedded":{"employer":{"_links":{"self":{"href":"/companies/2"}},"id":"2","name":"The White House"}},`+
+			`"id":"1","name":"Francis Underwood"}`)
+	test("/people/1", "application/json", "application/json; charset=utf-8",
+		`</people/1>; rel="self", </companies/2>; rel="employer"; title="The White House"`,
+		`{"id":"1","name":"Francis Underwood"}`)
+	test("/companies", HALType, "application/hal+json; charset=utf-8", "",
+		`{"_embedded":{"items":[{"_links":{"self":{"href":"/companies/2"}},"id":"2","name":"The White House"}]}}`)
+	test("/companies", "application/json", "application/json; charset=utf-8", "",
+		`[{"id":"2","name":"The White House"}]`)
+}
+
+func TestHALLinks(t *testing.T) {
+	links := []Link{
+		{Rel: "item", Href: "/people/1"},
+		{Rel: "self", Href: "/people"},
+		{Rel: "item", Href: "/people/2"},
+	}
+	expected := `{"item":[{"href":"/people/1"},{"href":"/people/2"}],"self":{"href":"/people"}}`
+	if got := string(halLinks(links)); got != expected {
+		t.Errorf("Got %s, expected %s", got, expected)
+	}
+
+	h := http.Header{"Link": {`</people?page=2>; rel="next"`}}
+	setLinkHeader(NewEnvelope(testLinkedPerson.Employer, time.Now(), "", 0), h, nil)
+	if got, expected := h.Get("Link"), `</people?page=2>; rel="next", </companies/2>; rel="self"`; got != expected {
+		t.Errorf("Link is %q, expected %q", got, expected)
+	}
+}
@@ -1,7 +1,9 @@
 package rst
 
 import (
+	"math/rand"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -23,6 +25,12 @@ return partial responses.
 - The Marshaler interface allows you to customize the encoding process of the
 resource and control the bytes returned in the payload of the response.
 
+- The LocalizedResource interface allows the resource to return a representation
+in the language negotiated with the Accept-Language header of the request.
+
+- The StreamedResource interface allows the resource to stream large
+representations from an io.ReadCloser instead of encoding them in memory.
+
 - The http.Handler interface can be used to gain direct access to the
 ResponseWriter and Request. This is a low level method that should only be used
 when you need to write chunked responses, or if you wish to add specific headers
@@ -48,15 +56,7 @@ r are not matching with the current version of resource.
 	}
 */
 func ValidateConditions(resource Resource, r *http.Request) bool {
-	if d, err := time.Parse(rfc1123, r.Header.Get("If-Unmodified-Since")); err == nil {
-		if d.Sub(resource.LastModified()) < 0 {
-			return true
-		}
-	}
-	if etag := r.Header.Get("If-Match"); etag != "" && etag != resource.ETag() {
-		return true
-	}
-	return false
+	return preconditionsFail(resource.ETag(), resource.LastModified(), true, r)
 }
 
 /*
@@ -85,7 +85,9 @@ Otherwise, it will be processed as a normal Get request.
 	}
 */
 type Ranger interface {
-	// Supported range units
+	// Supported range units, advertised in the Accept-Ranges header of
+	// responses. Range headers with other units are ignored, as required by
+	// RFC 7233, and rangers without units advertise none.
 	Units() []string
 
 	// Total number of units available
@@ -96,34 +98,78 @@ type Ranger interface {
 	Range(*Range) (*ContentRange, Resource, error)
 }
 
+// jitter returns ttl reduced by a random duration of up to fraction of ttl.
+func jitter(ttl time.Duration, fraction float64) time.Duration {
+	if fraction <= 0 || ttl <= 0 {
+		return ttl
+	}
+	if fraction > 1 {
+		fraction = 1
+	}
+	return ttl - time.Duration(rand.Float64()*fraction*float64(ttl))
+}
+
 func writeError(err error, w http.ResponseWriter, r *http.Request) {
-	ErrorHandler(err).ServeHTTP(w, r)
+	ErrorHandler(getMux(r).handleError(err, r)).ServeHTTP(w, r)
 }
 
-func writeResource(resource Resource, w http.ResponseWriter, r *http.Request) {
+// notModified returns true if the conditional headers of r match the
+// representation with the given etag and date of last modification.
+func notModified(etag string, lastModified time.Time, r *http.Request) bool {
 	// Time-based conditional retrieval
 	if t, err := time.Parse(rfc1123, r.Header.Get("If-Modified-Since")); err == nil {
-		if t.Sub(resource.LastModified()).Seconds() >= 0 {
-			w.WriteHeader(http.StatusNotModified)
-			w.Write(noContent)
-			return
+		if t.Sub(lastModified).Seconds() >= 0 {
+			return true
 		}
 	}
 
 	// ETag-based conditional retrieval
 	for _, t := range strings.Split(r.Header.Get("If-None-Match"), ";") {
-		if t == resource.ETag() {
-			w.WriteHeader(http.StatusNotModified)
-			w.Write(noContent)
-			return
+		if t == etag {
+			return true
 		}
 	}
+	return false
+}
+
+func writeResource(resource Resource, w http.ResponseWriter, r *http.Request) {
+	// Redirections have no representation to validate or cache.
+	if rd, ok := resource.(*Redirection); ok {
+		rd.ServeHTTP(w, r)
+		return
+	}
 
-	// Headers
+	// Headers, which are also sent with 304 Not Modified responses so that
+	// caches can update the response they store.
 	addVary(w.Header(), "Accept")
-	w.Header().Set("Last-Modified", resource.LastModified().UTC().Format(rfc1123))
-	w.Header().Set("ETag", resource.ETag())
-	w.Header().Set("Expires", time.Now().Add(resource.TTL()).UTC().Format(rfc1123))
+	setLanguageHeaders(resource, w.Header(), r)
+	// JSONP responses are scripts whose body depends on the callback, so they
+	// don't share the validators of the JSON representation.
+	wrapped := jsonpRequested(r)
+	etag := representationETag(resource.ETag(), r)
+	if !wrapped {
+		w.Header().Set("Last-Modified", resource.LastModified().UTC().Format(rfc1123))
+		w.Header().Set("ETag", etag)
+	}
+	ttl := jitter(resource.TTL(), getMux(r).TTLJitter)
+	w.Header().Set("Expires", time.Now().Add(ttl).UTC().Format(rfc1123))
+	if directives := cacheDirectivesOf(resource); directives != nil {
+		w.Header().Set("Cache-Control", directives.header(ttl))
+	}
+	setSurrogateHeaders(resource, w.Header(), r)
+	setDisposition(resource, w.Header())
+
+	// Conditional requests only validate the representation of a resource
+	// written with its default status code.
+	code := statusOf(resource, r)
+	method := strings.ToUpper(r.Method)
+	safe := method == Get || method == Head
+	if !wrapped && safe && (code == 0 || code == http.StatusOK) && notModified(etag, resource.LastModified(), r) {
+		varyRepresentation(resource, etag, w, r)
+		w.WriteHeader(http.StatusNotModified)
+		w.Write(noContent)
+		return
+	}
 
 	// If resource implements http.Handler, let it write in the ResponseWriter
 	// on its own.
@@ -132,46 +178,86 @@ func writeResource(resource Resource, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var (
-		contentType string
-		b           []byte
-		err         error
-	)
-	contentType, b, err = Marshal(resource, r)
+	if streamed, implemented := resource.(StreamedResource); implemented {
+		writeStream(streamed, w, r)
+		return
+	}
+
+	head := method == Head
+	if head && writeSizedHead(resource, code, w, r) {
+		return
+	}
+
+	contentType, b, err := encodeRepresentation(resource, etag, w, r)
 	if err != nil {
 		writeError(err, w, r)
 		return
 	}
 	w.Header().Set("Content-Type", contentType)
+	pushRelated(resource, w, r)
 
-	if compression := getCompressionFormat(b, r); compression != "" {
-		w.Header().Set("Content-Encoding", compression)
+	// Representations large enough to be compressed vary with Accept-Encoding,
+	// even when the client doesn't accept a compression.
+	out, body := w, b
+	if compressible(resource, w.Header(), len(b), r) {
 		addVary(w.Header(), "Accept-Encoding")
+		if compression := getCompressionFormat(b, r); compression != "" {
+			w.Header().Set("Content-Encoding", compression)
+			if cw, compressed := compressedRepresentation(b, etag, compression, w, r); compressed != nil {
+				out, body = cw, compressed
+			}
+		}
 	}
 
-	if strings.ToUpper(r.Method) == Post {
-		w.WriteHeader(http.StatusCreated)
-		w.Write(b)
-		return
-	}
-
-	if len(b) == 0 {
-		w.WriteHeader(http.StatusNoContent)
+	code = responseStatus(code, len(b) == 0, w, r)
+	if bodiless(code) {
+		w.WriteHeader(code)
 		w.Write(noContent) // Allowing an override of ResponseWriter to work.
 		return
 	}
 
-	if w.Header().Get("Content-Range") != "" {
-		w.WriteHeader(http.StatusPartialContent)
-	} else {
-		w.WriteHeader(http.StatusOK)
+	// The length of representations compressed while they're written isn't
+	// known in advance.
+	if head && (out != w || w.Header().Get("Content-Encoding") == "") {
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
 	}
+	w.WriteHeader(code)
 
-	if strings.ToUpper(r.Method) == Head {
+	if head {
 		w.Write(noContent)
 		return
 	}
-	w.Write(b)
+	out.Write(body)
+}
+
+// varyRepresentation adds to the Vary header of w the members only known once
+// the representation of resource is encoded for r, such as Accept-Charset and
+// Accept-Encoding, so that a 304 Not Modified response varies like the
+// representation it validates. Streams and resources writing themselves, other
+// than envelopes, aren't encoded.
+func varyRepresentation(resource Resource, etag string, w http.ResponseWriter, r *http.Request) {
+	scratch := &batchWriter{header: w.Header().Clone()}
+	switch resource.(type) {
+	case *Envelope:
+		resource.(*Envelope).ServeHTTP(scratch, r)
+	case http.Handler, StreamedResource:
+		return
+	default:
+		contentType, b, err := encodeRepresentation(resource, etag, scratch, r)
+		if err != nil {
+			return
+		}
+		scratch.header.Set("Content-Type", contentType)
+		if compressible(resource, scratch.header, len(b), r) {
+			addVary(scratch.header, "Accept-Encoding")
+		}
+	}
+
+	for _, value := range scratch.header["Vary"] {
+		for _, member := range strings.Split(value, ",") {
+			addVary(w.Header(), strings.TrimSpace(member))
+		}
+	}
 }
 
 /*
@@ -209,8 +295,7 @@ func (f GetFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	if resource == nil {
-		w.WriteHeader(http.StatusNoContent)
-		w.Write(noContent)
+		writeEmpty(http.StatusNoContent, w, r)
 		return
 	}
 
@@ -220,25 +305,38 @@ func (f GetFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		writeResource(resource, w, r)
 		return
 	}
-	w.Header().Set("Accept-Ranges", strings.Join(ranger.Units(), ", "))
+	// Rangers without units don't accept range requests.
+	units := ranger.Units()
+	if len(units) == 0 {
+		w.Header().Set("Accept-Ranges", "none")
+	} else {
+		w.Header().Set("Accept-Ranges", strings.Join(units, ", "))
+	}
+
+	// Without a range, paginated resources are written page by page.
+	pagination := paginationFor(r)
+	var full = func() {
+		if pagination != nil {
+			writePage(pagination, ranger, resource, w, r)
+		} else {
+			writeResource(resource, w, r)
+		}
+	}
 
 	// Check if request contains a valid Range header, and check whether it's
 	// a valid range.
 	rg, err := ParseRange(r.Header.Get("Range"))
 	if err != nil || rg.validate(ranger) != nil {
-		writeResource(resource, w, r)
+		full()
 		return
 	}
 
 	// If-Range can either contain an ETag, or a date.
 	// If the precondition fails, the Range header is ignored and the full
 	// resource is returned.
-	if raw := r.Header.Get("If-Range"); raw != "" {
-		date, _ := time.Parse(rfc1123, raw)
-		if !date.Equal(resource.LastModified()) && raw != resource.ETag() {
-			writeResource(resource, w, r)
-			return
-		}
+	if !ifRange(representationETag(resource.ETag(), r), resource.LastModified(), r) {
+		full()
+		return
 	}
 
 	if err := rg.adjust(ranger); err != nil {
@@ -254,6 +352,36 @@ func (f GetFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	addVary(w.Header(), "Range")
 	w.Header().Set("Content-Range", cr.String())
+	if pagination != nil {
+		size := rg.Len() + 1
+		pagination.setHeaders(w.Header(), int(rg.From/size)+1, int(size), ranger.Count(), r)
+	}
+	writeResource(partial, w, r)
+}
+
+// writePage writes the page of ranger requested by r in w.
+func writePage(p *Pagination, ranger Ranger, resource Resource, w http.ResponseWriter, r *http.Request) {
+	rg, page, size, err := p.rangeOf(ranger, r)
+	if err != nil {
+		writeError(err, w, r)
+		return
+	}
+	if rg == nil {
+		p.setHeaders(w.Header(), page, size, ranger.Count(), r)
+		writeResource(resource, w, r)
+		return
+	}
+	if err := rg.adjust(ranger); err != nil {
+		writeError(err, w, r)
+		return
+	}
+
+	_, partial, err := ranger.Range(rg)
+	if err != nil {
+		writeError(err, w, r)
+		return
+	}
+	p.setHeaders(w.Header(), page, size, ranger.Count(), r)
 	writeResource(partial, w, r)
 }
 
@@ -294,9 +422,11 @@ func (f PatchFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		writeError(err, w, r)
 		return
 	}
-	w.WriteHeader(http.StatusOK)
+	if applyPrefer(resource, w, r) {
+		return
+	}
 	if resource == nil {
-		w.Write(noContent)
+		writeEmpty(http.StatusOK, w, r)
 		return
 	}
 	writeResource(resource, w, r)
@@ -335,9 +465,11 @@ func (f PutFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		writeError(err, w, r)
 		return
 	}
-	w.WriteHeader(http.StatusOK)
+	if applyPrefer(resource, w, r) {
+		return
+	}
 	if resource == nil {
-		w.Write(noContent)
+		writeEmpty(http.StatusOK, w, r)
 		return
 	}
 	writeResource(resource, w, r)
@@ -376,16 +508,23 @@ func (f PostFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		// TODO: make sure the URI is a fully qualified URL
 		w.Header().Set("Location", location)
 	}
+	if applyPrefer(resource, w, r) {
+		return
+	}
 
 	if resource == nil {
-		w.WriteHeader(http.StatusCreated)
-		w.Write(noContent)
+		writeEmpty(http.StatusCreated, w, r)
 		return
 	}
 	writeResource(resource, w, r)
 }
 
-// Deleter is implemented by endpoints allowing the DELETE method.
+/*
+Deleter is implemented by endpoints allowing the DELETE method.
+
+Returning a Tombstone for a resource which was already deleted will respond as
+if it had just been deleted.
+*/
 type Deleter interface {
 	Delete(RouteVars, *http.Request) error
 }
@@ -396,11 +535,70 @@ type DeleteFunc func(RouteVars, *http.Request) error
 // ServeHTTP implements the http.Handler interface.
 func (f DeleteFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if err := f(getVars(r), r); err != nil {
+		if _, deleted := err.(*Tombstone); !deleted {
+			writeError(err, w, r)
+			return
+		}
+	}
+	writeEmpty(http.StatusNoContent, w, r)
+}
+
+/*
+MethodHandler is implemented by endpoints allowing extension methods, such as
+REPORT, PURGE or MKCOL, which aren't covered by the other interfaces.
+
+	func (ep *endpoint) Methods() []string {
+		return []string{"PURGE"}
+	}
+
+	func (ep *endpoint) Handle(method string, vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+		cache.Purge(vars.Get("id"))
+		return nil, nil
+	}
+
+Handle is only called for the methods returned by Methods, which are listed in
+the Allow header of responses along with the methods of the other interfaces.
+*/
+type MethodHandler interface {
+	// Extension methods allowed by the endpoint.
+	Methods() []string
+
+	// Returns the resource or an error. A nil resource will generate a
+	// response with status code 204 No Content.
+	Handle(method string, vars RouteVars, r *http.Request) (Resource, error)
+}
+
+// MethodFunc allows a MethodHandler.Handle method to be used an http.Handler.
+type MethodFunc func(string, RouteVars, *http.Request) (Resource, error)
+
+// ServeHTTP implements the http.Handler interface.
+func (f MethodFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	resource, err := f(strings.ToUpper(r.Method), getVars(r), r)
+	if err != nil {
 		writeError(err, w, r)
 		return
 	}
-	w.WriteHeader(http.StatusNoContent)
-	w.Write(noContent)
+	if resource == nil {
+		writeEmpty(http.StatusNoContent, w, r)
+		return
+	}
+	writeResource(resource, w, r)
+}
+
+// extensionMethods returns the methods allowed by endpoint if it implements
+// MethodHandler.
+func extensionMethods(endpoint Endpoint) []string {
+	handler, implemented := endpoint.(MethodHandler)
+	if !implemented {
+		return nil
+	}
+	var methods []string
+	for _, method := range handler.Methods() {
+		if method = strings.ToUpper(method); method != Options {
+			methods = append(methods, method)
+		}
+	}
+	return methods
 }
 
 // OptionsHandler returns a handler that serves responses to OPTIONS requests
@@ -413,7 +611,8 @@ func optionsHandler(endpoint Endpoint) http.Handler {
 		}
 
 		w.Header().Set("Allow", strings.Join(AllowedMethods(endpoint), ", "))
-		w.Header().Set("Content-Type", strings.Join(alternatives, ";"))
+		setAcceptPatch(endpoint, w.Header())
+		w.Header().Set("Content-Type", strings.Join(encoderTypes(), ";"))
 		w.WriteHeader(http.StatusNoContent)
 	})
 }
@@ -428,16 +627,50 @@ type endpointHandler struct {
 	endpoint Endpoint
 }
 
+// endpointWrapper is implemented by the endpoints wrapping another one, such as
+// the endpoints of HandleBreaker, which share the policies of the endpoint they
+// wrap.
+type endpointWrapper interface {
+	wrapped() Endpoint
+}
+
+// policyEndpoint returns the endpoint declaring the policies of endpoint: the
+// innermost endpoint it wraps, or endpoint itself.
+func policyEndpoint(endpoint Endpoint) Endpoint {
+	for {
+		w, wraps := endpoint.(endpointWrapper)
+		if !wraps {
+			return endpoint
+		}
+		endpoint = w.wrapped()
+	}
+}
+
 func (h *endpointHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if upgrader, implemented := h.endpoint.(Upgrader); implemented && isUpgrade(r) {
+		if err := authorize(h.endpoint, r); err != nil {
+			writeError(err, w, r)
+			return
+		}
+		upgrader.Upgrade(getVars(r), w, r)
+		return
+	}
+
 	methodHandler := getMethodHandler(h.endpoint, r.Method, r.Header)
 	if methodHandler == nil {
-		if allowed := AllowedMethods(h.endpoint); len(allowed) > 0 {
-			methodHandler = MethodNotAllowed(r.Method, allowed)
+		if len(AllowedMethods(h.endpoint)) > 0 {
+			methodHandler = methodNotAllowed(h.endpoint, r)
 		} else {
 			methodHandler = NotFound()
 		}
+	} else if err := authorize(h.endpoint, r); err != nil {
+		methodHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			writeError(err, w, r)
+		})
+	} else {
+		methodHandler = withPreconditions(h.endpoint, methodHandler)
 	}
-	methodHandler.ServeHTTP(w, r)
+	serveWithTimeout(methodHandler, timeoutOf(h.endpoint, r), w, r)
 }
 
 // getMethodHandler returns the handler in endpoint for the given of HTTP
@@ -447,26 +680,31 @@ func getMethodHandler(endpoint Endpoint, method string, header http.Header) http
 	case Options:
 		return optionsHandler(endpoint)
 	case Head, Get:
-		if i, supported := endpoint.(Getter); supported {
+		if i := getterOf(endpoint); i != nil {
 			return GetFunc(i.Get)
 		}
 	case Patch:
-		if i, supported := endpoint.(Patcher); supported {
+		if i := patcherOf(endpoint); i != nil {
 			return PatchFunc(i.Patch)
 		}
 	case Put:
-		if i, supported := endpoint.(Putter); supported {
+		if i := putterOf(endpoint); i != nil {
 			return PutFunc(i.Put)
 		}
 	case Post:
-		if i, supported := endpoint.(Poster); supported {
+		if i := posterOf(endpoint); i != nil {
 			return PostFunc(i.Post)
 		}
 	case Delete:
-		if i, supported := endpoint.(Deleter); supported {
+		if i := deleterOf(endpoint); i != nil {
 			return DeleteFunc(i.Delete)
 		}
 	}
+	for _, m := range extensionMethods(endpoint) {
+		if m == strings.ToUpper(method) {
+			return MethodFunc(endpoint.(MethodHandler).Handle)
+		}
+	}
 	return nil
 }
 
@@ -478,6 +716,14 @@ type methodLister interface {
 	allowedMethods() []string
 }
 
+// methodNotAllowed returns the error of r, whose method isn't allowed by
+// endpoint.
+func methodNotAllowed(endpoint Endpoint, r *http.Request) *Error {
+	err := MethodNotAllowed(r.Method, AllowedMethods(endpoint))
+	setAcceptPatch(endpoint, err.Header)
+	return err
+}
+
 // AllowedMethods returns the list of HTTP methods allowed by this endpoint.
 func AllowedMethods(endpoint Endpoint) (methods []string) {
 	if lister, ok := endpoint.(methodLister); ok {
@@ -489,5 +735,14 @@ func AllowedMethods(endpoint Endpoint) (methods []string) {
 			methods = append(methods, method)
 		}
 	}
+extensions:
+	for _, method := range extensionMethods(endpoint) {
+		for _, m := range methods {
+			if m == method {
+				continue extensions
+			}
+		}
+		methods = append(methods, method)
+	}
 	return methods
 }
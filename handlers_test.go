@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"strings"
 	"testing"
@@ -352,3 +353,150 @@ func TestPost(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+type purgingEndpoint struct {
+	purged []string
+}
+
+func (e *purgingEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	return nil, nil
+}
+
+func (e *purgingEndpoint) Methods() []string {
+	return []string{"purge", "REPORT"}
+}
+
+func (e *purgingEndpoint) Handle(method string, vars RouteVars, r *http.Request) (Resource, error) {
+	if method == "REPORT" {
+		return testPeople[0], nil
+	}
+	e.purged = append(e.purged, vars.Get("id"))
+	return nil, nil
+}
+
+func TestMethodHandler(t *testing.T) {
+	endpoint := &purgingEndpoint{}
+	mux := NewMux()
+	mux.HandleEndpoint("/cache/{id}", endpoint)
+
+	expected := []string{Head, Get, "PURGE", "REPORT"}
+	if methods := AllowedMethods(endpoint); !reflect.DeepEqual(methods, expected) {
+		t.Errorf("Got: %v Wanted: %v", methods, expected)
+	}
+
+	var test = func(method string, status int) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(method, "http://example.com/cache/1", nil)
+		r.Header.Set("Accept", "application/json")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Errorf("%s: Got: %d Wanted: %d", method, w.Code, status)
+		}
+		return w
+	}
+
+	test("PURGE", http.StatusNoContent)
+	if !reflect.DeepEqual(endpoint.purged, []string{"1"}) {
+		t.Errorf("Got: %v Wanted: [1]", endpoint.purged)
+	}
+	if w := test("REPORT", http.StatusOK); w.Header().Get("ETag") != testPeople[0].ETag() {
+		t.Errorf("REPORT: Got: %s Wanted: %s", w.Header().Get("ETag"), testPeople[0].ETag())
+	}
+	w := test("MKCOL", http.StatusMethodNotAllowed)
+	if allow := w.Header().Get("Allow"); allow != strings.Join(expected, ", ") {
+		t.Errorf("Allow: Got: %s Wanted: %s", allow, strings.Join(expected, ", "))
+	}
+}
+
+func TestJitter(t *testing.T) {
+	ttl := 10 * time.Minute
+	if got := jitter(ttl, 0); got != ttl {
+		t.Errorf("Got: %s Wanted: %s", got, ttl)
+	}
+	for i := 0; i < 100; i++ {
+		if got := jitter(ttl, 0.1); got > ttl || got < 9*time.Minute {
+			t.Fatalf("Got: %s Wanted a duration between 9m and 10m", got)
+		}
+	}
+	if got := jitter(0, 0.5); got != 0 {
+		t.Errorf("Got: %s Wanted: 0", got)
+	}
+}
+
+func TestVary(t *testing.T) {
+	mux := NewMux()
+	mux.Get("/small", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return &echoResource{[]byte("small")}, nil
+	})
+	mux.Get("/large", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return &echoResource{bytes.Repeat([]byte("a"), CompressionThreshold)}, nil
+	})
+	mux.Get("/envelope", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(&echoResource{bytes.Repeat([]byte("a"), CompressionThreshold)}, time.Now(), "etag", 0), nil
+	})
+
+	var test = func(path string, header http.Header, status int, vary string) {
+		r, _ := http.NewRequest(Get, "http://example.com"+path, nil)
+		r.Header = header
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Errorf("%s %v: Got: %d Wanted: %d", path, header, w.Code, status)
+		}
+		if got := strings.Join(w.Header()["Vary"], ", "); got != vary {
+			t.Errorf("%s %v: Vary: Got: %q Wanted: %q", path, header, got, vary)
+		}
+	}
+
+	test("/small", http.Header{}, http.StatusOK, "Accept, Accept-Charset")
+	test("/large", http.Header{}, http.StatusOK, "Accept, Accept-Charset, Accept-Encoding")
+	test("/large", http.Header{"Accept-Encoding": {"gzip"}}, http.StatusOK, "Accept, Accept-Charset, Accept-Encoding")
+	test("/envelope", http.Header{}, http.StatusOK, "Accept, Accept-Charset, Accept-Encoding")
+	// Not Modified responses have the Vary header of the representation.
+	test("/small", http.Header{"If-None-Match": {"*"}}, http.StatusNotModified, "Accept, Accept-Charset")
+	test("/large", http.Header{"If-None-Match": {"*"}}, http.StatusNotModified, "Accept, Accept-Charset, Accept-Encoding")
+	test("/envelope", http.Header{"If-None-Match": {"etag"}}, http.StatusNotModified, "Accept, Accept-Charset, Accept-Encoding")
+}
+
+// unitlessNumbers is a ranger which doesn't support any unit.
+type unitlessNumbers struct {
+	numbers
+}
+
+func (c unitlessNumbers) Units() []string {
+	return nil
+}
+
+func TestAcceptRanges(t *testing.T) {
+	mux := NewMux()
+	mux.Get("/numbers", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return newNumbers(10), nil
+	})
+	mux.Get("/unitless", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return unitlessNumbers{newNumbers(10)}, nil
+	})
+
+	var test = func(path, rg string, status int, acceptRanges string) {
+		r, _ := http.NewRequest(Get, "http://example.com"+path, nil)
+		r.Header.Set("Accept", "application/json")
+		if rg != "" {
+			r.Header.Set("Range", rg)
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Errorf("%s %q: Got: %d Wanted: %d", path, rg, w.Code, status)
+		}
+		if got := w.Header().Get("Accept-Ranges"); got != acceptRanges {
+			t.Errorf("%s %q: Accept-Ranges: Got: %q Wanted: %q", path, rg, got, acceptRanges)
+		}
+	}
+
+	test("/numbers", "", http.StatusOK, "resources")
+	test("/numbers", "resources=0-4", http.StatusPartialContent, "resources")
+	test("/numbers", "bytes=0-4", http.StatusOK, "resources")
+	test("/numbers", "resources=-3", http.StatusPartialContent, "resources")
+	test("/numbers", "resources=10-", http.StatusRequestedRangeNotSatisfiable, "resources")
+	test("/unitless", "", http.StatusOK, "none")
+	test("/unitless", "resources=0-4", http.StatusOK, "none")
+}
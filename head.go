@@ -0,0 +1,68 @@
+package rst
+
+import (
+	"net/http"
+	"strconv"
+)
+
+/*
+Sizer is implemented by resources which can tell the content type and the
+length of their representation without encoding it, so that HEAD requests are
+answered without marshaling them.
+
+	func (c *Archive) Size(r *http.Request) (string, int64) {
+		if rst.ParseAccept(r.Header.Get("Accept")).Negotiate("application/json") == "" {
+			return "", -1
+		}
+		return "application/json; charset=utf-8", c.encodedLength
+	}
+
+The length is the one of the representation before its compression, and must
+account for everything the mux adds to it, such as an envelope. Resources
+return an empty content type or a negative length when they can't tell, for
+instance when the client negotiates another format, in which case they're
+encoded as usual.
+
+Resources which don't implement Sizer are still encoded for HEAD requests, but
+their representation is taken from the RepresentationCache of the mux when it
+has one, and isn't compressed.
+*/
+type Sizer interface {
+	Size(r *http.Request) (contentType string, length int64)
+}
+
+// writeSizedHead writes the headers of the response to r, a HEAD request for
+// resource, from the size it reports and with the status code chosen by the
+// endpoint, if any. It returns false if resource doesn't implement Sizer or
+// can't tell its size.
+func writeSizedHead(resource Resource, code int, w http.ResponseWriter, r *http.Request) bool {
+	sizer, implemented := resource.(Sizer)
+	if !implemented {
+		return false
+	}
+	contentType, length := sizer.Size(r)
+	if contentType == "" || length < 0 {
+		return false
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	if isJSON(contentType) {
+		setLinkHeader(resource, w.Header(), r)
+	}
+	compressed := false
+	if length > 0 && compressible(resource, w.Header(), int(length), r) {
+		addVary(w.Header(), "Accept-Encoding")
+		if compression := acceptedCompression(r); compression != "" {
+			w.Header().Set("Content-Encoding", compression)
+			compressed = true
+		}
+	}
+
+	code = responseStatus(code, length == 0, w, r)
+	if !compressed && !bodiless(code) {
+		w.Header().Set("Content-Length", strconv.FormatInt(length, 10))
+	}
+	w.WriteHeader(code)
+	w.Write(noContent)
+	return true
+}
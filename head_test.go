@@ -0,0 +1,97 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// sizedCounter is an encodedCounter reporting its size, unless unsized.
+type sizedCounter struct {
+	encodedCounter
+	unsized bool
+}
+
+func (c *sizedCounter) Size(r *http.Request) (string, int64) {
+	if c.unsized {
+		return "", -1
+	}
+	return "text/plain", int64(len(c.body))
+}
+
+func TestSizer(t *testing.T) {
+	resource := &sizedCounter{encodedCounter: encodedCounter{etag: "v1", body: strings.Repeat("representation ", 200)}}
+	mux := NewMux()
+	mux.Get("/resource", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return resource, nil
+	})
+
+	var test = func(method, encoding string, encoded int, length string) {
+		r, _ := http.NewRequest(method, "http://example.com/resource", nil)
+		if encoding != "" {
+			r.Header.Set("Accept-Encoding", encoding)
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s %s: status code is %d", method, encoding, w.Code)
+		}
+		if resource.encoded != encoded {
+			t.Errorf("%s %s: resource encoded %d times, wanted %d", method, encoding, resource.encoded, encoded)
+		}
+		if got := w.Header().Get("Content-Length"); got != length {
+			t.Errorf("%s %s: Content-Length is %q, wanted %q", method, encoding, got, length)
+		}
+		if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+			t.Errorf("%s %s: Content-Type is %q", method, encoding, ct)
+		}
+		if method == Head && encoding == "" && w.Body.Len() != 0 {
+			t.Errorf("%s %s: body has %d bytes", method, encoding, w.Body.Len())
+		}
+	}
+
+	size := strconv.Itoa(len(resource.body))
+	test(Head, "", 0, size)
+	test(Head, "gzip", 0, "")
+	test(Get, "", 1, "")
+
+	resource.unsized = true
+	test(Head, "", 2, size)
+	test(Head, "gzip", 3, "")
+}
+
+func TestHeadRepresentationCache(t *testing.T) {
+	resource := &encodedCounter{etag: "v1", body: strings.Repeat("representation ", 200)}
+	mux := NewMux()
+	mux.Get("/resource", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return resource, nil
+	})
+	mux.SetRepresentationCache(&RepresentationCache{})
+
+	var test = func(method string, encoded int, encoding string) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(method, "http://example.com/resource", nil)
+		r.Header.Set("Accept-Encoding", "gzip")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if resource.encoded != encoded {
+			t.Errorf("%s: resource encoded %d times, wanted %d", method, resource.encoded, encoded)
+		}
+		if got := w.Header().Get("Content-Encoding"); got != encoding {
+			t.Errorf("%s: Content-Encoding is %q, wanted %q", method, got, encoding)
+		}
+		return w
+	}
+
+	// The compression of the representation is unknown until it's requested
+	// with GET.
+	if w := test(Head, 1, "gzip"); w.Header().Get("Content-Length") != "" {
+		t.Errorf("Content-Length of an uncompressed representation is %q", w.Header().Get("Content-Length"))
+	}
+	get := test(Get, 1, "gzip")
+	head := test(Head, 1, "gzip")
+	if length := head.Header().Get("Content-Length"); length != strconv.Itoa(get.Body.Len()) {
+		t.Errorf("Content-Length is %q, wanted %d", length, get.Body.Len())
+	}
+}
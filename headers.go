@@ -15,12 +15,13 @@ import (
 )
 
 // addVary adds value to the list of values of the "Vary" header if it's not
-// already there.
+// already there, including in a comma-separated list of values, or if the
+// response varies with everything.
 func addVary(header http.Header, value string) {
 	value = http.CanonicalHeaderKey(value)
-	if values, ok := header[http.CanonicalHeaderKey("Vary")]; ok {
-		for _, v := range values {
-			if v == value {
+	for _, v := range header[http.CanonicalHeaderKey("Vary")] {
+		for _, member := range strings.Split(v, ",") {
+			if member = strings.TrimSpace(member); member == "*" || strings.EqualFold(member, value) {
 				return
 			}
 		}
@@ -32,7 +33,56 @@ func addVary(header http.Header, value string) {
 type AcceptClause struct {
 	Type, SubType string
 	Q             float64
-	Params        map[string]string
+	Params        map[string]string // Parameters of the media range, nil if it has none.
+}
+
+// specificity returns how specific the media range of c is: */* is less
+// specific than type/*, which is less specific than type/subtype.
+func (c *AcceptClause) specificity() int {
+	switch {
+	case c.Type == "*":
+		return 0
+	case c.SubType == "*":
+		return 1
+	}
+	return 2
+}
+
+// match returns the specificity of c for a media type of typ/subtype with the
+// parameters params, or -1 if c doesn't accept it. A clause with parameters
+// declared by the media type is more specific than one without, and doesn't
+// accept media types declaring different values.
+func (c *AcceptClause) match(typ, subtype, params string) int {
+	switch {
+	case c.Type == "*":
+	case !strings.EqualFold(c.Type, typ):
+		return -1
+	case c.SubType == "*":
+	case !strings.EqualFold(c.SubType, subtype):
+		return -1
+	}
+
+	specificity := c.specificity()
+	if len(c.Params) == 0 {
+		return specificity
+	}
+	for params != "" {
+		var param string
+		param, params, _ = cut(params, ';')
+		key, value, found := cut(param, '=')
+		if !found {
+			continue
+		}
+		expected, declared := c.Params[strings.ToLower(strings.TrimSpace(key))]
+		if !declared {
+			continue
+		}
+		if !strings.EqualFold(expected, strings.Trim(strings.TrimSpace(value), `"`)) {
+			return -1
+		}
+		specificity = 3
+	}
+	return specificity
 }
 
 // Accept represents a set of clauses in an HTTP Accept header.
@@ -42,103 +92,294 @@ func (accept Accept) Len() int {
 	return len(accept)
 }
 
+// Less orders clauses by decreasing quality, then by decreasing specificity.
 func (accept Accept) Less(i, j int) bool {
-	ai, aj := accept[i], accept[j]
-	if ai.Q > aj.Q {
-		return true
-	}
-	if ai.Type != "*" && aj.Type == "*" {
-		return true
+	ai, aj := &accept[i], &accept[j]
+	if ai.Q != aj.Q {
+		return ai.Q > aj.Q
 	}
-	if ai.SubType != "*" && aj.SubType == "*" {
-		return true
-	}
-	return false
+	return ai.specificity() > aj.specificity()
 }
 
 func (accept Accept) Swap(i, j int) {
 	accept[i], accept[j] = accept[j], accept[i]
 }
 
-// ParseAccept parses the raw value of an accept Header, and returns a sorted
-// list of clauses.
-func ParseAccept(header string) Accept {
-	accept := make(Accept, 0)
-	for _, part := range strings.Split(header, ",") {
-		part := strings.Trim(part, " ")
+// sort sorts the clauses of accept in place, keeping the order of the header
+// between equivalent clauses.
+func (accept Accept) sort() {
+	for i := 1; i < len(accept); i++ {
+		for j := i; j > 0 && accept.Less(j, j-1); j-- {
+			accept.Swap(j, j-1)
+		}
+	}
+}
 
-		a := AcceptClause{}
-		a.Params = make(map[string]string)
-		a.Q = 1.0
+// cut slices s around the first instance of sep, returning the text before
+// and after it. found is false if sep doesn't appear in s.
+func cut(s string, sep byte) (before, after string, found bool) {
+	if i := strings.IndexByte(s, sep); i >= 0 {
+		return s[:i], s[i+1:], true
+	}
+	return s, "", false
+}
 
-		mrp := strings.Split(part, ";")
+// ParseAccept parses the raw value of an accept Header, and returns a list of
+// clauses sorted by decreasing quality, then by decreasing specificity:
+// type/subtype comes before type/*, which comes before */*. Malformed clauses
+// are ignored.
+func ParseAccept(header string) Accept {
+	accept := make(Accept, 0, strings.Count(header, ",")+1)
+	for header != "" {
+		var part string
+		part, header, _ = cut(header, ',')
+		if clause, valid := parseAcceptClause(part); valid {
+			accept = append(accept, clause)
+		}
+	}
+	accept.sort()
+	return accept
+}
 
-		mediaRange := mrp[0]
-		sp := strings.Split(mediaRange, "/")
-		a.Type = strings.Trim(sp[0], " ")
+// parseAcceptClause parses a clause of an Accept header. Extension parameters
+// following the quality are ignored.
+func parseAcceptClause(part string) (clause AcceptClause, valid bool) {
+	mediaRange, params, _ := cut(part, ';')
+	typ, subtype, found := cut(strings.TrimSpace(mediaRange), '/')
+	clause.Type = strings.ToLower(strings.TrimSpace(typ))
+	clause.SubType = strings.ToLower(strings.TrimSpace(subtype))
+	clause.Q = 1.0
+	switch {
+	case !found && clause.Type == "*":
+		clause.SubType = "*"
+	case !found || clause.Type == "" || clause.SubType == "":
+		return clause, false
+	case clause.Type == "*" && clause.SubType != "*":
+		return clause, false
+	}
 
-		switch {
-		case len(sp) == 1 && a.Type == "*":
-			a.SubType = "*"
-		case len(sp) == 2:
-			a.SubType = strings.Trim(sp[1], " ")
-		default:
+	for params != "" {
+		var param string
+		param, params, _ = cut(params, ';')
+		key, value, found := cut(param, '=')
+		key = strings.ToLower(strings.TrimSpace(key))
+		if !found || key == "" {
 			continue
 		}
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+		if key == "q" {
+			q, err := strconv.ParseFloat(value, 64)
+			switch {
+			case err != nil || q < 0:
+				q = 0
+			case q > 1:
+				q = 1
+			}
+			clause.Q = q
+			break
+		}
+		if clause.Params == nil {
+			clause.Params = make(map[string]string, 1)
+		}
+		clause.Params[key] = value
+	}
+	return clause, true
+}
+
+// Negotiate the most appropriate contentType given the accept header clauses
+// and a list of alternatives. See NegotiateBest.
+func (accept Accept) Negotiate(alternatives ...string) (contentType string) {
+	contentType, _ = accept.NegotiateBest(alternatives)
+	return
+}
+
+// NegotiateBest returns the alternative with the highest quality, and its
+// quality. The quality of an alternative is the one of the most specific
+// clause accepting it, and alternatives of equal quality are preferred in the
+// order of the list. Alternatives can have parameters, such as
+// "text/plain; format=flowed". NegotiateBest returns an empty string if no
+// alternative is acceptable, including those of quality 0.
+func (accept Accept) NegotiateBest(alternatives []string) (string, float64) {
+	var (
+		best    string
+		quality float64
+	)
+	for _, alternative := range alternatives {
+		if q := accept.quality(alternative); q > quality {
+			best, quality = alternative, q
+		}
+	}
+	return best, quality
+}
+
+// quality returns the quality of the media type contentType.
+func (accept Accept) quality(contentType string) float64 {
+	mediaType, params, _ := cut(contentType, ';')
+	typ, subtype, _ := cut(strings.TrimSpace(mediaType), '/')
+	typ, subtype = strings.TrimSpace(typ), strings.TrimSpace(subtype)
+
+	specificity, q := -1, 0.0
+	for i := range accept {
+		if s := accept[i].match(typ, subtype, params); s > specificity {
+			specificity, q = s, accept[i].Q
+		}
+	}
+	return q
+}
+
+// LanguageClause represents a clause in an HTTP Accept-Language header.
+type LanguageClause struct {
+	Tag string
+	Q   float64
+}
+
+// AcceptLanguage represents a set of clauses in an HTTP Accept-Language
+// header.
+type AcceptLanguage []LanguageClause
 
-		if len(mrp) == 1 {
-			accept = append(accept, a)
+func (al AcceptLanguage) Len() int {
+	return len(al)
+}
+
+func (al AcceptLanguage) Less(i, j int) bool {
+	return al[i].Q > al[j].Q
+}
+
+func (al AcceptLanguage) Swap(i, j int) {
+	al[i], al[j] = al[j], al[i]
+}
+
+// ParseAcceptLanguage parses the raw value of an Accept-Language header, and
+// returns a list of clauses sorted by q-value.
+func ParseAcceptLanguage(header string) AcceptLanguage {
+	al := make(AcceptLanguage, 0)
+	for _, part := range strings.Split(header, ",") {
+		sp := strings.Split(part, ";")
+		clause := LanguageClause{Tag: strings.Trim(sp[0], " "), Q: 1.0}
+		if clause.Tag == "" {
 			continue
 		}
+		for _, param := range sp[1:] {
+			kv := strings.SplitN(param, "=", 2)
+			if len(kv) == 2 && strings.Trim(kv[0], " ") == "q" {
+				clause.Q, _ = strconv.ParseFloat(strings.Trim(kv[1], " "), 32)
+			}
+		}
+		al = append(al, clause)
+	}
+	sort.Stable(al)
+	return al
+}
+
+/*
+Negotiate returns the most appropriate language among the given tags, which
+must be listed by order of preference.
+
+Each clause of al is matched against tags using the lookup fallback chain of
+RFC 4647: fr-CA will match fr-CA, then fr. The first tag is returned if no
+clause matches.
 
-		for _, param := range mrp[1:] {
-			sp := strings.SplitN(param, "=", 2)
-			if len(sp) != 2 {
-				continue
+	al := ParseAcceptLanguage("fr-CA, en;q=0.8")
+	al.Negotiate("en", "fr")	// fr
+	al.Negotiate("en", "de")	// en
+	al.Negotiate("de", "es")	// de
+*/
+func (al AcceptLanguage) Negotiate(tags ...string) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	for _, clause := range al {
+		if clause.Q <= 0 {
+			continue
+		}
+		if clause.Tag == "*" {
+			return tags[0]
+		}
+		for rg := clause.Tag; rg != ""; {
+			for _, tag := range tags {
+				if strings.EqualFold(rg, tag) {
+					return tag
+				}
 			}
-			token := strings.Trim(sp[0], " ")
-			if token == "q" {
-				a.Q, _ = strconv.ParseFloat(sp[1], 32)
-			} else {
-				a.Params[token] = strings.Trim(sp[1], " ")
+			i := strings.LastIndex(rg, "-")
+			if i < 0 {
+				break
 			}
+			rg = rg[:i]
 		}
-
-		accept = append(accept, a)
 	}
+	return tags[0]
+}
 
-	sort.Sort(accept)
-	return accept
+// CharsetClause represents a clause in an HTTP Accept-Charset header.
+type CharsetClause struct {
+	Charset string
+	Q       float64
 }
 
-// Negotiate the most appropriate contentType given the accept header clauses
-// and a list of alternatives.
-func (accept Accept) Negotiate(alternatives ...string) (contentType string) {
-	asp := make([][]string, 0, len(alternatives))
-	for _, ctype := range alternatives {
-		asp = append(asp, strings.SplitN(ctype, "/", 2))
-	}
-	for _, clause := range accept {
-		for i, ctsp := range asp {
-			if clause.Type == ctsp[0] && clause.SubType == ctsp[1] {
-				contentType = alternatives[i]
-				return
-			}
-			if clause.Type == ctsp[0] && clause.SubType == "*" {
-				contentType = alternatives[i]
-				return
+// AcceptCharset represents a set of clauses in an HTTP Accept-Charset header.
+type AcceptCharset []CharsetClause
+
+func (ac AcceptCharset) Len() int {
+	return len(ac)
+}
+
+func (ac AcceptCharset) Less(i, j int) bool {
+	return ac[i].Q > ac[j].Q
+}
+
+func (ac AcceptCharset) Swap(i, j int) {
+	ac[i], ac[j] = ac[j], ac[i]
+}
+
+// ParseAcceptCharset parses the raw value of an Accept-Charset header, and
+// returns a list of clauses sorted by q-value.
+func ParseAcceptCharset(header string) AcceptCharset {
+	ac := make(AcceptCharset, 0)
+	for _, clause := range ParseAcceptLanguage(header) {
+		ac = append(ac, CharsetClause{Charset: clause.Tag, Q: clause.Q})
+	}
+	return ac
+}
+
+/*
+Negotiate returns the most appropriate charset among the given ones, which
+must be listed by order of preference, or an empty string if none of them is
+acceptable.
+
+Charsets are compared case-insensitively, and * matches any charset which isn't
+excluded with a q-value of 0.
+
+	ac := ParseAcceptCharset("iso-8859-1, utf-8;q=0.5")
+	ac.Negotiate("utf-8", "iso-8859-1")	// iso-8859-1
+	ac.Negotiate("utf-8", "us-ascii")	// utf-8
+	ac.Negotiate("us-ascii")		// ""
+*/
+func (ac AcceptCharset) Negotiate(charsets ...string) string {
+	excluded := func(charset string) bool {
+		for _, clause := range ac {
+			if clause.Q <= 0 && strings.EqualFold(clause.Charset, charset) {
+				return true
 			}
-			if clause.Type == "*" && clause.SubType == "*" {
-				contentType = alternatives[i]
-				return
+		}
+		return false
+	}
+
+	for _, clause := range ac {
+		if clause.Q <= 0 {
+			continue
+		}
+		for _, charset := range charsets {
+			if clause.Charset == "*" && !excluded(charset) || strings.EqualFold(clause.Charset, charset) {
+				return charset
 			}
 		}
 	}
-	return
+	return ""
 }
 
 var (
-	rangeRe = regexp.MustCompile("^(\\w+)=(\\d+)-(\\d+)?$")
+	rangeRe = regexp.MustCompile("^(\\w+)=(\\d*)-(\\d*)$")
 )
 
 // Range is a structured representation of the Range request header.
@@ -147,6 +388,8 @@ type Range struct {
 	Unit string
 	From uint64
 	To   uint64
+
+	suffix uint64 // Number of the last units requested by a suffix range.
 }
 
 // Len returns the number of units requested in this range.
@@ -165,9 +408,9 @@ func (r *Range) validate(ranger Ranger) error {
 }
 
 /*
-adjust will correct r to fall within the boundaries of ranger. If r does not
-overlap the current extend of ranger, a RequestedRangeNotSatifiable error will
-be returned.
+adjust will correct r to fall within the boundaries of ranger. Suffix ranges
+are resolved to the last units of ranger. If r does not overlap the current
+extend of ranger, a RequestedRangeNotSatifiable error will be returned.
 
 Range entities are always adjusted before they are passed to Ranger.Range
 implementer.
@@ -175,10 +418,23 @@ implementer.
 func (r *Range) adjust(ranger Ranger) error {
 
 	count := ranger.Count()
-	if r.From > count {
+	if count == 0 {
+		return RequestedRangeNotSatisfiable(&ContentRange{Total: count})
+	}
+	if r.suffix > 0 {
+		r.From, r.To = 0, count-1
+		if r.suffix < count {
+			r.From = count - r.suffix
+		}
+		r.suffix = 0
+		return nil
+	}
+	if r.From >= count {
 		return RequestedRangeNotSatisfiable(&ContentRange{Total: count})
 	}
-	r.To = uint64(math.Min(float64(r.To), float64(count-1)))
+	if r.To > count-1 {
+		r.To = count - 1
+	}
 	return nil
 }
 
@@ -188,12 +444,16 @@ ParseRange parses raw into a new Range instance.
 	ParseRange("bytes=0-1024") 	// (OK)
 	ParseRange("resources=239-392")	// (OK)
 	ParseRange("items=39-")		// (OK)
+	ParseRange("items=-10")		// (OK, the last 10 items)
 	ParseRange("bytes 50-100")	// (ERROR: syntax)
 	ParseRange("bytes=100-50")	// (ERROR: logic)
+
+The last units requested by a suffix range are resolved when the range is
+passed to a Ranger.
 */
 func ParseRange(raw string) (*Range, error) {
 	m := rangeRe.FindStringSubmatch(raw)
-	if m == nil || len(m) < 4 {
+	if m == nil || len(m) < 4 || m[2] == "" && m[3] == "" {
 		return nil, errors.New("malformed Range header value")
 	}
 
@@ -202,13 +462,28 @@ func ParseRange(raw string) (*Range, error) {
 	}
 
 	// Regex guarantees numbers are valid, so errors of strconv.ParseUint can
-	// be safely ignored.
+	// be safely ignored, except for the values overflowing uint64.
+
+	// Suffix ranges request the last units.
+	if m[2] == "" {
+		suffix, err := strconv.ParseUint(m[3], 10, 64)
+		if err != nil || suffix == 0 {
+			return nil, errors.New("invalid Range header value")
+		}
+		r.suffix = suffix
+		return r, nil
+	}
 
-	r.From, _ = strconv.ParseUint(m[2], 10, 64)
+	var err error
+	if r.From, err = strconv.ParseUint(m[2], 10, 64); err != nil {
+		return nil, errors.New("invalid Range header value")
+	}
 
 	// To is optional. When omitted, it means "all remaining available units".
 	if m[3] != "" {
-		r.To, _ = strconv.ParseUint(m[3], 10, 64)
+		if r.To, err = strconv.ParseUint(m[3], 10, 64); err != nil {
+			r.To = math.MaxUint64
+		}
 		if r.From > r.To {
 			return nil, errors.New("invalid Range header value")
 		}
@@ -237,3 +512,28 @@ func (cr *ContentRange) String() string {
 
 	return fmt.Sprintf("%s %d-%d/%d", cr.Unit, cr.From, cr.To, cr.Total)
 }
+
+var contentRangeRe = regexp.MustCompile("^(\\w+) (\\d+)-(\\d+)/(\\d+|\\*)$")
+
+// ParseContentRange parses raw into a new ContentRange instance. It's mainly
+// useful to clients of rst services.
+//
+// 	ParseContentRange("resources 0-9/100")	// (OK)
+// 	ParseContentRange("bytes 0-1023/*")	// (OK, Total is 0)
+// 	ParseContentRange("bytes=0-9/100")	// (ERROR: syntax)
+func ParseContentRange(raw string) (*ContentRange, error) {
+	m := contentRangeRe.FindStringSubmatch(raw)
+	if m == nil {
+		return nil, errors.New("malformed Content-Range header value")
+	}
+	cr := &ContentRange{Range: &Range{Unit: m[1]}}
+	cr.From, _ = strconv.ParseUint(m[2], 10, 64)
+	cr.To, _ = strconv.ParseUint(m[3], 10, 64)
+	if m[4] != "*" {
+		cr.Total, _ = strconv.ParseUint(m[4], 10, 64)
+	}
+	if cr.From > cr.To {
+		return nil, errors.New("invalid Content-Range header value")
+	}
+	return cr, nil
+}
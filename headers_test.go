@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -31,6 +32,19 @@ func TestAddVary(t *testing.T) {
 	addVary(o, "Accept-Encoding")
 
 	compareFn(h, o)
+
+	// Values listed on one line, and the * wildcard, are honored.
+	h = http.Header{"Vary": {"accept, Origin"}}
+	addVary(h, "Accept")
+	addVary(h, "Origin")
+	addVary(h, "Range")
+	if got := h["Vary"]; len(got) != 2 || got[1] != "Range" {
+		t.Errorf("Got: %q Wanted: [accept, Origin Range]", got)
+	}
+	h = http.Header{"Vary": {"*"}}
+	if addVary(h, "Accept"); len(h["Vary"]) != 1 {
+		t.Errorf("Got: %q Wanted: [*]", h["Vary"])
+	}
 }
 
 func TestParseRange(t *testing.T) {
@@ -63,11 +77,65 @@ func TestParseRange(t *testing.T) {
 	if _, err := ParseRange("bytes=12-10"); err == nil {
 		t.Errorf("Error not cached")
 	}
+
+	for _, raw := range []string{"bytes=-", "bytes=-0", "bytes=99999999999999999999-"} {
+		if _, err := ParseRange(raw); err == nil {
+			t.Errorf("%s: error not caught", raw)
+		}
+	}
+}
+
+func TestAdjustRange(t *testing.T) {
+	var test = func(raw string, count int, from, to uint64, satisfiable bool) {
+		rg, err := ParseRange(raw)
+		if err != nil {
+			t.Fatalf("%s: %s", raw, err)
+		}
+		err = rg.adjust(newNumbers(count))
+		if satisfiable != (err == nil) {
+			t.Errorf("%s/%d: Got: %v Wanted satisfiable: %t", raw, count, err, satisfiable)
+			return
+		}
+		if satisfiable && (rg.From != from || rg.To != to) {
+			t.Errorf("%s/%d: Got: %d-%d Wanted: %d-%d", raw, count, rg.From, rg.To, from, to)
+		}
+	}
+
+	test("resources=-10", 45, 35, 44, true)
+	test("resources=-100", 45, 0, 44, true)
+	test("resources=20-", 45, 20, 44, true)
+	test("resources=20-30", 45, 20, 30, true)
+	test("resources=40-99999999999999999999", 45, 40, 44, true)
+	test("resources=44-", 45, 44, 44, true)
+	test("resources=45-", 45, 0, 0, false)
+	test("resources=0-", 0, 0, 0, false)
+	test("resources=-10", 0, 0, 0, false)
+}
+
+func TestParseContentRange(t *testing.T) {
+	var test = func(raw, unit string, from, to, total uint64) {
+		parsed, err := ParseContentRange(raw)
+		if err != nil {
+			t.Errorf("%s: %s", raw, err)
+			return
+		}
+		if parsed.Unit != unit || parsed.From != from || parsed.To != to || parsed.Total != total {
+			t.Errorf("%s: Got: %s %d-%d/%d", raw, parsed.Unit, parsed.From, parsed.To, parsed.Total)
+		}
+	}
+	test("resources 0-9/100", "resources", 0, 9, 100)
+	test("bytes 1024-2047/*", "bytes", 1024, 2047, 0)
+
+	for _, raw := range []string{"*/100", "bytes=0-9/100", "bytes 10-9/100", ""} {
+		if _, err := ParseContentRange(raw); err == nil {
+			t.Errorf("%s: error not caught", raw)
+		}
+	}
 }
 
 func TestAcceptAdjust(t *testing.T) {
 	from, to := uint64(15), uint64(100000)
-	rg := &Range{"resources", from, to}
+	rg := &Range{Unit: "resources", From: from, To: to}
 	rg.adjust(testPeopleResourceCollection)
 
 	if from != rg.From {
@@ -113,3 +181,103 @@ func TestAcceptNegociate(t *testing.T) {
 	test([]string{"text/n3", "text/plain"}, "text/plain")
 	test([]string{"text/n3", "application/rdf+xml"}, "text/n3")
 }
+
+func TestAcceptSpecificity(t *testing.T) {
+	var test = func(header string, expected ...string) {
+		var got []string
+		for _, clause := range ParseAccept(header) {
+			got = append(got, clause.Type+"/"+clause.SubType)
+		}
+		if strings.Join(got, ",") != strings.Join(expected, ",") {
+			t.Errorf("%s: clauses are %v, expected %v", header, got, expected)
+		}
+	}
+
+	test("*/*, text/plain;q=0.1", "*/*", "text/plain")
+	test("*/*;q=0.8, text/*;q=0.8, text/html;q=0.8", "text/html", "text/*", "*/*")
+	test("text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,*/*;q=0.8",
+		"text/html", "application/xhtml+xml", "image/avif", "image/webp", "application/xml", "*/*")
+	test("Text/HTML, *, application/json;q=2, invalid, */plain, ;q=1", "text/html", "application/json", "*/*")
+
+	accept := ParseAccept(`text/plain; format="flowed"; q=0.5; ext=1`)
+	if len(accept) != 1 || accept[0].Q != 0.5 || len(accept[0].Params) != 1 || accept[0].Params["format"] != "flowed" {
+		t.Errorf("Clauses are %+v", accept)
+	}
+}
+
+func TestAcceptNegotiateBest(t *testing.T) {
+	var test = func(header string, alternatives []string, expected string, quality float64) {
+		if ct, q := ParseAccept(header).NegotiateBest(alternatives); ct != expected || q != quality {
+			t.Errorf("%s: got %q with quality %g, expected %q with quality %g", header, ct, q, expected, quality)
+		}
+	}
+
+	test("*/*, text/plain;q=0.1", []string{"text/plain", "application/json"}, "application/json", 1)
+	test("text/*;q=0.5, text/html;q=0, */*;q=0.1", []string{"text/html", "text/plain", "image/png"}, "text/plain", 0.5)
+	test("text/html;q=0", []string{"text/html"}, "", 0)
+	test("application/json, application/xml", []string{"application/xml", "application/json"}, "application/xml", 1)
+	test("text/plain;format=flowed, text/plain;q=0.4", []string{"text/plain; format=fixed", "text/plain; format=flowed"}, "text/plain; format=flowed", 1)
+	test("text/plain;format=flowed, text/plain;q=0.4", []string{"text/plain; format=fixed"}, "text/plain; format=fixed", 0.4)
+	test("application/json; pretty=true", []string{"application/json"}, "application/json", 1)
+	test("", []string{"application/json"}, "", 0)
+}
+
+func TestAcceptAllocations(t *testing.T) {
+	const header = "text/html,application/xhtml+xml,application/xml;q=0.9,image/webp,*/*;q=0.8"
+	alternatives := []string{"application/json", "application/xml", "text/html"}
+	if n := testing.AllocsPerRun(100, func() { ParseAccept(header) }); n > 1 {
+		t.Errorf("ParseAccept allocates %g times, expected 1", n)
+	}
+	accept := ParseAccept(header)
+	if n := testing.AllocsPerRun(100, func() { accept.NegotiateBest(alternatives) }); n != 0 {
+		t.Errorf("NegotiateBest allocates %g times, expected 0", n)
+	}
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	al := ParseAcceptLanguage("fr-CA, fr;q=0.8, en-US;q=0.6, *;q=0.1")
+	expected := []string{"fr-CA", "fr", "en-US", "*"}
+	if len(al) != len(expected) {
+		t.Fatalf("expected %d. Got %d", len(expected), len(al))
+	}
+	for i, clause := range al {
+		if clause.Tag != expected[i] {
+			t.Errorf("expected %s at index %d, got %s", expected[i], i, clause.Tag)
+		}
+	}
+}
+
+func TestAcceptLanguageNegotiate(t *testing.T) {
+	var test = func(header string, tags []string, expected string) {
+		if lang := ParseAcceptLanguage(header).Negotiate(tags...); lang != expected {
+			t.Errorf("%s: got %s expected %s", header, lang, expected)
+		}
+	}
+
+	test("fr-CA, en;q=0.8", []string{"en", "fr"}, "fr")
+	test("fr-CA, en;q=0.8", []string{"en", "fr-CA", "fr"}, "fr-CA")
+	test("fr-CA, en;q=0.8", []string{"de", "en"}, "en")
+	test("fr-CA, en;q=0.8", []string{"de", "es"}, "de")
+	test("en;q=0.5, es", []string{"en", "es"}, "es")
+	test("EN-us", []string{"de", "en-US"}, "en-US")
+	test("zh-Hant-TW", []string{"en", "zh-Hant"}, "zh-Hant")
+	test("*", []string{"es", "en"}, "es")
+	test("fr;q=0", []string{"en", "fr"}, "en")
+	test("", []string{"en", "fr"}, "en")
+}
+
+func TestAcceptCharsetNegotiate(t *testing.T) {
+	var test = func(header string, charsets []string, expected string) {
+		if charset := ParseAcceptCharset(header).Negotiate(charsets...); charset != expected {
+			t.Errorf("%s: got %s expected %s", header, charset, expected)
+		}
+	}
+
+	test("iso-8859-1, utf-8;q=0.5", []string{"utf-8", "iso-8859-1"}, "iso-8859-1")
+	test("iso-8859-1, utf-8;q=0.5", []string{"utf-8", "us-ascii"}, "utf-8")
+	test("iso-8859-1, utf-8;q=0.5", []string{"us-ascii"}, "")
+	test("ISO-8859-1", []string{"utf-8", "iso-8859-1"}, "iso-8859-1")
+	test("*", []string{"utf-8", "iso-8859-1"}, "utf-8")
+	test("utf-8;q=0, *", []string{"utf-8", "iso-8859-1"}, "iso-8859-1")
+	test("", []string{"utf-8"}, "")
+}
@@ -0,0 +1,302 @@
+package rst
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// HistoryEntry records a successful write to a resource.
+type HistoryEntry struct {
+	Method       string    `json:"method" xml:"Method"`
+	Principal    string    `json:"principal,omitempty" xml:"Principal,omitempty"`
+	Date         time.Time `json:"date" xml:"Date"`
+	PreviousETag string    `json:"previousETag,omitempty" xml:"PreviousETag,omitempty"`
+	ETag         string    `json:"etag,omitempty" xml:"ETag,omitempty"`
+}
+
+// HistoryStore is implemented by the storage backends of a Changelog. Keys are
+// the paths of resources.
+type HistoryStore interface {
+	Append(key string, entry *HistoryEntry) error
+	Entries(key string) ([]*HistoryEntry, error)
+}
+
+// NewHistoryStore returns a HistoryStore that keeps entries in memory.
+func NewHistoryStore() HistoryStore {
+	return &memoryHistoryStore{entries: make(map[string][]*HistoryEntry)}
+}
+
+type memoryHistoryStore struct {
+	mu      sync.RWMutex
+	entries map[string][]*HistoryEntry
+}
+
+func (s *memoryHistoryStore) Append(key string, entry *HistoryEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[key] = append(s.entries[key], entry)
+	return nil
+}
+
+func (s *memoryHistoryStore) Entries(key string) ([]*HistoryEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.entries[key], nil
+}
+
+/*
+Changelog records the successful writes made through an endpoint.
+
+	changelog := &rst.Changelog{
+		Store: rst.NewHistoryStore(),
+		Principal: func(r *http.Request) string {
+			user, _, _ := r.BasicAuth()
+			return user
+		},
+	}
+	mux.HandleChangelog("/people/{id}", &PersonEP{}, changelog)
+
+Each POST, PUT, PATCH or DELETE request that succeeds appends an entry to the
+history of the resource, with the ETag of the resource before the write when
+the endpoint implements Getter, and the ETag of the resource returned by the
+endpoint. The history is exposed at {pattern}/history as a collection
+supporting range requests with the "entries" unit.
+*/
+type Changelog struct {
+	Store     HistoryStore
+	Principal func(r *http.Request) string // Optional. Returns the author of a write.
+}
+
+// HandleChangelog registers endpoint for the given pattern, and its history
+// for pattern + "/history".
+func (s *Mux) HandleChangelog(pattern string, endpoint Endpoint, changelog *Changelog) {
+	s.HandleEndpoint(pattern, &changelogEndpoint{endpoint, changelog})
+	s.HandleEndpoint(strings.TrimRight(pattern, "/")+"/history", &historyEndpoint{changelog})
+}
+
+func (c *Changelog) record(r *http.Request, key, previous string, resource Resource) {
+	entry := &HistoryEntry{
+		Method:       r.Method,
+		Date:         time.Now().UTC(),
+		PreviousETag: previous,
+	}
+	if resource != nil {
+		entry.ETag = resource.ETag()
+	}
+	if c.Principal != nil {
+		entry.Principal = c.Principal(r)
+	}
+	c.Store.Append(key, entry)
+}
+
+// changelogEndpoint wraps an endpoint to record its writes in a changelog.
+type changelogEndpoint struct {
+	endpoint  Endpoint
+	changelog *Changelog
+}
+
+// wrapped implements the endpointWrapper interface.
+func (e *changelogEndpoint) wrapped() Endpoint {
+	return e.endpoint
+}
+
+// allowedMethods implements the methodLister interface.
+func (e *changelogEndpoint) allowedMethods() []string {
+	return AllowedMethods(e.endpoint)
+}
+
+// validateMethod returns an error if the method of r is not allowed by the
+// wrapped endpoint.
+func (e *changelogEndpoint) validateMethod(r *http.Request) error {
+	if getMethodHandler(e.endpoint, r.Method, r.Header) == nil {
+		return methodNotAllowed(e, r)
+	}
+	return nil
+}
+
+// previousETag returns the ETag of the resource at the URL of r before it's
+// modified, or an empty string.
+func (e *changelogEndpoint) previousETag(vars RouteVars, r *http.Request) string {
+	getter := getterOf(e.endpoint)
+	if getter == nil {
+		return ""
+	}
+	copied := withMethod(r, Get)
+	defer delVars(copied)
+	resource, err := getter.Get(vars, copied)
+	if err != nil || resource == nil {
+		return ""
+	}
+	return resource.ETag()
+}
+
+// Get implements the Getter interface.
+func (e *changelogEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	if err := e.validateMethod(r); err != nil {
+		return nil, err
+	}
+	return getterOf(e.endpoint).Get(vars, r)
+}
+
+// Post implements the Poster interface.
+func (e *changelogEndpoint) Post(vars RouteVars, r *http.Request) (Resource, string, error) {
+	if err := e.validateMethod(r); err != nil {
+		return nil, "", err
+	}
+	resource, location, err := posterOf(e.endpoint).Post(vars, r)
+	if err == nil {
+		key := r.URL.Path
+		if u, err := url.Parse(location); err == nil && u.Path != "" {
+			key = u.Path
+		}
+		e.changelog.record(r, key, "", resource)
+	}
+	return resource, location, err
+}
+
+// Put implements the Putter interface.
+func (e *changelogEndpoint) Put(vars RouteVars, r *http.Request) (Resource, error) {
+	if err := e.validateMethod(r); err != nil {
+		return nil, err
+	}
+	previous := e.previousETag(vars, r)
+	resource, err := putterOf(e.endpoint).Put(vars, r)
+	if err == nil {
+		e.changelog.record(r, r.URL.Path, previous, resource)
+	}
+	return resource, err
+}
+
+// Patch implements the Patcher interface.
+func (e *changelogEndpoint) Patch(vars RouteVars, r *http.Request) (Resource, error) {
+	if err := e.validateMethod(r); err != nil {
+		return nil, err
+	}
+	previous := e.previousETag(vars, r)
+	resource, err := patcherOf(e.endpoint).Patch(vars, r)
+	if err == nil {
+		e.changelog.record(r, r.URL.Path, previous, resource)
+	}
+	return resource, err
+}
+
+// Delete implements the Deleter interface.
+func (e *changelogEndpoint) Delete(vars RouteVars, r *http.Request) error {
+	if err := e.validateMethod(r); err != nil {
+		return err
+	}
+	previous := e.previousETag(vars, r)
+	err := deleterOf(e.endpoint).Delete(vars, r)
+	if err == nil {
+		e.changelog.record(r, r.URL.Path, previous, nil)
+	}
+	return err
+}
+
+// Methods implements the MethodHandler interface.
+func (e *changelogEndpoint) Methods() []string {
+	return extensionMethods(e.endpoint)
+}
+
+// Handle implements the MethodHandler interface. Requests with extension
+// methods aren't recorded in the changelog.
+func (e *changelogEndpoint) Handle(method string, vars RouteVars, r *http.Request) (Resource, error) {
+	handler, implemented := e.endpoint.(MethodHandler)
+	if !implemented {
+		return nil, methodNotAllowed(e, r)
+	}
+	return handler.Handle(method, vars, r)
+}
+
+// Validators implements the Validator interface.
+func (e *changelogEndpoint) Validators(vars RouteVars, r *http.Request) (string, time.Time, error) {
+	return validatorsOf(e.endpoint, vars, r)
+}
+
+// Authorize implements the Authorizer interface.
+func (e *changelogEndpoint) Authorize(method string, vars RouteVars, r *http.Request) error {
+	if authorizer, implemented := e.endpoint.(Authorizer); implemented {
+		return authorizer.Authorize(method, vars, r)
+	}
+	return nil
+}
+
+// Preflight implements the Preflighter interface.
+func (e *changelogEndpoint) Preflight(req *AccessControlRequest, vars RouteVars, r *http.Request) *AccessControlResponse {
+	if preflighter, implemented := e.endpoint.(Preflighter); implemented {
+		return preflighter.Preflight(req, vars, r)
+	}
+	return nil
+}
+
+// withMethod returns a shallow copy of r with the given method, sharing the
+// values stored for r by the mux.
+func withMethod(r *http.Request, method string) *http.Request {
+	copied := *r
+	copied.Method = method
+	shareVars(r, &copied)
+	return &copied
+}
+
+// historyEndpoint exposes the history of the resources of a changelog.
+type historyEndpoint struct {
+	changelog *Changelog
+}
+
+// Get returns the history of the resource found at the URL of r without its
+// /history suffix.
+func (e *historyEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	entries, err := e.changelog.Store.Entries(strings.TrimSuffix(r.URL.Path, "/history"))
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, NotFound()
+	}
+	return history(entries), nil
+}
+
+// history is a collection of entries, from the oldest to the most recent.
+type history []*HistoryEntry
+
+func (h history) last() *HistoryEntry {
+	return h[len(h)-1]
+}
+
+// ETag implements the Resource interface.
+func (h history) ETag() string {
+	return h.last().Date.Format(time.RFC3339Nano)
+}
+
+// LastModified implements the Resource interface.
+func (h history) LastModified() time.Time {
+	return h.last().Date
+}
+
+// TTL implements the Resource interface.
+func (h history) TTL() time.Duration {
+	return 0
+}
+
+// Units implements the Ranger interface.
+func (h history) Units() []string {
+	return []string{"entries"}
+}
+
+// Count implements the Ranger interface.
+func (h history) Count() uint64 {
+	return uint64(len(h))
+}
+
+// Range implements the Ranger interface.
+func (h history) Range(rg *Range) (*ContentRange, Resource, error) {
+	return &ContentRange{rg, h.Count()}, h[rg.From : rg.To+1], nil
+}
+
+// MarshalRST implements the Marshaler interface.
+func (h history) MarshalRST(r *http.Request) (string, []byte, error) {
+	return MarshalResource([]*HistoryEntry(h), r)
+}
@@ -0,0 +1,139 @@
+package rst
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type versionedDocument struct {
+	Version int `json:"version"`
+}
+
+func (d *versionedDocument) ETag() string {
+	return fmt.Sprintf("v%d", d.Version)
+}
+
+func (d *versionedDocument) LastModified() time.Time {
+	return testTimeReference
+}
+
+func (d *versionedDocument) TTL() time.Duration {
+	return 0
+}
+
+type versionedEndpoint struct {
+	doc *versionedDocument
+}
+
+func (e *versionedEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	if e.doc == nil {
+		return nil, NotFound()
+	}
+	return e.doc, nil
+}
+
+func (e *versionedEndpoint) Put(vars RouteVars, r *http.Request) (Resource, error) {
+	if e.doc == nil {
+		e.doc = &versionedDocument{}
+	}
+	e.doc.Version++
+	return e.doc, nil
+}
+
+func (e *versionedEndpoint) Delete(vars RouteVars, r *http.Request) error {
+	if e.doc == nil {
+		return NotFound()
+	}
+	e.doc = nil
+	return nil
+}
+
+func TestChangelog(t *testing.T) {
+	mux := NewMux()
+	mux.HandleChangelog("/docs/{id}", &versionedEndpoint{}, &Changelog{
+		Store: NewHistoryStore(),
+		Principal: func(r *http.Request) string {
+			return r.Header.Get("X-User")
+		},
+	})
+
+	var test = func(method, path string, header http.Header, expected int) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(method, "http://www.example.com"+path, nil)
+		if header != nil {
+			r.Header = header
+		}
+		r.Header.Set("X-User", "francis")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != expected {
+			t.Fatalf("%s %s: Got: %d Wanted: %d", method, path, w.Code, expected)
+		}
+		return w
+	}
+
+	test(Get, "/docs/1/history", nil, http.StatusNotFound)
+	test(Put, "/docs/1", nil, http.StatusOK)
+	test(Put, "/docs/1", nil, http.StatusOK)
+	test(Delete, "/docs/1", nil, http.StatusNoContent)
+	test(Delete, "/docs/1", nil, http.StatusNotFound)
+	test(Post, "/docs/1", nil, http.StatusMethodNotAllowed)
+
+	var entries []*HistoryEntry
+	w := test(Get, "/docs/1/history", nil, http.StatusOK)
+	if err := json.Unmarshal(w.Body.Bytes(), &entries); err != nil {
+		t.Fatal(err)
+	}
+	expected := []HistoryEntry{
+		{Method: Put, Principal: "francis", ETag: "v1"},
+		{Method: Put, Principal: "francis", PreviousETag: "v1", ETag: "v2"},
+		{Method: Delete, Principal: "francis", PreviousETag: "v2"},
+	}
+	if len(entries) != len(expected) {
+		t.Fatal("Got:", len(entries), "entries Wanted:", len(expected))
+	}
+	for i, entry := range entries {
+		if entry.Method != expected[i].Method || entry.Principal != expected[i].Principal || entry.PreviousETag != expected[i].PreviousETag || entry.ETag != expected[i].ETag {
+			t.Errorf("entry %d: Got: %+v Wanted: %+v", i, *entry, expected[i])
+		}
+	}
+
+	header := http.Header{"Range": []string{"entries=1-"}}
+	w = test(Get, "/docs/1/history", header, http.StatusPartialContent)
+	if cr := w.Header().Get("Content-Range"); cr != "entries 1-2/3" {
+		t.Fatal("Got:", cr, "Wanted: entries 1-2/3")
+	}
+
+	if allowed := test(Options, "/docs/1", nil, http.StatusNoContent).Header().Get("Allow"); allowed != strings.Join([]string{Head, Get, Put, Delete}, ", ") {
+		t.Fatal("Got:", allowed)
+	}
+}
+
+func TestChangelogMethodHandler(t *testing.T) {
+	endpoint := &purgingEndpoint{}
+	mux := NewMux()
+	mux.HandleChangelog("/cache/{id}", endpoint, &Changelog{Store: NewHistoryStore()})
+
+	var test = func(method string, status int) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(method, "http://example.com/cache/1", nil)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Fatalf("%s: Got: %d Wanted: %d", method, w.Code, status)
+		}
+		return w
+	}
+
+	test("PURGE", http.StatusNoContent)
+	if len(endpoint.purged) != 1 || endpoint.purged[0] != "1" {
+		t.Errorf("Got: %v Wanted: [1]", endpoint.purged)
+	}
+	expected := strings.Join([]string{Head, Get, "PURGE", "REPORT"}, ", ")
+	if allow := test("MKCOL", http.StatusMethodNotAllowed).Header().Get("Allow"); allow != expected {
+		t.Errorf("Allow: Got: %s Wanted: %s", allow, expected)
+	}
+}
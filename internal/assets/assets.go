@@ -1,783 +0,0 @@
-package assets
-
-import (
-	"bytes"
-	"compress/gzip"
-	"io/ioutil"
-	"net/http"
-	"os"
-	"path"
-	"sync"
-	"time"
-)
-
-type _esc_localFS struct{}
-
-var _esc_local _esc_localFS
-
-type _esc_staticFS struct{}
-
-var _esc_static _esc_staticFS
-
-type _esc_file struct {
-	compressed string
-	size       int64
-	local      string
-	isDir      bool
-
-	data []byte
-	once sync.Once
-	name string
-}
-
-func (_esc_localFS) Open(name string) (http.File, error) {
-	f, present := _esc_data[name]
-	if !present {
-		return nil, os.ErrNotExist
-	}
-	return os.Open(f.local)
-}
-
-func (_esc_staticFS) Open(name string) (http.File, error) {
-	f, present := _esc_data[path.Clean(name)]
-	if !present {
-		return nil, os.ErrNotExist
-	}
-	var err error
-	f.once.Do(func() {
-		f.name = path.Base(name)
-		if f.size == 0 {
-			return
-		}
-		var gr *gzip.Reader
-		gr, err = gzip.NewReader(bytes.NewBufferString(f.compressed))
-		if err != nil {
-			return
-		}
-		f.data, err = ioutil.ReadAll(gr)
-	})
-	if err != nil {
-		return nil, err
-	}
-	return f.File()
-}
-
-func (f *_esc_file) File() (http.File, error) {
-	type httpFile struct {
-		*bytes.Reader
-		*_esc_file
-	}
-	return &httpFile{
-		Reader:    bytes.NewReader(f.data),
-		_esc_file: f,
-	}, nil
-}
-
-func (f *_esc_file) Close() error {
-	return nil
-}
-
-func (f *_esc_file) Readdir(count int) ([]os.FileInfo, error) {
-	return nil, nil
-}
-
-func (f *_esc_file) Stat() (os.FileInfo, error) {
-	return f, nil
-}
-
-func (f *_esc_file) Name() string {
-	return f.name
-}
-
-func (f *_esc_file) Size() int64 {
-	return f.size
-}
-
-func (f *_esc_file) Mode() os.FileMode {
-	return 0
-}
-
-func (f *_esc_file) ModTime() time.Time {
-	return time.Time{}
-}
-
-func (f *_esc_file) IsDir() bool {
-	return f.isDir
-}
-
-func (f *_esc_file) Sys() interface{} {
-	return f
-}
-
-// FS returns a http.Filesystem for the embedded assets. If useLocal is true,
-// the filesystem's contents are instead used.
-func FS(useLocal bool) http.FileSystem {
-	if useLocal {
-		return _esc_local
-	}
-	return _esc_static
-}
-
-var _esc_data = map[string]*_esc_file{
-
-	"/internal/assets/error.html": {
-		local: "internal/assets/error.html",
-		size:  42463,
-		compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\xe4]ݓ۸\x91\u007f\u07ff\x82\x99M.\xeb\xb5ġ\xbeG\x92=\x95\xdc&W٪l.\x95\xf8\x1e\xae6~\xa0HHbL\x91\nI\xcdث\xd2\xff~\xf8F㋤4㼜\x9d\xac%\xf4\x0f\x8dFw\x03݄\x00\xf0ݯ\xfe\xf0\xdf?|\xf8\u07ff\xfe1\xd87\x87\xfc\xf1\x9b" +
-			"w\xe4\x9f \x8f\x8b\xdd\xfb;T\xdc=~\x13\xe0?\xef\xf6(N\xd9G\xfa\xb5ɚ\x1c=\x9e\xcfA\xf8\xf7&nN\xf5\a\xf4\xb9\t.\x97w\xf7\x8c\xa0\x80\a\xd4\xc4A\x11\x1f\xd0\xfb\xbb\xa7\f=\x1f˪\xb9\v\x92\xb2hPѼ\xbf{\xce\xd2f\xff>EOY\x82\x86\xf4\xcb Ȋ\xac\xc9\xe2|X'q\x8eޏ\xee\xdc" +
-			"̪rS65`U\x94Y\x91\xa2σ\xa0(\xb7e\x9e\x97ϰb\xdd|\xc9Q\xd0|9\xe2\x9a\r\x16\xf5>\xa9k@'\u007f\xee\xbf\xff\x95\xf6\xfd\xfb\xe0?K\xdcBS\xc5\xc7\xe0i\x12N\xc2Q\xf0ݾi\x8e\xab\xfb\xfb\x1dj6\x82\x16&\xe5\xe1\x8dQ\xf1\x87\xf2\xf8\xa5\xcav\xfb&\x18G\xa3\xd1\x10\xffg\x16|x\xce" +
-			"\x9a\x06U\x83\xe0\xc7\"\t\r\xfc\x9fq\xef\x8b\x1a\xa5\xc1\t\xf7\xa0\n~\xfa\xf1\x03k\xaa&me\xcd\xfe\xb4!\xad\xdc7ϛ\xfa^6|\xbf\xc9\xcb\xcd\xfd!\xae1\xd7\xfb?\xff\xf8\xc3\x1f\xff\xf2\xf7?\x1a\x82\xdck_\x89Y\xcf[\xac\xae\xe16>d\xf9\x97U\x1d\x17\xf5\xb0FU\xb6]\x0f\x0f\xf5\x90\xe8eXg\xbf\xa0a\x9c" +
-			"\xfe\xf3T7\xabQ\x14\xfdf=|F\x9bOY\xe3\xa6^6e\xfa\xe5|\x88\xab]V\xac\xa2K\\5Y\x92\xa3A\\g)\x1a\xa4\xd8XY^\x0f\xb6\xd9.\x89\x8fMV\x16\xe4\xe3\xa9B\x83-\xee\x03V\x05q)\xf2Ϯ*O\xc7\xc1!Ί\xc1\x01\x15\xa7A\x11?\rj\x94\xd0\x1a\xf5\xe9\x80\xd9\u007f9\xa7Y}\xcc\xe3/" +
-			"+\xdc\xe9\xe4\xd3%>\xa5Y9H\xe2\xe2)\xae\aǪ\xdcU\xa8\xae\aO\xb8\xd5R\"\xb3\"\xcf\n4\xa4\x15\xd6O\x88\x88\x86\x9d*γ]\xb1\xda\xc45\"T\xc6hU\x94\xcdw?\x13G\xaaʼ\xfe\xf8F\xb2(\xca\x02\xad\xf7\x88X\x12\xf7\xee\xe7}\x96\xa6\xa8\xf88h\xd0\x01\x93\x1b\xa4\xe1.\xf1y\x13'\x9fH_\x8a" +
-			"t\x98\x94yY\xad\xb0\x99\x8a\xfa\x18W\xd8?/\xf1*\xc6=z\xc2\xcaY\xedK,ι<5D\x04\xa2\xb6ͦ\xfa\x99\x8e\x9b\x8f\xe7MYa\x9d\f\xb1o7\xe5a5:~\x0eR\xfc\x11\xa5\x97\xcd\x00[\xbd,v̂\xcfL\xa8M\x99\xa7\x97t[\xb0B\xea䫬\xc1}L.\xfb\x11/\xc4&[\x8d\xd1a-\xac\x14" +
-			"\xce\x17\xe8\x10D\x17\xfc\xfd\x13\x10y\xf5\xedv\x1b\xad\x99\xdc\xdfFQt\xa9\x0fq\x9e\x03\x1e\x0f\xd8\xdc\xf5\t\x8bq:\x82\xd2\xc5\xec7k\xaag\xa1\xa6\xf5\xb1\xac3b\xbaU\x85\xb0\x92p\x8f\xbd\xca'\x9c\x9a\xf2\xb8\x1aF\xe1\f\x1d\b\xf33\xef7.\x19\x93\xa2\xec\xb0\xe3\x1a\xc1j\xaa\x9fv\xd4R\xab\n\xbbϛ3Q\xe2\x16\x0f" +
-			"\xf3\x153˅\xf9\x96p\xc6\x11\xee\xe34:~\xbe\xec\xab\xf3\xf0P\xfe\x825\xfa\x99H\x9c\x15\xbb\x15\x9f2H\x91to\x0f\xd9S,}\xe2\x88[\x94\x82ħ\xa6\xbc$%v\xfdO\x9b\x14\xbb%\x1a\xd4\xf1\xe1\xa8\r\xb9CY\x94\xd8#\x124\b\xe4ǵ\xd2&\x96\xfa\xb29a\x15\x14\x83\xac8\x9e\x9aAyl\xd8\xe8\xc0*\xc3" +
-			"#b@F!\xf6\xa7\xf8\xcc\f\x95\x15{<|\x1b\xcaA~\x91ÑqR\xf2=eu\xb6ɑh\x81\xb1<ӁM=u[V\a\xe6\xcb\x1cA\x03\x01\x15\xe4g6u\xb2\xf2\xbb\x8f\x03X\x88\xc7\x1ej\x8c2l\xcbC\x86\v\xcfB\xbf\xf1\xf1\x88b\xdcH\x82V\x8c\xc9:9U5\xee\xc2\x11O\xdbx2\xe0M\xfe\x8c\x87" +
-			"T\x8ceL?\xc2\xc6e\xe1\x99WJ\xd16>\xe5\r\xaf\xb4ZQ\vo\xcb\xe4T\x0f\xb3\xa2\xc0s\n\xadg\x97KgZ\x1f\xe34%V\x8d.\x14z\x86>\\`=\xc4\xf9\x05\xf6'٣\xe4\x13\xb6\xbc\xd9\xf5\x18O\x1f\xa0\x97\xc0]\xe4@\xc6>f\xf8\x1f \xb9K\r\xe1xc\xc5\xe9\xb0A\xd5\xddG\xdc-\xde\x1c\xedӰ" +
-			">f\xc5\x10z\x8d\x17\x8f\xa7\x1c\x1d\u007f\xe6=\xa6\x9e\xab\xd9\x0f\x1b+ٻ\xedG\x1cf\x9b\xa1<\xb5:\xf6\xe2\x81\xe5\x94A\xc9\xcfJ\x86\t\x11#wu\xd9[%EIY\xc5dZr\xf5\x88\xfa<\xed\x12\xf6d\xe1#d\xee\xad\xcb<K\x83o\x93\x88\xfc\x95\x03+\x18\x1f\x81\x8d\xc2Ɍ̧\xe1|\xcc\xfe]\x90\x89+G;" +
-			"T\xa4.w\x93#X\x9f6\xc4@\xb7\xa7\xf7\x86\xb8\xbe\x88\vx\xd8\xe7\xf1\xb1F+\xf1a\xcd\td&\xe1\r\xa4\x83f\u007fV\r~\xff\xca\xdey\xf9~\xb5Ax\xaa@\x83\xefW\xf1\x16;\xd4k\xf3Wy\n\x9b\x13\xf1,\xae\xb2\x90\xf88\xdcc\xdd\xe4D?<\xcaV\xbbM\xfc]4\xa0\u007f߰\x8c\x04N\xb9w\u007fB\xf9\x13" +
-			"\"\x01(\xf8\v:\xa1\xbb\x81\xfc>\xf8}\x85\xf3\xcc\x01H\x83@\xabS\xdc*\x9c\x14F\xe1t\xfc0[\x8c\xa6\x13\x11$'\x93\xc9\xda\n\xf98\x8an\x99\x17\x0f\xb4iV\xcd\xdcP68\u007f\xb3vE\tl\x9a\x97]Ĭ\x8f[^ěŚ\xce\xdcʳy\x1a\xc2\xd2\v\x9cf\xd0yOT\x19Of\xe3EbU\xa1\t'" +
-			"˄8^\xa4%\xcd>+x\xee\xb1\x16e3<$\x88\xb7\x06\xc2\x1clj\xad\xb0\x01Y\xf7\x05rXn\xb7x(\xad\x86x\xa4\x18\x819\xa2A\xddH\t\x0e8\x84\xe3\xc0\x14b\xd2\x10\x87\x93cY\xd48qГ><\xfc>\xb3G\x04\x96\x98©\x8bU$f@b\xd0\r\xc9\xdc|\xaaWs,\x01%\xe34\xfa\xb0)p" +
-			"F*\xc7F\x8b\x89\x9dv]\xdb3C\x9a\xa6k\xbd\xbd)\xf4V\x12UY2\x84s\xa9 \x1c\xd7\x01\xc2\xc9\x0f\x9e\xb7\xc9T\xbc\x1e\x96]\x88\x0e\xb23\xd5\xedRS\x92U\x89\x9aP\xb8\xd43\x9c\xdc\xe1L\x89\xd9hH\x92\xb21\x19v\xfc;\xcf\xcah\x91\x9c\xd28\x03\x82\x05\nA\b\x9b\xb1\xae\x86e\x91\u007f9\xcbT0\xde`2" +
-			"\x0e>k.\xd8Q\xa6P#\xd9\xcaj8\x82\xd3\xea\xda\xc8\xef\xd6I\x9e\x1dqN\x994x\xac\a\xfc\u007fo\xa48\xb2Q\xe6\x93d\xce\x14\t\xb7\x83\xc2\\]\x8aW\xe3G\xd8,\xe1\xc2\x11MA\xad\xc9i\u007fm&RL$\xaa\xd9\xfdh\xb0\x1f\x0f\xf6\x93\xc1~:\xd8\xcf\x06\xfb\xf9 \xc4E!.\vqa\x88KC\\\x1c\xee\xe7" +
-			"\xfe\xe1\xcf\xe7\xfcY\x14\x19N9Zk\xc9\x1en+\xa0\xb99nQ|\x98\x88\x0fS\xf1a&>\xcc\xf9\x87PV\ve\xbdPV\fe\xcdPV\re]\\5\x94M\x86\xb2\xcdP6\x1a\xcaVC\xd9l\xa8\xda\rUáj9TM\x87\xaa\xedP5\x1e\x82G\x90g\x98\x9a\xe9\n\x12\xf3\xf1b\xb1\xb8P\xa5S[\x84\xcc\x1e" +
-			"\xb8\xa1\x0e\xaf\x1eѧ\x84\x91\xa5&\xa0%K\xcfJq\xb0w\x0e-\x85.\x85\xa9\u038307\x9f\xe1\x118\xa5\xaeB=\x85;\xd1\x1cJ?\xf2I?\xb5\xcc\b\xac\xe8p\x85y`\x9a.tY1t\x1btnK\xbf \xd2S݃\xc2\t\x99{\x99)`)\x95\x98Y\x06<\xa1Ni?\x88\x1c0\xf6?\x90R\xaa\x8e\xb3\x1e\x9b" +
-			"/\\;\xa0\x94Ě\xa3\f3A\x14P݄9\x8aӳc\"\x035\xe7\xe2+\xf7\xb2\x895\x06\xa7\x97\xdf\x1dP\x9a\xc5\xc1w\ăM\x14\x8b9\x96\xee͙5\x00z\x82\xa7\xb1˅\xeb\xcaz\x88\xc6z\"\x0fރ\xd0x\xfc\x96a&\xd9>\xa0\x89\x9c\x06C\xfc\xe4~\ti\xd4\xceі?\xac\xb1\x98I\xbes\x12]" +
-			"k\x824Z\xc0\x89\t\"\xcfW\x90\xcaJ8\x99\xac\xe5d\xdb/\x90\u038b8\xa0(\x9f\xab\xf8x~\xdeg\r\xa2i&ɗI\x91\x90\xab|FU\x82\xa3\x92\xf9$)\t\x1cx\xc2\t\xb7\x13(\tB\xe2\xf8H\x171~\xb1\x90\x8a¡\a\x1cT\xd23\x98\x00X\xf1\xb1\xca誑\x96/]b\x8dȗaD~\xf40\x8f" +
-			"\x96\x11\xaf^\x9f\x92\x04\xd52w\x9a$\x8b\xf9$\x15\xd59Ѩ\xbe\x99M\xc7\t\xaf\x9e\x15\xdbR\xd6\x1d-\xa2\x87\xad\xa8K(F\xc5\xe9l<_\xf2\x8a\xcfqU`\xab\v\xdaC<O'\x1bQ\x97\x13\xf5\xea\xf3\xf9l$\xdbM\xe3b\xa7H\xf1r:\x9d\x8eEmF\xd3+?L'\xb3\xc9\xf4\x12nv\xa6\xc2h\xb2c\xf9\xa6" +
-			"T\xa3\xaa\xc0\x19\xdaX\xa1O\f\x15ڴA\xe9v\x1b\xa5\x0f\x8c\xa1\xaeV\x1b\x9b\x8c\xd0x3\xa1\f\xa9~\x1dܖ(\xddr\xf1\x80\xa2m`\xbc\xc5PDY\t\x8d\xfb\x06\"㦫ށ]\xa0d3\xa3\f\xb9\r\x1c\x981\xceM\x11\xe3\xa7\x19Æ\xa2\xe9f\xb9\xc1>q\x8cwd\n\"\x8b\xa4\"g\x153\xd8R\xa5M" +
-			"d\x95\vOv 9\x83\xab\x87 -;\xe5\x832\x87\xe1$rŒS\x1eP \xf9/\xfe\\\xd2Ϫ\x1e\x87b\xcb\xe6Y\x8d\xc7tA\x17\x1dS)\x1f\x99\x95Vd\xf6\xac\xc5z$}*ah\x96\xa7v`\x85P\x94:\x9c\xd1)\\U~\xcc3\xf7\n\xaf\xc6t\xa62I69\x92\x92K\xda\xda{\xa2\xc0K\xda\f\xd2\xf4" +
-			"\xec~(\xc0D\xc7⫌-\xac7-q\"͇\xfb\xb2\xca~\xc1,\xe2< \xcc\xf22n\xe8<.\xf2\xe291\"\xce\xce\xe3\x8a\x15\x9bS\xba\x95\x14S\x80,D9NH\xeb\xac^\xbb&k\xa3y]\xee\xd1\x03\xe9=\\\x89\x1e\xd0\xcfi\xdc\xc4C\\\v\x03\xf1\xc3\x1a_\xa3\xe6\x8bp{\x94\x1f\x1d\x1eǞ\x19\x036\x1b" +
-			"\xf3\xdfr\xb2\xfa\x00\x82\xe0\x12?\x96xC\x00\xb5\xe6\xbfNe#\xfd\x84\xfae\x0027\x1a\xdc\xcd\x18\xbe\bgj\x00\b'\x80\xee\xaf\x18\a\xc7U\x1ec\x8fJ\xf6Y\x9e\x0e@\xf9)\xf7\x10JH\xb0\x86\x02\x00\xf2_6@\tK\x04@\x01\xcf\t\xf4g[m\x99\xbdc݁(\xd6jR\xacɘ-;\xcaCH8\xf3շ" +
-			"\xd5o\xff1\x8eF\xd3\xe0\x1fQ\xf4\xfb\xe8\xb7x*\x93x\xfc@\x8e\xfd\xab\x86,\xc2\xe3)\xcfy֡\x0f\xb3\x11\x1cy||\x8b'M1\x0e\x81Q4{E\x96\xbb\xbb\xc4\xf0\xf6\x17\be`\\\\<ʁL4\x88\x8bG\u0603\x89G\xd9N\r\v\xb1\xe9\xaaZ{\xcf\x18\xc4߱6\x16\x10\xd1ҭ6\x16\x10\x02<\x88\xf8" +
-			"N@\xfd\xe8\xb7\x17\xec\x03\xe4G9\u007f\xe6\xcd'|\xfbyNM\xb8\xed\xbf\xa5\xfc\x84\x8a\xbc\x1c\xfcT\x16qR\x0e~(\v\xecVq=\xb8\xfb\xa1<U\x19\xaa\x82\xbf\xa0绁\xfc\x91\x85\xf2\x923\n~T\b\xa6\xda\xfcA\xe6$\x91i,Ƴ)r-\x01-\xb7\xe3\xed\xd4^\xef\xb9`\x11\xfb\xb1\xf6\xe5U\x13\x83\xe9\xe4h" +
-			",\xa0\xef\xe3\x14\xcf\xefYQ\xa3\x06\xcf}d\xa5\x04\xff\x03\x17>\xc3\xf1\xecͺ?\x94\x88\x1c@\xb1#8\x97\x92u#3ҹ\xe4\xa1\xe1\xda\xf8N\u007f\x12\xd3'7\xd1ƒN\xd1\xc6\x03\x1alw\xe2_\x90{\xc6\xfa\x19n*\x14\u007fZ\xd1\xff\xe2i\"g\x85$\xba\xf12\xf2\xbdcuvF\xfe:\x16\xf2\x92$q\x18\x16\xf7" +
-			"%\xd0\x1c'r,\xd5\xea?\xc5\xc1Ћ\xabS\xf1lA\xc0/\xc3F\xb38\xb3\"\xd5\xea\xa4\"+\xfcd\xe9\x9f,\xe8q\x85LH\xb2'\x93\x80\xe1\x97\x15\x83]B2\x06\xe3\x8c\xfc\xc2\xc5\xc7[e\xae_\xb19\x96\x16h\xf3\xf3\xc8ΕHQK\x1e\xa3\xda\xe2\xe53\x9a;\xd8\x15\x96˱\xb3\xc2r\xe1\xa90\x1aG\x91\xb3\xc6" +
-			"hĪ(\xc2p\x9b\x9f\xb2\xf4\xd5z\x1bV峖\x10\rG\xcaW9pȐ\u0600\xc3\xcf\xf5p4\b\xe8\xc7\xfa ?\x1eR\xf91\xdfɏ\x18;Vر\u008e\x15v\xac\xb0\x13\x85\x9d(\xecDa'\n;Uة\xc2N\x15v\xaa\xb03\x85\x9d)\xecLag\n;Wع\xc2\xce\x15v\xae\xb0\v\x85](\xec" +
-			"Ba\x17\n\xfb\xa0\xb0\x0f\n\xfb\xa0\xb0\x0f\n\xbbTإ\xc2.\x15v\xa9\xb0\xa3\b\x18#\x02\u0588\x809\"\x80\x87ƃփ\xe6\x03\xf6\x1b\x01\x03\x8e\x80\x05G\xc0\x84\xa3\xf1\xd9\xde\xf9@\\\x1b,t\xf7sE\xc3\xc1\x80\xff\x00\xf7\x00\xd6\a\xc6\x05\xb6\x03\xa6\x01\x9a\a\x8a\x85z\x83:\xa1\xfd\x05\xcf&\x17P\xaa~WP\xa5#" +
-			"1\xaaG\xe1\x9c\xfdY\x00jĩ\x0f\x93p\xc2\xff(\xeaR\xce \xaa쁗\xcd\xe7\x0ev\vN\x9c=8\xb8\xcd\x05\x11H7\xe3eS\x97pSN\x9c\xb8d\x9bp\xe2\x18\xc8&\x15\xe0\x92M\xe8\xc1%\x1aM\x9d\xb0\xfe\xb8\x99\xa1\xfe\x18i\xc4IN%2H\xc4!NMRȒ#\xa0:)\xe1\x81\x13\x9c:\xa5\x88\x05G" +
-			"8\x15K\x11s\x810e\x9fq\x82S\xc5\x141\xe5\b\xa7\x9e)b\xc2\x11cSr\xa92\xaf\xe4Bs^\xc1\x85\xde\xd8/`\x92R\xef\x89A\xd8H\xd4\xedA(#F\xf1\x98\x83 \"\x86\xf0X\x03#\x96\f\xa0\x1b\x03\x97?\xb0r\x8f-0`\xc1\x00\x1eS`\xc0\x9c\x03L\xa9g\xac\xdcc\b\f\x982\x80\xc7\x0e\x180a\x80" +
-			"\xb1)\xb3P\x94Wf\xae/\xaf\xc8\\[\x9a\r\xd8o\xc4\xc4\n\xdaJ\x044\x86\x80\x8c4\x88\xd3*\x02\x1aiP\xa7y8t\xa9!\xa1\x9d8\xe0A\x038\rƑ\v\r\xe9\xb4\x1cG\xceu\xa4\xddי\x06pڒ#\xa7\x1a\xd2iT\x8e\x9chȱ\xddS\xc3\x04-=\xd5-\xd1\xd2Ѩ\xf7\xba\x98\x91E\x81$\t\xe4@" +
-			" \xc5\x01\x19\fHP@\xfe\x01\xd2\v\x90=\xc0\xdc\x00\xc6}\x1aӭx\xc7J\xcdxG\xeby\xe3\x1dm\xc1\x1b\xef\x88(f\xbc#\x92z\xe3\x1d\xe9\x917ޑ\x9e\x9b\xf1\x8e(\xc6\x1b\xef\x88\x02\xbd\xf1\x8e(ڌw\xc4\x0e\xdexG\xba\xea\x8bw\x98\xe6\x8bw\x92\xe4\x8fw\x12\xe2\x8fw\x02b\xc5;A\xf0\xc7;\x81\xf0\xc7;" +
-			"\x81\xb0\xe2\x9d \xf8\xe3\x9d@\xf8\xe3\x9d@X\xf1N\x10\xfc\xf1N\xea\xc5\x17\xef\x04\xc0\x8ew\x94\xe2\x8cw\x92\xe2\x8dw\x12\xe1\x8dw\x02a\xc6;Q\xee\x8dw\x02\xe0\x8dw\x02`\xc6;Q\xee\x8dw\x02\xe0\x8dw\x02`\xc6;Q\xee\x8dwR\x1d\x9ex'\xe8V\xbcÄ\xaex\a ]\xf1\x0e@\xbb❂z\xe2\x9d\x02t\xc5" +
-			";\x85\xec\x8aw\n\xe9\x89w\n\xd0\x15\xef\x14\xb2+\xde)\xa4'\xde)@W\xbc\x03\xfam\x8fw\nhƻ\xd6\xf5\x10m\xad\x00,\x05\x80'}\xf0 \x0f\x9e\xd3\xc1c8x\xca\x06\x0f\xd1\xe0\x19\x19>\x00Ç[\xfa\xe0j\x05<Vj\x06<Z\xcf\x1b\xf0h\vހGD1\x03\x1e\x91\xd4\x1b\xf0H\x8f\xbc\x01\x8f\xf4\xdc\f" +
-			"xD1ހG\x14\xe8\rxD\xd1f\xc0#v\xf0\x06<\xd2U_\xc0\xc34_\xc0\x93$\u007f\xc0\x93\x10\u007f\xc0\x13\x10+\xe0\t\x82?\xe0\t\x84?\xe0\t\x84\x15\xf0\x04\xc1\x1f\xf0\x04\xc2\x1f\xf0\x04\xc2\nx\x82\xe0\x0fxR/\xbe\x80'\x00v\xc0\xa3\x14g\xc0\x93\x14o\xc0\x93\bo\xc0\x13\b3\xe0\x89ro\xc0\x13\x00o\xc0\x13" +
-			"\x003\xe0\x89ro\xc0\x13\x00o\xc0\x13\x003\xe0\x89ro\xc0\x93\xea\xf0\x04<A\xb7\x02\x1e&t\x05<\x00\xe9\nx\x00\xda\x15\xf0\x14\xd4\x13\xf0\x14\xa0+\xe0)dW\xc0SHO\xc0S\x80\xae\x80\xa7\x90]\x01O!=\x01O\x01\xba\x02\x1e\xd0o{\xc0S\xc0\x1e\x01\x0f\xac\xe7kK\xe2`\xc5\x1b,h\x83\xf5j\xb0\x1c\rV\x9b" +
-			"\xc1b2X+\x06K\xc1p\x99\x17.\xe1\xb2\xe5Y3\xe2\xb1R3\xe2\xd1zވG[\xf0F<\"\x8a\x19\xf1\x88\xa4ވGz\xe4\x8dx\xa4\xe7f\xc4#\x8a\xf1F<\xa2@o\xc4#\x8a6#\x1e\xb1\x837⑮\xfa\"\x1e\xa6\xf9\"\x9e$\xf9#\x9e\x84\xf8#\x9e\x80X\x11O\x10\xfc\x11O \xfc\x11O \xac\x88'\b" +
-			"\xfe\x88'\x10\xfe\x88'\x10V\xc4\x13\x04\u007fēz\xf1E<\x01\xb0#\x1e\xa58#\x9e\xa4x#\x9eDx#\x9e@\x98\x11O\x94{#\x9e\x00x#\x9e\x00\x98\x11O\x94{#\x9e\x00x#\x9e\x00\x98\x11O\x94{#\x9eT\x87'\xe2\t\xba\x15\xf10\xa1+\xe2\x01HW\xc4\x03Ю\x88\xa7\xa0\x9e\x88\xa7\x00]\x11O!\xbb\"\x9e" +
-			"Bz\"\x9e\x02tE<\x85\xec\x8ax\n\xe9\x89x\n\xd0\x15\xf1\x80~\xdb#\x9e\x02Z\x11\x8f\x1f\xcdk;\xf6\xcdO\xbe\xcbmRd\xe7\xe1\x03\xf8\xe1\x8f\xef\x8b!E\x89\xdc\u07b56\xb7y7{\xc7\xceo\xda88\xe9c\x1c\xfcq\xecndu\x1e\x1bz\x97CS\xe1\x0f\x03QD\x8e\xcd\x19Ed\xab\x91Q$+\xa6v\xc5\xd4" +
-			"\xae\xa8\xb6\x97<\xf8wv\x18G\xc1\xb0\x82<G\x8b\xd24u\xf4\xc0<J\xc6\xfak\xecK\x1c;\xb9pۼ\x15\xdcV۬\x12\x9b\xfc@\xaf\xb1]\xe8\xd1\xcc.\x1c%\xeb4/\xcb֖Ӟ-\xa7\xfd[\x96\xa7\xe2\xe8\xce\xd7\v4\xde[\xfa_Hwj+\b=\xdeNO<2\".)Rz\x93\x85\xc3\xc7 \xd1\xf26" +
-			"H\xb4\xfc\xce\xc96mc\xeb\":\xbcr&\xc7Đu_\x9e\x1et\xfb\x9dD\xb9\xba\xa7hv\xef\x14\xcd\ue703g\xda\xc2\xd3A\x03={\x05\xe9\x95\x14\xfae\x14|n\x19+\x9d\xd5M\x95\x1d\x81p\xab\xa2\xd93\x87\xfb\xaeL\xd37.WY\x92\xbf\xa2>\xdd\xff\xaej{w\xd7\xd3=[l\xb2\rp\xd9\xcfI\x1e\xd7\xf5\xf7" +
-			"\xef\xef\xc8\xec|\xf7\xd1:\xc6\xc7\xf2|\xba7M\xecC\x13ΐ\x9f\x0e\x05gԤ\x06\x9f\x01/\xdf\xdf\xce\x1f\x91\xadX\xd6T\x19\x8as\x88\u058ciR\x94!M\x8a2\x98\x97\x9bEQ\xae\xe6\xe1Ƌ\x1d\xb3\xb9\x83¹9(&7+\xa88(&\xb7\xbd\xdf\xeeNgQ*\xe2ǝ=\xa8}\x0f\x94\x06\x01\x03\xcc垏" +
-			"\xba*\xdb9\xb9:\x85\x1e\xc8_\x97\x97\xf0\x030.71I\xc0OL\x12p\x14/C\x9b\x04\\\xc5\xc3P\x94\xbb\x9c\xc5A\x12\xf6u\x90,\x86\xb6\xbf8H\x16C\x97r\xf9y\"\xaf\xc7hg\x8c\xfc.\xd3\x03\xa6c:\x9dFWj\a/g\xcf\"\xb4L\xe6.\xb7!'\x9d\\>\xa3\x95\x03\x87\xd1ʁ\xb7\xb8\xf9\xec=|\xf6" +
-			"N>\xb4\xd0\xe5!f\xb9\xb0\xa6Y\xae\xf3\xb1\x1d\xc3,\xd7\xf98\x15\xc7\x0e\x85y]B\x1d\x14\xf3\xfbC\x17\x06\x00:=\x01\xa8\xad\x8d\x8b\xab+\xc9\x14M\xb6\x13\x97\x0f\xf0\xf3i.70I\xc0\x13L\x12p\x06/C\x9b\x04\\\xc2\xc3P\x94\xbb\x1c\xc3A\x126u\x90,\x86\xb6\x878H\x16Cg\xb0a\xc7\xfd\xbc~\xa2\x1d\x01\xf4" +
-			"\xbbJ\x0f\x98\x8e\xe9t\x18]\xa9\x1d\xbc\x9c=\x8b\xb7\xe3$q\xb9\r;\x86\xe8\xf2\x1a\x83\x02\x9cƠ\x00\x9f\xf1q\xb3(\xc0c\xdc\xdcx\xb1\xcb_l\x8a\xb0\xaeM1\xb9\xd9\xcebSLnN\x85\xb2\x93\x9c^W\x81\xa7;\xfd\x9eҍ\xd2 \x9d~\xa2\xa9\xb2\x9d\x933/\xd9$\x89\xf4\x12x\a\x8b<l\xf0\x99\xef\xafW[\x9a" +
-			"\xa30\x1a\xfdF,\xfb\xd7I\x85P\x11\xc4E\x1a|\xa7V\"\x16\xf3\x05\xfd\x01\xc0b\xeb]\xa8\xa0ۢ\xc1\t\a~Б\\P(K\xd9\xd9!RDD\xc2\b\xb2\x92\u008eBl\xe2\xca}M\x8bݵG\xfeDk\x9dl\xf5\x00]\x8fM\x0e\x90\xfd\xf4\xe7\x00ُ\x81mͥ}\x9ak\x03\x81'D\xe79~w=\xeb\xa1" +
-			"د\x1b\xe7\xb3%\\\x82\xf0\n\xe7|n\xbe\xb6\xa6R\xe7\xb55\x95\x8eo\x96\xf6\xea\x9a\xca\x1a\xb0\xe6Y;\vy\xa5\xa6\xc1\xb1\xd5\xeb\x14}]E\xa0\xe7\xeb*\x025\xdf(\xea\xb5\x15\x81\x92\xc1\xd1]\xed,j/%\x8biV1i\x1b\xb4\xb6\x00\xd7Wt\xb5xM\x97\xf5\x8aƍ\xa2\xd1\xe5\x12\xfe\xf3t\xc0\xdf*\xb5*M\xef" +
-			"d\t\xe0\x89'\x8e\x9eDr]Z\x9cos\x84\x0er\x97\x82\xe4\x19\x90k`\xd47r#\x8c\xc6\x00B\x8fg\xc7ܯߤ\xa2\x9dC\x1cG\x11\xa8\xfe\xb8\xaf\xc0\xaa\xa5L\xf8g\xe4/Y\xba/\xd8I\xb1@\xd5\x18\x84\xc6\xf91@s]\x1b\x06\xba\x01\x0f\xd8\xc1evg\xf8\xb3\xb68\xdb\x1a\x9f>\x90s\x997\x88\xa9\x9d!\xa2" +
-			"\xf7\x05\xe8g\x88\xe6\x91.\xb9m\x0ep\xe7Є\x1e\xaa\x8bsT5괽\xed\x06\xf0\xe2/\x15U\xfdg\x18\xc9\xd1I\xc65\xd8O\xf5;\x17\f_` \xf6\xcf0ϊO\xf6\r\v\x8c\xf8x\x1c\xf0\x0f'\xc7]\x14\x1c\xf2\xf6\b\x1b\x9bI)\x86iV\x1f\xb2\x9a\xde\xfd5Ћ\xc8=^\xc6\x19\xf6\x89\xbb\"\xf6\x81\xbc\xac]" +
-			"\xf59\xc5q\xfe\x8b\xdenKn\x8b\xe4'\b\xa9G\xbbT\xd0yI\xcaZ]\x00I\v\xe7d=@\x9eue\x17\xd4\xe8\xac\x02\xe7\xf0H\x96\xec\x1a\x15\x1d\n\r\x90\xe87\xda0J\xeb\x95+\x86l\x9b\x04=l\xe5\xad\\\xfc\x02\x1c\xc0\xc7-X<G#\xa4\xb5甊_\x97\xc3(]\xb7\xb7\x18\x82mc\x92\xe5\n\xc1\xf8\xed:" +
-			":+\xb7l\xdb\x05\x1a\x91\xfb]t\xa8C<q\x1d\x0fw\x91\xf6\xbb`\f\xe9\x88l\xa9T\x1b\xbf\xbdG\xe3\xe4\x16\x8e\xdc\x16\x93D\x06\xd2!\x9b\xb8\xed\xe7\x18\x17\xc8\x1cBl\x80\xf7\xbb\xf3\xb0uػN\x89G\x01\xa9L\xfe\xaf\x9dB\x8f\xf4\x03\xeb~\x14\x17yH\u007f\xa6\x82\xb3\x94 \x90䂸\x81v_\xc8h\xd6r\x1f\x8e\xa3" +
-			"\x0fD\xa3t\x94\xc23\xf8\x80F\xe6[@2\xda\xc6\xcf_Uy\xc4\xfd\xc0S\xac\xf8\x84\xab\xedv92\xc3\x1f\xabG\xafRi\xbb\x8e&2/(\xf3\xb3y\x8c\xddm\xb0\xbb\"\\ji?\x17\xef\xf8\xe1UפWQ\x9c\xec\xd6\xd5#\xbbǇ\xddg-\x8a\x98\xa4\t\xbf\xcd\x16B\xecY\xde\xe2\x12\x80\xcfC\xfcXs\xe8\xc1\xd6" +
-			"\xaa#\x86\x94\xbc\x9a2\x88\x1cG\xf2ͦa\xe6n\xb1\xd4\x1f\b:E\xea\xcdK\xfb\x11\xf7e\x8e\xab\xb5\xaf\x12F\xbby\x98uw\xf7\xa4\x1f'3'}\r\xef\x12#\xf1m\x9b\xad=\xca\xe4\xb6\x17\x97Z\xb1\xdf\xdc\xf5A\xe4\x02su4,\xad\x80\xdf\xec4ݫ<\xcf\x1a\x04\xe4\x16\x88\xd7't\xb4a\xe1\x9cm\x05斔\xf6" +
-			"\xd3\xe8\xb0I\xa7_\x9b\xc2h\x1b\x0f\xecz\xe7\x17\xba\xadŰs\xf7í\x92^\xc9X\a\x92`\xf5Ud\xea`|\xf6\xab\xb0m\xc2x\x81z\x03s\x01\xe4+i\xbb\xb5\x9d\xabt\xf4j\x12\xbf\xa4\x9d+\xfb\xbe\xff7鸥\x9d+\xfb\xfeJ\x12_\xd7N\x8b\xfb\xbf\xd0\xc5\x1dq\xf0kx\xb8\xaf\x99\xab\x1d\xef5\xe4}A3W" +
-			"\xbbݿE\xbf\xfef\xaev\xba\u007f\x8b~\xf7\x8e\xa4\xa9\xc7\xf4\xddG6\xb0Hh\xd5:\xbfJ\xb6or\xe5\xfd\x85\x05ՋE\xbd\x8a\xa9\x06#\x89ݫ\v\xd3\xca\xf4ܪ\xb7\x8e$\xf8V\xb5\xf6\x0ev/\xd0r{\xa0뭟W\x12\xf5\xf66\xae\xe9q\xcf\xf0\xf6\"\xad\xb6\x05\xe3+z\xfc*\xa2^\xd3F\xbb\xa3\xbfē\xbf" +
-			"\xf2t\xd1\x1aѮ\xf2\xb1\xaf<\x95\xf4\x16\xb4\xd3þ\xbaF[b\xeeU\xfe\xf5\xd55\xea\n\xb6]\x93\xb1Z\x99|\xeb|\xce\a\x14 \xa7ށ\xb7\x00\xeb\xed\x1a\x04\x9d[\xceG\xc0\xea}\x12\x8c\xc1\xb55̓\x04\x9a\xbc\xe2\xb7Ȯ\x95\n\xd7\xce\x02'#\xffv\x82\x9e\r\xf4dн\t\xa1w{\xbd\x18to]\xe8\xdd^" +
-			"/\x06\xdd\x1b\x1e\xae\xd7g\x1b\x83\xeem\x12\xd7\xeb\xb3_{\xee\xcd\x15\xb7\xe8\xb3uwF\xb7\xb7\xf6\x98\xafn\xab߹\x8f\xe3\x16W\xedњg\xf3\xc7-\x8eڣ5ώ\x91[\xdc\xf4\x1aM\xde\xd8Z\x9f\xfa\x1d{Snsі\xbd-튵\x8e\x92]\xab\xd9v\x06\xf6v\x96\x9b\xdb\xebŠC\xbc\xfdK\xfbg2\xe8\x10" +
-			"\xef\x9a\xf6\xdc\f\xac};]\xed\x1bۅ\xaen\xbe\xad~\xc7\x1e\xa3\xeb]\xb7gk\x9e\xcdW/\xea\x9bߒ\x9e\x1d[/\xea[\x97\x1d\xc1\x96X\x91\n\x99?\x04\x8b\x1f\xb8\\\xbf\x8a\xb2c\xbd\x80\x1e8\u007f\xdf7\u007fќ\xbak\xbd\xd5+\xcbM46R\xfe\xe6\xee\xee\x9d\x13\xfb\xd6\xfa=\xca\xca{{\xd6\x03?\x12w\xa5\xc2:" +
-			"G\xeb\xc7<\x8f\xc4\fg6\x1c8rp\xfbU;\xa0m\xfe\x9a⳱w\xc7\x02<\x1aJMz_aߛg\x9b\xf2]\xbb\xe8\xba\xf8\x05\xe1&Nw\xe8l\b\xe5z\xb7\x81\x87\x91[\xc7.\xb9\xf8C\x98-\x9axk\x95\xae\v\xfe\x9a*\x1d\xe3Qqۋ\xae\xd6׳\xbdR\xcb}X\x1a\x8a\x16\xa2\xb9Oa;\x19ݪ" +
-			"h]:\xb9Aͱ\x0f\xcd\xc0\xf8ܙ\xeeRs\x88\u07b2í\x9d\xf3\xb5N݃\xa5\xa1n!\x9a\xed\"|˝\x93\xd7ͮ\xad\t\xc8\xf6\xdc9\xb6\xd6A\x80O\xd7tםK\xd7\xfe\x1d{-l\xafTt'?S\xcb\\([\xcb|\xf3\xa0\xcd\xe8V\x15\xeb\xa2\xc9\r\x84\x8e}\x82\x06ƣh\xb6\x8b\xd05\x1e\xfd;" +
-			"\x10\xdb9_\xa9\xeb>,\xcdɚ\x8bf\xc9,\xb6D:yݪq]@\xb1'ұ\xf5Q\x87x\xf4\xcd\xf6E\xba\xf4\xed\xdfS\xd9\xca\xf8Ju\xf7\xe0hj\x9b\vf\xbf\x86\x90o\xf1t\xb1\xbaU\xd9B\xbcg\x94\xe34N\x1d\xd5\x1a\x83\x8d\xe2\xab\xd1ҳû\xff\xebrЄ\xfc\xed\xb7\xffS\xbc\x8a\xa8\xcf.\xd0.," +
-			"\xebY\x00^\x12g\xe5A\xba\vh\fF\x82\xc10W\xbbEɛg\u05ce\xcd\xff\x14W\x1f$ni\xc1\xe8B0}[\xdf6\xfb,_\x14&\v\xf8\x1b\xb6\xcc\xf7\xeeI\xa0E\xe0\x15\xe4\xe6\u007f\xc5R\x96\x98\x10v>\xc0\x06\xf2r\x0e\xaf\xb0f\x05\x84|\xe6\xc5ʙ$\x15\x14\xe9/\xf7\xba\v\xee\xf4\xdb..\xdd\x1d\xb5\xfa\xe3" +
-			"\x91\x1eHiK\xc6Š\xefD\xc4κ\xc7\xed\xd2\xd7ײ\xd7>Z\xaf\x887^\xfec\xbd\x1f\xe8\x02\xdf]\xc7n\xf2`\xaf4\xfbUv8\x96U\x13\x17\r\x87\x10&\xe0\xce@\r\xb0\xcfR\xf5\x92+r\x13\x88F\xad\xf7\xe5\xb3.\x98F\xce\n\xfe\xfe\xf03\xfd7˳F\x1cs\xe4/r\xa5\xec\xc9\xf6\xdfUt\x1f\x05\xf1\xda" +
-			"\xbaV\x8a]\x10\xa5\xbd\x85\xab\xfb\rS\xe4Y\x875\xe3\x13}m\t\xa4\t\x1eo\xb1\xb5\xd5)\a\xfc\x05\xa5\x97ߑc\x98O\x19z&0~\xac3EOY\x82\xd8F\xc9K\xc8{;\xfc\\\x0f\xe4\xe7\xfa\xa0>\x1fR\xf5\x19\x8fK\xafZ\x15\x1ff\xfb\x01,a\xef\x02u\x14\x99\xd8\xfa\xe0(1k\xcb\"\x13{H\x1d%fm" +
-			"Ydb\xf3\x9d\xa3Ĭ-\x8b\f\x0f7\xf5!\xaf۴\x0e\xdb*\x1d\xf8\xbd\x90\x8ea\x17\x92\r\xee\xa6\xf2҆x\x9cj\x9c\xf6\xd0\xc2M\xea\xafH\xeeǁ5{u\xc15ίg\xc2\xdf<\xab\xbf9\xf6V6\x86H\xb0\xd0\xcd\xd28\x0ff\x1e\x92^.GZC8\xe8\xf4\xb4\x1b@Zv3i-vã\x11\xd8ͪ" +
-			"\xe8\xb7\xdb\xd5\x1d\xebo\xcd\xebY_a\xe3[\x99\xdfnyv\xf7\xbb\xd9\xd0h\xb4\\j-\x1dҾ\xa6\aH\xcb\xf4&\xad\xc5\xf4x\xf2\x05\xa6\xb7*\xf60}\xef\x9e\xdd`\xfb\xfe\xbco1\xfe\xb5\xdco\xb7\xbe\xbc\b\xd9\x11\xe7:\xec\f\x90\x96\x9dMZ\x8b\x9d\xf3\x1d\xb4\xb3U\xb1\x87\x9d\x1d}\xb8\xc1\xa2..\xb7\xd8\xce\xcf\xe7j" +
-			"+Y\x13>K}`\xfc2c\xef\xd53\tg\t\xa6\xd6\x1e,;\xfc\x93\xf3\x04c\xb6\aO\xa97^\xbb%\xe1R\x19ױʊ\xa6+\x11a O\x9d\x0e'\xd7\xc1\x96\x9f;\xc8-\xaeN\xd1\xd0\xdb]\xd5-\x87\xd7\xd1\xfdr/W\x97;Ǆ\x017\x9c\xff\x9a\x96\xbaǍ\xb3\xc2\v\xfav\xd3\b㜸õ\xfb\xd2\xe5" +
-			"\x9bo\x02\xf0\xe7ۺ\xc1\xcf5AX\xa1\x04?\xc2\x04\xe1\xf6T$AX\xc4\a\x14\x9c5 \xf9\x03O\x8e\a\xf3(Z\xbb\x11\xfc\xedс\xfe\xa2\xe8\x80\u007f\x1b\x04\xf2\x95\xd1v}\xf9\xa2\xfd\x80\xdfJc!\xe0\xed*\x01\xbb^\xc5\x06\xe9/\xed\x0f\xe4[\xfb-\xa0\xd0R\x00U\xech\x94\x8e\xec\x80^\xa6\xa3\x11\xbb\xb4\x99\xe5^=" +
-			"\xd2s\xa7\xecU\xe1\xa8\xfa\xff\xa1\x89\x90\x0e\x9f\x0e\xbf\xa2\xaf¶ \xda2\x03;c{}\xf3\x1e\xb7\xceQC\x96;\x88&\xc92\x14Y\x16[\u007fcp\x17\x9f\xde\xddӫ\x91\x1e\xbfa_\xe8N\x06\xf6\x99\xfe\x12\xaepi\xf6\x14\xd0kN\xdf\xdf\xc9\xcb!\xee\x1e5\xae\x10#Wl\f\f\xc5\xedG\x02v\x8cw\x88\xae\x82\x12\xe0" +
-			"\xf9\x1c\x84\u007fCq]\x16\xc1\xe5\x82e\x199\xaa\x1e\x1f\xdfդ\xe9\x1dG\xff\xeb\x84\xea&\xfc\t5\xfb2ŵ\xfe\x03\x1d\xea\xe3\x1a\x92\xfeZ\x95M\xe9\xa4\xf0\u007f\xff\xe7o?\xd2\xe68\xdbw\xf7Gg\xb3\xa4\xe6\x0fe\x8a06\x18\x06\xe4\xdbߛ\xb89\xd5\x1f\xb0C\xd2\xfa\xfej\u007f@uRe\xf4\b\xa5\v\xf9\xee\x1e\xeb\r" +
-			"h\xda\xf8J\x94\x9a\xa5L\xa3\xa8h\xee\xba4\x8c[̶T:\xec0\x97\x8bm!\u008c\xbaӝ\xb2B\x81\xf2@[\xc3w\x99\r\x98W[\xbe\xbe{|\xb7\x1f?\xd2\x06\xb1\xd5ƏF\x0f\xfc\xf5\x89\x8b9\x1a\xa2\xe02\x17X\xfa\xdb\xfd\xa9\xa0~\x9az\xe0\xbc\xe3\x15Y\x12\xf7\xf4]\xe3\x9eg\x82;\x1fQ\xecZ\x11\xed\xba\x86" +
-			"\x96\xa6\xcc\xee\x90(\x83\xb5\x80G\\!\x8a\xc8\xd8d.\xfd_\x98HG*\xf52\f\xf1)\xc8\xcb\x1d\x0fv\x83;\x99x\xee\x1e\xffL\xa7\x1f\xdc\x04\xfd\xa0\xd8{\xe4\xc0l\xae\x92\xe3\xdd}\x9e\xb5\xa9\x1b\x15\xa9G\xc7\xef\xee\xcb\xdcc\xd7\xe3\xe3\x87=\n\xd8t\xd6Tx\xae\x0f\xb2:\xe09C\xb0AI|\xaaQ\xf0\x8e\xbcB\xff\xb1" +
-			"\"#\xfb\xf4\x19\x8f\x9f\xcdi\xf7\ue796\x114Y\xf0\xc7c\x9a\x81\x9a\xea\x848-t\x0fB\xbb\x93\x8e\"\xac ܝ\xc0L'䀩\xd8d\xf1\xbaC\x86\xcf@\xaf7h\xbe\xee\xd4\xd8G\xb5\xb4\x98&\xccBj\xf6\x85\xe5\xd0\xf4:A\x9f\xf0\x8d\x1eqlz%X\xb2K\x93\uf08e!Ԥ\x8f\u007f*\x89z\x9b\xb4\x1b\t" +
-			"\xbbOjю\xb7U\xc4\xd4ꕥ\xfd\x1b:\x94\r\n~\x9f\xa6\x15\xaa\xeb\xeb\xe5f\xf5I\xf5\x17J/\xa7\xd2_\u007fB_\x06\xc1\xaf\x9f\xe2\xfc\x84\x82\xd5{\xa0\"\x1a\xb3[\xe7ض\x06\x80\xf4\xa4\x89Nq\x01\x9e\v\xc6D\xba\\H\xffI\xf5Mu\xff(\a\xf1\xcb\xfa\xde6\xafy\x9c\x14\x13\xe8i\b\xd74s]t\x16N" +
-			"\xe3\x8aG\x1f\xf6x\xf6#)S\xf0\x1c\xd7\xc1\x0e\xe1\xe0\x1f7(\r6_pi\xf2\x89\x10Ȝ\xd9:\xbd\x19±\x8f\xacSx\x1ej\x0ex\xde\xfe\xbf\x00\x00\x00\xff\xff\x15:(-ߥ\x00\x00",
-	},
-
-	"/internal/assets/recover.jpg": {
-		local: "internal/assets/recover.jpg",
-		size:  91319,
-		compressed: "\x1f\x8b\b\x00\x00\tn\x88\x00\xff\xec\xfbw\\Sݶ6\f\xaf\x10 \xf4H\xaf\x12\xa4H\x13\x11\x90\xdeE\x9aHGPZD@z\xef5\x14\x05)R\x15\v\b\b\"\x88@D\xe9\xbdW\xe9\bJ\xef\x1dL\xa8\xa1%_\xf4\u07b7{\x9f\xf3=\xe79\xef9\xef\x9f\xef\x1e8\u007f\\\x99k\xae\xb1\xc6\x18k\xce1\xaf1\r\xb8\xef" +
-			"\xb89\xe0\xc2-5M5\x00\x04\x02\x01\x1a\xf8\x1f\x007\x0f\xdcV\xf5wx\b\x00\xdaڀ\x00\x00\x00$\x00\x01\x88\x06\x00\xe3\x11\b\xff\x03D;\x00\x84\xbf1\x00\\\x06~\tA6\xc1\xdf=\x84\x93\xd9\xe0?\x98\xf1\xd7E\xdc<\xe9%{oow\x19aaW\xaf\xabV6n\x0fl\xafZ\xbb\xb9\b\xfb[\xb9\v\x8b\\\xbd&\f" +
-			"\xc8)\xfa\xbb[Y;\xd9zs<\xb0\xb5sp\x95\xe7\xfcY\xdb\xc8\xc9\xe1`#\xcfi\"\xae}M\xdb]\xc5\xd6\xdeA#\xd0\xd3\xd60P\xc7\xc8:\xd0\xc9ZچSQ\x81C\xce_\xc6\xdf\xc5\xdd\xc5\xd6ۊ\xc3\xdf\xc5\xd9\xd5K\xc6_\x9e\xf3\xb7r\x19<\xfe\xd5-\xcc\xc9\xf1{\x88\xb7\x93<\xe7]m=\x0e\x157O[\x0e" +
-			"\xf1\xab\u05ef^\xe3\xc4\xdf\xedi\xf3P\xc6\xe0\xa6\xda?\xee\xc5\u007f\x92\xe7\xfc\x87\x91~~~W\xfdĮ\xbay\xda\t\x8bHKK\v_\x13\x15\x16\x15\x15\u008f\x10\xf2\np\xf5\xb6\xf2\x17r\xf5\xe2\xfa[\xc3M[/kO\awo\a7W\x8e_\x9f\xad\x1e\xb8\xf9x\xcbsr\n\xe3\xaf\v\xff\xe3\x11\xbf\xe0\x1f[\x158\xfe-" +
-			"\xff\x96\u007f˿\xe5\xdf\xf2o\xf9\xb7\xfc\u007fO\xfe\xc9vl]\xf1\x14\xc7\x0f\xcfe\x00\xdc6 \xa5g\xef\xe6\xed\xe6e\xef\xe6\xce!v\xf5\x1a uCS\x9b\x90\xf07\xb9\xfa\v\xf3\xfc\x86\x17F/\xc6\xffH\x04>\x13\xae\x87\x91\xbe\xdc\xc2\xdc\b\xc5-P,i\xaa\xa8\xc0\xf5\ft\xd54o\xab\xe29\x1c\x00P\x8cZ\xb9\xbb;\x13" +
-			"\\\x00\x00\x17WoO\x03\xf5\x1b\x1cw\xef\x99r@f\xf1\x94\x8c\x00 \xc5\xd38\x12+k/we=\xbd\xdb\xc0\u007f)\x87c\xbf\xe9\x1d0\"\xf4K\xd7\u007f=\xee\xff(\xd46xZ\x84g\x80zx\xfc\xc0\xc6\xcb\xda\x05\x8f\xcb\xf0\xad\xce\xda\xdd\xd3\x1b\x00\xc0\xee\xf8~.?o\xf7_\xf8\x19\x1e\xd3y\xe2\r\xc4\xe3\x82_\xd8\xee/" +
-			"\\\xf7\v?\xf8\v\x0f\xfe\x1ecd\xa0\x82\xc7Kx6Jae\xe5i\a\x00\xe4(|?\x87\xaf\xb5\x1d^\x0f\x05\xde[\xe0\x9a\xab\x8d\x83+\x1e+᱂\xb5\xbd\x95\r\x1e\x87\xe1\xf1\x15\x17\x17\xb7_\xb8\b\x8f\xf9\x1e\xfc\x8b\x1e\xbb\xff\xa0\xf3\xc1\x1f\x9dVVv\u007f\xf0_\xbe\xfc\x16\x92\x9b\x0e^\xee\xceV\x01\xff\xc3p\xfc\xf7\xe2\xe2" +
-			"\xec\xf3\xf738\xf1\x8d\xc2\xdeS\xc3\xe0\x97\xcf\xf8\x98\x158\xb9i\xfd\xc1\xae\x0ftt\xff\xc6\x0e6\xbf\xc7\xff\xc6\xf6>\x1aw\xfe\xc6\xd6^*\xa6\u007fc\x1b\xab\x9bZ\u007fc\x1f\xa7;\xca\u007fc+\xcf\u007f\xde\xeb\xe0\xadi\xf47\xf6t3\xf8\xa3\xdf\xd6K\xd5\xf0\x8f~[\xcd\xdb\u007flp\xd6\xf9\x83\x1f:\xa8i\xfe\x8d\x03\xed\x8dL\xfe" +
-			"ƾ\x0e\xc6:\u007fc/'C\xad\u007f\x8eQ\xf9\xd3\xef\xe9c\xf0\xc7懞j\u007f|t\xf1\xfa\xa7m\xd6V\xff\xb4\xc1\xdb\xdeH㟶\xdd\xfdc\x83\x8d\xedM\xd5?\xfd\xaew\xfe\x8cw\xf7\xbe\xf1G\xa7\xbb\xb3ޟ\xf1\xb6\xce\xea\u007f\xfa\xbd|\r\xff\xdc덟`\u007fcG\xab[z\xffԣ\xf7'>\x80!\x10\x00\xb8\x02" +
-			"ր6`\x05x\x01ހ-\xe0\xe9m\xeb\xef\xfd\xcb`\x157\xf7\x00O\a;{o\x0ee\xfc\x8a\xb1\xe5\xd0t\xb5\xbez\x85C\xf4\x9a\x888\x00\xfcZ\u007f\u007f\xbd\xde=\x86\xdf\xeb\n\xc4\xd0\xf3\xcf>7\x19\xfc\x1a\xef\xc2\xcf\xc3\xe7\xff\xec{\xb0\x06\x00\x95?\x00\x80\x99\xfc\x9f}\xdc\xf85\x04\x85\x03@\xc5Sk\x1fO\u07ff\xfa~" +
-			"\xa7\b\"\x80\f\x80\xe2-d\x06\xd8\x01.\x80\x0f\x10\x02D\x01\t@\x16P\x02T\x81[\x80.`\x04\xdc\x03,\xf1V\xdb\x03.\x80'\xe0\a\x04\x03\xe1@\x14\x10\a$\x01ρ\xd7@\x16\x90\v\x14\x00\xc5\xc0g\xa0\x12\xa8\x03\x9a\x81\x0e\xa0\x17\x18\x04\xbe\x01\x93\xc0\x1c\xb0\fl\x02(\xe0\x108\xc5׆\x10\x10%\x88\x16\xc4\f\x82\x81" +
-			"x@\x82 Q\x90\x14H\x01\xa4\n\xba\r2\x00\xdd\x03\xdd\aف\\A>\xa0`P$(\x0e\x94\x02z\rz\v\xfa\x00*\x05U\x83\x9aAݠ!\xd0\x0f\xd0<h\x1d\xb4\v:!\x00\x13P\x10\xd0\x11\\$\xb8L L E\xa0L\xa0E`D`A`G\xe0A\x10H\xf0\x88 \x81\xe0%A\x0eA\x11A\x05A3A" +
-			"/\xc17\x829\x82M\x82\x030\x00&\a3\x80/\x81\x85\xc0R`\x15\xb0.\xd8\x14\xfc\x10\xec\t\x0e\x05ǂ\xd3\xc09\xe0\x12p\r\xb8\x13<\x02\x9e\x03o\x81\x8f\t\x89\ti\t9\b\x85\be\t5\b\xef\x10Z\x13z\x10\x86\x12\xc6\x13\xbe&, \xac l#\x1c!\x9c'D\x11b\x89(\x89؈\x04\x89d\x884\x89\xee" +
-			"\x12\xd9\x11\xf9\x11E\x11\xa5\x11\xbd'*'j'\xfaF\xb4LtHLL\xcc@\xccK,I\xacA|\x8fؑ8\x888\x9e\xf8\r1\x92\xb8\x89x\x88x\x91\xf8\x00\x02\x810C\x04!\xf2\x10]\x88\x15\xc4\x1b\x12\x05y\x05)\x824B\x86!\xcb\x10\f\t9\t\x8cD\x94D\x8dĔĕ$\x82$\x8d\xa4\x90\xa4\x81d\x98d" +
-			"\x95\xe4\x94\xf4\x02)\x0f\xa9\f\xa9.\xa9\ri\x00i\"\xe9;\xd2\x1a\xd2\x01\xd2e\xd2S2j2^2y2#2G\xb2p\xb2\x97d%d\xeddSd{\xe4\xe4\xe4\x9c\xe4\xd2\xe4\xfa\xe4\x0e\xe4a\xe4/\xc9?\x91w\x91ϓ\x1fS\xd0P\bP\xa8P\x98S\xf8P$P\xe4S4Q\xfc\xa0أ\xa4\xa4\xbcL\xa9DiJ" +
-			"\xe9M\x99@\xf9\x81\xb2\x95r\x86\x12CEKu\x95J\x93ʆ\nA\x95NUA5L\xb5\x03%\x85\xf2@\x95\xa1\x96\xd0@h\x1a\xf4\vt\x00\xbau\x81\xf4\xc2\xe5\v*\x17\xac.\x84^H\xbfP}a\xfc\xc2\x015-\xb5\b\xb5.\xb5\vu<u!u7\xf5\x1a\r\x84\xe62\x8d*\x8d\r\xcd#\x9a\\\x9aV\x9aEZ0" +
-			"-\x17\xad\n\xad5m$\xed;\xdav\xdae:b:^:M:G\xba8\xbab\xba~:\x14=\r\xfduzcz\u007f\xfat\xfaz\xfa9\x060\xc3e\x06M\x06g\x86D\x86\xcf\fc\f'\x8c\x17\x19\x95\x19m\x19c\x18K\x18\x87\x19\x8f\x98X\x99\x94\x98l\x99b\x99\x90LߘN\x989\x98U\x99\x9d\x98\x93\x99+\x99\xa7" +
-			"Y\bY\x04X\xf4Y\xfcX2Y\xdaY\xb6X\xe9XeY\xadYcY?\xb3N\xb0\x11\xb0\t\xb0\x19\xb0\x05\xb1\xe5\xb2}e;\xb8\xc8~Q\xfd\xa2\xfb\xc5W\x17[/n\xb13\xb0+\xb1;\xb2?co`_\x87\xd1\xc2\x14`\x0e\xb0g\xb0F\xd8\x06\a=\x872\x873\xc7K\x8e6\x0e\xd4%\xb6K\x1a\x97|.\xbd\xbd\xd4\u007f" +
-			"锓\x97\xf3\x0eg\x04'\x92s\x9a\x8b\x8cK\x8a\xeb!\xd73\xae\x16.\x147\x8c[\x9b;\x98\xfb#\xf7\x04\x0f)\x8f\x14\x8f=\xcf\v\x9eN\x9e\xa3˼\x97M.G_\xae\xbc\xbc\xc6\xcbī\xc9\x1b\xc8\xfb\x91w\x8a\x8f\x92O\x91σ/\x87o\x94\x9f\x98_\x8a߉\xff\r\xff\xa0\x00\x81\x80\xb8\x80\xbd@\xba\xc0\x80 \x81\xa0" +
-			"\x84\xa0\x83\xe0\x1b\xc1\xa1+DW\xa4\xaf\xb8^ɹ2.D!\xa4,\xe4+\xf4Qh\xfe*\xc3\xd5\xdbW#\xaeV^\xdd\x11\xe6\x166\x15N\x16\xee\x14\xc6^\x13\xbf\xe6|\xedݵI\x11\x1a\x91[\"\x11\"5\"\xbb\xa2\x02\xa2֢颣b\x94bjb\b\xb1*1\xf4u\xc1\xeb\xb6\xd73\xaf\u007f\x17\xa7\x15\xd7\x16\x8f\x16" +
-			"o\x11?\x97\x90\x94\xf0\x94(\x91X\x97䖼/\x99!9.E'\xa5'\x15/\xd5%M$}C\x1a!]'},#!\xe3-\xf3Y槬\x90\xac\x93l\xa1\xec\x9a\x1c\xaf\x9c\xad\xdc;\xb9EyNy+\xf9\xb7\xf2s\n\x1c\n\xf7\x15\xb2\x15\xe6\x14/)Z)\xe6(.(q)\xd9(\xbdWZU\xe6WvT." +
-			"R\u07b9q\xed\x86\xe7\x8d\xf2\x1bG*2*!*M7\xc17\xd5o\xc6\xde\xecW\xa5Q\xbd\xa3\xfaZuF\x8dS\xcdN\xed\xa3\x1aJ]\\=H\xbdI\x83HCK#Yc\\\U000e29b5\xe6\aM\xd4-\xc9[!\xb7ڴ(\xb4\f\xb5^k-\xdc\x16\xb8\xedy\xbbF\x9b@\xfb\x96v\xaa\xf6\x94\x0e\x8f\x8e\xabN\xa5." +
-			"\xa0\xab\xa9\x9b\xaa;\xadǫ\xe7\xa1W\xabO\xac\xaf\xa7\x9f\xae\xbfb b\x10l\xd0iHk\b7,4<4\xbaa\x94h4y\x87\xef\x8eϝ\x16c\xa8\xb1\xb9\xf1\a\xe3#\x93\x9b&)&sw\x85\xef\x86\xdc\xed\xbd\xc7r\xcf\xe1^\x95)\xc4\xd4\xd8\xf4\xbd遙\xaa\xd9s\xb3esq\xf3(\xf31\v^\v\u007f\x8bn" +
-			"K\x16Kg\xcbz8\x14n\x05\xffr\x9f\xe8\xbe\xc9\xfd\xc2\xfbgV\xbaV9V\a\x0f4\x1fd<@Y\xabX\xbf\xb0\u07b4Q\xb2yf\xb3n+o\x9bb\xbb\xfaP\xfea\xca\xc35;y\xbbT\xbbu{E\xfb4\xfb-\a\x15\x87\xd7\x0ehG\r\xc7,\xc7#']\xa7|'\x9c\xb3\x893҅\xc4\xe5\xbeK\xb5+\x8d\xab" +
-			"\x93k\x9b\x1b\xbb\x9b\xbfې\xbb\xa0{\x94\xfb\x9c\x87\x8c\xc7s\x0f\x94\xa7\x96\xe7{/\x90\x97\x85W\x957\x1d\x9e\xe8|\xf5\xe1\xf3y\xec3\xef\xab\xe0\x9b\xee\x8b\xf13\xf6\xfb\xe2O\xed\xef\xea\xff5@  &`5P-0/\x880\xc8:\xa8%\xf8Rpx\xf0|\x88r\xc8\xdbPP\xe8\x83\xd0\x16\x04\x17\xe2\x11b9L=\xac" +
-			" \x9c,\xdc)\xbc/\xe2ZDJ\xc4~\xa4Idͣ\x8b\x8f\xc2\x1e->V\u007f\xfc1\x8a*\xca3j<Z6:\xeb\t\xe1\x13\x87'\xfd1b1\xafb\xb0\xb16\xb1=q\xd7\xe2\xd2\xe2\xce\xe2\xad\xe3{\x9e\x8a<}\xf9\x14\x97\xf00\xa1?Q\"13\x898\xc95i,Y1\xb9 \x85:%0e1U;\xb5\xe2\x19" +
-			"ǳ\xd8g\xfb\xcf\xe1ϻӮ\xa7e\xbd {\xe1\xf3b\xee\xe5\xed\x97U\xaf\xb8_%\xbd:{m\xff\xfa[\xfa\x8dtd\x06[FL\xc6\xd1\x1b\x9b7ÙJ\x99%Y\x17\xb3\xe2\xb2N\xb2\x1d\xb2\xbf\xbfU\u007f[\x91s9'-\x978\xd77w\xe5\x9d\xf1\xbb\xce<\xa9\xbc\x0f\xefY\xdeǽ?\xcfw͟+0(h" +
-			"\xfb \xf9\xe1C![a\xe2G\x82\x8f>\x1f\u05cb̋\x06\x8bo\x16W\x95\b\x95\xbcE2 \xe3>\x01\x9f|>m\x94\xde/\x1d\xfb\xac\xf5\xb9\xe5\x8bԗ\x922\x9e\xb2\x8cr\xda\xf2\xd8\nPE@\x05\xaaҾr\xae\xea^\xd5P\xf5\xad\xea\x96\x1aٚ\xf2ګ\xb5\xf9u\x97\xea\xd2\xeb\xe9\xeb\x13\x1b\xc8\x1a\x1e5\xe0\x1a\x03" +
-			"\x1b\x0f\x9aܛ\xb6\x9a\xed\x9a\x17[\xe0-\x93\xadw[G\xdb\xf4\xdb\xfa۵ڻ:\xd4:Z;\x95;\x1b\xbb\xe4\xbb\xea\xbae\xba\xab{\xa4z*{%z+\xbe\x8a\u007f-\xef\x13\xef+\xef\x97\xe8\xaf\x18\x90\x1c\xa8\x1a\x94\x1e\xac\x19\x92\x1bj\x18V\x1cn\x1e\xb99\xd21\xaa9\xda\xfbM\xe7\xdb\xd0\u061d\xb1\xef\xe3\xe6\xe3s\xdf" +
-			"m\xbe\xaf\xfdp\xfe\x81\x9e\xf0\x9d8\x9d\f\x9b\"\x9a\x8a\x9d\xbe0\x9d6\xc36\x933\xcb?\x8b\x9c\x93\x98\xab\x9f\xbf9\xffu\xc1par\xd1zqs\xc9k\xe9l\xf9\xd1\n\xe5J\xda*l\xf5Ú\xe8Zݺ\xda\xfa\xe0\x86\xd9\xc6\xf2\xa6\xfb\xe6\xe9V\xd46\xf5v\xc6\x0e\xdfN\xd9O\xa5\x9f_QwQ\xcbhO4n7~" +
-			"\x8fy/\u007f\xff\xfa~ˁ\xde\xc1̡\xcb\xe1\xe9Q,\x86\x19Sp,u\xdcybr\xb2z\xeaw\x069{y\xce\u007f^\x83\xd5\xc2N\xe1\\p8w+O\xab\xdfT\xe0\xd7\xf1,\xc1Ç\x00\xb0\x9b\x0f\x00\x94\xf7\x00\x80\x16ϛɨ\xfe\xe2ǿ\x05\xf4\x17\xa7\a\xfe:\xba\xfd/\xf0_\x1c\xfa\xb7H\x00@n\x13\x00\x18" +
-			"\x85\x01\xc0m\xfc\xefW\xf8ߗ\xf1\r\xaa\x04\x00\xbf葑\x12@ &\xf6\xa7\xfdC\xbc\x1e\x8a\x89\xfe\xa5\x8b\x02\xcf2\x8908\xdc\xdeE\x00\x80\xd4\x00\xc0\xb9'\x0ew\xfa\x06\x87;\u007f\x877\x16\xcfm\x9a<\xfe\xe2\xe5\xbf\xe4\xb6\x10\x9e\xcb̨h\x8b\x8a\xb5\xf7>Z\x02\xfe\x93\xe0\x9a\x00j\x12BF\xc2I0\x88\x13 \xa0\x06" +
-			"\x81\xa9A\xb86\x00\x86\u007f\x06\x11\xe8\xb7\xfc=\x10D\x00&$\"\x86\x90\x90\x92\x91\xe3\a\x94_\x00\b@`0\x01!\x98\x88\xe8W1\x05\n\xc1_\a\b\xa9\x89h.\x89(\x13\xd3\xea[A8=\xe8DÓߒp\xdd(m\xa67\x18Bq\x8b=\xf0\x8c %c`dbf\xe1\xb9\xcc\xcb\xc7/p]\\BRJZF" +
-			"妪\x9a\xba\x86\xe6-C\xa3;\xc6&xJfmc\xfb\xd0\xce\xde\xc1\xd1\xcb\xdb\xc7\xd7\xcf? 0\xf2\xd1\xe3\xa8\xe8'1\xb1)\xa9Ϟ\xa7\xbdx\xf9\xeauN\ueefc\xf7\xf9\x05\x1f\n?\u007f)+\xaf\xa8\xac\xaa\xaeiimk\xef\xe8\xec\xea\xee\x19\x1e\x19\xfd66\xfe\xfd\xc7\xc4\xfc\xc2\xe2\xd2\xf2\xca\xea\xda\xfa\x06zwo" +
-			"\xff\xe0\xf0\bs|\xf2\xcb/\x10\x00\x06\xfd-\xffG\xbf\xa8\xf1~\x11\x10\x12\x82\t!\xbf\xfc\x02\x11\xf8\xfd\x1a@MHtI\x84\x98FY\x1fb\xe5A\xcb)\x1aNBw#\xf9mi3)\x97\x98\x01\x8a\xfe\x81\xe7\x10\x19\x03\xf7\xf5y\x1e\xf4/\xd7~{\xf6\xff̱\x88\xff\x95g\u007f\x1c\xfb\xa7_\x93\x80\n@\xfc[\xc8\xf0\xff\xa8" +
-			"\xc8\xc8Ȩh\xa8\xf0B\xc3H\xf3K\x18\xd9\x18\u007f\v\xdb?\x84\xf3\xb7\xf0\xfe\x92+\u007f\xe4\x97\x12\x10\x9e~\x91\x93\x91Ӓ\x91\xd1^\x85\xb1\xc0\xae\xfe\x8f\x057\x8dg\xcd:\xb8\t\x80\x02\x8c/\x88\xa9\xc1Ԁ\"p\xa2\x13˗M\xf0\xef\xf6?nP\xdc\xe0\xbfc\xf7\xbf\x8e\xddпc\xf7\xbf\x8e\xdd\xf0\xbfc\xf7\xbf\x8e\xdd\xc8" +
-			"\xbfc\xf7\xbf\x8e\xdd\xe8\u007f\x8c]\x1d\xd2\x1cS\x9c\xb7\x16Xޑ*\x9f\xb1+_\xebr%\xcf\xdd\x1f\x12\x9f\x15xp\v\xb3\xb8\xc0x\x96\x10\x98u\xee\x03;!ɆZ\xe0\x80\xc7?r\x11\x03\x8f;W\xb2\x16\xb5p\x009Ru@B\x12\xf6\x1a\xf1\xf4>\xc6p\xfea\"\x12\x13:nR]S\x95\xa0\xf9-N\xc0y\xe2Z\xbb" +
-			"\u007f\x17o\xb9\xb3\xf9\x19\xcd\b\x8bܽ\rZ5\xecgΪ\xb3\xa8\xd9U\xa5\x8bƉh\xf5\x96\xc0\xba8\xf2\xdbc\x9e\"Mfat0\f\x13\x8f\xfa\x82j \aG|,f\xbcݒlL\x8eG\xe0\xbc\xeaJ\xda.\rW\xa6\xdc3\xde\xc1\xd5\xe7\xce^\x91\x18\xdaH\xcbdTV\x87\xdf\xe5\xe5`\xa1\x0f\n\x98\x1f\xba?," +
-			"\x12\x05\x8cl:\xb3v\x16\xb9\x03\xad/v\x00\n\x919\xb1|\xc0\xaf\x86\xe4\xfd\x86\x15r0U\xcb\xf7\xca볓\xad/\x8b\x12\xed1!Dz\xf4\x05\xb6\a\xc3\x17\x11Ǭ\xe5\xba'ǈU%\u0603\xbb\x16\xc5\xe7O\xebw\xf9\xefT\xc5\xf0\x90\x05\x86ͱ\xc1\xfaK\x8c\x85\xa3\x15\x97\xe0#\x8d\x9bc\xb0s\xc0\xb8\x0f\x10\xa2\xd3" +
-			"W\"\xfa\xaf\x9a\xddFͣ@\xe3t\xaf\x0f=y\xc3\x13\x97\x9f?\x0fLʕb.&\xeb\x1b\xbb\x8e\xfe\xe8`bj\xad\x98v\xf5\x81\u007f\\\xf8\x9b\xda\x0e\xb1\x04\xb1X`o\x1b\xb2\xf0\xfe\x15\xd2\xf1\xf0D\xb5>c\xa8\xbe\x99C\x8c\xbe\xa9%m\x152Q\xafS\xf7\xa2u\x96\xccś\xf2\xe1\xec]\xad\xa3g\x93\xa5\x97(\xac\x94" +
-			"{\x9eoV\x9d\x8c\x17\xeb\x81T\xe9\x9e\x01e\x06\x06\x83x\xa0\xf7\xa7Y*#Б\x1a\x9f\x1a\xaaJ\x83\xf8\u007f\x14/\xfb\xc7\x10\xb9\xdf\x121PW\xf8\xb6y\xb0p\x12\xdd\xdckq\x03\xbbp^Ϋ\xe2!\xd8ct\x93\x84\xf5{\x85\u0091\xf3 \xe4\xf3ͅ\xbbcJ\"U\xe0\xc4bM\xfd\xb6\b\xbe\xb1k^e\xa0\xac<\xcd" +
-			"\xff\xda\xc7\u007fi\xec\xea\x1f\xce,\x86\xbd\xfb\xd4VuRz\xfc\x82\x1c,9\x9bJHo(se9\x1e\x989\xb4\xf7\x95\x99\b\\\xe3Qu\x87\xba\xb4\x8a/\xc9 \x9bO-\f\rjF\xd3\xf5\xbd\x9d^YZ\xe4p\xdc\xf8\xf9\xc0㨺\xc3i\xbc8\x9balȌ\u007f\x8aSUI7$\x97\x0e$\xff\u007f{\xaaG\xa80\xba" +
-			"\xf8Ed{im\x84&v\xa4\xd1-M\xa9㫝\xb4ⶉ\x83\xb9\xf0S9Zh\xbcjA\xdazqCo#\xab\x9e\xc4\xf6i\x88F\x87\xc4 Q#\t\xa6a\xa4\xfdpojB\x99[\x10\xfc\x88\xf3\x06)w\xfcaI\xda\xcea\u007f\x8b\xb0\u0089\xff\xf6b\xbfdO3#o\xc5\xdd\xf0\xd7\xcbu\xacs\x98:\x98\x93\x87" +
-			"\v}\xaf9\xe9\x94½\x1b\x8b`\xa6\xb9\xd7\xcb\rb\x88\xaeb\xc8yH>6l\x1f\xb4\x9e\x16X\xf1\xbc\xa0V=\u007f\xbaٳK\xb4\x85\x9f\xbe\vRM\xa6#\xb0\xf9馝<\x1d\xed\xb1I\xff,a\xe3\x12\u007f\x01\x0e\x18\xa7\xc3\x01\x83\x1f\xd7\x1cp\xc0\xa8&v\x1dk\x04Z2\x9ee\xf6\x8eK\u007fR\x9c\xd21w\xbd\xa4\x80" +
-			"\x94?\xbe\xb4\xb38\xe8b\xb0\xe0;\x1c\xd0e<\x82\xad\xed\xc4\x01o\xc9N\v\xc3\xc8\xf8\xf9큿\xe7\xef\u007f\xd7\nM^\xa7\xbd\xc9'r\xb9\xb1\xc2z\xff\x98\xcc\xd6\xe7\xf6b\x89$\xf5\xebZKRt\xebκ\xe2=\x1cpx\v8ܔW\xd7\x1d\xbd\x93\xa5[\x1d\xbb\xac7\x1f\xafS\x02ڗ\xd5l\xb7@\xd2`xp\x00" +
-			"G\xb1\n\xb66\x1f\x1bi9\xa9Ĵ\xe7\x1f\xadT\xf1\xfcmը\x8bu2\x15\xb3\xa5\x8d\xb1\x81L\x04iW\xb1\xad\xad\x0f\xd5#\xd1O]\\I\xca;\x82\v\x89\fx{G\n\x11\xe3S\xb3'\xcc\xc8\xcf\xde\xc8c\xa2\t\xc48\x13\x0eاVb\xa1\xd3W\x05~5\xfa\xe5\x89\xf1\xf6,\xcau\xc9Dk2m_\xf4Ţ!" +
-			"\xa2\xef$sam\xf1\x8b߭gj\xe1\xb4\xe6\xbdv\xf3\xd3j\x1b\xe0\n\x8d\xe2<[\xe9\x0e\x12\x95H\x8cI'\x96yh\x04>^|=\x86\xad\xe3\x054\xcaj\x94\x8f(A\x1e%\x95\r}/'\x88f\xbd\x8d6\xce\xf1\xeeurx\x9b\xa46l{\xf8\xbc%I&2co\xfd\xae\t\x82i?N\xabڅ\xa5c\xae\xcc\xe0" +
-			"\xbaM\xc4K\x1e\x1dy\xa7\x0f\xa8\xa3\xd6sH\xabL\xf4a\xda\xeb\x87{\xc1\xb9\xd4T\xf3Ϣ8R\xe3\xa9\xfcO\x82L\x98\xfdP\x8e\xc3;\xbbN\x15\xa3\xef\f\xaf?\xbdDp\xa9c1#d\x038|.\xfb\f\xa6\xaf\xf3><\f\x92'\x13FƗ\xf3Wÿ\v\xf0\xd7`U\xb4\xcam\xd4\nR\"\xd1\xc3\xe1:\x92\xc4\xfc" +
-			"\xf3s`Go\xcaf\xf5mɸ\xd2\xd8\x034q21\x92ЅF\x87鱳\x8dU\xec^\x82\xacI/\x0e\xa0C,\xf95\x9c\xa8“\xb4\x860\x8c\v\x0e\xe0\xd4y\x83]\xc4\x01k\x1d\xd9T+\xffmr\xfe\xf6\x1f\x93s\x83\x14\xe61\x0e\xe0\xca\xcc\xc7\x01\x16\xea\xd8\b\xc1\xad\xc0\xff\x94\x93s\x1bX\x11]\xa9\xf9\x88*" +
-			"\x1cp\"\U000b1e84u\xbe\xf2,F\xc6\xef4\x11\a\xe4\xb2Ծ\x06\xda\xd1T\xa9\f\xd59\x16\xeb^@\xf0\xdaw~\xb9mw\xd1b\x8c\x04e\xb0Y\xbe\xa5\xd6{G\x89#\xb4\xfb\xe1nQ\xe4F\xbe\xf8ѽ5/8\xf1\xd43\xa7\x18\x16\x91\xc7W۠ʙ|\xe0\x9d\xce\xea\bAk?\xc1G1z]_\xa7\x80l\x9b" +
-			"\xd4}\xfa=\xc68,\xaf\xe3D_\uf13dg\xfd\x9b\x94\f~\x95\x8a{\xf2\xbdTJ\xf2ag^\x96\x89hA\x83,m\x94P}a)\x8dű\x9e\xf4\x80\xec\x8d\x1dאùS\x96]d\x94'\xc6w\xc04D\xb7\x8d\xf6\x9d\xe2C\xa5\xfb4\xe0H.X}\x93\\\xc1\xfa\x8e\xd7D\x98\x98I\xb3\xa5t\xe6\xd4\x06̪\xda" +
-			"\xd6YC\xeb\x027\xb7\xb7,\xe9\x8cn>s1\x942\xf8&\x1a\x1a\xf5\x93\x90qW\xd3Ǳ\xb8\xe2\xeee\xee\x9a\xc5ȲJ\x01\xf5|]\xd7\xc55E\xcc]vJ\x1c\x90\x9d\xa1\x889\u0098D\x05\x1bԢ\xfa\xf3C\\\n\xd7L\xa8\xfb\xae\xbfM\x99S\x83\xcaz\x94'\x823lB\xc1\xc3a\n/F\xcaɵ\x8d;.\xaa" +
-			"\xb5\xb7%\xe9\xbcM\xaeOD\xbe\xbdH\xb2\xe5\xb48\xc0\x8a\x9f\xda\xd3\xf8\xa9\x8d\u007f\u007f2\x87\xf0\x88\x89\x8cJ\x96;;\xcf/ߚ\xe8*\x89\x1e\xbcE\xa5\xbe\xf2\x0e+\xbeh)\x89\x03\x92k\x8dp\x00\xf2\x05\xd6\x1bT\xcd\x1bSg\xb5P^9\xb8\xaf\xfa\xeaRe\x94T\x9b\xc4\xd2\xd2#\xbd\xbcw\x1e\t\xa4\a-\t\xb2\xbb\x1e>" +
-			"P\nLm\xab\x92\xa3d\xda\xc8D<\x0e(Q\x83\x10fn=Z\xe4\x1d\x1d\x1f\x9e\x85zˆж\x1cf\x9a&ʬ3\x04dՊŇg\xa9\x12\x82\xf0٣\xa4\xdf\xfeAU\xcc\xdc\x1b\xc2䮼{wm\xae\xc9]Pޠ\x04\x827\xe7\x14c\xc6\x0f\x8f\x92\x1d\x14\xb8^\x0e\xb8\xf1\x93\xa4V\xf7v肽\x104" +
-			"\xa9\xa3\x99\xea\xe6\v\r\x8f\x1a|\x13z\x02.̧Ir$\xb4\x81\xe1\xac\xfe\x9d\xaa\xbaPLʞV\xf4\xb9\x81\xc2\xda\xdey'\xe8\x99\xcdA\x93\xd4@y\x88\xea\xfc\xf9\rm\x93\xa6ã\x11\x9aa\xf9WT\xba\x06]y\x9ag\x1a\xd9\xd0\xd7\xde\xc24\xfe\xb1.\xae-\xfc>\x82\x95\xea\xd51\xf7L\x03H8\xaa\b\xa9k\x93\x9c" +
-			"\xb6b1~\xad\xb3\xbb0\xfc\x86\xb8\x01;\xa70\x992\x02-72\xfcD\x96\xe1\x00\xcaV\xf9G\xbb\x93]\xae\xc9Z7\xb7\xe2\x95\xd5\x17\x05\x94\xdb=\x1b!\a.&\xfa\xa3\xc8\x11\xbd\xda\xcce\x03z\x01\x9e\xe4\f\x02\xb3\x02\n\x1d\xc1lL\x1f~9\x94\xc1\x0e\x0e\xb3N\xc0\xe30\x94\x16\xf6\xc6\xeb\xc4s\x0f\xdds\xb20\xf9\xc4\xf6" +
-			"\x19\xferTf\xca\xf8\x1e\xf2\x95\xe0\x9b\xa4\xf6\f\xb7`\xbb,%\x99\xd4s\xa7\xe5\xdasD{赆S\xb9\x94>\xcf=6\xa1:\xe9\xef\x90l\xd5E\xc0OZj\xc3\xe9\x13\xa6\xbb@\xd3cA\xa3H2tg\x8c\xa8\xe5D\xeeQ\n\x82\xbar\x17\xa9\x84Q\xcc1\x9b\xf0\x0f\xdeTU\xae\xaa\xf7\x05\xd5>\x19\xdc&\xc7|\xe2>" +
-			"A\xaf+\xb1K\x05K/\xfe$\xeb𑚫.\xbby\xfcj\xde]\t\x9cQ\xa5&\x17\xb8\xc3tPP\x9eŎI4I#m\x9f\x1a\xebc\x13d}\xc2ccr_S\xb8\xdc\x1d$\xcd|\x12\xe8\xae\xd0\xc6A\x1d\t\x87\x9d\xdd\x1c\xe2\x18\f6\xf4p\xb1\u007f\xcb\xf1\xf2\n:{\x8e/4;\x88\x18\xc2>\nڇ\xb5\xca\xd6" +
-			"~\xfcIbf>\xf3u\xafgથ\xda\xd5N*\xa5\xa5\xa5q|\"[\x10ꈓ\x1e,\xbbL\xee\r\x88\xddVR\xb0T\xbbQ \xe7ʕ\xa2\xab\xfcs$h>8\x0e\x1a.\xb4\x12\xfad\xa4\xf4\x04b\r\x17\xf92\xb59ѿNe\xd7P\xb5\x94\x99\x9e綜\xaa\xc4R\x11\xa8\x1e'gť\xebv\xb1\xfdX%" +
-			"*E\xbd'r\xbd\xbe\x8b\xf9\xb0\xcf\xed\x9e#V\x02\x13\x14B\x03\u007f\xb7\x0e\x92k\x16\x11\xa2-ؠn\xb0\x85L\azd$\bn\xc8\vk\x97\r\xedc\xbc'\xe9H)\xc8\xe8\x95\xce5\x0f\xb8wu\x8b\xf5\xd0\x121n\v\x944\xab\xb7\xab\xf4\xb4ފ\x94\xc2<\x95\x14\x8ca*'\x1eJ0\xbe\xf5\x99\xcbh\xb6\x9a\xbc\xcf\r" +
-			"\xf9\xbewV\x95\x8c\x06.\x112\xb0\xf9 ?\xcaݻ>\x86e\x1e]\xad\xf4M\xb8Y\xfe\xfc\xf9\xe0s\xdbK:$\\IN\x8b!\xdcԅ\x13`\x1c \x8fOr\x83\x8fCb\x16a\xc7t\a\xf0\x93\xfdt\x1c\xa0t\x1d\xb4\xd7\x19&~\xee\xbb\xd7~\x12R>\xf3\xd6\xe7\xba\xcev5\xbb\x9d\xad\x94\x9a\\\x84\xbb\xd3D\x03\xef\x8c" +
-			"\xc5w\xff[Nq\xcc\xef\"\xf4\xaf(\xc99\xeb\u007f2\xcbT&n\b\xc5x\xe0\x93^I:\xf6\x14\x9f6+\x90\xfe\x98/\xf8ic\x83\x03\x0eƄ\xf1SEN\xb1\x15\a\\𩧛\xee\x93\xffz\x94\xd2\v}A\x92\xa9\x96L\xddK`\x1e%\xaf\x04\x81\xcd\xebT\xa1f\xdbti\xda\xcf?\x04\xb8yy\x16\xa7\x11\x1b\x93y" +
-			"`\xbf:+w\xbe\xff֙';\x1f\x95\xea\xf4\xd1\xff3]\x9d\u07ba\xebS\x85\x91\xae\x87\xb7\x89\x06\x063\xb0\xf4\a\xf5@\xb0a.&\xb5M\x81\xb1\xa6=~\xf7\x86\xf5\x03vRQ\xbd\xa4\x00c\x95\xc1Cy\x92-\xd5\x10\xbf\xf9iG\u0605\xb5\x8a\x89:.\xfb7\x9e\xbd\xe3N\x91Q\xf0\xa6ȁ\xc0\xe3\xc9E\xcaw\x12XA" +
-			"\xcd\x1c\xbd\xf7\v?.\xa2\xa7#EWw\xf5-\x8bQIY\x18\x95\a\x87\xaf\xe1\xabJ\x17\xf0\xfbȩ\xbf-\xf1\xf3\xbc\xfb\xb3=\xb5q\xc9\xc94\x99\xd1\x17\xc9ٛ\xd0S\x87\x03\xe1\xcaN\v\x03\xcc\xd3\"O\xcc\x1di\xf8{\x19\x1e\xdc\xf1\xdai\x138\xa5\x92/\xac\x8c\x15'\x15\x87S\xc3g5&뺧\xf8q\x80\x95\xce7" +
-			"XD\x98\xe0\xe6\x00\x85}\x1a\x166$T\xd6\xeb\x03䀹\xa0\x8bI\x89\xaf\x12G\xb1*\x04a\n\xb6Qn\x17\x83\x15\xaa\xa3\x9c\x1eg\xce\\l\np\xf5k:\xefɠ\xa9\xe6\xf0=\x8evlo@G?)\xf9iW\x13\xa7W\xfb\x98'y\xda\xda,\xe6Zx\xcc%5\x12\xa6\x8b2d\xa7P\xa3\x11\x17\xf1\xa7\n.\x12\xc7" +
-			"\xe0V\x99\xe7\xf7\x83\x02\xa9ה\x97\xd8;\x1a\xd8\xeb˃\x04\xbb\x8b\xdeI\xfbs\xdbO\xb2\x17h(\xf6\x84)lTpO\xde\xd4\xec\xe6\xab\xe6]7\xfc\x16\x04\xc7\x1cĝ\xb7c\xef<\b#ì\xb6\xb1s\xa3\xe9L\x0f\xdfҙ>\x9fസ]\xf8Q.\f`\v\x03(\x9b\xe8\x0fŃ\x15\x17t\x8f\x99ʅON\xb3" +
-			"p\x80\x9a8\x10\x92\xe3\x9aS6\x12:\xe9\xfd=\x81/\xb8\xd3z\x80\xac\xfar\x83\t\x9a\xb1\r\x9f\x86R\xf6\xdbm\xafT\x8cmM>\x88 \xb8\x93.G\xd9\x1e\xf0 D&\x90\xec\xc4\xfa\xce\xe7\x17\x06\xa8\xbe\xfe,b\xb9\xeem\x96\xeb\x03\xda__\u008a\x83}\xf2f\xa71\x88\xbbQ\xe7\\\x04AҞ\xd6I~\x86\x11\xef|\xd8" +
-			"3s\xc2d\x18P\xd0\x04v\xc5J\x85,\x91E_-\xfeT\xec\xf1t\xa3b\x94\xc3uE6\xff\xacVɷ\xb7w{\xd9\\*̜\"4\x8e\xfd\x05\xa3\xbaU\xf6;彆둷1\xad\xf3\xe9e`}͢wM\xd3\xcf\xf7h\x15\xdaE;\xd1\xfa\x86\xdd\xd9\xc12\xe8\xf2\xd3\r\x8f\xdb/\x18ŀ{\xd6/s\x83s" +
-			"\x81\x97\x18\xae\xf0)=H\xb6\x03K^E\xf9{\xb3\x8d\n\x9bc\x13\x126\xf0h`\xe4\x0e\x94\xb8죰\xdaЖ\xf7E\x05y1\x1d\xe3\xbb\\W\x8c\xc4\x18\xb8<\xb8wC\xfa\x1c\xf1\xc4=hq\x96\xadu^\xf0NĴ\xb1\xd9q\xb3\xc8\xdb\xd3\xc2z\xc9\n\xe9\x8e@*|\x91\x15H\x1fl\xbb\xf8\xc6\xc0¬\xdd\uf206" +
-			"\xad\xf0\xd6`\xf6\x00\x88SVM\xa1j`t\x10\xfc\xfc\xccn1\xee\xc5\x1d\xa7\xf4C\xa6+bOFF\x12\xa8Cj\f\xa6M\xfdG\x9a+\xc9=<\x0e\xd3\x19\x93?_\x84\xf3m\x8f\x13={n\xddɱR\xbf\xd7jɃ\xe2\x8d\xde7s\x11\x9d\xd4\u007f\xe4\xacd>\x1f\xee@r\xfc\x12=\x9a\xd5\xe51Q\xe28s\x05\xc5\xf0" +
-			"%_\xfb=\xdc\xf1\x82\x9c\xe1\a\xc3\fT\x10u\xa7^\x00d,L\xb4\x81=\xf8\x06\x0ex\x94\x84\xb0٘4\xd1S\xed\xf9\xc4t\uf64f\xd4\x1a\x93\x0f\x87{`\u009c\xef\f\x1f\x9a\xa82\xea\xbaC\xb9Sx\xdd{\x86\xeb\xfd\xe4\xb5!\xdf\x066B\x00q\xf4JrAwΦ\xc4\xc9\xd3/\xae\x12\xc3Q\x846\xaez\x0105" +
-			"\xb6P\xf1\x83\xf1\xd8:A\xbb\x85S>\xe7\x9f\x0fӘ\x16R\xfb\xd8-\xa7R#\x88=\xa1\x0f\xec\xc1+\x17U\xda\xf1,_{-n\x9b\xb6k8\x85ff\xd2\x13YB l\x1e\x98\x91\x15\x98\xa6\v`f\xbe)L\x9eg]\x9bܳe\xc6s۟\xcf\x1f\xfc8\x13\xb3\xdc~\xeb\xef֢\x00ԤmX\xa828$qH" +
-			"\x1a\xa8\x15\xe5J\xcb\x06\xb2\xfb\x0f\f\x97!\x15x\xfc\xaf\x0e\\B\xb6\x15)Fq\xfb\r\f\x86W\x1edC\x89P\x8dm\x16\x88֣\x98\x8b\xfb\xc3\x16?v\xdaJoi\x89&<z\xeb\xaa\xcc\xfe\xaa\xf9\\\xfd[\xb1\x0f\xc1D\xfapk7\xe9\x8a\xebv%\xf3\xc02\x9c\x16\x13g\x18}o\\X\\˿\xee\xe5\x0e\x8f\x9d\x91\x14" +
-			"\x9e\xf6w2\xcb\xeb6\x16\x87\xb8\xd89\x1eaɢzD\xc1\x03\xf9W\x1c5\bm\x17\x00w\xff\x9fJ0\x19L\xfa\x82\xf0\x93`\x95\xc0\xc1\xf5=-\aG3g\xbd\x96[\xb5\xbe\xac\xb1\xbb\x9a\xfb\xfd\xf2\xb5ߠ\x18\xa5:\x0f\x0f\xd8d\xba\xee\x9bϒ\xb6?\x82\aN\x97*\xceN\xad\xc5\xfb\xefM\xcd\xd8\xcbԙX\xde5\x8b" +
-			"㏤K\xf2\xf6\x96\x8b\x82\xe9Ǟ\bka\xda\xe7k\xcb\xf4?\xa8\x8b?{y\xdc\x00\x17\x11\xdb2Y9\x1bՃĮ\xcd\\G\xeb>\xfe\xe9\x1b\xb0\xfb\xb9cҦ\xa2\xea\x8aI\xc2Ǩe\xe8\b\xe4\xd8d\x8c\xe52\xd2\t\xe9`|R\x9e\x19\\=\xee\v\xbd\xc3\x15e\xb8C\xc2Y%]\xe6\x16m)\xdd(\x93\xa2\xf3" +
-			"\x82Q\x94\x8f\xc8\xfaY\xc7\x12_\x13\x8f\x94\xf3b\x82\xaa\x9f\xe9\xe5\x18\xab\xf9S\xa2\x11\xefo\xb6\x8f\xef\xbd$~\xd0y\xc53\xe0:h\xb72VN}\x81IN\xf3\x83\nq\x9eor\xdehO\xe1\x97.z%O\x00`\x03\x047\x85D^\xedO\x9ax\xde{\xcd\xd4ͽ\x94b\xe4\xa9\x1d\xec\xb00{\xcc\xe2\x03?9ŧ\u007f" +
-			"\x15\U000438a3\xd5[\xa8TG\xad\x88\xb1C\xcb\a\x91SE\x01\xe0\xbdl\xa8\b\xe61\xfaj\x88\u008a6yk\t\x83}\xff\x8f\x9dkT#Ӵ\xd2\xcc\x17I\xb7\a\xbd\xfa\x02\xb4_D\x9f\xb9,<\xc6\x0eUF\xadly\x12\x0e+\x88B\t\x89\x93\xa3B\xa8\x04yo\x9a\xb1n\aΞ\xfa\xba\xa4\rk5,\xa8\rp\xfd" +
-			"dK\x1e\xe8\x1bQ880\n\x95C\xdd\x16)\xd2w\xbc\xc6\xc9AV\x98\x1d\x06\xcc\x01\"\x83z\x90>\xdf\u05eb\xf1r\xf4\xc1B\xf1\xa9\x93\xd7\xfd\xfb\xea~\x88|^=͏g\xb3\x16İ\x9c\xf8\xb5\xb0\v~A\xbb\xbe\x14\xbe\xcfn>\x94\xf4\xa5=㙛\xdb\x1d\xf9\xc4\x03\xe2\xba\xc5\x05\xb5\xf2\xb9\xd9\v\x98\x90\xbe\xf2-\xd1" +
-			"\x99\xc9\v(\x17\x88G7\xe8E\xfe\r\xe9\x93ps\xf5\x16y\x1d3a\xfa\xbcإ\xce \xd6\\\xaa\x1fJ\x16\xba\xf3\x11\x8a,\xc0Z\xe8U'\x99\x867\v\xac\xfeާ\x93q\xfe}\x85\xa5w\xf3\xbc\r\xfc\xaa\xfa\xe9\x12\xfcKLu\x9f\x9c\x8c\x86\xb2T\f\x15\xed\xf00\x12v\xb9^\xb5\x88\xbb\xa5l]~'\x86\xfb\xbe\x8f|\x10" +
-			"\xc5\xfd\xb1Ǝ\xc4cf\x97\xc6\xcd!ľ\xe4\xc7Y\x92`!\xbb\x02M\xc9'>$c\xfa\xae\vR\xf4\xd5\x04J`\x06\xaeQ\x02\x89ӽQ2`\xe9\x8cֺH1\xce\x1b_I\x91G$^\xb3\x16\xcbM\xf2\x84\xcav5\x9fmn\xe2\xab\x06Bdb\xb0j\xa1\u007f\xae\xea\xb8\xdb\xf5\x8eo\xc2\x1e\x84_?\xa6\xc7H\xdf\xf0" +
-			"\xcc\xff\xe9g@\x19\x12\r\x17\x0f~8\x1c\xdaWl戎\x17\x9a2\x83\x9dc\xaa\xbaO>\xc0\x9e\x03A\x87\xe1\x05O\xe17\xbf\x8cF\x9b=џ\xfc\xf1\xe48z\xce˒\x1f\xed\xd7\xf6\xfaif\xa9\xc3\xfb\xf5\x17\x86f\x01z\xaf\xb8wml\xba\x93\xe6\xc0e\xf1s\xc20Ӎ\x06\xa8#\xdc\t\xd5z\xb7\xf4\xb8h\xc5\x16|" +
-			"\x1e}\xdc|\xd6k\x92\xb0Y[w\x0fMef\xc3\xeb\xd2>1\x19!\xcby\xe1q\xf4\x1ci6\xd5\xcfy\xe8\x93\x03\xd6i\x83=\xedђ\xf7բ7>\xab\xafj\fTZ\xdf\x0f\xafʰ\xf3[\xb7\xec]\x90\xbfm\u007fB\xbf\x93\x9f\xc1t\xe9!\xfc\b\xfd\x18\x16G\r\xbf\x8aQ3F\xcd&no/s\x93\x94d~;?" +
-			"\xa2\x85)\xc6\x10\xd7\a-^\xce?\x84S\xb5\xbe\u007f\xdbjy[T\xde@\xf1%\x0eX\x9c\xb4\aw\a\xc3>\xf8\xa7\x9b\xa1\x85\x122&\xde,r\xf5\xb0(\xd9%=\x8dٓ\bu\x9d{P/\xbc\xc8\xfe\xe1\xc3\xfa\xc7\xf7\b;\vm\x97\x15\xe6\xca\x00QQ\u05ce\xafv\x0e\xcc\x1dN\x8cs%\xa9+\x163\x9b\x9eT\x9c\xfa\x84" +
-			"v\xe7\xef\x8f\xd3w\x81P\x1f\xaf\xc5\xe8\xe9\xe1P<\xfd\xd8z\x06\xcaC!\xf5\xbf\xf9\b\x91\x1b\x05}|Ubk\xdc\xf6P\x93\xf9;M4H\x8b6\xc1\xa9\xe3`R\x97\xa5\xf5!rA\xb1\xe2<\xeb+(tx\xa7\xbb\x11\xc2D\xa1\xd1/fٌg\xb9ʦ\xd1\xe7~\xba\xe7\xc4e\xa1\xffz\xa20'C\xc8Γ\xe9`\xd2" +
-			"\nw\xd8#H\x14\xdb\xfa\xda\xe3\xbb\xfatRcQ|?\xba\xb5+X\x19\x12\xed:҆\xb0?U\xcfX\x0e\x1d\xfe\xda\xf7\xe5\xc1\"Q\x8cT\xbf\xd3@()\xa2\xcbP\x1f_O\xe0)\xc1[\x93\xc1\x11\x1cP\x90z>rV\x00\x9eג\xc6\xf6\xf9Q\xf6O\xcf\x18\xc5b\xfa\xc5\xca\"\xe3\x0f\x91*\xb55\xe8\xa36\xe9\xf7\x99\xfd" +
-			"\xd2\xef\xca)\x94b%v\xe7\xf4ϧJ\xf5:ǥ\x15o\xed\xd5j(|\xf3\xa1\xac\xf0=.\xf9\x90\xa5\xddɌ\xac\xf6>%\xb7\xb8\xdasQ\xa1\x9bн)\x9cZ=\xfb\xe0H^s\xc7\x1e\xbcH:\x87f|T\x0e\xbd\xaa\xaf\x90\xe5yM\xd9\xc0\x84k\x94^\xcaj\x9f\xdbd\x18F\x19l籐N\xearp\x85\xb5W" +
-			"\xbb6\x83\x82\x94\xbaF\x02\x02,]\xf0\xe8\xbcՏ\xe9u\xa0h`\x89\xd8\x0e\xdb\xe3\xa8\xd2+Np\xdfP\x86\xa9\x84!\xc8\xe6\xb1d\xa98\x80\x94hn\xfb\xd0\x10\xefB1\x10\"\xa8J\xa7G\xacP_l\x8aAhf\x99\xd4uk0ʪ\x1b?p/Tx\xb7\xd0`\xf8\xad\xceaa\x80v\xe6\xd8I\xae\x87\xdbY\xdd\xc5\xf9" +
-			".U \x85\xf0\x95\xd0j\xf1\x03h\xac\x9c\xa4\xcb\u009b\xeb\x0e/\xbc4)\x1e(_\x12Y䵗\x82]\x93\xf3z\xbdt\x1dN\xe2\xaf\xd5\x14\x18>\xba:z\xa3g\xe8\xe5\xf8\xb6\x81Z\xd5t\xc4-\x14\x95\xbb\xc8~\x1f\xe0\x1f\x80A,>֭\xb8\xa8\xe0\x9a\xfe\x99Y\v\a\xcc^\xaa\xb4C\xf5i\xcf\xe3\x00\x98\xff\x8bV\xbf\x1c" +
-			"٘\xbc\xaap\x98s\xc5^J\x1b\xe0~#m\x99)\xf4\xde\x06e\b\xa9\x1f\x1a\xd6\xec\x1b\x00-x\xf7\xc87\xc6\xf26V\x80\xe4\xdci\x05ư)1\x81\x03\x9e\xb8\x1e8i|\xd5\xd7\xe9-7c\xfdT\xc8\xc8\xcbf\xb3x\x1dt\xe8\xf2fJ\xf20if\xf2\xe2\x90N\x1c'\xec\x8eU\xf2ɝ\xd7+r\x8cx6\x9b\xf6\x1c" +
-			"\aX\x96\"\xd68k\x02\x01\xc1\x15Ř\x89\x95J\xeb\x85\xf5\xed-\xe4\xce\xfd'\xeaԤl%\xb1\xa2\xb9r\x04D!\xd2p\xde\xe0\xbb\xf8\xb2sx\x11[ݎ\xafs\xc9\x00\xa4p|\x9d\x05r\xf2ޚ\x8c\xf5\x14-[\x10\xd3ͯ\xc3_\xa7\u007f|\x0f\xfe\xde\xe9<\xe7W\xb4\x89\x03\x881\xbd\x1aO6^\xf8\x1a\xaa}ܻ" +
-			"^\xd8\xdcs\x92\xfa)E\xbe\x1b\xe9\x85Q\u007f;\xf9\x1d\xe3\uee2c\\\xd5\xf0\x90\xa7\xf6\xc9Pla\xa2s\x04\x02\xe6x\xe0\b\x1c[7\xe3\x00Z\x9f\x10\x86u\xa1\xf2\x98\xcc\xdd\xf6'\x94+4\xc3\xeaY\v\xc6r\x19\bo,\x13\xf2\x03g\x9a\xf4\x82\xe6\xdb\xfbk\x1b\xccw=I\xe4\"\x14\xcaw\xe1m\x8d\xd0\xc95v\x1e\xef/" +
-			"JiG\x1a\xbc7\xc1 Mn\xd1D\xe7\xf8\xf0\xefl&\xd5gWF\xb9\x1bQ>5=Z\xe2_\xe4\x98\x15b=sQ\x06\x90y%\x98\u0086\xc2e4\xa1\xe9r\x0f\xc2a\xba\xc8\xc1s\xd1>S\x89 Z\x0eT;R\x0eG\xeb&\xf8\f0\xc0ˋS\xb5.\xbe\xe5yP\x19'\xed\xb1q\xfa\x8b\xf7Q\xcd\xe4'\x8d5." +
-			"\x89\x05\xe1\x008\r\xbe x\xd8P\x88\t\x99w҅ڻ߲\x869W?\xb5y}C\xc1\xed\xa5\xcd\xe5 \"6\xeb\xea\x950\xa9\xc4Hc\xc9\nha\xf1\x19\x8dY\n\aǭ\xc7b\x89b\x8a\xfed\xabgnh5\xec\u12b6d\xabgz\x90\x11\xcf\xed5\x0f\xeew\uedf2\xe6\xe1\xb7=\x03W\xcb\x03g\xdbBt" +
-			",\x04[\x18?7M\xda<\xbb\"\x10\x84E͗\xfe\xac\x14\xdf\xcb\nC|2lӟ\xc2D\x19\xc5\xceLߎ\xab\xb35UR\xd2\xf3\x97\x17\xcbj\t#\x91\x83->5\xa5\xfd\xc0\xf3\xa6\xed#_w\x00\x13\xb1Ǩ\xfb\x16s\xb4G\xbbn\x8e\xffl\xbb%m\xfd\xa9ܮ\x16\xb7\xc7'\x9a\x89\xa9\x9d9?\xb0\xdf\xd7\xe8u" +
-			"L$\\\r\a\xb44\xa1\xdc\xce\t\xf1\U000edcf1\xa5\x0e\xb1P\x92;o\xe1\xcaꢥQ\x13\xebԆ\x10j\x01\xa7\xc5G(\xcb!H\xd9B\xec\xc1c?}\"\x17t\x89\xcc7,\xd9\x1c(\xbe(\xcd\xe8%\n\ntI\tv^\n\xdf?\x93B\xbe8SvY(\x90\x8b\\p+߫\xed9\xd5\x12\vt\x9d\xac\xca\xf0" +
-			"\xed\v\xcco\x96\xbf\xa5/\x8eMh{\xd9Sܒ\xa8\x06q\n\xbd\x8f\\6<s?\xad\xd1\x1dC\x9c\xa7\x84\xc9\xe5*U\xa0\x1f\xa1z\x82\xc5\x0f\a\xae\\\xdbS\xf8\xda\xff\xb1\x96\xcd\u0381m\xa1\x9e\xdc\xe8\v\xe6\xc9\x04CBZㄢ\xbdQ\xd66\xabM\x92B\xf0He\x00\x84wy=\xf3\xdb\xd0\x19\xfe\x1d$\x19\xbf\xc5\x01" +
-			"\x16\xf8ԣ\x8ctɆF\"ivd\xabSp\x00b\x820\x9egO\x14\x1e\x1a\xeaҶ\x97q\x80\xd4E-\xbfh\xd3/\\\x1f\xd6=\xcf;TT\xa0\x99\xcc\xd4\x15\xee\x99\x12K\xcep\x99<1\xa1\xfeM\x14t\xffA\x14\x0eu\xf0\v\x82\xa3V\xf7d\x11\x86\x8d\xe0\x03/\x0f\xb0\xcc\xfa\xa7+\xa3\x85\x96#6\xfc\"8\xb16" +
-			"\xf0\xc6$\xa8\x10\xd6*1\xbdR\xb7\xa5!\x02\xc5P1q\xf4x\xfb\xea\xb2˞Z\xc3\xedw\x19)htJ\xc8\xdd\xfbX\xf1\xd5Y\x96\xeb\xba\x12\xfd\xe3\xf0ǡ\xf6\xba\xc9\xfc\xb0)W\xbd\xac\xfb?`(\x81N\x8cPѱ\xc9x ]\xf1n\u007f\x8e\u007f\xa7\xcbzk(75\x0e\b\x1e\x844\xceZ\xaa\xe4\xff\xd0E\xb8{\xb0" +
-			"Q\x97Ň<\xb3\x19\xefb\xc0r\xa0\x1fV\x16\xf1\xd47\xe4z\xa7X\xea>6z\xadTh\xe3\xda\xc4Ng\x87\xa2ߙe\x14O\xdc\xc2\x01\x14\xe7\x11\x12m/vIN\xf6J\x15\x1e*v\xc1\x8a\x03\x1a\x9b\xb7\x19\xb7'\xf6k\xearE\xbe\xe7\xf7\xe2\xe3\xc3\xdd\x05K\xd99\xe7\x03e\x8a\x8f\x9c\xb9Q\xbd\r\xfdT\x91}b\xe3" +
-			"\xf4\xb4C\x93⡸\x12S\xa6\xbbrs_\xc0\xfb\xb4\xd7\x19/З\x13W\u038bR\x97\x8f\xb9c\xc4\"\u07fc\xf3\x1a\x11?\x14\x8eG,\x19~Cl\x0e\xbb\x9d\x03\xe3_\x15\xa9\x14\x97|F\x10\xb3\x88s\x86Q\xd0\xe6,\x85K\x1d\x9c\xe8|\x1e-<)\xf7\x85\xe6\x18\xe1`g;]\x9b\xe1ʛa\x12\xbf1Ãf\v\xac" +
-			"\x9fr\xf80]X\xdc\x16\x06\xfaU~́j\x1az\x91Q>Yǔ\x968\xbc\xb2\xc6\x13\xe6lh\xd5\xc83\xf3\x132\x940,X\xa76vǂ\xb6\xd5m\x99$F\xb3D\xfd\xca]\xf7;\xf2\xc0\x9a\xd9\ay\x91ѓ\xd9\xd8rɡ3᠂6\xdfn6\x88վ\xef\xb1\x11\xd6Ƥ\xbb\x81.Q#\xbdM\xa2q" +
-			"r\xf8\x8a\xaf\xe3\xf2\xb2p\n\u0560\x86a\xf3\xfc\x13\xe0T\x8f\xf8q~\x94\x9c\xdb\x02\x1f\x0e(\x9b\xaf\xac\xae6!$\xb5\rf4\x1c'V#\n?\xec\xca_\x9f\xe1E\xfbE\xba5|\x1d\xd6f~\x12vX\xc9\x1aK\x8d\xdd\xf4\x1e(\\܄k\n'\xd61d\vl\xe8\x85I\x01v\x89\xc3u\x03\v\xa1\xc8y\xe8\x05\xfbu" +
-			"/\xab\x00\xbaʱ~\xb9O\xd5S]\x9d\x14\xf52\xd0zL\xd0 \xa4\x18\x03oK\xb3\xe4\x1f64,c\xa5\xf2\xede\x96\x16\xa9ڨޑ\x8d\x9c\n\xf4.\xb1^\x80%\xe8>\x162\x18n\x12\x9c\x9d\x8a0\xf1\xe3\xbc\xce\xe6\xc6U\xcd\xfb\xf8\xa2\xcc\xea{\xe8\xcb`\xe6ѼHxՈ\xd8\xcb\x12\xab+\xea\xb9\x1d\xfe\x8a\xc9\xe2" +
-			"\xddg*\x8d!*\x1d,\x91_l\x1f\xa4v\xeb\u007f'阂\xb6\xd1b\x06\x89\xed\xd2\x17\x10\x8c\x96ǲ;⤓+\x93)\xcd\xea%\n\x1b\x89]d\xb3\xa7\x906EpGM\xd4d\x9c\x86Q\xfcs\x0fns\x1c\x10b\xf4y\x0f;\x88\f\xc5\xf8e\x9b\xad\x852W\xd8(\xf1֪f\xda\xedh\xc5\x17\x9c\xf0Iu\x8e\x1e" +
-			"\x95,h,\xa6I\xc4ޡJ \xfb\xfc\xa9\x8b【='L\x81\x1b\xcdP-\xf9\xa4\xdcA<\xe8\xc9\x18\xffe#\xaaB\xcf\xdad\x82\x14\xb1.\bs\xa7\xd4\x01\xf2C\xe8\xbf\x1e\xee\xae$\xb2\xac\x95ڳ_\xae\x1bq\xe9\xb8d\fe\xdaW\a\x85\x9d\x89%\xb64\xbc\xf8\x1f\x9f2\x8f\xfd\xbf;eF\xa6\x96\xe9\xc6*p\f" +
-			"zW]O\x97\x12\xb2\xe4\x03\x91٨_\xf1\xd4\xf2T\t\x9f\x1dF\xf6\xa1S\xdbS:\xf4\xb9ʞd-hL%\xe3##\v\x1d\x0e\x93\x1f\xe9h\xe0\xacD\xad\x84{x\xef0\x04P\xe7VZnsQ\x10@r\x0e\xc4w\xe1\xd1\xfb\xd5\xe2Ů\x03Z\xf1\xb4\xab=\x85߷\xaa{a\xcd\xca\xd7_\x9cd~Y\x90\x9d\x17\xdf" +
-			"\xff<c\xde@\xaf&\x8a\x98\xbe\xa3\x1f\xbeO\xd0\x10\x85q\x9a\xe3\x0fV\xc87\xfd6\xce\xf8\xb9d4\x11\xf9,\xba\x041\xa1\x98\xbdX\x9dM\xa2p]\xa9\xb3u\xcc3\xfbغ\x1a@\x8f\xf0}_E!\xf9Q\x90Ge8\x80\xd1\xc2\xc2\xd4Ҽ\x0eJ&\x98\xadDz_\x87io\xbds\x15\xae㟮\x12\xd7\x16x\xb1?\xf9" +
-			"\x12\x99-<9\x11U\xb3\x9b\xbf\x9e<P\x1e\x9c\xe8\xe2Q\x82\u1ca4!\x928J\xeb\x8bRg66\x1c\x85\xfd\x14\xa4\x9a;\x8d\xcc`\x01\x0e\x1b1\x06\x81\x8ag8`\n1ˏ\xa57]\x9d\x87F\x15E\xa4\xf7\x99j\xa8\x84\xcc^\xeb\xa8\xee|\xefaЌ\xfe\xa2-1Y\xe2}\x9c\x16\x1c2\xeac\xc3{\xbe\x18\xa1(\xb0" +
-			"\xabK\xe7\x9fk\x84\xae\xf8H\x97\xc80\xd8X[Y\x99(\xfc\xb4\xb3\xd2\xf7\xa1\xd4\xf6z;\x10B?\x87 ]òJz\xaeKκ\xd0n\x99H\x1bO\x18\xf2\u0084Kv\x0e\xf6\x10Oq\xc0\x92\xeb7\xc5\xcde\x1c\xf0&Dl\x0eJ\xd1=\x9f\b\xcd+\xee\x829\xbc\xa9[/\xed\x1f\xe3\x00\xaaq\x80\rE\x88\x17\\\xcc\u007f" +
-			"\x16\xab\xb6m\x88m\x80\x1f\x0fB\xc6\xd8\xe9kcO[M}\xber\x8b\x96\xd7\xdd_d\x13-0f\v\xbc\xa0\xeaM\xbf\x8bx\x14\x1cP\x88\u17fd\xddP\xe9P\xacIa\x15\x9c\xdba\x06\x11L\x1eLpW\x83\xea'x\xfdp\x82\xb30h\xa9E+\x97\xf9\xafx\x84_Y\x0e\xbd}D&\x96\xba\x9e\x9ah=\x1c&%\x1c3" +
-			"\xae7\xed?\xbc\xdf\xe2\xc9\xf5\x83Yz\xe0k\x01\xe8,\x91\xfe\xa7%iE\n,:\xad\xf4\x82\x9f|]\x11\x04\xdep/z\xcdi%\x04\xac2\x1alR\x94\x17\xcf\xfa\x06\x9f\xaao\xfd\x905\xca\xf8R\x8a\x03\xa4\xe5L&\x18\x0e\x93L'ԭ\t\xb6c\xf9\xda\x1c\x1bbs\x81P#\x10Z2\xbaqI1\x1f1>\xaax\u0084" +
-			"\xac\xfa\xb8@\xe9w\xab\x16\xb5S\xae\xf0\xe6\xeaRP\x00U\xc7ڭU\xbe\xa6\xd3x\x96\xc3\x10\xb1\xc5;\x9f\u007f\b8\x1e\x1a\x88\xf7\xc43<*\xe9\xe4j`%\xf2Z\xef\x03\x9c\xcf\xec|ؙ+n\x84>\xac5\x87\xe6(8\xa5\xfc\xfa\x9f\xaeK\xda\xeaت|\xeccsx\\\x9d\xfeG\xccP\u007f.7\xbb\xf6\xf3W$\x05\xf9" +
-			"\x97(\xb2f\xac㿸\xec\xaf\xc9\xf8\xf0\x96\xfd\xebX%\xa6,\xc69\xff:\x8b\x95\n\x8d\xd73\x12\xe6\xfb\x80\xad\xbb\"\xb5e-*焵\x11\xa2Lh'\xecĝ\\\xb5\xcf\x13\x978\xf4X\x05\xad\xd8\xc2\xc8\xce5\xc2U?\xf2\"d:m\xe1\x82O\x8a\xc4i\x8f|\x87\xa8\"\xf5LC0j6V<\x91d\xb6\ue410" +
-			"r\x96{\xb0\x8bjl\xf2G4!\xf5\xf7\x03\xd8\xcblh\xd9\xe6\xd2\xcf\xd8#4ܿ\xc5\xe6ђ\xd9\xcd$\x1a\xc5:\xd1\xce]\xa1Ė\xcar\xe1\xf8\x93\xf6o\xb9\xca\xf9\xf7:\x1d\xb8(\x98\xb5\x98I\x06|\b\xf9 \x9bYy\x01\xae\xc5\xef\x1dM\x9d\x87.\u07b9\xf7\xb0&\xdeܪ[\xb8\xbc\xbb\xd9y/5~o\x00Ϥ" +
-			"\xc5P\x1db\x92ao\x9f\xb01\x8a\x15pUX<\x0e\xee~o\x8ex\xe8\xc4Pi\x18\xa1\xd0랰\xc8v\x96@\xca$\x8fA\x16\x84\xd2ီ\xe7\x0eسY\xfc\x82\xee\n\xe5\xf5\x97\xd9\xd4\xf8\xf6pl\xdbZ\xc8\xda\xf7\xf4{\x01\x0e0\xe1\n\x99\x9b\tO\xe4\xd8_\xa5\x03\x1dPxr\x97\x16\x95&\xf5̉&\x0fFv" +
-			"\x89\xeb\x1a6Dk\x8f\x94\x88\x93G>\x99{\xfa\xce\rC2\xb0\t\x17\xf17[H(\x9d\x9f\x14\x1f\xb1\x8bqsYd\x8a\x97\xa5\xf7\x86MSF\xee&4%Ȳy\xe8\x8e\xc9\xc1\x17ܞ\xbc\x10\xb1\x97\xb8Fb\x95\x1c\xbekͽӉ\xd6XŘ \x95\x14\xf2\xe9@M\x0e7+\x8a\x1d;\xeb\xd0Yg\xfe&\xdfCE" +
-			"\xf0\x94W\xf7\x0e\x0e@j6\xee\x83\xe1\xec\x0e*\xaeŎ\xf9e\xe8\xc4\xff\xff\x8bJ\xb0kgR\xf8\x8eqm|\xa6\xc1\x01\xfbf&u\xc1\xf7\xf0\x9fE\x11'\x9b\x8c\xd8ǖP\xd4\"V\xddB\xf2\xfc\b\a\f\xb9X\xa2\xd1v؛\xdd8`\xb3\b~B\x0f\x04H6\xcf\x1e\xb3±\xf568`0\xa5\xe1\x1d2\rK\x85" +
-			"\x0f\v\x9e\xce\x1fl\xf2b\x1f=?\xe0\x01\x82{ѹX53\xb7\x93}\xf89\xe5\xe6\x88\x02\x9e3t\xbd\x81\x9d\xfb\xbe8\x1e\u007fuf\x8f\u007fN\xda$\xb6\x1eO\xe8U\x02\xf7\a\x1e!vy\xd5\xf1)4\x0f\xeb\r\xda\xd3)\xa7H\v\\ޢ\xb1\xa93\x11\xe5\x17\xea\xe61\xdf\r-?lx\x13@\x95kEt^:͆" +
-			".\x8a\ry\xf9\xb0\x0e\xbc\xd0\x19Y\xf2\xb6\x01A\xfb\xfdR\niة\x92~*k\xf8\xd36\x84wx\xf7V\xe0A\x89n\v\x9c\xba<\x91\xac\xf9\xf0B\x9c\x8fC\x8a1/7\x1do\xc9\x18s\x13o\n\t\xd7\b\x1b~\x81\xa7\xf4\xb2Q\xcb\r\u007f2R\xbdҶ\x0fP\xa4\xc2)\x1c_\xdc\xc0\x01\x865\r\xfa\xe1r\xc3\x1a\x90\xd3" +
-			"-\xdd\x03\xa4\xdax\xe8%L\\\x87\x95\xf7E\xd1\xeffy\xdd$\xd6O^\xa4\xe8\x1ejy<>\x98\xf3\x18]\xa8\xeb\xdf\x1d3c\xad.\xca\xef|E\xf8\x94\xf5\xfe\x92\xabJd\xe4\x01\x1d\xe8H\x1by\x16؎]\xc0\x01\x02\xa5w\xb6\x11\x8bc\xc2G\x8c\xc7wb\x01A<e\xa5At\xb5\u007f@\x8cO\xfd\xde\vv\xc80\x88\xb6" +
-			"\xac]\x11G\xf8\xc1\x91\"6\xde\x118\xbdia\xfaá\xdb'@@\x99\x86s'\x80I >L\x9a\x17\xf02\xbf\\\xfc6X\x1e\xfdfl\xb6lR~+l~\xdbNW\xfc\x93L\x12)e\x98L\x92\xbb`\xc1\xd8u\xe4\xf6\x00\xc5@\x1e\xf1\xc48üQf\xf8'\xb2\xd4\xed$1\xf8\xa5\x1fӎ3\xb4\xe34\xf3z\xcd" +
-			"\x01\xb6\x97z4d\x17\x894vH\xa4\xe6\xf6\xa5!\xf5@\x10\xd5[\x94\xd6O\x85\xe7\x05\x87\xc7\x06\xde?w6\x10\x87\xa6\xbb\xf2~\xad'\x03\x8f\x0e\x88*F\x19{\x92\x82\xd1Z\xcc=\x15\xd0\x195\xa6\xb5\xd0G&\xe5\x93\xc1\xce#\x14\xc0\xf4\xfa\x1e\xf7t\x9bsQ\xe3\xab\tĭ\xddD\x16L\xcfb\x87d\xe9\xed\xc18\u007f\x97\xd1" +
-			"\"\r\xaa\xc5=\xea\x1430\xf7\x9c\xefB\x98<\x0e\x98\u007f\x11s\xf5Lu~ׇx\xd6É7\xa5?\xb0f\x8e\xde\xfag?\xb4D\xcc\xf2\xa9\xa5\v\xaa\xb6\xf5(\x10\x1a\xad\x05\u007f\x9b\x10\xd4-\xb7\xf0\xc8\xea\xb9;A\xd4Ѣ\xd4v\x86O_\xa06@\x8f\u0097\x85\xf3A\x88\v\x8e\u05fa\xae\xf5\xfa\\[O\\\x94\xc7\xc7\xeb" +
-			"\xb8wa\xfc\x91\xabU\xa3\xc2\xe5\xd2\xf8\xfa\xba&\xf2\xa7\xb9\nf\t`\xd8\xe1]\xeb,\xcbzU\xc0\xc54\x98m\xf0\xa7\x90Um\x9cޛ\x14\x1b\x13\x03\x82\xfbQ̄\x1eƊ\xf3\x9ak8`\x0f\ax\xe0Ik>Ƅzn6V\xd2\xf2\xe2\x17\vA\xc3G\xd3\x13\x9d\xd8\xe2\xe7\xa5\xdcN\xe73\xfeVk\xa4L\a\x1d\xc0" +
-			"\x14\x9a\xb1C\xccϖ'3\xd2\x11u\xbdШ\x8f\x9f\xf5\x19;\xd57\xf3\xbd5\xaf\xce<\xe0\x1e\x8c<\x98ݜs\xf3\xd5\x03wb̗̗\x1dN~\v\xc8X\xb72rr\x17^-/\x8aJ\xe5\xca[\xf9\x14\xa5\xef\x89\xe9\x0e\xb93\xeb\x95\xd8\x0f\xf0\xdb\x0e\xa5\x1c\xafWɦ\x87\xa3\xe8[\x06c\x1dn?\xaeh\x9fe\xbd" +
-			"\xc0\x8c\xdd\x10\x88eN\xad\xdbSS\xed\xaf\x11\xbd\xa61\xe4\xaaL\xf6\xb6Vg\xdc\x19\xefC\x12\xb9\x14\xaa\xf0\xd3hu\x86\nu9wQ\xe2\xf5V\xbf\xd0\xf6\xa2\vR\x8c`\xab?\x80\x83\">\xd3S\x93\v\x19xP\xeb\x16\x81eMt2\x9f\x9a\xbe\xa5\xae\xc7L\xaf;_\x965VW\xdb\xd4x\xc1\x06\x15\xef\xf9}\xc0\xe50\xee" +
-			"^\xd6WY\x9dq\xe3&\xc2x\x94\x1a0\xb7\xb8iQ\x82D\u007f`\xf9\x91;\xb8\xf5\xca\xd6*\x9c{\xc9J\xb7\xe0N?\x82F\x91\x05@\xe3\x00\xba{\xa6\xeb3\x9c\x8d\x9f>[$)\xcb\xe6\\պ\xcf\xdf!\xaa)~\xb0\x12\xe3\xe1\xe60\x1fG\x9e\xfe\x81\x81/e\x98\xa6\u007fI\xa0\xe0\xbb47Jp\xa1\xf7\x15\xefk\xbf̵" +
-			"/Z\xfc{\xcc\xe9\x1f\x19\x8f?\x87V\xf7\x85\bχx\x0et*0T\xa3\xbe\x8a\xd4\xd14\xdb$\xc9V\x90\xed\b\x18QGf\xd8zueSj\xc3\xd50A\x8b\xb2\xd9O\xbd\x9cx\xb6\xc2\x1e\a9\x8c\xf4;CU\xb9%\x80\x1d*\xa0DL\x0f\xc3\x1d-\x94\xe7\xc8\xedѢ\xf3juz4\xf1\x9c\xb8Ε\xd9Ǎt3" +
-			"\xee\xea\b\xd1v|\xc6Ǆ\xc5\x02\x85¦\xc3\r(q}\xc3\xf8\xe2\xc3\xd7.\xcd\r#+\x9a\xb5O~܃.\xf8&\x1f\xe3\xddH\xbc\xe0\xefv\x0f\xf5f\xd7lC\xab4q\x80\xae4\xe3\xa3uM%\x84\xe3d.\xb1\xa7!\x16c\xb6\xa0Շ\xb8\xe1_\xce(:\xf0pA\x8c\x8b\xed\xcb[f\x83\xe9n\xb6\xf8\xa3\xce\x12\xa7" +
-			"\x963\xb7E\x873k/r&\xa2:\x86\xe6G\x0eo\xbejY\xf3$@̩D:냢[\xb2\xa0\x96\xf6\x81\x14/\x18\xd7\x1e\xbc>h\v'i\x15\x00=\x9e\x9e\xa2\xed\xeet>Y\tS\xf0C\xbb\x9d=\x95A\x9e\x87\xf4့\xafO^\x98?^EQ~Տ/\x1d\xe9\x063\xf7s\r\xec#\xd3\xea\xcc\xf0\x14Q" +
-			"\xe7#\xbe\b\xf7B\xe0k\xf0\xce\x11}\x9ba\x97\xe4\x8e;\xd9J\xca;d\xb1\x00f\xa05Х\xa4\xb4d\xc2\xc1\xef\xf3KΪ\x90\xc7\x1d\xa2\xa4\x10{\xe6\xc5\xd4p\xcc8\x9e\x9d\xb4\xa4\x0e\xe1\x80U\xec\"\xf6X\x17\x85\xe4BG?\xfey\xc6U,Z\xe0S\x89\xdfI\xaf\rpI\x83\xfd\x1eZF7\xe2\x97\xe9;\xfb,\x16B" +
-			"\xe38\xe2F\xfbݞX\xd0k\x82F\xc5j\x950h\t\x95\xfcc\xc0^\x1b\xe5\xa4\\\x19\xf3\xbej\xf4}\x82\xf8\xc6@\xa9Q\x88\xe6\x02\x12c\x8dOIQeo<g\rF\xca\xe1\xe5\xda\xc4+\xd4\xc4O?\x93=\xe7%\x1f`F^GW\xb6\xa5g\xd1M\"\x1e\x9a*\x1f\x9a\xc97\xbfd\x98ڥ\xee\x9aN\x00 \x19^\x03" +
-			"\x81;\xa1\xd4\xfe:+\xdd6\xe8\xcb\xd9Qb2\x01\xbe\x1b\xad\x10+\x8e\x17\x9d\xf7݁\xc3\xee5U\xc0\x13\a\xb0(.\xd9V\xe07\x1aU\xfc\xfe0SO>\xb7z\x16\xeb\x89g'Y'd&\x99\xff)\xfb\vGf\xed\xf2\xe3w\x16\xb8\x01b\x8d_\x89\xbd\xf4\xf7\xb7\xb3\xdcІ\x9a\xd8\x11Գ\x11\x8e\xafi\xb7\xbf \a\xdd" +
-			"q\x00f\x9d\x17\x85\xd4(<2F[\x9ai9HM\xb3uu\xb5~\xbb{\u007f\x10ҋ\xa0\x94\xbbq\x9d\xbf\\{\x89(\x88\xf7\xb9\xf2On.\x01\xc5\v\xdaڨD]ə+\xa7\xcea\xc9\x0e\xb4\xbdY\x87\x93K\xb0\x13j\xc5\xc7\x01\x1dy\xd6W\xd3\v\xcc\x1d\xf6BN\x03,\xc5v\x8c\x16\x15\x9f\x86@Pfڻ\xdeq" +
-			"}\xfaQ\x94m-\xe2\x8a[\x1dbg߱F\xa0UE\xba+Y\xb7\xd0E\x95zo\x1a\xed\xef\xee\xe8%t\xeed\x9d\x05h\xe6\xe2\xb7cZ\xcbo\xce_)_\xe6\x94Ŕ\xae\xed\x81.\x9d\x93%\xb6\xd46>}\xed\xbbi\xa1h\xac\x8f-\x9e \xc6\x01\xe1O{\x8f]\x14\xdf\xf8\xbf\x88ޯ\x18+\xaf\x95\x17R\xc6\x01\x1a\xcf" +
-			"\xef\x94\xfe\xf0JS\x8cX\x1a\x84|S\xa4\xf2N\xdcJ$\xbd\xd7\xd1Jc\x0e-St\xb5Y\xb2\x9b\xba\n\x99\xb7\f\xfa\xe7\x17\x91\x8e\x9e\xa9>%\xe0h)\x00@\xdf\xc9Pn\t0?͓\x01\x1c\xf0Y\x8f\xe8g\x04|\x97lTq\xb3\x06\xb1&\x9e\x8bNǪ\xda+nn+\xeeC\x8a\xfe\xf5B%:p\xd8,\x8b\xe4\xb8" +
-			"4B\xb5\xbe\xcdSA\xb1F\xf96֙\x0f\xfc\xf8_\xe9\x1a\\E\x17\x9b\xa3{\xa4\xbb\x8bL\x94c\\X\xaf\x83\x15t\xfb'\x91?YI\xec\xf5\xefu\xde!\xe1\x98\xdbUx\xb3\xb9\xaeK\x8aAbi\xceYV\xf5O%\u007f<\xf2\x94S\xf6\xfb\xd9\u007fA«\x1cQ?B\a\xda5N\xc5\xeb3\xd3=\xf9\t\xc3>\x12\xdc)" +
-			"Ęh․\xe2\xfcs\xbfD\x1cp\x83\xfe\xe8\x0e\x10\x00\xef\x80\x1e\xd3!\xb6W\x14\x97\xbe\xad6\x12!\x96\xee\xd7\xe1\xe7\xc6\xef3\xcc@\xc6\xff\xf2\x12\x10\"\x84\x9f\v\x90\x17g\x06h\xed\xd5\xe8\xb2'.ec\"\xe6\x96_\xf8\x92|n*I\xd2˿\\\x02\x82\xbc\x12\x9cZ\xeb\xb8\xe6\xfat;ƽ\xba.\xef\xe6į8" +
-			",\x11u?\xecnbf}y \x97\xb8lY\x8c\xceը\xae\x19q>\xb0\xa7\xfbl*\xf6\xae\nK\x9f\xeaʺ\x9e\x9c\xd8f\xf2\x02\xc3\xdai\xe8\xafp/מ\xa8\xbd\xbc\xd9`\x83jܠ\x9e,\x83\r\x15\b\xfb\xaa\a)2\vf@\xed[i\u007f\x9b\x1e-\x92\xd9\xe6\x8bEP\xbeÖ/(\x88\x8f\\)C\xd0ά" +
-			"\xd7xnEЄӥ|\xef\xe1\x97\xcb\xc8|7^\xec\bg\xd9\xc6\xf6\x9bh|BH%\xb0\xf8\x82\x8cN\xe6=\xcd֓-\xa1!^~A><\xdb{b}\xee\x1dj\x0f\x99\xae\xdc=8\t\x91ʆ~\xfe\xcf'\x99\xbbYQ\x96\xd7\xcaǮp\xa0v\x95\xd0\x03\xd6Β\xd2\x1c\xb9\x84\x97Ԥ\xfbu\x94\xd7-\x8b\x95" +
-			"\x88\x82ߠ\xad\xb1\xeaZ\x89'\xab\x8c\xd80\xc1M\x96cمγ\x84\x17\xb0\x83~\xe1s@\x0fR\x1d,R\x1b\xfb\xb39\xed\x86(\xb9J\x03/\x98\xed\xda\x03w\x8f-\xe53\xa7Fdb\x1d\xef¶u\xb3X\xccM5-\xad\xf2\x19\xabT\x81\x12\x90\xc0EV\b\x84#~.rp\x9fi\u007f\x12\a<M˲U\xfc\x91" +
-			"F\xc3\x1a!\xb5\xf7\x92\xd0psw\xa9r$\xb1\xf8\xd7*f.y\x9bޖv\x88\xce(c\xe8\x81e>~\xf5\x04\xbfCB\x17-\x13Q\x81\xc4q-\xda\\v\xb4\xe6]\x8bk\x04H\xd8\x13\xf1\xbdw\x1e\x1bX\x81\xb1\x8a\x92\xcd\xf4\xc9nQ\xdd\x0f\xf2\x9a[t\xd0&\xd0:\xdcƞ\xe5\xa6)\xc3S\xa7w-\x9eB\x8bdw" +
-			"=\xaak\xbe\x84^\xd1̆Ƌ#\x8f)fp\xc0x[\xe3\t3\xb2\xf2o\xa6d\xd2\xc62~\x16\xe5\x86\xd8\\\x80\x9fS\x8cO\x1e6\xa27\x19O\xe3\xce\n\xc0\xfdX!\xa5]\x8c\x84\x9de\xb26m\xa6X\x87/\x96\xed5J\x11\xec\xef\xd9\xdb.\xd3\xeb\xe0\xf7\xa5\f\xdcmw\xe3e\xafhr\xa7B[VKC已" +
-			"\xc9\x10k\xed\xaa\x91\xab\x8dߦԺt=\x92\x9b\xd9\xe7v\x05\x17k?j{5\xb3\x9cD\u007fe4F6H\xb2}D*\xf5n\x14I\x9d5\xafr\x86\xc9\u007f\xc4\xd2e\xe2'\xac\xe2\x11\xec\xa8\a\xf1X\xd9\v\x15\xd4Za\xcf]\xfb\xcd\xf6\xb1f\x93\x83\\9\xe1\x89\xcd\xe2k-\xd8Ӵ\xcb\xdf\x11\x0ewo}\t\x1fH\xe9" +
-			"\xd1˂\xa7\x13\x86H\x9f\x8c\xbf\xf2\xef혈\x8e\xdfy\x10\u07fed֦\xfb\x9c,\xa5\xed\x8bG\x9a\x14\xb3|4\x9b\x1e\xa4\xc6_\xb0\x19\xcfv\x0eH\x91\xfboT\xb6K\x86\x02n\xd7&WD\xdd\xf7\xda=\xd9\xfei\x1e\xdd\t\xad}wn\x87\x03~\b\xfe\xcaȚgҹ\xf5\xed'[\xb5\xf5\x02;х\x9c\x89\xcfu\x1b" +
-			"\xb9\xb3JdM\xc4\xd1\x15\xc3&\x9fF\x90\xcfh\xd9\f4Y\x83\x18\x81\xc4s\xc2A\xc2,\xc2\xc6%\xfa\x02ĸ7>\xcb:M:-\xb9\x811\xbbn\x10\xf2\x97ڟj\xa2\xe6>\xba\xcc~\xfaxUMy\xe2G\xd7\xe6>\xf2\xbd\x9cPI\x16\x8d\xbd\xbb\xd3e\x18\xd7\xc0\xeci\xfd$\xba\xbc砩\xdfc]\tƅ\xa9\xc4" +
-			"\xaa\xbd1\xc16\xac\xe0\xd5\xf4\x85\xa4\xe6!\xba\xae\x8e`\xab:q@v\xec1\xb8\x93!\xe5\x87\u007f\xada\xec}e\xa7\xe8$\xbb\x9a.\xbf\xc8c\x96\x03E\x8a\xe0{\xaf\r߯\x990\x80\x96\xfd\xa2\xbe\u007f\xe5i\x92\xbe$\xe9\xf2=\xe0g\xfc \xe4\xf3愑\xe9\xcaS\x9f<\xadxs5#\xda\x1f5\x1f\xceX\x89\x1b\xe4\x06\xcb" +
-			"I\xcf$\x17d\x9d~\x80\xd4˲h\x90\xc1\xe0\nՓEΆ\t\xe4\xb3PA\xfb\xac\x1f\x96|AU\xe3\x17f\x91\x19U덹\x89\x03+g\xf6\x04\xbaq,\x83\xdf\xe4\xb4\x0f5̼%\xf4\v)\xbc\xaf,zQ\x1f\x87cLʃņ\x10\xa5u\xfc\xae6\xe6Z~\xd8$\x1c\x80R\xacQ\xfc\x99\xa4\xc8r\x9c\xd8\xca" +
-			"\"\xeb4q\x9e\xf9\x8c\xd8\xd8\x01\x9f\x81\xed\xc7W\xbc\xf0\xa4/\x12\xee\"P\xc8w\x93\x89\xec\x1a\xb0E\x04xe\xfe\xc7\x12\xe9?\x17A#3\xff\xd7\xebz\x90\xef>\xa8\xfe/\xf8\x84\xf7q\xf6\xe4\xf8ů\x13\xd8#\xf1`\xb1\"|u\xe3x\x84m\xb0\xfd\x15B \xc4\x02M<\xbd\x13L]\xa0\xf6Ƙ\a\xd1\xcc|\x17\x1b\xd1\xf0" +
-			"\x00\x95\xf6\xd4i\x812tV\xa3\xeeU\xb6\xe6\x11eo\xfc\x12?\a\x05\xba\x9b\xfeЦ\x025=!^\xdc\x12\x95p\xb7G&s\xa3\x950\xf4\x0ez\xe0\x89\xf7E\x93\xe6\xc0\xb9\xa7\x95\xbciXۢ\xc5\\\"ic\xc8\xfb\xf9\x0eu\xeb\xedX\xe0P\xf7?\x1c\xa4\xe0\vR\xe23E'\xe6\u05eb\x86i|\x1d \xbb\xef\xd9v\xe1" +
-			"\xdd\v\x1f\x1d\xe0,\xd9\f=\xaf\xbf\xa5\xbezv\xbf\x8e\x90\xdek\xb3\xbc\xb4rO\xbc\x193\xb9@\xbf\x1aw\xe7JN\xdcV\x85G\xc5vz\xa5ا9\x06)p\xf8 \xf4+:\fj\x81J\f\x97\x93\xcdq\x12\xfc\xe0\xf0:k\xf2\xf2S\xa6\x00\xab\xe4OL֊\xc2\xe2s3\x12\x95Ap\x86\x8ddECϟ\x18\xe9)E" +
-			"\x15\xcbyTV\x8b\x89\x8c\xfc\xbb\x9a\x99w\x99\xe6G\x8e\f\xdcVQwk\x96N\x18\xa4\xa4\xf6\t>)\b\xfb\xeb,\xaf\xc2\xe9\xee\xaa\xd7K\x10\u0590I[\xd4\tf9\xbb\x87\\\xe3\x03\u007f\x0f\x16\xf2\xa3?\x8a\xda\xdf|\xa9!06\x94 CP\b\x866E\b\x866\xdcF\x99\xb4i\xfd$}[\x9a\xa9X~O̕\xb4\xd6" +
-			"IM\xd7 :9rZ7t1\xe30p\xb5\x03!3\x94=\xe8\xc2ΟA\xbdz[\xbd;s#\x97#\xebv\x06TE\x89\r\xd66\xd9\xe5\x16l\x178\xc8Vd\xc6ɍ\b\xa0#>KP\x9f\x87+\x9fI\xe0\xdf\x1d\xaf\xceo\xf2\xbfom\xd2u\xa4oѱ)\xb4P\xe50\xdeG\xb4\xf7\x82B\xb7o\x80W\xa1~s" +
-			"31jv\x97{}v\xe7w\x1d\xb6c\x9f\x13\v\xf0\xfd_\x1an\xfc?\x1e\x02VM\xab7\xb1S\u007fs\xb3\x1d\x15ު\xeb^\x91}S\xf7Ä\x97i\xad\x04\xb2$\xf89'L\n\x8a\xb9\x83\xc5O\xc6l?\xacZ:\x0e\x88˃Q\xc8QP\xa9\xbd\x19\xc1\xa6\xe3\xe7T\xfbx\x88*`\xbd\xfe\x9a8&\xd7\xee\xf4j?\xab" +
-			"\xa7\x1a9'\xd0\x16Ti\x88y\xfd\x1d\xff(A\xda{\xa8\x95\x98\x05\x92G\xa6텺\x95;\xa5]>'\xa2\xf3\xd1sN\xae\xb1\xc0n\aFq\x9e!\xd5\xff\xf4V\xe2N\f\xb1\xe2\x1dރ\x92J\x15Ttt\xb0L\x87\xb6\xc0\x90\xf53\x94\xed\x03\x8bs\xd4WM\xe0\x84\x82l\xbd\x00oe\xed\xcf\xe8\x06\xe8(\x92\xa7\xb1\xbet" +
-			"\xef\xf2\xab\xb8ڀ\xcf%w\x0f\xa4\xa8\x803\"\xdfgz\x10\xe1\"\u007f\xb1\xd6\xc0-\x8f\u0382\t\xc1\x97\xcf8eRJ\xe8Հ)\x12n\xa8J\xb2y\xce\x19\f\x15\xd1\xc5v\x9e\x17}D\x11\t\xcbY=@\x1a愉z?\x85j\x0e\xba4t\xbf\x9b̫\xb3K\xadL\xe9\xfc\xf6r)\xf5@\xbcX\x95\x0e\x14^\xe2o\xad" +
-			"\x82f\x11pb\xe8\x15ѭ9\x16\xd8ܷ\xe5X\x9c\xcc\xf3\x88AE\xb6\x90_K\x8a\x8b\x82\xef0+\xc0\a\x89ϝF\v\xb2\xa9\x1c\xe1$\xfe\xae.<ޒ\x9f\x95\x1f\x16\xc1zot\xa7\xb1\x11_y'\xa2\xb0\xb4\xa73$ǃ'\xa9TZ\xdc\x13\xe2\x95I\xbb\x05&\xb2ӻ\xa0\xaeӭoCCJl\x8c\xf1g>" +
-			"\xb0\xc8w\x15\x9aE3s\xe6ї\x9e\xb7\x84\x192\xb3En\xde5\xbd\xf832\x94\xe7\xfc-\xb2\xec{\xca\x06\xa7\xa4 ۭ.u\xcaz\x89R\x00=\x9e \xef\xf4\x05\r\u007f\xbcUw/\xef\x8d\xc2\xd6\xcbi\x1dL\x1e\x98\a6~\xb1yM\x1da\x1d\t\x97\xb1<\x93DW\xc4\xf8\xac\xdem\x19\t\xb0\xe3\x97\xff\xb8\x87\xa1\x88\x86" +
-			"\xa0\xce\xec\xc1k!b\xcd\xectCy\x8c\x12,\xc1\x9a\x9c\x19ў\xc3\xf4\n\xd9\xd5Ρ\xf4{\xd1m\xf5\x8e#f\xe8\xdb_\xeeV\xa50q\xdd\x05\u007f\xe8zP\xcd\x1d9e\x93\x9c\x89\xc9\v\xe1]p͉\x1a^\x90\u05f9;\x19\x91*\x97\x0e\x1d\x0fh\xf7i`\xc0\xa8 \xc4[,0\x19O8n@\u007f\fE\xca.~\x11" +
-			"]\xe2\xc3o[\xf9\xa6\xc0\x01,\xaa\xc2\xe0;F\xb4E:\x8aE\xd5\xc1\x03\xfb5\xfe\x1e\xe2\x9ar\x89\x96\t\xa6\xd6!\u007fv\xda\xc1DƬ{I\x03U\xb1\xceHY\x96\x9cA/\xc7rh{G\x95$\x8cH?\f\x02\x80,\xb5\x16g\xa3\x1aQ\xc9\xe6\x17\xadսcQa\xdc\xea\xf3<\x9d\xc0\x92\x1de\x82\xcc}\xf4H1\xe0" +
-			"4\xe1/\xdb\xc4\xce\xf3%ڤrdA\xa4\xffm\xf4\xb3>&\xc2\x03B\xb5\x1eQ(\xa1u\xa4\x19\xa3\bƭ\xe9D\xbc}\xbb\xee\u0084ړ\xd05\xd0\xd2}\x85;\xdd\xcb1\xf2\xa2N\xabt\xbf\xbe\xfdC\xf2\xf9\xde\xe4Ls\xd5\xd2\xf9\xf3n\xc8\xe6\xb2H\x9f\x14Fq\x01\xf6\x84[\xad\xbe\xb2\xe6\x19\x12T2\xf54\xfb\x00\a" +
-			"\xdc\xfd\xd19\x1c&\xddH9\xa1\xfd\xc1?\xb6e\x8cCKӃ\xdd\xe3;q/\xac\x89\xc0i[\xb6W6\xe4ư\x19k\xf9,Ś\xb5O7\xb31\x13\x17G\x84\"\xd9\x1e\x12t&\x1b\xd7R\xe4\xc3z\xcc\x10td\x91\x8d\x9c\x8a\xd1ի^J\x9dBw\x02>\x03\xf9\x83\x90\x8b\xef1\x90\x16Sխ\xc0\x84,\xdbe]R" +
-			"\x1eX\xdf\xf3O\x1a\x1c\xa8~u\x95u\xb3\x89\x8a\xc4|\xa74\xed\xfc\x8dҀA\x11\n\x13/a\x01Oi\x03覩\xb7\x1eD(\xd7_\xb8U\x01V+9\xa1\x9a\x9f,r#\xbd\xae\x9buY\x8a\xdb\xeae\xc2\xcc\xf3\xd1!U\xc0\x8be<\xb2Nz\x81\xf5Y혐ܱ\xbe\\\xb9\x9b\xe8͖\b\x9dk\xf1\xb9\x1f\xc4\xf3" +
-			"\xdf\xd9O\xb8>~x\xfd\\@\xf82\xd0\x1e\xb1\xf3p\xb9f\xb7-k\xac\x9c/\x1bʍfl\xd7\n\x01߭\x1e\xca\x1f\xc94\x04\x81\xfd^\x02\xf7{X\xa5&\x82X\x1a\xf30~M\x96\xd7G\x1e\x8c\xe5\x8e\xe4\x10\xc3\x1bJ\xbf\xa8\xe9\xe3\x80\x18\xb3^\xc1.\x85\xdc\xcc\xfa5\xf4\xcf\xd6\xefu&\x95z\xa5\xc9\xf7\x1b\x9f\xfa.\u007f" +
-			"\xf9\xacz\x11l\x9d\xa4n\x1d\xb9\xa6~\xdb?\xf1\xec\x12\x0e\xb8PFj\xfec\xd2\xe2\xa6Y\xc4a\x82Μ\f\xe2\xa3t\xe7X\x98Bj\xa4\x16\xaa1\xb2\xe8ac\xc4\f+_\xaey\xf7e\x85\a\xf2\x92l\xa5\xddkb\xdd\xc8$;\a\x9f>W\xb5J\xdd\x19\xb8y)\xbe\xdar4\x99\v}XS\x89\u007f_.\xfe\x85\x96f3" +
-			"\xfdtK!\x1f\xba\xc0X\xec'\xa4\x19\x8a\xb7\xf5\xd2\xfc\xe9\xb31\xf1W\ffm\x90ާ\xd2\x1a\u074b\xdcYu\xdf\xcf \x8d\x84@\xe0\x94\xe6r[\xf2\xc1Dv\xe8\x8dw\xbf2Ot5\xc6m\xae\xb7\xf7\x95\xeb\xce\x15I-\xb1\xb2\x84\x19\xef\xd6+\x9d\xdcsR\xe0\xac[;|\xd9ą\x85|\xaa\xf8\x1dU!\xe2\x93O#\xb9" +
-			"=\xfb%\xf0\xa9\xe5\xd0e\xdby\xf8swyI~\b\xfb\x8fvo<uO\x93l\xc6\x01$_~\xc2\x1c\xf4χ8:7\xf0$zxm\x86\bU\xf1\x98\xaf\xea\xd3\x17\xb8t\xa0\xe6%\xd1k\n\xb9\x1e\x97tG\xe8\xc7\xff\xf1'+\xe2\xcf-nI\x11RG@9b}\xe8@\xab5\x89-8\x00\xe2(z=gtR\xd6" +
-			"皴2FX@\x11\x10\xa8\u007fт\x80\x98J\xa5h\xd8Vs,\x91\xdaH-\xfe\xf0\x8dČ\xe7\xe9+]0\x9b\x9c\x9c\xb8\x916VZ\xa4\xafv\xad L\xa0`\x1fp\xe7\x1fi\xa6\xfc*\xec\xe6\x1e\xc7\xedIP\x94\x00\x16\x04\x8f^\xf0\xe7\xcc\xcb\t\x93\x1bޛW\x8c,Y\x16\xed\xa4\xab8\x96\xe6䧚\tI\xf7U" +
-			"$\xab\u007f\x1f|\x1d\x15\xfa\x8a\xf3kZhp\xdc`\u007f\a\xben\v\xcez\xb1_r\x18\v,ʩ\xcc\tSa\xd2lk\x1ac\x86\xdd\xdf\x06w\x999\xd1_\x04\xb1E&\xbew\x1c\x8f:\x83\xe5\xf8\x1b\xdd\xf2~\xe7>BDտ\x1cC\x9e\x11DU\xff\xd8\xe7\xba\x1e1U\xf9\xfc\x1b\xf26>Z\x93r\xcd\x1e\x14\xb2\xa7\xea\x90" +
-			"❄\xfc\x97\u0605\xac9\x1c\xf0\xe8\xde\x11rB\x0f\xe1\xad!r\xf2A\xb18\xf0\r\x1d\xa8\xd3\x1eK\x80*\x89\xe6\xab\xfe\xfc\xc5\xf8\x95G\f\xcfe5\xc2\xff\x1f{o\x1d\x17W\xb7,\n6\xda\x04\t\xc1!H\x93\xd0\x04\x82\xbbk\b\x1e܂\x13\x1c\x1awׄ\xe0\x1a 8\xc1\xadi \xb8\xbb\xbb\xbb;\x81\xe0\x10t\xc8\xf7" +
-			"\x9d\xef\xd8ܙsߙ7\xef\x9c{o\xfe\xa8\xdfow\xf5\xdakU\xd7\xda]\xba\xaa\xb63q\x10\xe0ڇ\xb6\xf4a_V\x19\xeeH]8\xaev5\xe7\n1Nd\xf7)\xaa\x92\xa8\xfb\x98{\x1e\xc4\xf8\xd6h\x1dW\x86\x84\xbe\x84>\xe1k\xf9\x02~\x00!\x1e%.\x9c\xecUk\x9fb%\x84\xff\x15n*\xc1n4\xf5\xbb." +
-			"\x11\xffy\xb7\xa9\xd4\xf9\x1b\x8aCǶ\x83`\xee\xfd\xae\v\x83OQ\xf7\x80\x88\x00\xf6\x9dqy Z\xd6\r\xd7ax\x14ͼ\x88ٖT\x9a\xe3\x96\xc1\v\xcc\xe2\xb1\xe3\xad%\xa2\xe2\u0378`\xc0&;\f\xe8b\xdd\xceI\xebZ\xa4\xf1\xe38\x03e\xa2\xbe\a\a\xf5\x11W+\x13\xa9$\x94\x94Xd\xf4F\xd0\xd4\xc2!\xd0#P" +
-			":\x9e\xb6&i\x93\v\xf1Ar4@4\xfe\xa26\xd7*\x18\x01.Ҫ\r\xf1\n\xe3t\x1e=\x9b\xe4u\x8f(\xb5\"\x01\x0fjRxG\x1b96k\xe7\x8a2\xd8l\xd6P\xe7\r\xd6z\x81a%'\xb6\x1c\x9d$_\x9a\xc6ȃ\xa29\xf3$\x96\x99\xb2\x18L+h\xdb\x12jg\xd6\uebc7\x89Ē\xdfU\x84e\xbb\x10\xb4" +
-			"\xd6R\xee\x9a\xf3\x18E\x06\xaf\xc0\xa1nJ\x82\x88\x03\x1e#0\x93\xf2\x17B3|P\x9b\xa7\xf8\x98\xbf\\F\xbef/\xf4.w%\xaeoJ\x19#.M\xab\xeeK^v\x84^Q#\xe0\xbc>\xdc\f[\xe9\xce\xf2\x17\xf3\xef\x96|\xcb\x1av\xf0\x83ܠ0\x0e\xeaJ\x11n:K\U001091cb\xa6\xb7\x8d\xdc\u007f\xfc\x89\x9b\xbf\xa2\x82" +
-			"\x10Ƀ\x81\x85^\x8fc\xfb2\xb6\b>\x8e\xfb\x198J\xed\x10\xaeD\x10u\xfb\xd4\x1as\x87\f\xf4\xd5\x1d\xe3\x05\xe8\x05\x11a\x1dæE)\x00'\x0e|\n_\xf9\xa0\u0530A\x01\xa9\x8f\xfc\x94S\xe9\x051\x89\x91\x95\xb6\xde\xd8\bw\xef\x9e-<H\x1d#\xc8S\x86\x04\x98\xfa\xad\xbf\xeaǡ\x8f6\x9eu\t^\xef\x867$" +
-			"\x04\xa1&\xa6\x8d\x98\x87\xdf?Z\x91\xed\x89W\x1c\x83\xa38X]\xa3\x05\xd4\xc8\xd7\x1e\xb7;\xe2\x8d\xfd\xfe\xd7@w\x90TȌ\x15\xa1\x85'\x92\xf8\x99\x05UKY\xba\xa19t<\xcdQ\xf6\x93\xd6\xef\x02\vm\xa8\x1d\xaa\xce\x03\x87\xe1t\"D\x01\xae\xe7i\x87M-\xb3\x1e!\x8c3\x18m\x8c\"\xbc\xf1\xa0\xe0\xc3\xdc#\xbc\xecU" +
-			"\x10¥{{\x0e\xe7\xf8\xb3\x97`\xe9Rp\xe07=\xff\x8c\v\xc4\xc73$Nō\xc1\x80u>\xee\xe50`\xeb\x18\u007fg\\\xb4kًNW\x92R\xdaH\xdbp\xa0\u008e\xb6'\xae<\xdc\xdf\x1f\xa8\xb9\xealt\xbb\xfc\xb0l\x8d\xbb\x97J\x95\xa40\xfa\xedf\x9b\x94\x02\xae\xbe{\x8aR\x19\xa1\x8dI0\x1b\xe0t\x871|" +
-			"\x9a\xe2\xde\xcccY\f\xb5\x88G\xc1\u007f\xc5i\xa3\xfc#\xac\xb7\xa4\x05\xb8m\xcey)\xbc\x02\x8cq\xbb\xfd\x02\xbc@L\xf6*\x90\xdb\xd3\x16\xf7s\xe8[\x00\xb5\x9ek8\xa5\xa7j\x1d\xd1\x02\xb5\x02Z\x18\xba\n\xa2y\xc8:k\v\x00\xcd\xc37\x0f\x9biǒ(Z֪\x19\xe0\xa5>\xb7;\x10\x13a\x15\xdb\xf4`k\xb4\x0e\x1d\xce" +
-			"\xb5\xa8\xb1\xf6\xd8r\xa4\xd5J\x12ցx\xde\xc5\x19DۀH!բ\xca\xf2\xc1L\xb8z\x9eH<\x0f\xe2\x8cVu\x84-\xc5n\xe9[mE\x99\x96\xf8\x9c͆\xfd\xc2[\xbd\xfc\vDU(.\xdcF\xd9\r\xf9\xe1\xfbhka[»՞\xae;\xbeG\xb7\x90\xec\x11>̕\b\xcc^\x06\xb6kѓ.I\xc3\x17\xe2" +
-			"\xa0W3s\x97\xfa\xb5\xb3Ԁ\x82)\xa7\xb8\xaa\xc9\xeff\xee\xd82\xe8\xfe\xe8\xe1\b/ɖ'\x12\xa9\x11\x86\xd2V\x9c\xfdH\xfbn߇\x9e\xf8ҥ{i\x16\x96\x8c\x9e\x16b\xe0w\xf2s\xcaD\xf4V\x85\xf0*\xd9ZXQF\xd0I\xeezBĂ\x01͓\xf5\x8c\xe9\x97\xef\x19\xcf;2d{\x99\x1e\x84\x02\xa9m\x8d\x84" +
-			"\x85\xbb\t^\x90\xc9\xc3\xef\x99$PЙ\x9b\x89\xa2c(\xa3 2\xe42\xe2\x06\x12\x97\xd8xBj3|\xb0\x1b\x1d/G\x8a\xe6\xb4\x16g\xb6\x87\xf1\x9c+1\xc0Yz\xb1\x8c>\x04\xfc\xd5D\x14\xf3\xaaU\x1d\xda\xc4휳\x1dٛ!\x96|\x05q\x1bDˈ\x91\xd28\x11\xf1\x89'\x96T+C!\x8e\uec52t\xeb\x15" +
-			"aI[q\xcb\x16\xa8\xbc\xc9$\xac^\xf0>\xbc\xdf\xfdf\x9f1:\xaf\x12\xc5\xfb\b\fa]p\x99\b^\xa1\xdc\xecm\xa3\xf6\f\x9f\xba\xcb H\xf9K\x96\xdd\x03\xd4\xe0ר\nq\xfa\x98/\xa8\x12'\xd3Q\xab✮>dUrB\x1a\x89\x86;\xf7\xa2Y\x8a\x98Ą\x11\xbd\x90k\xc28\x9b\x05\xb0G\x19\r\xc6\x1d\xae\x19" +
-			"i\\$\xa9\xef,\xa0~\xc2\xc0\x00;\x1e\x90\xd47\xd3\a\xfbӬ\x91\u0605fչ&In\x01\x1c\xaa\x1d\x91\xc2\xf4Sna\x86W̏\b\xa1\x00\x10\x10*\x14\xc6\x1d\x06\x04U+9\x12\x93\x1f\xd9bmAB\x97\xaf\"D\x0e\xb8\vO\xe5\x81\xf1\xf3\x1e\xcf\x0f=\x86\x1a\xd9S\xb1n\xc7\xe3)\xee\x01 to\x8d\xb9\x91;" +
-			"\x90\x8b\xd2\xeb\x04+KK\xc4\x18FZp{\xb70i\xb3 \xb5\xc0\xb2q\x9d\x065 \x8b\x9c\x89\xa6\x8d\xfcP\xf8\xa7\x94\xbfP\xba|\xb7|+%\x96\xf4-gf.\xdar:\xfd\x86 \x16\xe4\x93c\xb6\x84\xc6G\x9a\xdb\xfb\x02\xe2\\c\xc4M\xe6\x1e\xa7\xdb!\x86{G>\x95\xf1`Z\xa6B\x97\xbd\xd1 \xf1\xaf.\x94\xbeb\xf7" +
-			"b\xc9c\x81\x03\xe1a\xc2\xef\xf5\xb8\xaeTϓr\xd5\xcd\xf0\x15vf=\xbc\x94\x9f\xf8a\xb1h\x02\x92\xcf\xf4%\xd7/\xd8\xdd\xfe\xdf}\x86\xdf\xfc\x86\xff\xa7~\t\xb4C|\x90\xb5\xf1Cǎr\fc\xab\xc6\xe1\xa2\x19l\x8b\xb9r\xdf\xdb\xc9A{!\xb2\x8f\xa2vd\xe8Go\x8f\xbc\x92\x182\xe8\x1b\xfb)>wTyݬ" +
-			"\xa8|M)\xbc\xdd\xdd\xdb\xfe\xbf\x178\xd6\x17\xaeV\xd6\x14\x9a\xbai'_ɴi\xd6\xc7\xc4\xeaW\xaf[R\x91\xf8\xab\xdf\x03\x1eՋ@!\x03\xf9ސ\x05\xfe\xae\xe8g3u \x86\xfd\x1f\xdb@-T\xc0\xb9\xc6\x1f\xb5{\u0530\xe7GT\x1d\xa67\xfc\xd0y\xd3-Y\xd6$\xbbL\xef\x94*\xdeO\x9c2\x1f\xa7&}\xf8\x9d" +
-			"\x82\xfbF\x1cA\xfb\x9e/\xa6\x94\x8fo`\xe5\xede\xdf\x06\xf7\xeb\x02l\x93\xd1\xefT\xf2\xffS\x15\xb0\xff\x1d\x01\xf4\xf7\xad\x1c\x1e\f\"\x1cS\x1dR\xa1\xab\x86\xd1W\x9a\xb1\xb2\xeas\xf34\xebkK\x84Р\x8d'\xc2\xe0v\x13\xfd\xb8\xaea\xe3F\x8a\xea\x9aƣ\x17\x15[ڽ\x18\x1d\xdfz\xb3\xe7W\x84\xed\x00g\xad0\xb9w" +
-			"B\xa0\xd7}G\xf1\xadQ=\xa5\xcf\xeb\"\xbd]\r\xdex\xaf\rb\xeeh\xbf\b9\x02\xb6\xd6~\xeb\xb34s\xdc\x18\xbc\a8q\xfb\x8aQ\r\xe3\x1c\xc7|{\f\t\xf6_\xce\t\xa5o\x9d_\xd2b\x9bވ|\x9e\xe3\xbd@\x9e\xecž\x9e\xfa\xe2P\xa0te\xe1\xcdt\x17I|T\xec\xe3\xe8\x0eb=\xff\xa8n\xa2\x16D\x87" +
-			"\x9etL\xa8\xa3\x8cb\x97Xi\x8c\xe2x\xd61t\xb5\xf3\xbc\xc5\xc2\xea\x998\xea\xa3]O\xdaʹ`\x01ʑzIW#\x860\xee\x94wa\x13\x83\x9do\xb0\xc5\x04\x0e\xf1v\x05@UMG\xb9>\x9a\xe2SO!\xaem(\x1a\xe2\"\r\xef-\x8a\xa1\x01\x9d.\xcdT5CnG\x11d.\x1c\x98\xc0\xf9\xd9\x16\x8c6-\xb4" +
-			"\xf7Ɣ\x9f\x188\"yq\xf6\x84Ham\x8cD.\xf6m\xb5$\xe7n\x1d=\x01j5fx\x1eU\x1e\xf9\xaf\x89z\xf3I\x85anc\xdf\xf7٥\xa9+\xd0\bN\xc8\x13\x9c)\xf4\x8aŇ} \x9b\xd6\b\x1e\xbc\x95GN\xb1\f\x99\xecks\x9f7\x99-\x95\xd1QP\xa1\xc9т\r:3\xf9R\x1e\x8f\x96\xd5\xf0\x876" +
-			"\x1b>=\xe7J\xf1!r\xb2,\x90\x18ҷ\x8f0\a\x8cM\xf1\x19\xac\f=\xae\x8a-\xd0.\x90r=\xfeD\xe0ݡ\xc65\x94\xdfaky\x94\xd4Zp\x8d\xc8\x19\xcaz\xf1\xde,bR\xc8[\xea\x10\x03Gb\xdcC\xbd\x982\xd4\xce*u#a=\xee\x95,P\u0558_\xee\x8c\xfd\x80\xf9\x06w\xe45\x9cqc\x8dYi\x1f" +
-			"\xb5\xa8\xd6\xf5K\xc4{\xc0\xda8ܼ\xa9\x17\xdfaS %\xfe$\xa7\xb0\x89U\x94\x14*\xf39\xd1JDɋ\xba\xa7\x97_;tHj\x0e\xc3\xcb\x1df^`\xe3\xa9\xc4q\xabmPʉ\x84&?\x11\xfe\xae\xabt\tR\xa9\x9e\xac\xa77\xc8[H\x19\xe3\xc1s\xe2\u007f\xdb\xd9\xc1\xe9\xc55\x00p8\x19\xc2(g\xb4\xb5\xdb\xc3" +
-			"\xb5\xf5\xeb?xU\xe0;\x1e\xed\x02_\xbf&\u007f\xf9u\xf9\x9a\x80\xb9\x03<eH\x02z. \xc8I\x8d\xb9\x92x\x1c\x11\xac\xc3P=Q\xe1\xea \xad\x8eR\xf6\xd52\xb4\xc0%Z庣\xc1)\x15p>\xe4ְ\x82\xf9\xfe\x18\xa2\x8a\xb5[;Ц\xd9\xc6s\x10Lݼ\xd8\xeb\xc6LE\x8e0\xad\xf7\xb3h/\xe2ݑu" +
-			"\xcbE8\xb6Z\xe2x\x8c\x8fqD\xc4\x00\xda\xda\x11L\xbc\xaa\xa6\xaaΗ\xc1\xd2P]?\xd2H\x1b\xc5\xe8\xdd.\xca\xf2Ԡ\x17ӥ\xbc\x83\xb6\x80\x8c\xa6h\xb5몯\xb34n\xba5*O\x89Q3\u05ee\x18\x90h\xab\xc2\u0603\xe6\x88~0\x1e&\x9eٽ>\xabKZ'\xb9b\xd4\xd5\xdb6\u007f;\xb6\x17\xb5M\x8dP" +
-			"\u007f9s\x0f \x87`^]\n^\xc1\x15\xc1|\xef\x01\xee\xaaW\x0f\xb6s\x05\xadښɍo\x91\xe0ރ\xb9\xbb\x8d\\&\xf0\xa8\x87D\xbaH\x9a\x16\xf1\xf5[\xa2\x84p\x00B\u007f\x0fU\t.\xdc\xf7\xb2>\\,\xb6\x13&\x89a|c\x03\x1f\xc0z\xb4\x9c\x10\xad\xbf \xae\xc7\xe3G k>\xef1\"\xef\xcbࣥ\xc0\xb4" +
-			"'YG\x88\xd0,3t%9\x91\x13\xa6\xe5\xc1\xb6\x9a{\x80\x9e\x13\xe9Uj\xb3C\xda㻧[}S\xf5\xe2\x04$l\xf8\x83\x82T\x91ޔ7\xb7\xd4\b\xf3\xdf`\xe4\xe3\xf4\x14\r\x95\xf3\xfa\xb1\xceό\f\xc1d\x1f!\x001w[՝\x97\xa6\b@\xad#\x86\x90\x95\xea\xd5x\xf5\xb4n\xf5\xa0GǼ\xeb\xe1M\x0e " +
-			"\x8d\x1f\xbc\x9d\xb3)q\xa5\xabI\vҲ\xde,\x8f\x12P9P\xb7P\xd2\x0f\x9b\xc92G\x1b\x13\x8f\xe2\xfd\xf5\x8e\xdes\f\x0f\xd8M\xde\x03L\x8c\xf5TDLl\x10j\xc8b\x8f\x87\x82\xd9\f\x0f\xef\x1a\x1c3\xbb\x9e%X\xf6\xfa\xac\xeb\xa5\xf4\x12\xe7\n\x94\xc3}\xbfÿ\xfc\xb8BL\x8a ;V\fC\x1d\xc2\xc5\xea\xdcې" +
-			"\xb2i\x86\v\xfd@~\xa2\xe3[\xec\x12!~\xb8\x8e5\xbf\x87\xe3\xfa\x92\xd6B\xa94\x8d\x8ah;\xfd\x1cFpx\x0fhՔ\x91\x93*\x9b\x98\xbd{m\xb6&\x19\x8c\xd2\x02\x88J6a\xaf8\x82\xdd\tM\x82\xce\x0e\xbdO\x11\x84\xc8B\xb2]\xea\xdaj'\x1a:\x16\xd3\x1c\x9e\x12\v\xa1\x0f\xee\x8e\x14 \xc4Dk1~)\x16;" +
-			"Y}\xf0.\xa0&L\xed\fVBk\x1e\n\xc5K7\x9e\x9f\xeebO\x1cCk\xb3\xc7\x05\xa7\x9f\xda8\x13\xd8}a\xa6R'\xb5#\x10h\x03_9\xef\xeb\x14\x9a\x1d\xaa\xa9E\xcfK;{\xa2\xb3\x92yT(.\xbb\xe3womc\x00<q\xe8\x0e[\x10\xb4\xe6y\xba\xb0\x196\x9d\x9f\xa9\b\x1dH.\xa3\x9e\f\x88\xac6\xf9\u007f" +
-			"\xd3\xce\f7cH\x90Ō\"\xa28\xa4S\x06\xe5q\xc1x.\xdb`\v\xfc7\x02$\x1f\xce[\xd0!\xe0\x02̥\xa8Z\xe4s\x05?`\xa3'-c\xf3\x83%\xbd\xd4>)\xa26n\xf5tq\xe2s\x11\x84\x10iɬ\x85\x9b\x8f\x1a\x18\xfe>\x99,\xa5\x99\x9fcn\r\xf3\xbd\x89gtH\x92\xed\xed\x0e\x05\xa2\xf7Zh\xd7)" +
-			"Lt\xccQ\x9b\xf7m\x05A\xa2֎-֚\x1d\xc9\xf2\x15\xd68\xc8`\xc0\xc59H\x00s\xf6\xed\x8e\xfd\xd2\xf6\x94\x1d\xeb\\\xa6\x84A\x9a\x19z\x01\x8b\x90\x11\x9a\u007fx\x02\xf0pOK\xad\xf5VcO\xf6Ȩ\xa2\xfc\x8dGR\xab~,/\xd9#\xa5\by\xf7+\x93\a\x9f\xba\xb2\bú\xfd\x8a\xe0\xa3<\a̡\xe4+z" +
-			"\x81\xdd\xe0\x92\xa8V\xb2\xfb\x1a\x1e(\xf8l\xfdp\xd1\u07fe\x90\t\xfd\xa8x\xfa\xd3=\x007\xac5\"\xc7U\xa0y\xaaGU\xd5m*\x8c\xfd;\xe5\xdb!W\x87\x8eTq>\x00\xcfW\xac\x11\xa1o\xc0t\x84\xf4tL/\xeb\xd0F\\u\x0e\x14kB\x13\xe2\a!\x03cx\x90\xdaN\x1f\u05fc1\\\x02\x84\x91S\x9c\x1amwp" +
-			"}2\xd3\xd2-\xacnXu\xbc1[\xf8\xc95-\xab\x9bRr\xbb\x16EU\xde\xdc\x03j\x85\x05/\xcf\\\xa6\x82\xf9\xd8\xf3.yU\xf1\xed\x1b\x0f\x10#\xfb\x13\xb4\x9b\xc9P\x0e\b\xb1\xba\x87@Q\xe9\x98\xe5\xe6\xc4!\x81\xf4\xcf\xdc\u007ff\xe33\x89\x13\xe6?\xa8 \xd4P\xf9j\xa9\xb55a\r\xac\xca=\x16\xb7wq\xd6j\xee$" +
-			"\xd2\xccYM\u007f\xb2\xa4\x98e\xb2ľ\xb2\x84}\xa3k[\xbc{\xbc\xe34\x8ae`\xba\x81'\xbc*I\x10`\xbf7\x84\xb6?\xe4\x068\xbb\x1e\n\xf1\xc2,O\x16!\x89\xd1\n]\xaeW\xb8\xc9\\\xa2S\x8e:E\x86\xee\x80p\xea)\v{K\xd0\x02*\xa2\xc2\xe1狛\xbd\x97\x85bAA0\xbeí\x96\xa1\x8a\x99\xec\xa5@" +
-			"+\xb0\xef\x86|+\x8a\x10z\xfa=\xc0\xf53V\x9a\x1e\xd7Y\xb1}\xe7=\x00Ê\x8f\xd9\xcaQ\xfaQ\xd8@$\xb9\xc02BW*\xb2m\v\xe2QTD6\xc0Sn*JZ\a\xed0\xa2サۃ1\xaf\xa7\x9e\x06\xbf\xc2x\x17\xfev\xfbd\x1e\xae\xf7\n \xfe\xc6`1\xef?\xdd\xc2\xe1\xbf\x18 \xfc]\xc7\"\xe4\xb2" +
-			"\x8f\x8a\xa1,(-\x0f\x92\x86\xb8\xa3\x110\xe9\xc1\xebJۂ0\xff6\xfa\x8d=9}\x85'\x88$\x9f,\xd7\u007f\xb6\xb1h\x82\x91Xv̪\x021b\x80\xe3\x88\xef\vk\xd8\x1cn\xb2\t\x90\xa8\xeb\v\x8c|\xb4\x91\xa5\xc5;_sF\xdblu\x96;K\xd9\xec͵\x9f\x15W\x0f\xd9۵\xa3\aw\xe6J\xae=\x8d\xc0\f\xab" +
-			"\x88y\xc13\x03L\xf8\xc8\xe6\xcb=\xe0\xe8\x00t\x0f \x83\t\x8d6r\x90\xf6&.\xf8t\x1dIZ-\xf5<\xe0\x82a\x86M\x87\a\xfe\x05\x1b\x15h\x84T\x06\x9d\x9ba\xce^\x18\xa1\x11\x83\x0f\x8e]\xcar\x03\xa8Mи~\x94\xb0\xb3\x8a\xaeW\xcfx!,\x9d\xb4Wr!Bk\xd3G b\xf4L\x1aø\x93_\\\xe6\x93" +
-			"\f\xa2\u007f\x00ق00R\xdc\x06\xd2\xd2\xc8\xe9\xdd-\"s\x0f(V\xf4\xde~\x05\xf3x\x92j\x97\x98\x9d\x0fn\xcf!@\xe0C\xb6l>:\x00\xdc\x14Dt~\xff\x81b\xd6t\xd6+w\x8b\"\x0fT9Խ\x13MJ\xbb\xba\xb1\xbeŏݽP\x9c\x13\xb6\x06_\x84\x06\xa1\x9b\xab\xcf\x1c\x95\xc3\xf5P\tĮ/!%\xc8" +
-			"\x05\x1c|\"\x13\x9d\xc3\x16(͖<\x8a;v\xe1cG*\u007f\xfd\xa1\xbaF%\x86\x14\xb5 \x0epG\x0ej\xf5yi\xaeK\xc8\xc7oD\xcb\x10ax!\xea\x82M\xf2*w\xa7\xc5z@Ԟ@\xf7iE\xcdc:\v\xcbա\x8b\xben\xefWi\xbde^x.\xee\x92S\x8e\xee\x1f'\xe4\xdf\xd0\x1b\xa8Ih\x1e\x94R\x11" +
-			"QT\xeb\xca&\x9f\xe8\xca\xceΨ\xab\x8b=\xff\xeaǅ\xe5\x82\xf6\xf4\x03\xc2!@\x1e\xd8\xe1\xf5l\x9c\x1d\xaa\xa4\b\xd9ww\xa9\x8e\x12\x86\x1b\xcf\xc1\xb9|\x95\x8f\x80\xb6\xb37\x05\xf5\xe4<\xec\xa9t\xc1\xeey\xf8\a\xbf\x10\xfc\x04\xd3!:\x12W\xa8\x18\xf7\xd0\xe6\xd5\xe0\x8az\xf7\xfa\xc3g\x12\xbf\xbe\x8b\x12Ϡ\xaeq\xb5\xda\x1b" +
-			"&\xf9E\xe4R\xa5\xe1\x1fm\xf7\x80\xe7\r\x00\x8fRӏ\xca\x13+\xe1\xf61\x8e6_z\x04OF~p\xdf@\x0e\fE\xc3\x10M\x99\n\x00\x00'\xf8F\xb2q(\x03\x1b\xa9\x9d\xbb\x18\xb1\xd1\xd7dߨ\xdb\xce\xded7,\xffS\xd8W\a~\xfd1\x9d\xd1\xe5\t\xba\xcf,oң\x164/?]\xfbw\x00̤q\x95\xda" +
-			"\x0f\xdf|Y\x8b\x92\xfayL:\xf4\x89\xf8\x17\xbc\x8f\xc0\x0f\x1e\xec\x10\x16-͝\xca\xd2\x1ebd\xf1\xb7\x9f\xf0\xdeu\x93\xaf\xf9\xd10\xe2\uf3788\v\u05cc'\xb4J=)\x02\x9cԪ\x12\xca\x03\xbd~J\xb9P\x17ޮ;\xe6\xa9zI\x93\x1c\x19\x1e6En\x16_\xec\x88(a\xe3\xf5\xc7\\\x84W\xc3@ѣ\xaf\u007fV" +
-			"?\xba\x82\x9a\xbbj\x93\x95\xce\xf8\xf8\xc7\xe56\xda\x16'\x9f\f\xb6fR\xd2S\xab\x89\xf8\x1c\xf8\xf7\xb6Aai\xc7\xc8%\xf7\x00\x98\xff= \xfd\xe9\xfa\xdd\xe3m7\xa7\xec|ms\xb5\xd2\x1feo\u007f\x18?Yr`=\xf8\x11x \xb6\xc8\xcfs\xd6\x02 \xb3\\\x19\x90\x9a\x92\x98\xa2g\xa7\xed\xc6_%4D?*\x19\"e\x01" +
-			"\x16\x9c\x91\xad6NW\xd6\xf8\x1d/\xf2֩a\x8b\xd2|\x14#E-\xe9~\xdehyY\xba\xc2\xf8m@\xfc\x0e\x1b\r\xdcQ\xb1\xe6u\n\xd7\xfaƕj\xfb֫\xe6\f\x0f\xf6 e\xee4\x1f\xf4'm\x9e`\xefzE!YW\xa1\xf8\xe5\x19\xd9\x0f\xd2\x0e\x80'\xd2\xcf\xd3%\xba \x0f\xa9{@Ԓ\xe2=\xc0M\xf0\xe1\xc1" +
-			";\xc7\xf0\xf0<\xa2\xbd\x13\xf7\x94\xbeݾ\a\x9cj\xc8\x03\xc7t\xd0\x1e\x06H?\x8c\x82\xbdm:\xc5\xd2q\xfd\xab\x9f\xad\xe3\xbf\x1c\xa7v\x18\x1e\xa7\xbc\xee\x99\xc8ܕG(\bu\xbd\xe8\x1c\xfa\x81\xf0\xd6{\xaa\xe5\x1e0\x8c\x01\xb0kB:\xbdd`/lx%\x846ۊ\xc7:\xdd-\x01v_ƺ\x9c\x1a\x8d=\x99j\xc1" +
-			"\xbc\xbc\x9a\x10\x9c\xff\xf8\xa3\xc1\x13M\x88$\"\xe8Fie\t]\xaclw\xda5\x8bH\"JD\xa2\xd96\xd5\x06p\x01F=\xdeK\v\vN\x88\x90\x1d\xf5\x11\xbex\x13\xbdv\x0f U\xb9\xab\xd8L\xe5\xafO\xbd\xe8\xb8\xfa\x14\x13\xedQ\x93H\t,\xeb:\xf6\x01\xe6\x12\xdd\xd8\xeb\x8a\\\x12\xb7z\x91\x95\x0e[\xc0\xf4+\xc3݃" +
-			"V$\x13]\xf1\xb4/x\xfc\x84\xa8x\x85v|w\x06\xe5\xd8\xe0\xd64rۛ\b.\x83\a݉M\x15\xa9Չ\a\xae\xf9,\xfa)\xba\x06j\x82,\xd0\x1d\xc9\x1fӦ\x1cbބ\xf3\x98\xdc:\x81\xba\xa7\xc6\x169\x9a\xbe\x8e\xf1\xbd\xcc\xe3\xef\xa3ӗ\x00\u007f\x00\xca\f=\x8fUA8\xba\xa9\x81}\xf6@\xb8\aP\x84'\xdc" +
-			"\x03\xb4?\x1e\xb3\xc1\xed\xdd\x03\x88\x19\x88\x1cB\xd0ލJ\xce\f\xdd\xc0\xd9\b\x9e\xd2t]R@p\xe4\xf2\x17K\x90F\xf4\xc3\xe4l\bK\xad\x87Lo\ns/\xbbr%\x1dW\xaaa\x99D\xd7\xe0\xe5\xa62\xae\xb9\xb4\xeb=K\xd7\xef\xad\x18nso&7Ǭ\xa6Ūv\vB]nwq*\xec\xef\x86\r\"<\x1e\x9f" +
-			"\u009d\x9e\x19\xed>e\x88*ĵ\x94\x1d%\x8e!{E$/0\u007f\xd6)\ue85a\xed\xe2ܮ\x1b\xe8\x16\x17R$\x9e\xbd\x8d\x93\xce\xfc\u008a\xa5`͝\x8c \xd2Fh\xef,\x1dS\x05B*(<Z\xbc$Y\x19<Mg\xcd0=NNW8\xbe\x16\xbe\x00Y\xed#\xfej\xe4\x89t>\xf2,@%\x1e⠟E" +
-			"\x022\b\x87\xec\xc2\xe0\xcc5\xba\x82nX\xf9\x17\xebw\xef\x01\xa6\xae\x14\xa1\xefQ\xf9\x1a\xbe\xc8!\xf8\x9f\x187\xbe\xf3\xef\x1fv\x9c\x1eXwO\xed\\w\x8dJݤZ\xf7\xe2J\xc7T\xfe\xd9ԫ\xf0a\xc7\x050\xafw\n\x02\n\x8f\xec\x15'\xca\xe7RX\xdcQ\xe0\xb5\xdaGbj\x82\x88\x03X\xf8\xbb\x14tE\xa6\xbb2v" +
-			"9\xa2t\xd5E\x11K\xfa\xbfD\xf91\xa0\b~\xdcR\xbf#\xaf\x1d\xbe\xe1\xcd\x1a(\xd4\xd1|\xad\xfa츸\x03i\xfev\x82đ\xeaxO\bDs\xf9¹\xea\xc8*\x1e\xec\xdaS\xf3zmdp\xec\xea\xe9\xb5X\xbd\u007f\x1b\xe8\t\x1b\f\v\"o\xe6\x94(\xf8\xe9{M}\xff\x97i\\\x9cT\\g\xc2\x1b\xc8\xd4\u007f\x10\t" +
-			"\xf8\xef\x01\b\u007fױ\x0e\xb9\xac0_\x9c\bQ\xcf\u007f\xfe\xe9\x0f\xf7N\x10:\xbb'́4\xf41~]\x99\x9d\xba\xaa`\xa2D\xcb\x1dv\xc3\xdb\xc7\xed&\xe1հ\xbc\xedt\xcc\xf0}\x0f\xb95(ٳw\x18\x03\xe3R\xf5tv\xcf\xf4ӝ\x88[\xc81\x85_\v\x90\x1d5\x85\xc0^\x8f\t\xdb\xf3V\x02Slk\x14\xd4\x04" +
-			"\x17=\xd0ѷ\x10\x0e\xf1\xce)q\xe1\xba.i[m\\\xa4%\xaf\t\xdfѷ\xe7z\x1c?\xfeP\aw\xd7{~#\xe7\xb6q\xf7\xf2R\\\xe1\b/\xa5\xe2 :\xd5\xe2\xfcC\x9b\x18\nR\xd4\xd1\x18\xbf\x04En\x13\xc9T\x91\x82\x10\x88pƬ\x91k\xd4\xd06W\xd3\\\xb5\xe8F0:\xe6\xc57\x87\xc1a\xd6\xf0\x89\xef_" +
-			"/\xf5/:t1-\xd9\x16D\xb3\"\xe4_\xab\xab\x14sZc\x87\x1e\xfe\xf8Q\x83迓|\x0eS\xc8\xf0\x11\xa0\xa9\x1e\xa9WZ\tY\xe2\x90vO\x92\xcc\x10\xa4\xcb#ļD\x83!zbX\x15\x97\x1eQ\xb53\xa3Q\x1b6\xa4\x806\x044\xf0\x10A\xdfY|\xbb\x8aei\xf7\x1e\xf8\xb46\xe0\xbcr\x0f\b<E\xe3b" +
-			"dHg\xa1+\x90\x0e,\xbbS M\x0e\xe7q&q\x1ap\xba\a<\xb2\xae\x17c\x978\xedA_\x9a\t!\t+\xcf=\x98\x88\xa0ؤ\bM\x9d\x81eR\xa7cR^~<\xfcv\x19\xd6T\xc9\x16V\xe8U\x80\xf0e\xc5\x18m,\xb5\x1f\bHEY^\xad\xe7A>\xca\xf5\xdft\xfc`\xf5\xed\x11\u05fb\xf2o\x06{\xdd\xd4" +
-			"\x8b]\x89\xd7\f\xc1\x80\xcbAS\x0f\x935\xb4,\xd7\xcc\xdaF\xaf`\xa9\x94\x904Yxj\xfe+\xb5a\x10&\x1f\xb2\a-b\xfc\xac\xafZS\xb5v\xae5-\xb6\xe3,\xa6\x9d\xdc\"?\x17\xc0\xa1紗\x1a\xa1\xbb\x91\xd1,1<7kF<gNƮ\x87\x9b\xae$\x93\x1b\xc1l\xfd\x030x\xe0\x927k\xb7\xd6\xc0\xdcD" +
-			"\xb1<u\xaa\xff\xd8\xc9u\xa4ܽ\xb4\x89k\x1e\xd96JgI\x14`\xbc{\xa5\x9dS\x913\x94\xbdX\xbc\x9d\xfb*w\x9c\xac\x9f\xbfe\xdd}\xaa\xfb\"\x15\xe3\xc88*\xbf\a\xa3!l>̒\xea`ˏ\xc0\xc82\x97f\xb7\x17\xb3t\xafk\xb2\xb17Ç{\bu7\xfe\xaa1\x04c0\x1fB\x9f={\x13\x03.-w" +
-			"5\xba\xa3D\abL\xcd\xe5\x9aoog\xf8\xa0\xfeXZ\xf5\xf6=+L1;\xdb\u007f\xb1P\x9cQ\xadr\x82\xff\xacfd\x1c\xe9:3\x1a\x05!*\xd5>u\xe2T\x14\x17\xee\xe8 \xc1z횑a\xb0\x97^\x91\x903\xb4\xeb\xe9`0\x8b\xeb\xb2\x1e\xfa\x05\xcc\xe8\xb2!\xc7\\#5ʈ,Œ\xbd\x0f\xbc^\xd6\x1a\xba\x8e" +
-			"\x91\x1b\x8dKF;e\x9a\x8e\x89vɟe>k4\x985\x1b\x9b|\xfee\x94<\xc7t\x96\u007f\x9a>\xd9\n\x81\v\xe6\x90\x11\f\xf8\xb1\xb7\xaaֶ/k)\xab\xfb\xc44\x8a\xbd\xdd~%'\bY[\v\x1d_e\xb7\xf9\xdap\x8b\xbfp\xa0|\xc0\xa4S\x03٢|\xc7\n\xda\xe9=\xbe\x0fFXBi\xf6\xf1\xb4\xa1\xfd\xb6K" +
-			"\x8d\xb0A\xac\x8bzy\xd19\x05\xe1\xc8ݢa\xbc\xa9\xa8\x9f\x0fL?\x9b\xce2I;\x17\xe3\x87DX\n\xea\x0e;\xb2#?\xda/^\xf6\xf8\x82j_#\xa7\xb2\x86\xe8\x1e\xeaG\xbb\xffpg\xf0a\xc6\x12$\xf5q0#Nf\x0f{\xafT\f]aip\xbc\x1e\v\x80\xf0\x87\xad\xff唿K\xeeJx]\x86\x16_\xad\xa1" +
-			"\x9c\xa7\xb1\xacB&q\x96\xc2\xf66w\xf7\x19\x02\xe9厮\xda\xc3\xe3N\n\v\xaew\xc5\x05\xbe!\x88\xf71\xb0\x1a\xc5\xfc\f\xccY\xe5\x8c\x18,\xbe\xb4^\x99\n\xac\x17q\x02\xd7\aH\xf3\xea\xcfD\x9b}*$\xdb2\xaf\xa2\x93\x83\xc9)\xe9j\x8e\b\xe1\x9e\xfeQ3\xcd!\x02\x8f(zN֘u\xc1\xbe\xde\xf4\xe4\x8ex\x00" +
-			":\xcfQ\xf3\xc5`\xdf\x02\x98\x82F\U00088249Hr\x99\x83\x8b\xfc\xd0\xed\xd0\xed\x9a\xfd\x81\xf9P\xe8RX\xfd\xf3\x01D\x10\xa4\x1419\xf3ٴ\\\x9e*\x92ܸ\xd2z\xe5QWh\xb1\xfc\x00\xe9\u009e\xf7#\x1bU\xf6\x1bY,o\xa9k\xb2`\xc0I\x1a\xe0\x12[\xf0\xd1\\\xc3\xc0\x1c]\xd5I(/\xe3\x82\xe8^\xa9\xe4\xfa" +
-			"I\x0f\xff\xe5)L\xec\x1f\x04m\xff+\xc3\xdf7\xac\xfc\x05\xff\v\xcd>'~\xf1\xee\x9f\xe6\xdd\xdfUI~-~\xd0ң\xb6\x96\x8eΈ\x8aU\xaf]\xf1\xde\xde\xc2q\x171\x92\x9a_\xf0\x80>\xc0\x1c\x89Y\x83bU\xb0\x9aj\r\xf5\x92\x1a3\xb9\xc9\xce֯\xc4}=\x98\xef\x01(\x19.\xfe\x1dU\xd6$Sk.\x9fkZ" +
-			"\tzt\xba\xec\xb1z\x06\xbd\xa1\xb4\a\x9d\x9e\x88\xc7]O]˘\x94A\xed\xb2\xc0-\x95{@9\x9d\xe0\xd6*\xe3\xf5\xbewg\xfd\x8b\xfezA\f\xc7\x14\xf6\nBv\xdc*\x14\x03\xa6xkz\xa2\x00\xdc\xef*\a\x8f\x10\x92Ո\xd2;\x0eg\xca\xf3\x92t\xe3\xf6\xad\xbd\xac\x99\xc4\x1e/\xd5;\xad\xa1z\u007fĽ*\xc7\x1f\xdd" +
-			"\x9a\x10\xbbk\xd7K\n\f\x10\x13\x1f\xac\x8f\x18\xfc\xd0X\xe6\x84\x12\xd3)\xc9\x05\xe0\x02\xa7\r\xd6'^4\v`\x8f\xd7s\x92A\x8d\xad\xf8\xaa-ԡ=6\x02\n\x8dBo\xb1\xc6\xe3\xd8\anX\x0eeà;!\xc5:D^#\xd6\xceǖz\x04\x17\xef\xde\x1a\xea]\xf0\x8dI\xed\x17\xb7\xbaS\xcd_=\x0f\xa6\xceB\x14\xf0" +
-			" \xb3\x8e\xdadL\x982\x16\xfb\xde\a\xc5\xd6\x12\xc6)\xb2\xeb.\x18\xb8\x10y\xf2\xe8\xeaA\xf7\xa8O\x16\xd5\xf3\x1fYy\xaa\xdep\aP\xca\xcf!knV\xa1r\x81\x9d\xd9ɚV\x17h;\xbc\x98\x0foj5z\xf1\xd1\x02cC\x83\t\xe2b%:\x1f\x9d\xe6TlT)40x>\xb6\xfe\xa6\x1c\v\t\xf4\x05\"\x12\xe8`" +
-			"nI\xde~\xd1\x13~\xbe\xca\xc0s\xb3wǾ\x02\xc2\xe1\xc3\xc9\xd8yjX{\xfb%~\x9fu\xc1肉\xf6\xba\xb5\u007f\x9a\xf0lG\xfb\xf1\xc7ê6\x02\r\xf6\x8a\xf9\x9c5υr\x99\x17`\xc2>$\xfb\x88\xf8pQJ\xa7\xa4o\x98b\x11\xaaw\xc3\x0f>\\Z`\xd3\xf7\x8a{\xc0S{]uj\xa4\xdcPd\"\"" +
-			"\xdb\xee\xf3\xcdF˪C\xb1\x10\xc6v\xb3\x1b\x86N\xdd\xd2.\xfc\xa3\x13\x8c\x1f\xc7\x135\\;1\x8a.{\xafFN\xb5\x9d_\x8d\xa8'X`\tE\xa5\xd4`\xd5o\xa4\xd1\x01vbĭ\x99*\xef\xc4G\x99\xb5\xe6\x06%\xfc^ǐ:\x95p\xdd\xe4\xc7;W:=\xb9\u0378r\xd9_E\xc48.\x11\xed\xd9fiG\xfd\x91" +
-			"\xa4\xecߖ(\x18\xec\x10\u007fH:\xd7\xc7ڟ!*ƐI\x97\x0e\x987\xb1\x013\xf5\xac\x8d6\xd0vR\xdca\v\x12\xec\x90a\xeb&\xa2`\xceYu)Txm\vʣ\xdc\x03|Q\xcd,7e.\xf8\xb5\xca|\xab#\x81Pz\x8f{\xc0\x11\xe4\x0e\xbb\xa3\x8a\xde\x19L\x9b\x950\xf1<\\\x804|T؋\xbb\x13\xd3" +
-			"\xc3a\xb5as\xec\x8e\xff\xdd=\xc0\xd6;\x96\xb2\xa0z1\xbc\xfd\x96\x9b\r\x9e\xd0HV\xbc\xc2չ=\xfb\xc8l\xccJ6\x16\xcclVh%\xd3\xe9\x92P\xc3\xfb\xc9F\fum\xf8l\xb3R\xe0\xe9=\xa0;*\u05fb\x8e\xea.\xd4\xdcL\x9b\xf3\xd6\x118|\x90\x97\x11,\xa4\x92x\xd5;k\n-\xa6\n\xe76\x01\xbf\x0e_Q" +
-			"\xef\xde+ǉ\x890\xafБɽ\at\xc8\x1c\xd1\xec\xc9_O֒x\xbd\xf6J\xb57d\xee*\x9d\x17\x84\xd3\xf9\xfaj\x86\xc9}\xfa_\xad1\xfe\xa5\xda\xeao\x8fU>v\xef\xd0\x00݀ݐ&#\x89\rޤg\x92\xf9\x8e\ah\x9d\xadQ\xa4\x99\x1fAd\x94:x\xc4\xc2\x1b\xf1&\xebY\xae>WZ\xbc\x1a\f\xdd" +
-			"\xe6\xd9\xdf\x0e\x87\x85\x1e\x8a<\xe6\x82e\xe4e\xbf\x9b\xff\xd1\xf6\xeap+\x90\xee\x86v\x15B[\xf7(\xb3\xfa\xb3\xc8Fg~\xca:\xb6\\|ճҴ\x95A\x1a]\x8c\xbdT\xf00\x9b\x8bC\xeeavG\xcf\xfb\xaa\xef\x1a/q+7黏#ެA=\xf1D9Κr\x97\x19\xe2\xb8i!}\xfb\x06-'ZZo" +
-			"\x1bb\xdb'O\xf2*\xc8\x0f\xb4\x90U\bir#\xe1\x10H\xf7R/\x15r\x97v\x1a\x89\xa21\xc4\x16\xa5͞nL\x83\xf3\x14*c\x85\x93\x99\x80$j\xb9\n2\xbc\x1c\xd7\xfe3\xe4\x19\xc1/\xe1V?\x8a\x8dS^\xd6_\xbd\xafT\xac\x88\xd2=\x1b\xc0!\x8c\xb8\xe2\x02\xb9\x845\xb8\xecM\x16].\x89\xd5\xc5w,\x92U\x8f" +
-			"Yd\xb9\xbcK@\x87\xa1\xbf.\x80\xa3I.U\xf0\xed\x94c\xff\xa6\xac$\xb7rL\xecܔ\xb0\xb9\x84Gg]\x01B\xbbM\x93\xf3\x93\x0e\x12\x18!\xb6\bB\xab\x04X$SS\xe1͘bI#\u007f\xc8ma\xf9\x14\xcdk\xaa\x12\x1b\x80\xa2\xe7\xf3D<\x8f\x1d&J\x99\x01\xa9N\xad<\xe6\v\x98z3\x1e\xbf\xba0\x9959" +
-			"\x86\xa6\xfa\x1e\x8e[\f+f\x83\xbc\xd9C/\xf9h2\xdfCx\xf8\xbb\xa3.\x91iq\x9c\xe2\xfc,\xc2S\xd9>b\x16\xa4\x13/\xbdut\xb8\xf2\x93ԉ}\a\x85\x9bF\x91\xc6;\x13\xc1w+ޏ\xcdX=ar%~D\xca$<\xe9\xf3\\[\xc0\xbc\xb5\xd1\x1aL\xb8\xe9E\xed\xddĦ\x10v\t\xb0\x1fd\xa6>OQ" +
-			"\x99z\x0fՀ\x84̕X\xfc\x06\xb9A \x18\xff\x82@\x9aL\xfb\b\"\xd7\xf4 @џ:\xd6W\xb1\xa0e\xe9GQ\xf0\u0095vڤ'\xdbkzQO\x9c\xed\xa3\x89U\xa4\xbd*\x85\xec\xcc\xc4q\x86\x8a\x1d\xa88\xe1$SQ \xc2\r8\x8d\xcbs\x9cs[\xe3\xb79\xba\xae\xd0^\xbe\v\xcaJ\xd54\x86gC,\xd5" +
-			"\xe7\x01\xb0H\x164aB\xad\x8a\xeaA\xef \xae8m4\xb4\a\x92\xb8\x85\xe8\xdd\xed\x86$X\x1dN\x0eJ\x8dwއ\xde\xfd\xda~eg\x853\xe5\xfb/v\xaf\x16_$\xbe\xcd4 1\xcd\x0e\x11`\xcaD\xa0*\xa6U\f\x8e+.b\xfbv`\xe3\xfd\xb8\\\xfd\xd4\x1b\xa3s\xaa\xa0\xcf\xd9eߞ\xe3\xe6`\xfe\x16n\x88{" +
-			"\x16Y\xbc\x18R\x9f\x11\x9cs \x85J\x9d\x11\xd9\xfao\xa0]\xff5\x1a\xfd\u05eb\x1e\xfey\xde\xfd\xed\xf9\x00\x94\xdcDZS\x9dU\xa79\x12s\xed\xcf\xe7*DT\xc7\xc5\x1ac]dč\x89\xd4\xf4&\xb1\xb3N*r\xa7\xae͔\xd5$\x9f\x9a\xc9\xd6W\x8b/\x8b\x17ﾷ\xc5O\xba\x19\xea8\xb6\x1f\x96K\xf8\xc6\xda7m" +
-			"\xf0\x95\"\xf8H\xeei-\ff\xae6\xa1kh.\x99\x17\x1a\xf1\x87\x1as\x1d`/\x18\x8a\u007fx\xfb\x01=;\xa1\xea\xc3\x10\x1f\xc2-Ra\t\u007f9\x8f\xa5\\\xfb\xacX\x8cS\x1c\x81\"\xef|-\x11\xa2|\xe7q\xb5p\xe7*\x96&\u009eb\xdb*=\xfe\x9a\x04\xcf7g\xc9sJ\xc4\xf2\\r\x06.r\xffn\xee5\xc2A\b" +
-			"A\xebQVbc\x93\xc7^P\xe2\xe5+ɽ\x8d\x0el\v\x90\xac\xe4\r$x\x1c\x9aq\x0fx\x9f\xba%AxMwU\x11Z\xf6\x9a\xb9\xfck\xebAs\xf8u\x02n\x9a\xc6s\x985\xaf\x17\a\xf1\xe8\xf7\xaam7\xb2\xb4\xd5}L\xa5\xba\xf0\xceEul\xb3\xc8zi6E\xd1M\xea\xf5O\f\xe9\x9c$G\xb3\xb5\xe6k|\x10" +
-			"t\xe3\xce\xc1\x93\xacʩs\x18\xb13R\xb62ő*7ޤּ\x88\x995\xe5D\xcb\x1c\t\xf5\xe0\x16⊲a2zLW6\x81*\x81&Τ\u0557\x02\r3\xe3i\xedh\x81\t\x1e\xcd.\x1fYC\x8f5\xaem\xae=\xe5\xf2\b\x9c\xed\xda\xd32\x91\xec\xfe\xd1rs\"\u007fQ\xb0\v\xe2\xda\xe5\x84\xfd\xa5\xaa`\x98" +
-			"\xc0\xdd\xc7{\xc0;\x88\xf7\xfa\xe9\xe8\x04\xf9\xf6\xe0b\xa1\xb6\xf6\x9czF\x8f#y\x98\xf6$;U]\xcdl\xe6\xae$\x8cw̲b\b˜\xa7i\x96\xdd=\t\xa2)\xdd\xe5G\xb8\xdfnڜ\a\xdc\xd4\u007f\x1f\xdbB\b\xd2訳TS\x1b\xa6\x9b\xbd\x90\xff,\a)\xc8N\xaa7\x0f\x06\xbf\xb4\xc12lQ\n@@t\x1a" +
-			"\x927Wj\xa8\u07ff\f+\xe4h\x903ɨOҜRİ\xf5\xe0\xf7\x86\xf6\xbd\xb34\xb3\n\xa3\xcb#\xd8\xc7\xe9\xbd\x15\xd0{O-\x0fǅ\xc0}\xf35\xa6#\xf1\xcb\xd8\x19-?ٍ9\xb6\x86\x9cٹf\xbcv\xf6\xa4\xeb\xab\xcf\xeb+\xc2-\xcb%\xbb\x98\x06#P'\x9c1\xfb\xd9<\x18\xc3\xf8\xf2\x91̇Ũ" +
-			"\xac\xae\xa2\xee\x93]\x1c\xe9\xad~\xfdP@>\xd9*\x16ǲ\x03\xd7\x05\x94\xd86\u007f[\x80\xd2_\x9a\xf3DwNgN\xe69tD\xb1\x87\x8eRֹ\x00\xd6+b@\xb8\x1a\xb1w\x06\xcc=\xdb2\x18\xa6\t\xd5\b+\x9f:\xd9ɓK\xb1\x8a\xaa\v\xb5\r\xe2\xb4l\xf9~!\xe3\xd2p\x11\b.\x1b]\x1f\xef\n\x02\xd7Ld" +
-			"\xc0\x8b\xf9ͨj29{\xb6\xe21\x11\x90X1\xf0{\x16\x8e8ğx\xa8\x17H\x8e9fG\xdd\xdc\xcc\x02f'\xd9\x1b\x04_Ui\x86\tw\x9f\x90\x88?}\x1e\xefJr\xaa\xa2\xd0\xf3\xa2\xcc\xe1\x1e@d\x16_U\xba3\xf5d᭖\\\xdaK\xc6g\xa6\xf5\xfd\x8e\xb3\xb2\xc2\x0239.iR\xe3\u058cl\xfbr\xe2" +
-			".2\x9b\xa9\a{\xe8\xeb\xef\xa3\xc0\xda\xd01\xf0\x13\x1e\x12۷\xf1\xb5\xabr\xfc\xb1Ua\x0efE\x95\v\x14-<ݔ\xbe\xc6J\xc7\xf8\x0e\xe4Œ\xdb\xe2S\xa1#\xa4-\xdfܴ\xf1֔.S\xc7\x14'\xd8\xe1\xf2!\xa3;\n\xb3\x83\x87\x9a-V\xc8r\xf1-ѯ\x14\xaa\xac\xacB:\x01\xb9s\x97\x11\xed\x1a\x9d)9" +
-			"\xc29:\xcf\x17\xa4\xdd\xf9\x93\xe3_0\x97\xbd\x0e\xe7xG-\xb2dE\xf8\x18\xce\xda\x1c?\xfd\x94?8x\xc5!\xa8\x82\x9e\xa2\xddn[#{=\xbeTK\x13-\xc4\xcc\b\x15Ő\xd7H\xee\x13\xa6\xbcxz\xb1\xd4IǬoE\x80+cǧ\xa7\r\xdf\x16_o \xb8rؠ\x1b\x89\x8d\x9dH\x88#n\x11e\x8e]m" +
-			"\x83\xa6V\xecg\xb6a\x14\xa3|\x92HM\x81\xf4\xa7PN\xb5xՊ\xfap\xf9\xd7k,m`s-\x8eàX=\x94\x16\x12,ͻGrO\x96v\xceg\x0fj\xbc\x8cNV\x88\xe9GT\fP\x85\xdaJ\x11\xe8\xf6CS\x85\x88:_N38\xf0t6\x19d\xf0\u05ebI\xb36\xf4\xbc{*B,\xd5/ox\x98\x9e" +
-			"\ndW\\\xeaPu\xe6Ě\x83f_\x11\x11R\x9b\x94'(c\xdbFw\xd8\x00\xf3\xf9i):b\x964!\xb3\xb8\xb3\x8eU\xb3[\x98\xf6\x95\x14\xe4\x11\xdc!i\x9f\xfd\x90\x81\xec\xf9\x06\x05\x97\x19\xee\xd5+\r#\xaa\xb3\xc8`\xbe\x8c}\xf04\x93\xac\xd3\aē\x0e0g鄂\x8ezv\xcf*d\x81\xe5B\xbb~<A" +
-			"\xbf\xf2Z\u007f\xae\xed)\xf9\xc2F\x02._\xa80\xd8\u007f\x18\x1d\xf3\x13\x99\x9dy\xd3܍\x92\xd9\x17\xf5\xdd)\x1e\x1f\xf7}\xbd\x16Kk\xfc\x8e'B&-\x8f\xc5X\x06qqz:e\x99D\xd1tێ\xa6\x9f\xdez#Lnøǡ\x15M\xa8YO\xdb5\xb2;n\x9e\x9bf\xd6\x05l\xbdS\x95|\xfbb\xfb\xbc\xe0\x13y" +
-			"\xb2o\xb2\x97V_.c\xa0\xa3\xa7\xb7\xfcR\x1e\xdbS\xcf\x0f\xd3\xca\xc1\xec\xdaǤ\x81\x1dD\x81\\©\xf3)]\xa9H\xa0\xa9\x8d;}~\xe4\u007f\x03y\xfd\u007fV7\xfcmb\x18\xdd\xd2[:F}4\xbb$\xc0\xa926\xb8\xde\x0eƫ\xc3\xf18+|\x8d\"jG\xabc1fu\xc1\v\xc5a\xc0\xb6h\xff\xdb\x05\x85V" +
-			"\xc5W\x89\xef\x1c\xad\x844\x01*(Y\xe1\xae/\x1a\xe0\x83\xf1}l\x95\xccx\xce\x13\xa0\xda\xe6npU\x89h\x87P6\x02\xf4\x91\xfdX\x02S\a#\xd5\x15\x1fb_Rq])\xd0a\x80\xae\x96Y<\xf6\xe2\x02\xf9$\x03\x83\xe2\xa9l\xb0G\xf5F\b<\xbb\xbc+u\xe1\xf1\xc9\xe0#\xd8\xeb\f\x1f.\xb5Q\x0f\x19\xe8\u008c\xb7" +
-			"\xf5m\x1c\x9a&\xb7\xeaJ\xf4Lʬ\x99\xc1u\xcd|a\x05\xdc\x16Iږ\xf6\x04\xae<\x9c\xd6\xf7@\xb6\x90\x14\xf5\xf21\xab\xee&\x83\xc6\xe0\x8d\x8b\xadOB3\b\x80\x93!\xa2\xe6\x8b\xe2\xe0`\xfc\xf0e\xfbZ\xe4(\xfbl\xe9|\xfe6\n\xb4\xbe\x19\x06\xa5wT(\xbd\xe9\xc8\xdd$Gs\x14\x8b%\xe0\xc3\xc6B\xb2\x10\xf6\xec" +
-			"\xa2H\xe2\xb4}Vo\xfc\xe3Eu2\xe7\xcf\x05\xed\xe8\xfe\xe1\xd5\xc0\xb4\xc1\x06\xe4`^o\xdd\xc96\xab\x83o\xabT\xa37\x92\xc8\xf9d\r9I\x06|\x87`θQ\xde\xc0\xcf_(5\xb8\xe1\xe3\x10\xb1\xc5i\xfd\xf8w\xeb.\xdd\xdb5>4\xb4\xcfBǍWW7gE}\x89\xee\x86\xd0U\x8f\vW\xecX\xb4}֗" +
-			"\r\xd7ҋ\xef\x82\x11\a\x9d\x95G\x19\xac+\x1a\xa8\x14\x83\"\xc5\xf5N\xd8|\x83\xe6_G}\"\xb6a\x9e*\x96(\xa8\xfd\xfe1w\x92\x9d\x9a\x01_%\x1c\\^[e\xdfcc\x85\xa7\x8cG\xd8\xd2\xf9\xd8N\xd4\xf1\x93b\xc9\xf7[/\xaa8\xc1\x80\x1b\x03\xfb\x95/+\x8a\xe7\xf8%`\x01\xc9D\n\n6_\xf1^\x8eX\xf9\xba" +
-			"ғJ9\x0f\xd4\xddS\x19*\xc5\xf2q\xaaq\xf6\x17\xef\xeb\xcf'G\x98\xdeW\"\xb459qb\xd6:\xa3ۧi\xecR\xe7N\xae\x1es\vb\x99&\xc6\xee/\xa4~\xfd2D\xc29\xc9\xdb\x1a\xe4\xcb,\x11\r ҵ\xee\xe7\x81\u007fp_\xf7B\xeb\xf6\xa9:\x1a\xc9M\xe9\xce\xecM\xcc\xf4\xa3\xc1_ќ\\\r#;[" +
-			"\xfc\xfcz\xbf\bS\xc4L\xfe\xb6\xd7g\\ʣ\xf52\x19\x8b\x8b\x95\xef-\xad\xe8\x19\x8d \xbe\xa4}\xb5lJ\x82\x8b-*\x96\xddr\\kW\tt7\xdc\x05\xedW\xda\x1dgӵ\xa9\xb3\x9b\xeeI\xf1\xfa\xd3/L\x17^\xb5?\xb6)\xac\x06p\x11\x12\x9d\x17\xcf\x06\x03\xd6n\fV\xf6\xf6\x9dF\xf1GM4!{&/\xb85" +
-			"\xd3%q\xb3B\"\x1fm\xc8\xe5\xca\xf38fMxpڞ\xf3s\xdea\xfd\xc0F\x83R\x80LN\x84\x99\xcb,\xa6\xbb/\xb7\xd0y\xc3!\xa5\x19\xbe\xf3k\xd0o|2\x9e\x05\x94w\xa5t\xaaÚ\x9d{\xa4\x15\xe5\xd1LQ\xf3z\xe5Y\x1f\x10\xa9\xaf3\x81\xc0\xc4\xe9\xc3\xf34\x8e\x98/3f\xf1H\x1aҦ\x8a\x1cԒ\x8a" +
-			"\xcazk\xe4$^x_E\xe1\x1f\x98a\x9681q\x97\x92o\x1a0\xddg8\x1a]E\xb7\x9b%\x91\xe42\x9du$i\xbe\xc8\xc3\xc9\x1c2}ڂ\xbc\xb2I\xb8\xa8\u007f\xed\xbe\x8f\x04\x18ʁ@3\xdec*ŕ\x8c0\xec?Ж\xd9\xddm\x9c\xb4\xaf\xf9\x16\xa7\xea\xe2\xe0[\r\xd7\x10\\\x80\xcb\xcd.\xed\x10Ɛ\xebJ" +
-			"\x12?\xb9,y]\x99\xc4ӗ\x91Vhl\x80w%\xb6Q\x91\xe2\xfca\x8e\xe3\n\xf7\x00\x84Cҟe\xe6\xbeգ\x1e*0vhN\xbd%\xfd\xb7\x95(\xcadM\xd6:٣\xc8\xde&\x9e\xe2\xf6\xee\x9e\xf5T$\a\r\x81\x04\x814\xdb|\x19֗\x04\xf1\xc6\x0e\xb3\x92\xcfޘ~\xb6\xf0\x1dT>\x1d\xb0R\x10?\xbb\xf5" +
-			"l\xfd\xf3{/\xfe\x87\x80\xe0\xafWH\xfd\u007f\x90\xf7\xbf\"\xf2\xff<\xef~E\xe4\xffy\xde\xfd\x8c\xc87\x9c\x9b\xde\xca\xfd\xb8\xa1\xbef\xf3ʽ\xb9Å\x13\xfb\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\xff\x19s?u\x95\xf3o\xa0\x06\xfe+\xc2\xcf\xd0\xf3\xdf\xf2.X\x95\x1c\xc0\xd5\xd3!2\x94\u007f3`B\x13\xe7\xf4\xf2u\x95\xa8\xf2\xd6" +
-			"\xf8\x9dq\xb8\xa7ܦ\xb9gU\xa4]\xdcvA\xf2UZ\xff\xc8\xcf\x11ds\xdd\xdc\xd2\x0e*\xb2\x0fߙ\xfe\x1c\x14a\xb9N\x92]K\x93+\x90{\xc2f\xbf\xeb\xcd*^y\xfcXq\x01O)M\xe9G\"\xf7\x99`B\xe6\x9c+\xf0\xa5Up\xfe\xcf{I~N\xb2_\xef\xff\xe9\xaf\x160=_\x14\xee\xd5\xcc(\xf8\xedޟ" +
-			"\x93\xb8[\x93oЏ\xa8\xfcv\xef\xcfI\xf8\u007f\x11\xf0\x8b\x80_\x04\xfc\xb7%\xa0T0\xa1\x90ڙ\xdc`^\xa5գռ-/#\xb8\xf4\xafKA\xffZV\xa1\x18߁`\xa7줒\x9c\x87\xb7\xde_\xf2\xf6\x12s\xdbM+\x1f|评\n\xa2\xb8\x80_\xf0\xb7\xa0|\xe4]\xa8\x9b\x9eG\xe3\xd24\x01\x1bV-\xafz" +
-			"\xa1\x89\x99\x11L\x9b\xee\x93\xf1\x97\"\x9a\u007f\xccܗE-\xc2\xc6\\\b\xd1r\xe6'\xd7K\x81W\xec坴\xa6\x1bը\xb6\r6\x05^\t܂\xb1F/\xf3\x0ez\x15\xac\r\x86\xf3\x8a\x95D\x8e\x02b\x8a\xfb\xceM\xd1D\x05X(\"\x01\x9a\xa4N\x91\x12\\7{:\xdf\xd5\xc7\xe8\xacVK\xeb\x92\xc1c\x06\xfaz\xd1\xce$" +
-			"\x8f\xfdkr\x12ݾ\xb0M\x06d\xffQ\xf8\x8b\x17S\xb4\x87?K\x1a\xd5X\xa7f\x88N\x80\xe5#+7\xee{5Q\x886]A\xcc\x05KG\x04\xf7\x92\x8a\xd8\xd8x\xba\x8dx\xf9Ze\xf7\xfe\x1bDm\xfe\xe5\x90\xcax\x069\xc5C\x92#\xb8\xaa\xb8B\x9d\x8c[\xef{w\xaa \xea\xf0䏷\xdfzߏ\xfd\xe3\xddeq" +
-			"ܦ\xaa\x1d\xb1\xa6\xdf\xf7e\x91D\xfbY1\xcb\xe3\xc5O\xdb]O\xba\xcaO\xf5J\xd2ґ\xd7I\x9d\xf4\x1e0\x85\x84\xe8\x1bj\xa9\xe7\n\xc0\x9a\xe3wS+\xba\xf4W\xa8\x19u\b:C\x93h\x8f\x16-߷XC\xf2\xa1\x8a\x10\xb2ך\f\xb3O\xbf\xec\xec\xee)МK*\x91\x88\x12\x9a\xbd$E\xe1^\bu?+6" +
-			"n\xb8\xee\xd83P\xbe]r5U\xa8\x1a\xb9\x11\x84BF <\x947\xb6\x9fg\xc0\xa6XL\x92J=-4b\xee7\x8d\xb7{1G\xa4]\x81\xe0#ʬ\xf8ɣ.\xbb\x0f\x02\x83\xc1b$\x8f\x1e\xf7\xdft\xe5_\xe0.X\xf5\xb6\xbd>b/p\xf1R|˂]0w\xcema9\xa4#n\x10\xf3cJx\xed\x85\xca" +
-			"Qܧ~!\xc0+I~\xe0w\x19\x13H\xf6\x98\x92L\xd2ޗ\x8a\xc2K\x98\xf2\xf0i\x88\xc98}\xa6\xb6\xd8r\xf5\xf4c\x03\xf9\xdc\xfc6\x92u\xacNo2fu\x02\x1d\xf2\xa3\xc5X\xf8]\x8d\xb0\xa2W\xf3Q\x91Bk\xceo\xf6-ێ\xa0\xa0\xa7\x97\x01w\xad\aSo\xc6ԟ\xbb\xfa\x18\xc76s!U\xfa\xc6!\xc2" +
-			"\xb5\xf4ܩ\f^\xc2$\xc6-\x1cJ\xed\v\xf60\xed\x0e\xfa*џ\x84\xbaGr:\x91\x9e\xdf\x03x\xecc&rK2\xf12 \xf6U\xe3\x1e\xef,\x8d҅\x8b\xb2ܳj\x11z\x03\xd3V\xbf\xc7l7\xc1HY\xb5\xea\\>vE\xa9\xdf\x03,$\xcd\bfl@\xc4n/\xbfW9ݸ_ُ\xd6\f8\x82\xee\xb0" +
-			"R\x90-p\x9e\x16\xa5\xa7\xceС\x80\x02\x81\xc70\xfd\xbaI\x87j\a\xd5\t\xb0Q\xf4Lf7\t\xe9\x0f\x90\rB\xa7\xdc\x1b/G\xab\xfd\x9e\xc5IJ\xf0'\x87\x86A\x82\xf6\xc4q\x8d\v\xbf\x8e\x1bHi\xfd\xa9\xf8\x9b-\xc8\xe3L\xfe.\xee|\xef\x15ڐW\x87\x93i\x10\xf3\f\xc8\xcc3\x99\xd2\xe4\xc8\xf4\v\v\xfb\xa1w\x8b" +
-			"\x85.\xfe\ne\x13|\x84SN\xf8KF\x81\xf3\x05\xfb\xb3\x85\xd1<i\xf1\x11֭5H\xa3g \xccٝ;\xaa\xc0\xa2\xe0oĄ\xddrBK\xdf\xd1o\x05i\x82$\xf3y-s\xb2\xf3r\xe2\xfb\xcfo\xb1\xb5\x8dݟ\t\x94G\x89\xb2\x1eS\x84bUx\xcf\xf6\xf0\xdd\x03hf\x8an\x19\x15\xab&\x8a\x9e7UpK\"" +
-			"\xdb.\xc8\xd9x\xb6@Qw\xb8[\x83\xa9\xb3\xfe\xe6E\xe1\xff\xd3@\xa9\xe3\x0e\xea嗞\xbfz\x0fPm\x12\xd6\x18\x9dO\xb0\x10\b\xa6.\xf6\xfb\x8bA>\xfe\x8f\xff\xa9\x84\xdb\xd1\x14\xe2\xcf\x03T\x11\x9f\x88\t\x03\xf3\x9e\xd7\xdf}_i\f\xe1ˑv\xa5\xf4\xc1I\xf1\tP8\xed\x1eڅ\fo\x17\x19!яH\xc9X\xd8" +
-			"\xce2\xfa9x>u\xac\xd3>\xe9or̢@\xf5\x14\x02\xa0\xdfګ\xb1/\xa1\ue912Gs[\xa3\a\x81\rLM\xbb\x11aZ$~\xb4\xd9\x19iϫ\xe8\xfd\x13\x16/\xb8\xfa\"\x10L/\x9eZ<\xb5/oڞ\xab\xaf\xb1\xe3\\\x19\x94\xe0\xd3\v\xbd\xf6\xd6\x18\fY\xa9\f\x8c\xaa\xed\xa3;fEG\xee&\xf6lF" +
-			"\xf8\xb1\xae\x96\xa1 9\x162\xf1%\xbf\xaf\xa6g\xfaRP\xba|\xd4hds\xc1\x16\x17\xb6\x89}\x89\xc8\x01@\x92\xe8d\x8e8\xa6\xc5\xf2\"\xda^\x95\x1f\xb0\xe5%\x17t\xa6M\x1b\xb2>\x85\x89g|Di\x18~\xd9c\xd1O|\x0fxlV5+eZmj\xb9F\x9e\x17\xe8#\xb6\xe4\x04\x84ym\xe6>\xa5\x8c\x1a\t\xf8H" +
-			"nR\x85\xf5Û\x83Sρ\xbb\x93\x05\x93\"\xbc\x02&\xca\x11S]\u070f\x81\xd66\xd9H\x16.Q\xb4\x990\xaaHXT\x94\x05\xcf\xd5\xdd\x1a\xdep\xf7\xb3\x81\xcdh\xa5\x9f\xd5Շ\u007f\x83F\x16\xffR\xf8]/\xf8\xe5\x86\xdcj\xdc\"A\xa4\xed\x8cJ\xae\xa83jQ\xfe\x92t\x98\xf8\x8f\x1f:&*+d\u05fb\x80\xcc\xd9" +
-			"{\xc0\x82`\xee\xe5\x14\xff\xeb\x1f\xe5\xf4\x16\xb8\n\x15\xf7\x80Zo\xb9\xa3L\xc8=\xc0\xe1.mM\xa1\xf7\x1a\x9d\xfa\x8f\x0fbSw;\xb7\x82\x9d\xe1j\xd3\xf0\xc1\x1cM\x17r;wl\x96\xde\aic7\xa6\x1b\x9e>\xbfF\xfd\x1a\xf5kԯQ\xff\xf3F\xfd.{\x85\xff$9\xff\xd0\xff\x8a2\xfc\xa2\xf4\x1fGD\xf1j\xfe\xb0" +
-			"\x10\x80\xf7\x93\u007f#\x8b\x91\x05\x17\xe5`\xa7lW\x06\xb7\xebW\xf7\x00\x11\xb9\xacڰ\x0f\xb8\x93z\xfe/2O>\xfe\xb6lr\xf4<\xf6\x8b\x8c/\u007f\xfa\xe4\xdav\x9d{z\x0fx\xaa3\xca\xdf\x16\x1b\xa3 \xff\xe3O\x9f\xf9VnǮ\xbc\xc3\xc8~\xa1\u007f\xa1\u007f\xa1\u007f\xa1\u007f\xa1\xffG\xa1k\xd3Fs\xf6\xba\v\x14\xe5\xf4\xcc[" +
-			"\xde\xfc\xa6\x89\x04\x12~\xf3\tx>\n\x9f*\x882\xff%\xc6:\xf5\x8f\xb4O\xe4\xc0\x88W\xd3\xf0\xb8#x\x81`\xef\x16C\\\xebL\xe0\xf9d\x8b(\xae\xaa\xc1\xbf<\b\xf6o\x04\xd8E\xe2\xbf\xfb\xf9k\u007f\xe5\xe7\xff\xceqO\x96\xdfbuM\x8an\x98\x19\xc1/\x03\xff\xaa}\xe5?\xc1\xf8N\xb2\xbaB\x99,MQ>\xfcΝ" +
-			"\xecX}T\x12*ƱhL\xa5\x8ewӗ\x9d\xd2_\x98\xabK\x99,|7_\x19)\x82\xfa,CY\x84\xd0Nlc\xb4\xf1\xa1Y\xa2E\"$\xac2t\xcf_\xe2˿\xd3˵;\x1d\x14ޙ\xda{˸\xec\xc9\U0009a67f\xa3G\x91Զ\xc1\xf6\xa3\u07ba\xe7\xa2o\x80\xd0\xd9^bˉ\xae\x88h\x9f\xa5\xb8\xa9\x0e" +
-			"\x1d\xcfL\xcd\az\xa7!D/\xfd\x8c\xdebМ\x13\x00\xc8g\xaf\xb8}\x05\x1d\xdbQ\x9b\xb2\x17\xa5\x90\x81\xb05w\x80\x83\xdbq\x01\xfb\xe8\x00BR\xa0\x86\xb45\xcaܜ\x1c娮\x8ek\x99\x98>\xfd&5\xaf\xbb\x01Z'\xaf\x9b\x12\xb4\x9e\xd2\xda\xfck\x96\xf3T\xb6\x95\xc8G\fjn\x93N̓\xdb\x03\x9e\xb1q\xfe\x11" +
-			"\xb6\b\xecys\x02\x8eÅ\xe9\x8a\x02\xed\xceQ\xa1M\xa1\xebV\xbdd\x1f8\x122\xd6\xc9\xda\x04\xf9\x1e\x9c\xce\"\xf9\xb2H\"\xdb\xd8\xee]֨(\xad3X\x00=Ԥ,\xc5\xcaJk:\x13l>\x88\x1ak\xb1\x0e\x8a\x1fC\b\xb5\x01f\x9b\xbfHU\x94\xce\x17\x18\xe1\xf5\xcc%D\xaa\x0eA\x00խm\x9c]\x8dm\xcb\xc6" +
-			"s}\xc7p\x89Pp\xa1\x83\x9e\xd1:\x1bV;\x871̴,.\x97\xb6\xbc1DG7\x99\x85\n\xafIlLvF\x9e\xaf\xa8l0Ӆ\xb0\xd4toK\xe5-\x83`xV \x14\xf5\x16\xd2\"\x85\x85\x85\xfd\xaf\x91}\x84'\x04\x85\x06\xe47\r<w&\x93\x85]˞\x02TA\x99uu\xa2~r,`5\xf9<\xc9!" +
-			"\x10\x837\x94r \xfb\x1e\x00mZ\xfe\xf4ñ\xea\xac\xe9\x06\xae\xf2\x8e\xbe>\xda\xe6\xeb\x0e\x14\x17dvM+\xbdYh\x19\xc3\xd59o\xc7:\xee\x1f\xdc\u007fD\x10x\xc6m\xb1\x15\xf3\xa6*\x91\xd8ݵ\xddxG[L]\x13\x02\xff\x1e\xa7\x8a*\x8f\"ٓ\xd6\xc6E`X3ɻR\xe7\x89\t\x01\xf7\t\x81\xf3g\xde-k" +
-			"\x98\xa0\x86t\x13\xf2\x8cv\x82Ҿ\xb3\x820k\x82\x06\xe1:<\xff\xfaz$j\xea\xcd\xdad|C\xee\xd9\xdc\x1d\xcaw\xe2\xe8\xf9\x8a\x89\xa6\xa0o\xb1V\x86\xa6V\xa3\xfa\xc3\xd1\x1c/\xc3\xd4\b\xd7\xf8bR!\x9c\xcaG\x03\x8d)\xde\xec\xc9Nw\xe6\x9aO(\xedi2\rϦ?\xc6w\xad\xfc\xb0W\xdb8\fU\x92\x8dg\xcc" +
-			"\xd7\xfd\x11\xe5\x87U\xbf\x12\x8b\b\xb0=>J\xb6ߓv_AC뫱\x1auݟ\xf5p\xcdx!O\x18~\x93\xdfr\xd6\xc190,\x1d\x9f\xaf\xa4Y3\x91\xf0\x8d\xe14E\xa4\xa7\xaa1\x9a+\x96\uf4c5\xb5\xe2\xdc\xf1\xfc)Bp\xdcE;Oj\x8aˬ\xa1\x13\xbdۡf\xd1\x10\xe7\xe7\xd2\x00y\xa6\x81*\xc4\xc7" +
-			"\xc2kǅj\x8d\xf8U\x8dL\xf8\xf5\xf5\xa1\x9a\x05(\x93\xdc\x00@z\xb3\x95\xf2\xcd4_\xa2G*\x91\x9ft\x02\xc3\xec\xc1\x9eG\xdc=\xc0\xc4z\x11\xb3\xa6\x8a\xe8\xba\xc1~h<FDN\xd3[\xc3\xfb\xf3\x8b\xf2\x1a\xd9\xef\xed\xf6\xf3\x19\x9a\xa6\xe7v\xf3\x92\xec%)\xc1\xb5\x9d\xfa\x92\xc8pH\xbeH\r\xa5?&\x91\xe2\xc3\xc0\xa5" +
-			"\xa3\x16\xfa)\x02\x13\x8el\xd6\x14leEB\xd4N\n'\x9c\x88G\xa1j\x12\x1e\x94\xe6\xf9\xa8s\x8b\xda\xcdG\xa2\xeb\t\x14\x91\xc8.-`\xe4\x1f\xb6\xc7[\b\x00=\x15\xe5C\x86$l\xb1g55j\x81,\x85\a\x8aN\x9c\xbc6j\x84@\xb0\xedx\xa2\\R:\x15\xb19\xb8\x91-\x98\x17M[\x85\x9e\x14JEi\x18\xe3|" +
-			"\x95\x03$$\x87\x97+];\x81\xde\xe2(\xa6\xa9\x96h\xee\xec'N\xf5s\x8b\x1ac\xd4ض9\x90\x80l\xbf\xabc.\x0f\xf0(W\x05\xbb\x1e\x18\x85\xcfǡ\x1c\x14\x85\xb6GK\xc3W\xf3\xdb\xce\x05O\x8d8\x98:\xf0*\x99X\xb3\x8dv\xd5\xd1n\x96X6\xf6\xf90\xf1ޚ\xc7\x1e\x1cPAL-\x14Dq{\xfeՂ\xed" +
-			"\xff\\FC\xf0\xf7\x8c\x06\xe8/\x19\x8d\ue7e9+\xa5\x9d\xa8\x9f\x89,\xe8Ṵ'\xae\xc2\x1b\xe1?\xbbM#\xff\x9c\xe2\xb2O\xa5\x19\xb7*\x87>\x95\xee\xe3@{^\v\x90\x00\x06\xe8a\"gO:\xbe\x18K\x1ci{R&%\xf2A~\xceG\xcc\xc20*<\x05\xc2\x1b\xdb;\xb0K\x1b$G<\x83rs]g\x96\x1e\x94" +
-			"~\xb2f<\xeb\xec\xd3b\x03\xe0Ö#\xea\x82\xe1A\xbf\\\x06\xcci\xb1\x82%\f\xa21\xea\xe2\xed\xaa\xdb\xd0\xfd\x88\x1e\x01\x97\x86Ge,0\xf8\a\xfd\x82O\xba\xebE\vfv߱\x9b\x84\xf4\xcd\xd0\xc5?\xe9\xe4d/\xa3\x9a\xa7ە\xa0\xac^\x83Q\x8c\xabz\u07fcHLҜ\xaf\x1c\x97\xd4Y\xa5N\x17\x85\x17{\x1d\x89" +
-			"?\x88{\xb3\xef;v=﮸)\xb2n\x92?\xa2\xf5=\xa0\x826L\xaaeq\xbf\xad\x0f\x17\x86\xe3\x11\xc0A\xec\xce\x03\xaa]L\xc7\xe6\xdf\xcb(\x04S\x17i^\xf7L\x9e\x13\U000eada0\x9c\xf4\xda<\xc6\x12õ\xbfݤ8D\xfeZ>\x84\xc5:\x93\x95\x98\x8c\xfdJ4w\xb6\xbe\xad<\b\x0f\xb0(\xa4(\xb1\xbe\x8e0" +
-			"=Z\xfdm\xb08xs\xaa\xfd\x99\xa2S}y\xe2BF\x15$VuV\xba\xb3\xc1F9\x12\xe5\x00\xf3\xcd8ƪ\xd9s\x13\x9c\x9d\x93\xceR\v3\xc2r\x13s\t\x1b\xa8O{L\xa4\x1d\xf0\x8d<-\xa96^\xdf\xfceA\xe7\x94\x12\x8f=l\xc1!h\xc9\r\x9e\xe8(\xf4\x99\xe5\xba\x1d\x8b\xd0\xdaa!\xb69\x8ct\xa3\xfd#" +
-			"\xbd%B\x9b\xf5\a\x84\x17fo\xe1\xed\xb7\xbfğ}a;G\x86\xd6\f+h\xdd\xde\x03\x02\xe9\xbfQ\xa4,r\x18\xa0\xef\xc0m\xa88\xf3\x00\x11\xbf>\xc1\xb3\xb1\x91\xfc.\xafu\x9919\xd9;\xbb\xb1\xf6\xec \x04Q\xcce\xd8#\xcd%\x81\xc8\xcf`\xd8\u007fP\x8c\xbav\xde\xc9DN\xd4>\xd2%\x98\xb6͘N/ں\xf2" +
-			"\x99\x8d\xd80\x96\x82_\xa9\xbe\x9c\xbe\xbb\t\u007f\x01\xe7d\xce\xfb!'\x03\xd7\x15ȇ\x8a\xaa0x\xbf~.\xe3\x1e\n\xbf\x1f\xa4\xb6\xbe\x89\xf0\xfd;\xdb\xc5f7b\x14![\xe5I\xfb\xf0\xb6\x16zHh@\xa3\xc5\\\xee\xb9\xf2\xf0\x1f\xb9Z\x97\xef5%\xc9\xdd%\xdf8]#\xb5\a+\a\x86\xf8؆n\x94\xa0P\x99r\xe1\xa2" +
-			"v\x89\x8bګi\x9c\xf2\xd8\xd4E\xaf\xc7c̓\x99a\xbd\xfe\xf6\x9dEȫB]\xe0\xe8v\x83\xdcl\x0eL\x9c\xb9\x859Q\xcadL\xb6\xd1\xde\xe1\xc0\xa8ȕH+\x83&\xa7\x81t\xfbHY;\xeaZ\xd2ԭ\a\xa2\x19\xa1\x8b\xa4\xe2\xb2\x15\xd2\xe2\x12\a\xed9\xc2/\x10\xf2#\xc2\x05\xac\x16\xe4\xb4\xfc\x98\x15\xe6\xf7\xf6\x8d" +
-			"\xad`FH\xfbц\x15\x81\x18\x05\x9c\xa1ߝ\x9c\x9aѪ\xf7{\xb8\x90<\xb7\xab\xed\xbe\x16dԄ\xa4\xe1j\xcc\xcehj\xd5\x1b\xf2\x8f\xe8\x05\x0e=Ō\xf9\x8eC\xc1\xf4\xc6\xed\x89kw\x87$00\xa3\x9eC\xbfsQ\xb2q\xfaC\v\x92\x90\xa8\x18jG\x10\u007f\xd0~\xf2y\xc6\xd54ݾ}fFɳ#\x8c\xac\xbc" +
-			"p\x85aB\xa3D!\xdb\xcfq8\x15\xfepKNB]_\x8a\xaeu\t\xa7\xb5:l\xa9\xc3|\xfd@喘\xc4q\x11w\x80vZ\xb7\xfc\x16\\m -\xcf\x15\xcd'\x02\x8bso\x8b\xda \xa8\x0fz\a\x89_o&_JVx\xf2j\x19\xfd\x8dX4\x13\xa7jٜ\xd80\u007f/\xef>y\xe7\xe7\x9e\xd6h\xe1\x16 ." +
-			"\xa7e\x87AVF\xc9\xe9\xad\xe1\xa2\x16}\xfdy\xd9\x1c[ԏ\x11\xd7$\xd9SD\x84\v\xc9\x16\"\"`*\xc4\xee\xf3\x83\xd9)\xf1\xaf>\x06\xf5\xbf\x15r#~\x8b\xf4(u\xfd\xb4\x00+\xb2kX\xa6\u007f7\xf9p\xaf\fuw\xa7\xb6i\xbd\xb2NA\xa2\xb8JO\xfe\x94\x99\x87\xfb\xbb\xcc\xfb\u007f\xd6\xf23\x86ek\xeeqп" +
-			"\xb3\x1b\fܐd\x8e$\xde\xe0&\x89\x14\xdfQ\x97^\x81\xd8\xc1\xcf\xeb\xe8hYZ\xf6mI\x80\x80\x10*\"\x1d.\xeb\rmQ\xbc\xd8(\xad!\x83\xb8\x98V\x1e\xe5\xe1\x0ekV\xa9\x1e\x8c\x9a*\xf9\xb7\x9e\xa3\"\xf06\x02\x87\xe4Xs\xb3\xe5\xe5\rd\xf4\x8c\x03F\x89\xea\x8b\xda5foI\x90\b\x01\x00\x00(\x9e\x1d&ʋ" +
-			"z\xfbX\xaa\xab\xe5\x1c\x9f\x85\x15-$!3*D\x12\xb7\bJ\xf2>\xbc\xb8N컎\xfbXZ\xcb\xecK\xd6r\xa9\xe1\xfa\xc1hZ\xd1\n\xf47)|\xf5\x8cdk\x89\xbb\xdbٽ\x1f\xfb\xfb\x1bh\xa5\xaaF\xc9]\xf4L\"\xbb\xf7\xc1\xd1\x12μu\x8e\u08dbB\xc5\a\xdb7\xa3\x92\x99\xbe\xa3\xa3\xc7ؤZE)\xd9" +
-			"\xa3 ݇ <\x01|+\xa3\xf2\x944>\x96\x84\xb1a\x94W\xb5\xbc\x85\xdf\xc02}\v`\u007f\x1c.F\xab\x1f\f\xffm+\xa6\xad\xf2N\xab\xc7֖\xbfM\x06\xaer\x89\xe2\xa5a\xe8\x19\xda\a\x04\xaa\x1a\xf2\x98\xe0\n\xfbL\x8d\x054_\n\xaek)qb\xbdp\xc4\xd7Q\xe1<'\x03\x90\x917\f\xfa\x92_\x9a/\xa0._" +
-			"\xa5\xfc\xc4\xd5\xc9(i\xb0R\x98\xe0\x04\x19V\x86\r\x91\x01k\x89\xae#\xd09YKA\x0f\x1f\xb6T\xb3\xc8'\xa0\xfe\xa5M\xabA\xe6\x94\xc5ih\xf0˷\fz9~A\xe8y\f\a\x1e=\xc68\xe5u\xf1N\xcb_l\xc3ꤖ\r\xcc\x14\x05\xa5\xc2<\xd8MH\x9et \x96u\x80\x1f\x1f\x94\x15\x0f\xcc\xc4\u007f\xf7C\xfd\xd4" +
-			"r:\xe7\xeb\x1b\xbc\x9d\x97\xd1bO3'\xe97\x91\xe1\xb2\x1eY\xceTN\xd3\xdf\x12\xf4X\x04\x9f\x9b\xa4R|l6oq\xdbX\x86cL\xa6\xcc)\xccr#,d\v\xf3\xfdz\t\x17\x85-\xdfi\v\xfbȈ\xd0A\xbfD9\xadio\xf7q\x1f]ƲVy\xb8\x9a}\xb5\xa2\x0f\t5\xf1\xabd\x00 G\xfb\xd2k\xf6\xd8" +
-			"\xd5\xc85G\xe2\x00\x95\xe8\x8b*<7\xaa\x1e\xfa:6\v\x00hF\x04_\x95\x97\xd1Z3\xe8\xad_1\xa7]\xb1s\x1c\x1a/\xcdG\x8fc\x14\xd3'*j\xa5F\xf4LV\x18Qo-1\xfe\x1a\x19D\xc3T\x85\x13\x94\x11\x95\xad~\\w\xc1Ն\x8e7S\x1e\xce\\\x85\xd7 \x8a\x8f\xf69\xff\xcb\\\xa7%\x11e\xbcg\x82]" +
-			"\xf1[V\xc1\x84\x8b\x8bu\x92\x83\xf3\xaeʗ\xf7\x80\xe9#\x864\xfc4\xf4\xd1\xf6\xe7\xcf\xde\a\xb6\xb9ZI\xd2G\xaaTaK\">\xb1\xd78\x95'\xe0\x9e8\x9cH\xb6\x11\xc1\xc5\xeeÍ\xe4\xad\xed\xad\xe34\xd6mD\xa1Q\x02\x8eY!#Ӝ\x15\xc7Z\x9b\x81Y(\xba\a\x10eP\x18\xe6B\x80z\xed\xa5\x9d\x92\xddW\xee" +
-			"\xaaٸ\xf4\x1ch\x9f\x0f\xb7\x9d\x9e\f\xb82).Y\xad\xaa\x18\x1c/o=\xf3\x84\x03\xc7\x1c\x98\x17y\x91Ti\xbaZfW\xa7\xaaāȭ\x8d~(͡\xc2\t|{\xf4\xb3\xfa-\xe9\xf1\xb7|\xf6\xbc\x84\x8b\xaf\xa5\xba\xf4\x99\xcc[\xeb\x96\xd3\xeb\xe15\x84\xcd[5\xc5J\x92\x87\xb7WZ\a\x13\x8f\xb7\x15g\xa0\xaf\x88<" +
-			"\x9aU\x02\a\xe7\x9f?\xa9&ܢ\x1f\xa3\n\xde\xff\xb9/\xf8m\xa2\xc1\x9d\x8eլ\x036;\xa0\x18Pb\xf3\xb77\xfe\xbe\x15\xe2T\b\xe0\xf5Cnh\x01u\xba\xf0\xbf:I\xfc\xbf=\xe9\xdc\xf5\xbfꌎ\xfdgE\x12_P\xbd\xed8IpH_\xcc\xdc\xc9=`O\x95\xfd\xf9w\xbb\x98aK\xdc\xef\fr\xc2?\xee\x01g" +
-			"y\xc7맠\xb4{\x80\xc6\xd7\rϏ\xb6\xe5J\xf55\xfc\xc0\xad{\xc0df_̰\x97\xf7\xad9\xb7\xae^\xf5\xec\xcbE\xadT\xff\x9f\xb7+l\xf4l\v\n\xdeU\x90\xc0J\xb4\xfe\x839\xc2զ\x198\x82\x9d\xe3\xa9\xd2o\xee\x01W\xe3\xaevn\x98C\x0fޕ8\xed7\x02bQ\xde?\xcd\xc8\xf7\xc7\n\xb1\xe7ha\x19" +
-			")\u007f\x9a\x91\xec\x8f\x15z\xaf\x91:Gd\xff\x981\xe2\x8f%6<\xfd\xd6Ls\xff<\xe3\x1fK\xd85\xb6\xfc\"\xfa\x17ѿ\x88\xfeE\xf4/\xa2\xff\u05c8\xfe\xdd\f\x16\xdd\xe8\xf6\x04\xae)\xe5\xcd\u0087ϫ\x96g\x8c\xd5\xe1~.\xff\x1b?\xde\xed\xef\xfd\xf8\xf1\u007f\x9c\xfe\xfc\xcb\xf0_\xf0W\xf07\x81}m\xf1\xbf\xe3\xeb\xc4?" +
-			"\xe2k\x94\x94\x84<\x90\x88+\xcf\xf5L0!\xdfx\xbbIwۜg+\xefvu\xad\xe4\xcfǼ\xe9\xf1\x10p\xfe8\u009d\x9d\xe1\xf3\xeb\xfa\xd7\xf5\xaf\xeb\xff\xe0:\xb5ߛUU\xfe@\xa8\xab\x1a\xb9p\xfc\xe8\x1e\xf0SЙ\xf1KF\xfe\x1e\x01x\xf9{$SuAr[ \x98:\x87\xfc\xcfG\xf0\xff\xe1ɏ\xff8\x84" +
-			"i\xe7\xc5\x16 \x1d\x06\xff\xf6]\x80\xfa\xba\x89L\bv\x9c\x9ep\x0f\x98\xd1¸\x86\x9b\xce\xda\xd2`\xbcȃ\x1f\xe2\f/\rA+^nc@ay\xf79\xeds\xa1\x9e\xef<\xd6l\xa9]\xa99\xa7x\xe1\x04\x84\xb7\xcf\xf2\xd5\f\xc3>\xc8C.\x05\xea\xc7\xea\xef\xdfҲK\xdbwC\x9b#w\xfe\xc32\xdbϴh\xab\xca" +
-			"0\x01ݮ\x83Ȋ\xb7.\xa6nG\x8d\x8f\n#.Z\xc1\xa4\xa46`\x1f_\x81\xef\xa0\xe8\x992b\x15\xb8r\xd1\xc7\x00>\xe5\xfa#Cx\x17\xc4\x15\xaa9\xb3\x18\x82\x1dA\x11\xa8\xb3r\x8e\xbe/\xbal;\x1eǇ\xaerM\xdbm\xbd\xff\xbc\xa1j(\x9e&@+\x94\x04d\xbe\xc1f͢I|\x0f\xc0\x96#\xae\x8f\xafK" +
-			"dS\x88?\x96\xc7z\xea\xbb\xebv\xbdY\x03\x85\xef(\xef\x1bx\x01q'\xeeN}\x1d\xc5H\x98\xb9\xcf[\xad=\xb3\f\x10s{\xccd\xf6\x92w\xe6#Ͷۇ\x86\x8d\x861\xa9\x12\x8e5\x84\xcfK~L\xcd\xf0\xe2\xfc.\x14\xd9O\x165\x87\xf8&G\x04\f\xabh\xc0ZX\xe5%\xf3\x025\xc0\n\xd7\xc1\x94\x9c\x9d+\xd9\n" +
-			"\x96\xc6$gp\xa1c\x92+\xc5c\x83\xb7݄\x94!H\xe4$[\xdc9\x95\xf1\xb9f\xb3gk29\v\xf3Vќ\x95\xa6\xaf?\xb8ʿ\x8d\x9b\a.\x9e\xf1\xc9g-\x04\xac\x16\x0f)f\xd4K\r\xbd1\xc6Ή7\xb2cT\xdc\u007fO \x1c\xf1)0\xc2\xef\x11\x15\t@\x18\x11\xdb\xd3]\rְ\x1d~ŋ\x80^d" +
-			"\xf8\xea\xceT\xab\xb3\xfa\xdb\xc0tjk\xb2\xf6D\xcf'\x8bo\x86C\xabK\x98s\x92l\xf6Z\xfb\xa6\xa0\xbe\x98\v;Rhw\x17\xda775\x11⢼\xb2g\xbd%\x88 \x03\x1dᢎ\xfc\xf9\x9eB\xd7'K\x1f\x12\x1d氛\xa5$\b\x00\xa5\\\x83\x02:\xcc\xfb*7\xd2\xc8\xd9\xde\xf9\x03\xeeH\xb1\xbd\x12\x19\x14p\x12" +
-			"Y\xc6\x14\x80\xf5\x12\xcb<\xf17pS0nvT7\xed\xf8o\x13C\xe6\x05\x17j\x8f̬.XhY:9\x9f\x04\xab\x87\xf3\x03֧\xe2͝\x98\xcd\x13cϾ\xcdz\xee\xe1\x18C\xf1\x15\xc6]\xaf]\x96\xc92\xc9\r\xa6\x8f\xe4\x174\a\x9c\xaf\xf7\x9dz\xafV²\xa4!\xa5\x9a/ɵ\xb8\xf5P\xda\b\x88\xd6I\"" +
-			"Z\x93b\xbe\xde\xd5\xdd\x03\"3\x11\xef\x010\xb9\x13~x9l\xb3#\xcd\xd9\x05\xbcO\x1d\xcea\xa1O\"9\x9f \xaf\r\x1b&.\xc1\xe8\xa2\x0fv<\xd8\r\xb2\xef\x01&\xbaD\x99L_\xf5\x91\x9b\x8f\xd7\x11#\xf2\x11\xc2\xf9i\x04q\xb3\uf80efN\x96V\x181\xaf\x84\x82V\xed\x14\xe5۱\xbbC\t\xb1\x99\xfdd_\xd6" +
-			"\x9ccG\xd8O\xec\xd1*O\x96\x0f\x10\x19XJ\xe9RKI\xe8\xc6&\x81\x1d\xafӯ\xa6\x12\xb5g\x17\x06Vny\xd0\xfa ?\x8a\x9fǖ\xec\x10[K\xf8\xa3\xaf\x1b.G\x85՜\xeek<M\xf9\xa4:\xcb\x1e\xaa\xc1\x9erJs\xa1o\x97\xc1\xe1\xaa?\x8d\x1a\xad\x1c\xe3\xe7B\xee\xc0\x96\xfcy\\5\x9e\x17ʪ9ƞ" +
-			"\xc6zͣ\xcb\xd9\xdby\x0f\x88\x8d'\xfe\x1aQ\x0f̆\xa8%\x0ed%\xd1bЪ=\x91\xb3uD/\xadhC\xb2\xe6\xea\U000bf20c\xe9=\xe4\x17}\xc7\xff%F&n\x82m\xee4p\x17\x8d\x86*\uee9d\xd2\xc6-f\x10n/Ub3\xbf\xce\xec\xd6\x01\x89x7\xc2$\xa3\x1eZ\xf4\x9d~\xbfB\x9b\xf3ME]\xf0" +
-			"\x88IP,@O\xe7Y{\x06\x87E\xf4\xdb\xd0CWP\xac\xa5\x8b\U0005433fb\xaa\f\xc3\xe0\xf1\xe4\x8b@x*\xc5\xd2\x03`\xe5\xea۪m\xc1\xa78u\x94\x9d\xbd[\x10\xb6\x11\xcdW\x178%\x12k\x8a\xfd]\xe9\b\xd1\x10*\x99#\xfc\x88\xc2\xfe\xc9s\x1c\"\xb0ʢr&\xac\xafy\xa8\x1f\bU\x1a\x87\t\x96{p\xdb" +
-			"\xb3\xbc\x9d\x178{y\xee\xac\x18\x9d\xea\x14\rX\x18\xcb[>-\xa6\xa1v\xec\x89>r\x18\xaf\xb3\xacLղ\xd3~\xfd<\xc1\xcb\xe6\x8d\xf9\xad\xe6\xc6\xd0\xda\x18nrQ\xafB-\xbf]\xf0\xd1\x12Ƭ\xf6\xcc\"7\x04\x12[\xe9nB\x80EǒnG\x90\xa3\x1e1\xe8\x86Q}ޡ}\xb8\xf81W\xab\x0f\xac6\xc99\xd2" +
-			"hD=\x06\fg\x0f\n\x82\x83\xeb^\xf7\x1fjrc8\x88LV\xdd*\x18U\x8b\xd9Y$l\x80\x9c\x18\xb11D\xedd\xa8\x06\xeb\xf7#\x0en1\x83\xe4\xe2\xf3\xbb\xbc0\x8cL\xe34\x1a\x8a\v!|\x91\xf3\xd9\xcdI\x04\x18\x1b\x12\xce\xf9\x82\x1c\x17tʡ\x0e\xe4^\x0e/uf1\xda<\xe5$\xe4\b]\x1c\xcf-j\a/" +
-			"\x8e\xa7.\xec\xec\xe6m/<w\x99\xd7t\xd1Y\x03;\x96\xfae\x01\xc7\xeb{c\xd7\x15\xadb\x89\xa9{\xae\x03\x8d\xe9\n\x8e86z\x1c[\xe69\xabs_\xa7\xb8\x04\xaa\xf74\xa5\r\xccn\x1b\xd9Vr\xb5o\xdf\v\x9f\xdb́\x8c\x8f8\x93꼼\x0eܷN\xb3\xf1\x17\x8a\x1fD,F\am\n\xee\"\xeb\xe18v=\xf6" +
-			"\xc2ʚ~\x11_\xcdc\x14.\xbe\x0f]\x1aq[\x1d\x8dd_k \xbcI\xd0\xe8/p\xbe\xe2k\x02+\xb6K\x935{s\x90w\xc5\xe2\xb9a\x02\xfb\xfb\xa8\x03$ť\xa5\xa1\x15m\xb4V\x98\xa9>g\xfc\x05\x18\x8a\xa4\xaf\xaeەf5C\n!\xae+\xd2j\x9f4]\x03\x9bqҒ#q\xf5\xd6l\xb2\a\xe8\x11b\xc9" +
-			"\xa2ã\x9f\xf7\x10\xa1\x17\xc1!ڡ\xf0\xa3\xe4N\xb8k\xba\xec\xd3g\x94\x87\xf0>\x13\x15\x1dҢn\x1ex\vՍ\x8aT\x10\xc5\r\xf8\xa3\n\xe7\xbf:\xe0\xf1y?h/\x9a?\xa5\xe6\xa0\xf4=qm\xbf\x1b\xf1\xb9c\xcf\u007f\x8b,i\x9eQ\xa5_Qg|\xf6\xfbs1\xc3?y\x9aD\xb9\xbe\xa1bl\x99S-\x1aeM" +
-			"\x11\xce 5k\xd9!W\xc6\xd9\xda\xeaH\x9c\xf5\x83ٜ\xa1AZB\x89\xac\xe03\xf5Я\x12Bz*\x01\xc2\xcb\xfeҢ\x00v\x15\x85W\xe4pB\xcd\b>\x80\\\x15\x11O㯒\bR\xbe+\xc2`\x9fd\v\xa5\x85\x06 \x9e\x18\xe3\x93\xed\x18\xcd>g\xbcy1j\xc1I\x0e\xaf\x037\xf7\x93\xb9\xa9\n\x024b\xb6I" +
-			"\xf9]\x85\x1aD\xbd;\xf6\xf0\xc5\xe7ã\xb3\xbc\x95\xf5\x81\xa2/\x9f\x11\xf3K\x9d\x91ի\x16\xb1\x8aڢ\xca!\x00Å\x88\xde:\x90\x9f\xb2\xc1%eok\xa8\x86mz\x1b\xb7m\xb8V/\x9c\xb0\x9a\x10u-RMVl\xe7a\xf4ïkX\xdd\ue23cX:\x17F\x10L\xc8\xec\xe6\x1cO\xfd\xed\x97g\xda\xe7\xcdhp" +
-			"\x87\xafʇ\xe2\xf4\x93o}\xe8\xd6JvOV\xd9\xd5\xc3U\x90\x94@\xca\xc8\xc7x\xd5?'\xee\x02F~)U\xc0\x04\xdcdp\xcaC\x80\x81'f\x1f\xbe\x95\x17\xb7E\x1f\x95Ƶ\x8c\xfb\xe0Wݲ\x1cI\x88~\x8b\xc2\xe6\xf3\xfb\x8c~\xbc\x81\xc6\xd1o3-\xca\xe9\xf0:1\x11}\x9aM\xb6\x85\xf3\xb7\x01\x19\x99,\xed\x94O" +
-			"\b\xe0Ő\xfc\xb6\xa2\x91\xe1\xc8\xddЃ\x8b\x1cјm\xcfE\xc8OD\xde\xcf\xf9_$H\xfb\xa6&\xcc>\xba\x92G\x9a\xa5ጣ\x9e\xc1\x16G\x1d%\xcd{\xd4b\xe0g\xc3\xef\x828*\xce\xc6J\x80\xc5S\x13V\xc91\x96R\xc4\xddr\xca7\x8cX\xae$\x89\x8a\xc6\xdcL\x12m\xceE\x15\xbc\x89X\xe3s\x10.\x8a\x9e\x8b" +
-			"h\x88\x15i\xe3\x9a\xfe(\xefߠ\xb1\xf1\xffn\xa0q\xb9\a<Xgʿ?\xed\xb4\x1a\x9a1\xf4\x1f\xff\xf4|\xcb\xe5\xfc_\xec\xfduX\\_\xd6&\f\x17\xee\x04w\b\x0e\xc1ݝ \x15\x12\xdcݽ\b\x0e\x05!Xp/\x82{\x82\xbb\xbb\xbb\xbb\x84\xa2 8A\x8a\x04\x0f\x12\xf2\xa5\xfb\xd7\xcfLw\u007f\xf3\xbc\xcft\xbf3" +
-			"\xd3\xfd^\x93?\xf6\xae\xab6\x8b]\xabΩ\xb3\xf6Y\xfb\xdc\xf7\xbd\x14\xfe\xb4\x91\xdar\xa4\xf6w\xa0\xa9\u007f\n\xbb3\xf2lG\xc5?&\aQ\xbf\xea\x038\xbbӚ\xc3\xd1 \x9e\x8c\xb0w\xbf\xc1\x8bS\xf1F\xc7\xc8\xc3\xc6!\xc0\xc52\xa9\xe56\xa9\x11\x8c\xca2̣\x06\xd89\x1b\xf2i\xd4}ER\bF\xa8=#n*T" +
-			"+\xf9Q3\x939\x98\x11C\xca\xe2\xeb62uc\xe4\"\x17\x1cz8\xe5E\x83\x1b}\xc7\xe0spۏhu\xbe\xac\xed\x89P\xeb\x03'\xe0\x97.\xf6\xb7+\xf2\xbeT\xffh\xe7&j\xa2\xbfY4\x0f\xbf\xbc\xff\xa1\xf3\xa1\x0eb\xe4PE\x94\xbe\xf3\xe4~\xc5\x164Nf\xbd{\x96\xedY\xadhD\t\x97\xe3\r\xe0,\x82i)" +
-			"12͋`;Q\xe1\xef\xc9\ru0\xc1\xaci\x94\xbe\xb0C\xbf\x14\x8d\xfd\b\xd89\x90ps\xfe\x9a*\x90\xbb\xffY\x03\xbd\x1e\xd6HП\xafv\x11\x06\x18G<\x85}\xba\x1fy\x9a\xe2\xe7\x1du\xfd\x13p\x92\xf5\x1a.\xfd\xfd\xf6|$#\xb7\xed\xe6f\xc4\xcco\xe9C\x19;\xa6\xea\x961\b\xa6W\xbf\xfa\xf9\xc8\x1er[\xff" +
-			"\x8ab8\x1a=\x90\x1e\x03M\xb6\xef\xb6\xdb{\xc4թ\xfeC0\x15Ύ\xc9\xe7\x81\xd2\x171>Dj\x1e\xb83\xeb@H\xb0\xb6\xf0\xe9yBm\xf6\xc1k\xc9\x1f\x0e \xe7\xaf\xdc\xce'\x9c\\\x90m\x91\xb0ɂo\xe7e\xf6!\xa2\x9a*\x16K\x18\xbd\b\xf1n\xc3N]\x1f\xdeEL\xba\xf94\xb7\b\x1bz\x17\x81\xf7D!>" +
-			"\xfa\xdb/\xa9\xfbY\xf5\x11\x86\xf9\xc7`\x9f\xe5u\xbcXkp\v\\G\xd3\x14/fbN\xe2\xb3\x1e\x92H':\x18\xdf\x04\x05\xc0\xfb\xda\x04?k(\x99\xb7\r\x82\xdd\u007f\x02\x9cFW\xf07\x04\xc7\xda͞}\xe4\xa2\xc33\xf7\x92>\xba\x8e\xf7A\xba \xfc:\xa5\xd9p\xef{F\xe4\xc1g\xcc\xd6yQ\x99\x01\x8c~7\xe0\f\xdb" +
-			"\xbd\xb5\x01\x0e\xcfV\x04\x89\xe5\x99=\xff\x00ѭ\x85\xc5\xf3+6\xb5A$\xf9\xa0\xdb,\xf1\xe2\xac\x12\xfb\x1b\x85\xb6\xba\xe3\xb4\a\xedq\x83wֺ:\xefcۋ\"\xb2\xae\xf3\x9a\xc6<DrV\xb3\xfb\xb7t\xbe\x91\xe6DP\x99s\xbf\xc4su\x9ag\x97\xa2f\u007f\x15rXc\xd1\x12\xc7\xf2foIF\xe2\xea\xe1\xfen\x0e\x14" +
-			"\x16@>\xec\xb0}\n\xdd\xde\xd8\xda\xee\x14\xd6\xc8\xc8\xfc^\xebAY^a\xce\xf5V\x91\xa9\xae\x96ű.\xc0\xc23S\xb26m\xca\xc0&}\xc6m\xaf0\xbe\x03\xc6\xcbvLȑ%K\x8b\xc8\x1f\x8b\xbd\xe2\xa3@2?\xb0\xbf|@Xbh\xb21Q\xdctg\xfc\xbcΨ\x83\xaf\xa1[b\xc8s\xb5\xf3\x9b\x1aU\x10\xec\xee" +
-			"\x00\xf5\xc3\xc2d\xea\n\xbd\u007f\xfa\xebL8\x85w\xeb\xeb\xecAm\x176\x80\xa8\"ZP\xb0h\xf5i\xf4ې\xb6_7S63\x8c\x14oH\xe5^\xc2^1\xae\xbc5⋛\x8e\xfddYH\x9d\xbf\xa5$\x19TY1\x01R;\x11\xe2\x91\xce\xd9e\x80Uf\x18\x14J\x88\xe2\x9d\xe2\xf3\x8d*\xce\xf5\x8dQ\xf3\u007fZ\xf98'" +
-			"\xaf\xa7K\x91\xd4\a5\x84\xc9\x06\x14;R\x84\x18\x03\xe9\rƫ\xef\x8d\x13\aP\xe4\xcbS\x01)3\xa6%\x9f\x89\xc5\x1d4a\xd0O\x01U\x8cOܼWH)\xb1\x9e\x99\x9c\x8d\x85q\x88\"(.\xc2\x0f7:\fY\xeb\x84E\xb8On\x11\x16h|K\xbc\x9a\xbas\xd0\xf8\xf89\xfc\xb3\x15Uq_|\xeb\xe1\x85\u007fF-W" +
-			"s\x84\x06\x84WWP\x0f\x82\x98._b\x92\xc2\xce:J\xee\x9aYP\xe2\xf5]=>]\xd7(\x97\x16\x85?[\xb1\x1e\xa7\x96\xc5\t\xe1\xe3+\xa1}D\xb6M㾺\tL\xbc\xed\xa9\xe9y\x98\xa6`u\xb1\x8e?\xed\x1f\x17\u007f\xd7Б\xcaju\x11\xbaGT\xc6_{!e^\xa4\x88\x1a\x18y\x06\xac\x1c\x00\x93\xd4\xd7C\x99" +
-			"\x06\xb3$\x82\xa9\x1c\xeb\x91{E@\x10:\xd6\u0380-\xfa\xcbC\x93\x1fD\xf7<m\a\x968V\x8f\x04O\x85\x14Ӆ\xa1ӼTa8\xdd\"\x947\x91!;\xcd\xebk]\xafL_\x89\x9dr\xb2\x81\xe4\xa4%68\a&\x89s\xc6l\xa2u\xe9\x10\x93\xe7\x92E\x00\x1e\x89\x01\xecͿ~>\xba\x94)3\xe2=MK\xb9\xe3" +
-			"\xd3\xc07\xb3i\x04*\x82\xe3a\xa9r\xe1\xaaV\xba\xfa\x19\xd6\xfd\xf1\"\"h\xa5\x15P\xaf8\xf8`\xa6\xfd\xa2\xe1V*%\x98\xba\x85$\x81\x8bьC\x19\x89*\xb1s\x13\xe2{\xd0彨\xf5bz{\u007f~\x84\xbdJ\xed:\x12\xde9z\x8a\xd2\x035\x14MB\xe2\x88'\xd6GD|\x98\xec\b\x92\xe6֘\xe65\xbe*\xc9" +
-			"p\xb3s\xb13j\xa9%\x15V\xa1\u007f\x96TD\xe6\xfc\xfc\xa9\x03\x1f^+\xedN\xc1\xb0Ӭ\xcbI\x15\xf7\xf697\xbdA\xb2x\x90[6>\xcc\xef\xa9\x1fdc\x03K_wc\x95Dd\xd6BG\x11\xf0\x80\xc2\x1eh\xe6jvw?9\x85\xe0\x92\xa6\x12i\x12\x85ds\x1d\x05NTj=y\xf2\xb8\f\xb3m\xb6\xffP*\xab" +
-			"@\xa4\xf9\xaf\xaev\xf5\xff\xba\xfd\xe5\t\x9a\xba\xf6\u007f\xe4\xf4./\x9b\x9f\xfeͶ\xdb\xe72I\xb49\x05\xa2\xc1\xffμ\xfe\apIDwO\x0f5\xf4$\xd5\"\x9bj\x1b5\x14ly\xfe\xd5_\xf7ߩ\xfd\xe5\xd0\xeb\x8c\x01\xac\x97\"\x87\x19\xd4\x03\xfe\xc2c\xfc\x9b\x1d\x95\x1f\u007f\xbf\xa3\xf2_B+\"Lq\xf8'\x12\xee?\x9e" +
-			"\x97\xcc\xc9\x03O\xd9c\xcfw\x1e\xbf<\x94\xfe\xb9/\xbb\xd4\x18\xef\xc0=\xb1K{z\xa3\xb4\xa7\xf4\xe7^\xedC\xbc\xce'\xaea\xb0\xdbO\x80\xe7[\xed\xbf\xbcܱ\ue644\xfc6\xfbm\xf6\xdb\xec\xb7\xd9\xffMf\xe8;TaOS~\x05\xdc\x17\xfd\x98\u007f\x1d\x865\x16\x03\xfen\r\xfc/\xe1$q\xb6\xe8|\x8e\xd2\"\x81\u007f\"" +
-			"M>\xff#G2P\x05\x89\xd3Cf\x93\xa7\x9f\xfe\x89z>w\xf0\xe4O\xecJG16\xc9\xc2\bk\xe0\x1f\xef\xec\xff =\nR\x13\xf9\x03Q\x92X\xb2\xa5\xffLJ\xff\xe3\x1f\x16=5\xe0\x8c$\xcfs]\xff\xcc\xc0L\xf8cj\x8d9\xa5\xc6D\x03\x02\xf0\xdf\xd2d\x94\x14h\xe6e\u007f\xcf\xf9{\xce\xdfs\xfe\x9e\xf3\xf7\x9c\xff" +
-			"3s\xfe\x85O\x98b\xf9\xe7\xad\xe0\"\xd1\xe6\x931\xc8\xdf&B\v\xa5\xed\u007f\v)\xfc/q\x1d\xff\xe3\xad`\x9b\xa3\xbb\xccC]\xdb\xdd\xe1\x05q\xbe\x11*\xa7\xa5\xf5i\"\xbb\x96\xe5:\xe77\xf2\xe5&\xa60\x87\v\a\x10\xf6\xf2\x198\xec͞3DJ\x01\r\xf0f\xa3\xabc\xd9x\xfc\x9amzE\xec\x88=m\xb6\xe6\xf4`" +
-			"ޅ\x99\x17\xc84\xc87\x9e\xab\ryfy\xf4\xf6\xf4\x1bbp.\xfbS\xe0\xd2պ\x96\\\xd2i\x81oD2;綘u=a\x10\x1e\xae\x98\x95\b\xfc\xab|J\xf8G\x8fY\xd6j4\xe53\xa1B\x10\xe7VW\x9d\ac\xf3\x14#\xae\xb5\xd6A\xfa\"\xc9\xccS.\xbf\v\xbd\xe4AB\xb3\xa6\x94\xd2`\xf8\x82V\xf0:\x8b" +
-			"\x01\xd1{\x1bMe@\x8d\xc6\b\xcb\n4&&%'\x8c\xf8 \x13-\xea\n\xdf\xca\xd2K\x05\x88\xcd\xdf\xfa\xce$\rͣ\x8eֆ7Qܶ\v7\xd3\xe7\xd3+#E\"=\x8a\x8dg]\xde\x0e'\x97cv\r\x06o\xf2$D\x90\x13׀\xf8\x9b\x10\xfeϷ\x1an\xdbct\xe0\x92\xe8~ \ag!\xaf\x8a;\xb5\"\x19" +
-			"\x15/\xc6b\x90\xff~\x1a\x1f\xecM\x8dU\xfd\x87\xce\xc3N\x0f\xbf\xcaM\x87\x97r\x19\x8ao\xae~\x02R\x91\xf8\x02\x9f|\v\xfd~]\x15\xe7w\xa72!\xfeJ\xa8/\x83i\t3h \\ٕ|\xed\x1b\xd5[\x01ӻ\xfc\xbd\x15\xad\xaa\x0f\xad*5$f\x06\x9fǊ\a\x8a\x05\xba\xb5+\\*m5\xdarTX\xd4ߞ" +
-			"\xc7^\xedt\xa9\x90Ɣt?\x17S̾Q\xa57\xb0ET\x90\xa59ْeؗ\xa0\x01\x1c\xdcRM\xb8\xeeف\x14\xc0'\x99\xd7\x05\xcc\x15 \xf0\xc7!\xce\x13a\xefpY\x17\x13+t\x9d Nȓ\x97V\xc7\xf3U\x8e4\x02\xf1\xf3\xfdӏXn\xb4\x1dr\xf7\xa66\xb1\xd5\x1b\xe8\xbb\x04\xe0K\xb2\x8f\x19^O\x19" +
-			"ģ\xd9C\xda\xcf\x1a\x86\x88\x84I\x96q\x044\xd7~\x14dMb\xeaȃ,b\xa96\x1f=\x93}7\x8d\u0590\xdcl\xad\xda\xf9\x05\xa4T^\x14(\xf6\x16&ӑQ\xf1\xc0\x97\x933\r\xd1\x18ď\xd8#Z\xce*}R\xe7\x02\xa2\xe7\x8a;Su#\x8d\"\x8cmP\xf7\xa5\xec\xd6\xd7\xe2\u007f\xfc\x04\x18\x16\x94|\x0f\u007f\x1c" +
-			"\x1e\xd1\xe8X\xc4_\xdfjï\xd4z\x11\xadGK \xbc\x89(\xc9#J䑿k\xf7q\xce\xf8\xb9Գ<\xado?N?Z\xbcn\xde^sO\xc1\xadDE\xf5\tg@F\x98\xe6\x9d\xe6Մ\x1e\x8b\x9d\xb4c\xa7\xd5\u007f\"N\x01\xcd\xed\x04\nIo@D\xdf\x04\xe2\xa2M\x85H\x96]p\x0e\xa4\xe4\xa4\x10\x8f\x1c\x8a\x9d" +
-			"\x961ǿ\xbeb\x97\x8c\xa1nM\xb2\xe1\x1bF\x1e\xa1\xc9v\x9e1uz&\xa1\u007f\xb7\x91V\xa6\x84ٔ3\xd4Ԗa*J\xf22젚G\x94\x8cavv7\xf1\xbd\xc1\x97F=\xea\x0f\xdfC\x1ew9s\xf6bFD\x9b\b\a_Fo\xb8\x92Q}\x13\x82\xa8\x05\x0e\xe7\x15~O\xd0X\xe2B\f\xb4\x19#b\x83S" +
-			"\xd5g\x10\xbd\xf3\x9a\x01\x98\xbbP\xb9+\xbd\xf0ѫ5\x80\x1e\x98(xP\x90\x14x\xf9\x94:\xe2\x99*1\xf2=\x9f\xcb\xe3\xdc5 \x96\u007f6\xbc\x9eoEk}\xeeP\xff\x8a\xabh\xdb\x1eN\xe7\xad/\xb0{\xc1\fu\xa50@\xc5W\x9b\x03?\xd8w\xf7.\x1fh)\xb8Em\x834M\xe4@R\x9c̊N>\x1a\xcf\b\xaa" +
-			"\x89\x8d\x12=b\td\xa7a\x8b\x9c;j\xdfs\xa8\x998\xc6\x107\x8d\xd2\xf8ʻ=\xe2\xfa%\xack\xde\x1c\x1411\x9ePU\x9e\xdaW35u\x81\xdf\xc8zX\xe1\x04g\xb2\xcea\xd6dQl\x920L\x8e=\xde\xdcx$E\xc3\x1dJ\xbc\xdc\x04A(\x1f\xdaz\b\x8c\xd7G\x88\xabRb\xd2\v;[\x95\xdf\xf4-\x91\xa6" +
-			"ZJ~cG\x1bj?m*\xfcl4dYj\xb9\xadK\xcb+\xae\r\u007f͏\x84\x80a1z\x10m<\xeaș\x1b\xa6\x00\xf6\xfa\x1a\xed\x95î\xdb\xd9\xd8Aos\x9ed\xa1?\vR\x89\x90\x19G\xe8\xb5Ę1u\xfc\t\x88x\x00V\x18\x1b1\x92\xaaSVD\x84\xa8\xbc\x03\x8d\xd1\xfc\xa8i\xb0\x05\x00\xac\x16\x1f\xb5\xdb" +
-			"R\a\\\xbd۾h\xeb\xe1\xb8\xed\b5\xd75,\xee\xd5\x056\x98<c\x96\xd9|g\xae\xbc\x16k}@\xed\xfb\xe1\xdepqw6\xff\fs\xf0:W{\x14\x8ea\xbe\xe1\xcc!w\x87m\xe7\xad\xf8\xb6;Eo\xb9\u007f\xcf\xceC\x81\xc9#\x97\xb5c\x11\xcea\x15 엚\xa2\xab\x17\x87\x1c\x9f\xb4\t\xd1ybHY\vi\x9b" +
-			"\xd70\xf5p\xd8n\xae\x9d\xb7\xe9\xecl\xa5\x88\xc9\xcac\xa6B\xc0\x18\x9e\x95I\xa6E\x1e\x11e\x91Nq0\xf8\xde\x03o\xf7ڃ\x91t\x94\xd4\x0f\x00\t\xc6+\x8b\x9e\x88\xa7\xca\xf6\xf5\"_£s=\xf5\x18\xc4_\xb0\x87Iq.\xa1\xe6BK\xae%\xf2\xec0\xa9DS\x14\xb5\xafD\x86e.w\x92f^\xe8\x9eeDU\x9b" +
-			":1\xe3\x19\x8eP\x8eяV\x85\xb2\x85\xc4\x04\xaf0\xee\xd0\xde/\xec\x9bȧ\xe4L\x11\x1f\xa8\xec\x88\nR\x8a\u007f\xa2Ӱ\xf3!n0~>z(\x96\x18\xcaH\x80\x8a\x00\x0fa\x8bKm\xa8\xf9\xac\xb6k`:&\x90g\xe1 'ٮ\x94ዄ\x124:6\xed禒\xe3\xf9\xd8\xf2\x13@\xfb'\xf2\x98)\b\xc4\x1d" +
-			"kx\xb4\xcd\xd8y\xaf\xb6:1<\xb1\xf7<\x8f7\u008f\xea'\xa0[\xe6O\xb8\xbe\x12\xb5\x1d\fA\xb5\x86W>\xfb1>-\xf7\xcf\xdfj\xab\x84e`\t3\xdc\x17Z\xc5w9\x94\x99\xe5\xbb\x1f\xfa\xe7&+\x80_\xa5vUq\x15\xa7\x82J\u007fT\xdbt:\xf94ߋ\x90G\x19\xa1\xbe\x1a\x17\x191\xb6\xed2\x8c쨈\xd6" +
-			"h\xa8\xd5\xf8\xab\x14\xe0\u07fd\xfd\x13\x8f6\xffK<\xdd\xff\x90\xb5\xa3\xdab\x9e\x19\x9f\xc5\xd9\xc1\xa0\xdc\xfb:\x96\x01\x19t<\x0e\xb7\xeaϾ\x11\x1c\xffb\xfatIb\xe28\x0exʙy\x88O\x1c{̸\xf3\x9a\xf1\xabN/uА\x1f\nq\x1a[\xd7\xf8\xbew\u074c\x03\rM\x8cR\xc6~\xf8\xe9\x91\x05\xf3\xf6\x98@\xf0" +
-			"\xfb\x06<\x15s\xd6\xdbx\xb1%\x91N\x86\xd7\xd8 d\x9f\x95\x18\\j]\xa6\xf7\xc8\xea\xb7Ө\x1b~\xd9v\x82\xcbsJlM\xa1\x19ٝ4č!F@\x85\xa5\x85\x1fQ\xd8n\x15\"l:Q\xa3\f\xaf\xddF\xc86\x9f\xbc({\x1aRe\xe9\x9b\xc3]\xa3\x94Hn\x81\xa83h\x11\x8en\x96\x16}\xf2\xe5c\n\x1a\xbf" +
-			"(\xa54\x9a\x81A#\xa6Kuq\xf8\xea\xc3\xe8VR_\xac\xc8.\x15\n\xf5\x13\xc1٪\f\xa3\xeb\xee\x8b=\xba\x8f\xf8\xb7L=\x9e<\x1e\xad\xdc7]Gp\xa2\xf9\xda,\xe7=\xb50\xcee=\xa1\x9b4W\xb9Sk\x1e%\xae\xc51QZ\xb7D\fx&\xf0\x81\xc1Bb\xbe.w\x8b6WM\xc8\xc8+\x19_\u070e\x85\x81" +
-			"|\xf7\x1c\\]\x1d\xad+\xbfoG\xf9\x13\x80\xae\x9a7\xedR=\xfd\xb2po\xe5\xd1(V\xfa\x9bOŝw\x88s\x13!\x98c\xd8&\x1a\xb9\xa5\xe5\xf3\x04\x1b7g\xeb7\f\x96Vj\xf6\x8f\x1f2\x81H\xa0\xec\xca\r\xc2\xc6ܫ\xaf\x99G_$\x929\xbe\xe3\xb6b\a\x85\x8dL\xcc\"%\x14\xe9\xc5\xf4\xe9\n\x8d\x12̟\x9e" +
-			"\"\xb5X\xd5+#xM˼N\xa3\xb2CB*\xf2\xd6\xd6ܓ\x9d\x9c6y\xf3̆hn\n\xe4恖\x82Т\x10\u05eb^H\x95\xfb\xee\x80\xc5\xdbc\x11I;\"\xf8\xf2Ry\x99\xbd\xa3\x91wx\x0e\x15\xb7\xa3\xb3Y\xbc\xf9\xc7\x06*\xf0\xf0ؤ\x8a\xc8=\u007fߎ@\x02\xab\x04}\xd0̩\x83\xb5N\x80\xeeeL" +
-			"\\\xac\x0f\xfe\x8d$\xf8\x01E\x85%\xa4\xf1\x10#\xa9\x9a\xebe\xc7`\f\x9d\x17\x93\x11\x82\xd1S.)sT\xf7'/N\xec\xff|P\x99\xbe\x05@\xe4\xca[\x84\xe9\xad\x13G\x93#\x10\x15\x18A\xb4\x16n\x8d\xc1\xa3Cz?\x01\xef\xe8G\x9cN;;\xec\x1ctA\x93G\xcaI\x1e\x17\x01\x1b\x9d7\xd5\xcb\xd1\x1c5\xae\xfbv\x82%" +
-			"\xbe\x963j\xf1\xa7t\x92\x83\x13~E6\x04\xad\xa2\xa3\xb4IA\xa2\xb4W%\x87\x8f\xd4у|\x82\xebK\x83\xb6\f\xe8\xf1\x06|\xd1\x11\xf1\x00\x80\x1d\x95m;\x1bk=\xae\xad;R\xdfr\xf8\xd2IIgG&I\xacb\xe99Ni~\b\x0fbpR\xf0\x8a \u07b7W\xb96\xdeݝ\x9c\x12o\xb5\xf2x\x15\xb5\x16N=" +
-			"\xe1\xd1p\xd6?\xfb\xe9\xf9\xad\x11\u007f\x8a\xba\xdf\xd4$\"\x1aɥd\xf2\xa9k\x88tǢ\x17ͦ\x9fv\xbbY\x04\xfd\xbc\t\x14k\xa2\x8dx\xc0\x861\x91\xef\xd4\x03ҋ\xf2T\xc8\u007fEIA\xdc\v\xab!\xad\xee[\xa7\x10\xe9g(F\x80?c\xac\xac\x1bc~e\x96\"\xeaO\xc0{\xc2\xe0\x85̞\x0f\x0e\xaf\x8b\x8d\x94Y" +
-			"\xdc8\a\xcf;\x17\x94Q\x91\xf8i\x06j\x91\x10\x9b\xed\vؑ@\xa7\x1e$\xa6d\xd1\xe3ߏ0\x02|OOȗvQ̐\x90\\\xa1|\x80\xc3)\x997Ԗ+r\xdb*1\x11\n\xe9\xd3\xf4v\x9a\xc1\x17\xb9R\xc6;\x92\r&^D\xef\xd5E\x85<\xa0\x11\x11\x1fz:\x85Ǽ<\x9c\xe1dq\x0f\x11Xm\xda\x10" +
-			"\xfe>\xc9c\x85\x13a5'\xbe\x19\xbe\x18s\xc5ޟ\x00\x8e\f\xb2\x1f\a7\x91G\x92\xc7r\xd1\x0e\xfa\xaf\xd2\r\xaf3\xdcJ\xd7\xfa\xaf\x9d\xcb<\x9c\xf8^῁3\xf9\x85\xf7\xb2 \x13\x1a\xbdoT'\x15-\x93\xe0\xb6\xe3\xbc\x1a\xba\x9f\x11\x85\xec@\x84\xb9\x16\xcdy\xaa\xf3\xb8\xe4i\xefK$t\x14\xbe\x81\xb2\xd5\a\x88\xbb\xf6" +
-			"`\x8axGԌ\b\xe3\xca\xee\xda9]\xfc\xa3\xdb\x1d\x98\xcf\xdc\v.\xf8c\u007f\x9dJ\x86\x97\xf6\xf0\xcd\xcc\xed}\xfd\xaa`\f\xca\xf6\xf7\xbb\xc9\x10\xa1\xab\x83\x96\xfc\xa2\xac\xfe\xc9Lk1\xa6\xa2\x81\xae\x9a\xdc\x1a\xe1ܵ\xac\tSof\"\x00\x06ҡI\n\x11kQ\xad\a_\xd7R\x0f\xb4\xaa\xb0\x99@\xc2\xd42\xe539\xf4" +
-			"8w\xf3Z0\xb9Ԟ\xe7\xe8\xd7\x02\xc144\xe8n\xe8\x0e\xf9\x18L'\xb2\x96\xcaH\x95\xbc\xd1RZ\xbc4\x98\x13\xaf\xf1@W\xa2b\xbbܠ\"\x01\x91\xe8\xf7\x10\x97\xb2\xb9\x00\xf4\x8a\x0e\xf3\xc6\xf3F\x13\xf6{\x8f9\xb99\xbe\xe6J\x80zG\x91\x89\x85\x86͌\xf3\xb4\x02\x0erE\xff\xb8\xecl\xb9\xb2>ȗ\x18\x8eY\xdb" +
-			"\xe5\xee\x93\x131\xaf\x85\xe3\x12\xcb\f/\xf5\x92\x91\xc1u8;\x9f\x15B\x0f\t\xed˩7\xb3S\xdd\a_\xb3\xc4R\x05\x89\x99[E\x1f\xda\x17\x14\xde\xe0ں\x95mN\xc8R%u\xed\xad\x81\x93һ\xefb\xe9\x18\x18¾\xc6R\x1d\x848\xc2\xf3\xc2\xcd\x1b:ï\\N\v}\x19\xce\xdfK\x04UOz2\xd8\xdf\xfd\x90\xa9" +
-			"N\x8d\xf6\xc6jț\xef\x94$I\xf6\xe2\x84\xf9\xf8h5\x0e\x1c\x10*\x93\x03w\xf6iYw\x11b\xef\xb48$\x84q\xc2\x1e2\xa3MM\x80\x8c\x03\xb8\x8c\x89\xc4.<|\x88\t\x1dk\xb9\xbf\xae\xf7\x94D\x0f~$\xa5o\x0fb\x02\xf5@\x9c\xa5\xc9~\xe3T\x83l\xbeS+\xc8'L)\xabc\xa3K\x8dW \v\xe1\x00\xb9\u007f" +
-			"5\t\xf4\u007f+a\xf4\x9f\u0089\x8e\x88nW)\x1e\xbf\x98+\xecl0\xafg\xcfG\x0e\xf2\xb5\x05|s4\xb1\xd3\x04\x89VQ\xf1b1\x16\x8a\xe8\xe0\xb4\"\xdb\x0e\x03\xc9\x0e\x97\xab\x8e\xeet\xba\x1b,\x97I9\xbe\xc4k'\x93#tk%\x8d!\xbfMy\xdfM\xd2B\xcb9\xb4\xb77\xbc\f\xeb\xf6\f\xfe\xe6]\uf5b2\x13\xfe" +
-			"\xa8\x93\xdc#\xb7TU\xa6 \xf1ښ\xe1\xe2\x14^\x1c\xc2\x1c:\x13\x97封\x84\xd2\v\xf8\x06\x94\xb0\xa3\xe9\xd4m\x8eئ\x04\xb9N&\xd1\xc5+\v\x9d\x9a\x8c\xef`\xf3\xa1[3>\r\x94\xd7)\xfc\x16\x9f\"\xc0k49_/\xef\x02Z$y\xea\x99؛\xfd\xa4\x13\x1d\xd9\xfb\xba\x8a\x93[)F\xe5\xc2\xc06\xd5;\xcde" +
-			"?%xK\x11\x00\x00\xa0\xedMa\xde,\xaa.\xcf\xeeEDzI\n\xeb*\v\xb6\xd1\xeb\x17\xc6\x1b\x98\x10\xa3\xe3\xb3a\x97*I\x1fcP\xcbP\xe9\xb0v\x1aT\x9a\x18\x99v\xf2&\x88\xdaFY\xc4\v\\\x180\xbc\x05ҩ\xb1P\xfby\x8c\x10\xcf\x19\xf9J\xbfhɠ\x86\x1al\xc7\x04\xa6 OҰ\x0e\xe6\xf9c%\xec\xab" +
-			"\x1d\xc8\u007f\x17WZ\x17HDk\x01[\x03\x89\v\xa0\xe1|\xa1H\xb6\xa4A\xfe\xa1\xb9\xe02`\x9ao\x9a\x84xi\xf7G\xeb\xf3W\xcf \xfa\xbb\xf60\x01S\x15:\xe37\x95m.J\xcb-\xc7\xdc/\xe6\xab.#i\xc8\xc8w\xf9ā\x8c\x10\xea\xe9\xd3u\xa0\x84n\x91\xbd\xedC\xec\u007f\xc2\xc6/c.\U00045aa6x\xeb\x83\xd3" +
-			"\xd4\x10G]E\t\x9ed!5\x81\x86\xec\xa8|\xb4\xd6w\x1b\xa6\x89U\xfd\xd8\xf2E4\xe0\x10\xb5\xc5\xe5W=\x11\x9cp(\x8a0\xe1\x18J\xc5\xd7pR6\x8d'\"\xbb\xacV-\x12\xd6ٚF\x05_\x98j\"7\xdb\x17\x8b\x16\x96I͞\xe5`\xa5\xca\xc9&\x94\xb1R\xbaj}J\x9a\xd2j<\x8b\v\xfb\x94^\x8eG@\x9a" +
-			"\x03\xf0\xfdTO;\x86\xccN\x10w\xf2\xa9\x8a.\u007f-\xeb\xaa'\xd5\xc1\x86\xb7\xa1\x9e!\x85\xadXF\x1e\xe0\xfduD\x02\xf1\x13M\n\x91\x14US89g\xe7\xed\x06sئ\x8e\xad\xb1bB\xdf0\x11\x81\xcc&\tL\xa6l\xf1\xed\xa1\xe1\xc1\x10\xe22\x16\xb8\xb8\x11\xc2\xef\xd5:\\/\xe1p^\x8aƘ\xdak\x95K*ZE" +
-			"ν\x97y=\r]c\xa5\xc6\x19\xec\v\x821 a\x16Rm6\xe0\xff\x04\xbc\xc9\f8M\xa2>\xe1\xed;\x98 \x18\x0e\xe7\xc3+\xc3.\x04ȳW<;\xec\x1bVr\f\bO\x01\x95\xb8\x9ccQ\x8dPɻM/FZh\xbb\x9d\xaa-fV\xef^\xf2\x92\x0ei\x8eQ\x8cgz\xeeY\xbf\x83\xb1L\x91M\xaa\xc4M\xdd" +
-			"\xb4\x0e+\xa2ievxl\x13\xc5\xec77\xa5n\x11\x10\xb7\xa6$ۯR\xeeƓ\xc5f#\xba\xb2M\xfaQ\x17\xba)`6\xa6G\xafV)*O\x0f\xafA|\xef?\xb3^_\x97_|\xf7\x86ڠ'Ϡ\xf3pv\x86\bi\xec+v!٨I\xe4*>aNm~\xec\xfc\t\xd8$.x\xa1\x86\xaf\x82\x02\x1f\xe3" +
-			"\xe9\xbcv\xab\xc4\xd9g\x9eŷ\xa1VU\xe6L\x9e\x06l\xeaL\xd4\xe0\xcb:\x943g1\xbe\xbahW|\xea\x8ehp\x16Rj\xe4\xcf:\x8d\x81\x048\x98\xaf\x04\x16\x18\x00\xa9\aeګƇ+\x89\x12\xde+\xf4\xc3w.\xa6\x1d;\x1f\xa9Zr\xe1\xd5C\x83s_3XX\xb3\x84\xd2b\x01\x18\xe3$\f\xc1\xc3n\xaf\xbe\x16" +
-			"\xf7\xcfC\x13{\xa2\x18/&y>\xbd\xa2\xe5\xef\xaa\x17\xb1\xefu\xd0b\x8c\xd7\xc7\xe3)܇\xec\f\xe9\xa6^\xa7^4>\a\xbaZ#\n\xfb\xee@-\xc5\xcd8NHC{\x0f2\x17\x13\x94\x9b\xe3\x92R\xf2n|\xf8\x8cc\x93nv\xb1\xd1\x0f\x17\xf6E\xbe~\xd5)\x9c\xe5̠\xc7\u007fš\x86\xf5b\x82>\x853*\xbd\xa1" +
-			"\f\xbbHyF\x95\x8fG\xb4\xa8*T%\xe5'`\x8b)\xda~;@\fj\xf4\x91Q\xda\u07bcCV\xf9\\I<\x12\xa5\x1e\xbdwF\xfeU\xf3\xe2W.\x90@\xa1.M\xc0<\xc7\x02\x8fƠ}~\x0f5\xea>[OB\x93~\xf2\xc0\xf2\t\xc39\x17K0\xe4)]\x0e\x19\x89\uf308e_۴2\x03\xb2\xf5R\a\x0e" +
-			"\xfd\x9a\x9a\x8do\x06\xac\x10\u007f\xad\xfa\x81\x8a\x11|\x89\x04Q\x93\x96U\xab\xb9\xfaፃkto\xa7n\xa4\x12`\xeb\xffiK%u#u\n\x9f\xf7\xd6\x00\tU\x01%\xd4<47c\x87\xacjm\x06\x8f\xa9\xf0\x82e\xb5\bBi\xf9\x01\x9c\xe6\xf2i\"H\t\xf9\xd4\x19\x11\xa3wS\x9b\xdf\xd7\x14\xaaO3j\fS\xb2?1" +
-			"\x1aUT\x8f\x06N#\xb0\x19\x91\x1f\xb8\xb8e6\xf8l\xdd;\xb5\xed\xa5\xecg0\xb4\xb2چ\xe9#'\x16\x03\xa6e\x19h\x01\xdeI\x89\xac&\xa8'J\x01\x15ME\x8b\xe7o\xa6@Vh\x19<2\x18v\x1e\xe7\xa53/Y\x19/`\xda\xc0m\xfd\x8dAS,\a\xaa\xc6\t\xc4B\x10\xe5\xa9\xed\x17\x9d\x94\xe6\xa4\xe3\x19\xeac\xad" +
-			"{.\xee\x06X/cʫ\x85\xc68\xfa\xe6\x15\xe4M\x9cBO(\x00\xe9\x93Gf\x11Z\x04\x88Ί\xfd\xd7U-p\xe8:\xed\x8d8E\xd6\x1f\xcb\x166R\x8d\xaaV\x858\xfb\xd48Ŏ\x87\xe9\xfd9ٻ\xb7\xe0W%\x13\x0e\x00:\xb7\xec6\xc0.|\x86\xc8\xc4q\x18z\xbe\xaf\xc7r\xd2\xcc\x13J\xab\x91\xea`-\xaaC" +
-			"\xb3%\xf3\xf5A^\x90i9\fei2\x8d\xc6s\x0e\xe3\x1a<7\xdcˑk\xf6r\x97\x84\xca\xd5;)I\xb5u\x9e\x01$t:͝s\x1a\x1b\xff\xf2]l\x87;\x9f^\"i\x10\xef,\x82R\xba\xbdz\x96i\xd6x\xae(h\xaf!\xf3\tm\xce\xdaSv\x81,E\x9eO\x92\xe8\x12g\xdfuT\xd6\x035b\x8f\xbe$\x9e" +
-			"\x90H齇\x11\xf8X\x89\x92\x8bN\xbb\x8b\x1c\xaedz\xe7图X\xd1t<\x97r\x91\bBKgt\xdb\x15ں@V\x00d\xbb\x92.^\tָ\x15\x18\xc2&|\x18\x80\xf41C\xb2\xf4eƶ\x12\x16\u1cb4\x9bVՒ\xa52U\xee\"Ws\xf5\xaf\x1b׀؉\x13\xd8 Jb\x96\x9bIbU\xed\xa4\xcf" +
-			"\xb9\x8e\xd4Z\x86\x99\xd8U\xd9\xce_\x89a\xeab\xb16\x03\xa5\xce\\\xcaH\xe1H,\xc4~\a\x8a\xcdm\xc2\x1c3\xf4McQQ\x9a\b\xba\xef\xf60ώ\xf8\xa7\x1d?\xfa\xba\xa3\x05o\xfbW\x84\x83Y\x8c\x92\xb9v7\x18\xd5\x01ä\x00l\\\xde;\xcd\xe5\xcdJ{\ah\xc2\xda`\xe9\x99\x00\xa1\xcc=c\xdc(#\x01\x12\"" +
-			"&\x04\x9d6\x96\xa2\\0\xd5\u007f\xa4%|ށ>^\x03k\xb2\xe3E\x84\xc8\xccDΚ\"X\xa4\xb7\ft\xf9\xae%\xae\u007f\x86\x1f\xc8Q\xc1\x01\x81t\xc0\xaayp\x89pZ\xc9z\x91\xa2c\xbf\xb8R6\xb7\x19\xeck\x83\x9f\xc31k\xf1\x1bcb\t\x87O{\x93:\x90\xaf\x8bۍ\x05\x16å\xf1\x18\xd6\x19m\xa1:-\x10" +
-			"\xdb\x16\xe0\xf4z\x15=\x84\xb7E<\x88I\x19\x8dڱ偱\xbes\x82\x88\xe2\xcd<\xb8\x8a\xfe\xb9\x8f\"R\xe3\xf3\x88#\x18\xae\bK\x95q\xc2\xc0u\x99{\xc3m\x83\x80\xdb\xe4B!r\x02\xa7\xb5\xf3^\x04e;Df\x1aAВ\x9a\x06\x8a\x16<\x97\x9d哱\xc2\xe8n\xcb\xd8\xec\v\xcfe5z\xa2v|w\xc9\x16g" +
-			"P\x13\x9es\x8d]\x02\xfd\x15\x06ׂ\x98,dSg4 /jv\x86\xb4\xd4\b'\xf8\x15k\x1d\x16zr\x19y\xa9\xe7\xe2\x1e5\x06\xae\\\x00\xf0ܦ\x8a\x12\xfbe\xa8\x13\xbb\xdc\xf4\x1e\x9d\xbe\x1e\x18\r\xbd0\x97\aM\x13|\x87\xc9\xd9\xe8d\xa4\xe5<אW_Y\x9e\xc1\x90\xf4\f\xa5a\xa4\x85\xc8\xc9\xc2^+\x10\xaf\xa2" +
-			"\x90yx\xd9)5\x15\xc1]ǻLy\xad\xd8\a\x0fCb\x13\xfb\xb2\\\x8f\x86!\x95\xbdF\f\x8a\xd6\xda(\x04\xf4\xf4\x86k\x96\x1bԏ\xb8\x91\xdf*a\xb2\xdd\xfb\xbaÔ0\x8d`jH\xf2\x80\xe6ϔ\xacv\xb1\x9e8)\f\fu\x00\xfc\xccZOvN\xc8\xf3\xac9\x9aE\x83M\xfcA+\x91aك\xfd#\x0f\x15\xb3" +
-			"ミ\x00\x80\xe3畉G\f\xd5\xfb\xc1\xe5ea\r\x993^\x03\x89\x9e<w\xae7\x03\xc1M\x06\xa1B\xe2\x9bn@\xafm\xe6\x966_\x86\xfaL\x1d`X\xf7\x15+B\xca{\xbe ,\xa4x\xb0$\n\xa9\x14iSkҮQ\xd4\xf6ś\xaa-!\x8bX\x91O@k\x96X\\\xfe\x04\xc7Y\x10\xcdjNv\xc3^\xb5" +
-			"\xee\x97s\xa9\xbbRu1\xcf\u07bb\xfb\x85#&'Gۙ\xed,'\u007f\x91S\xa6(\x88\x01\x93\xef\x9b\x12\xcfW54>6\xab!\xe6V\xd5\xe0\x1dbƷ\f\xf1G\x94v\v\xf0\xdd6\xff0\x9fOE\xa8K\xb1a\xceu\xe6]\xaa\xa8O\x04%2q*J\xcf\xf7 \xa2$`\x16\x03R\a\x1b\a \x8cg\\\xbblF\x0e" +
-			"\x87\xc1\x9e\xe7]\x90\xbc\x87\x1bQ-{i\x8a\x81Jm\xe2^\xa8\x8b&\xae\x9a\xa4\b<\xda0A\x9e\x8b\x9b\xb0\x91B\xb0\xeaǢ\xadb+\x01/\x13\x84\t\xc1\x88\x8c\xe9\x13Π'\x12\xe3\xe6R\xeb\xd5Zƕ2\b\x0e\x8fY\x9b\xb2\xbbN\x11U\xdf\xcd6w\xff\xf45\xa1쒼\xe8\xd7ݾ\xe9ܯ?m\xe5\xfav\xcf" +
-			"P\x0eV\x16a\x11%\x86\xac2\xa9>\xa1V\xc41i\x9e3oJ]\xf6\xddK\xf6\xaa\x94\xb6#g\x8fH\x88E\xbd\xf57Ǐ\xd5:\xfe\x01\xfe\t\xe8\xc5\xfaH\xb32x\xf1\x13`it\xe4\xd3\xfc\x1d\xe2m1\xf0A\xa9O\"ջw\x9a\aA\x94\xb90\x10\x0f\x06\xe4,\xbc\x16:W\xc3\x19\xaa\x98\xb4\xa2$\x0e_V\x05Z" +
-			"\xa2\x01\x92\x82\x17\x97\x92\xb6\xb2\xb4\xa37X]Xb\xa1\x19\xd8/1I4Xt(\x13c\xfd\xa8\xac\xfd\x96*8q\xa1ct\xcf!J\xe7t\xbeDu\xc6'\xde8Ӏ]s\x04\x15\xf5\xd9xI7\x17.8\x1c\x04\xdaM\x9e\t\xe3\x12~(\xd0\x14f\xb4\xa9\xf7ȳ\xc8^\xb2\x17Tɪ\x90\x144\u007f3\xe5+\xdf@\f" +
-			"\x88L\xde\xf7h\xb4\xc6\xfe\xd6\x15\x18\xdd\xc4qY\xe1\xff\x8c@?c,~Cܢ5\xa1\x8dFY\x02aF\xe2\xfa\xbaX\xdf\xfe\x1a\xea\xa18\xc7ߓ\x82\x1e,\x9c蚟*\t؋\xe7\xbf$6\x01\xf2\xed\x9d\xc9)81\xed@_\xd4qlȉ\xf3\xb15\x84۰0u\xeb \a\xec\xc47\xde\n\xae\xa5\x81\x04\xab\xaa" +
-			"\xa2TV\r\x9ezpi\xb3J\xa2;\xd3d\x05\x93#\xe3\x8a\xd27R*ǜXN\x93\x17GrT\xb1zZtp\fE҆\x14\xbd\xb5\xe4\x91%\xbb\xd9Ϗ\x18\xb8N\xfd\xb66\x84\ry֜Q\xa2\x87\xe2n\xd0Њ\xdf\xe2\x82n\xc9\x03\xb8\x1eR\xfd\xe8\xfdE^\xaa\x81\xa9cc\x83\x18\f\xaa\xcae\xf7T\xee\xe58" +
-			"o\x00\f\xdaQc\xa1\f\xfb\x85\xbe\f$\xa8\xd4;sX0\x1cM\x16\x91;2\x95\xa8Iw\x15\x8a[}\x13H\x02\xdbnLSn1B^\x98\x10\xd5I\xb2\xe1P\x86M\xd7c\xf0\xa9\xf2N\xe0\xfa\x96߅\x87\xbe.{iݚ\xf9\x9a3\x9a$\x92\x98\xf5\xa5\x1dml\x99\xcd\rT\xd1\xe1\x1aG\x90\xf4\xd5b\xf5WAj\xb9" +
-			"y\xdf7\xadpkm\xc5QƐ\xfc\x8b\x10\xbf\x03\x86\xf8\b\xb4\xecE\x8a\x84H\x85w\xc9\xe5\x86\x13b\x86(\xfc\xed \x04,\xec\xe0p\xda\\\x1eU1\xccv\xe8\vp\x8b\x87\x82Ĝ\xe0\xfe\xfc=f\\\x91\x8c\xfaD\n#\x01\xea\x13\r\xe3Ƕ\xfc#\xe1\x1b\xad\xaa\x8c\xf3\xad\x0e\xfc(}`#A\xce*&/\v\x15Ne" +
-			"\xdbW\x95ڥ\x9a\xb2\n\xf06rc\xae\xf5\xbd@\xbd\xbc\xb3\xb6\x1a\x1f|\x8d\xdc\x15}3\xbb\x19\x01yӕ=\xbf\x92\xbd\xe7ɚ\xc9\fG\x8d\xc0\x18u\x9f\xbc\x00[/c\x18\x0f\xaa;\x069\x00\xa916بq\x8e\xc3\xf0_-\xee\xf5\xbfT\xf8\xeb\xb7b\xe5\xbf\xe6\xb8\xff\x97\xa0\xf7\xd8$\xe1x\xb1\xd0$\r\xb0\xbb\xff\x9f" +
-			"(\xe4u\n/\xf3s\xf33\xcc\xfe\x8e\xb4^A\xa4y\xad\xfcf(\xf0\xefI\xe4\x95\x1aJ\xf5\xb2\x93z\u007fO[/\x1f\"\xf2g\xa4\xfam\xfe\xdb\xfc\xb7\xf9o\xf3\xdf\xe6\xffיK\x85\x9e\x95-\x82\x10\x94\\\x9eٗ\xc7\x12y\x13\xfa\xfc\r\xf6P\xeb\x88\xfa\x1f\xc5߇\x11\x13\x15\x86\x01ܒ\xa3#\xfeD\xe1\x8f\xd2n\xa8]" +
-			"\xfc\xebR\x86%\xff\xb6\xb5\x12\u007f;\xf0ہ\xdf\x0e\xfc\xdb;p2r!h\x83\xbb\x8b\xed\x1f\xe4\xb8%e!h\xb1\xf8\u05f7\xd5\xe5\xff\u007f\xf1\xea\x9fR\x03\xfd#\x9da\xab*\x82\x9a\xca\x1fЋ\xe1\f\xb9ѿ\x97~6\"\xbf6_\x81c8v9P!7\xc5٪\xb9\xaa\xc0\x98X\x82\xb67\xec;DzDb\x9bCl" +
-			":\xbf\xcf>\xa1_\xbd5=\x96(\xb6k\xbe\x15\xae \xb6m\xd3\x12|\xe0:ɠ9\xc8\xca1B\x13\xe4\xfe\xea\x89\f\xf3\x87\xb2Of\xb2e\x06#\x01\xa0d\xaa\x8f\xecI\xa1+\xd4l{<\xbfR\x86D\x14\"]\xef\x8a2\xf9\xc2Oc\x01\"\x1c\x84\x00\xb6+[\x16\xc0\x932\x01D=\x01\xfb\xe22\x8e\x82H\xa8\xf2\xf4\x13G" +
-			"/^\x14b\xa2\xf9HKp:?\xf2\x9fgE\x12\x01\xb8\xab5\xe9.4a\x05hN\x8b\x1f\x1bߺ\xdbQ\x86?A\x0e\x1dY\xd4$\xf7\xfboߜM\x94Q\xd9\xca\xc1ZD\x1b%\xea\x12)Y\x86@C}\r&\xef\x85\xec#\x04o\x17\xd5\x16\xcbU\x94\xc7\x0e\x9d\x11\x14\xfc5wz{\xb6Z\x85P2E\x83\x98\xc2~\a" +
-			"6\xc88\x1bE\xea\xa0f\xbe\xf1\x10\xfb\xbfsz\xcb\xc7<\x93\xd6\x15\xec5&^\x90\x85c\xbe\xc0鮚\xf5\xd7B\\\"\xd25-\xaaX=4Uoۘ\xe1\xa5\x17\x88\xc3\xea\xb0J\x0e\x92D3\x94I*S\xd0kj\x8b?_\xe7\xb2\xc4&\x1e\x96\xf1b\xfb\x1c)\x11]\xf7\xa7\xc4G\x93Là=\xb7̩H\xa6\x01v" +
-			"\n:\xc5\xc7'j\xe8ȍ\xce\xf7\x12T\xfe\x9e\xfc\x92\xc2\xf1(+\xfb\xa3\xf4{\xbcg\x17\xd8\x19\xc3S]>\xfe\x1a\x9e$\xb6?\x88\x83$T\n\u007f\x02ls4\x95\xac\xa3\x8c\x87\xfb\x92Z*C\xbf\xbf\bR\x16,\xb3\x02\xe3\x168\x10ûߴ\xbd\xe7\xdb5\u07fc\x9b%\xe1\x9c\xfb7(\x14\xfb\xcf\x17\x98\xfd3'\xae\xf4/" +
-			"\xfc\xe9?J\xcc\xfeA\x98\xce\xfb\x03\x19\xfaz17\xf4ˇh\xcb\xffH9\xa5\xff\xe7e&\xff\xeeAf\xa9\xcb\xc1<\x85\xb8\x0eD\xb6\v\xbb\f\u007f\xa0S\xe6\xe1\xa8{\xd7\xfb\xab\xb1b\xc2\xeb\"\x85rGwy+\xcf\xd4C\xe7\xf2\xe6]\xe9\xcc\xe7\xd9BK\xd3`\xf8L\xeb\x90_\xd1 \xbe\x89,S\xe3\xf1\xaf\x1f\xd0G\xec\xa4" +
-			"DE\xf4\xd1\xfd\xd2\xc3L\xa1\x8c\x8f\x1f\x8bs\xd0#\x95e\xad \x83;\xc5*\x04\xbe\x95\xe0\x80㴩\xd3O{o\xb8ʘ\x8a6\xa2<\xac|\xb0\t\x80\xfc5\xd9\xf11{\xbc\x00\xaes\x8f\x8e\xa7\x8f\xed\x03s \xf8\xe5\x049\xfd\xe3D\xd7}`\xb2\x80\xbbr\xe9\x1e\x8fG\x10\xdf'\xd1\x1d2\xe2cm\xf5\x1d\xaf\xef\x88\x19\xbb" +
-			"\x86\xb6\xefS\xcf\xf5eq\xe2v-\x8f\xa0\xca_\xb1\xd8\xc38>\xb3\x9d\x8a\xde\xca\xd0\xe6\xc2\xf0\x15b\x93\x8d\xd6\x0f\xf5\xd0T\xbb/8\xf2!\xec\xe1\xf8\xa2h\x98ra\xe4}\xbby\xaa]\xc7+\xf6\x1f\xd3j=r\x05\v\x0eg\x9e\\\xfb6\xd6u\x85j\x00\xfbr\xf5t\x95\x1f\xb2szww\x90\xbd\x87=\xee\xee\xfa7\xc2{\x9c" +
-			"\x9e0\xab\xeb\xf2\xb2d\xea\xdd(\xb1P\xca\a\xfaC\xab\xe7T٠\x98\x9a\x1dm\xbb\xaf-\xb0Ԟ-q4a\bJ7\xa6\x12\xf6ch\x16\x9c\x17\xe8'\x06{\u07fb)\xfc\x88[\x83\xadgpr?\xd5u\n\x01\xb9\x10\xfa\xbc\xaf;\xa5t]\x85\x12\xe1\xe6_>\x9a\xb6)0\xe1\x10<\xf6l\x8d\x1f,\x1f\xbc\x13\x8a\x9c\x9e\x17" +
-			"\xbe\xf6\xda7\xa8>K\xdf}\xfc\xc2\xf3VIZ\xeb\xd8\x04F\xa0R\xedh\x18\xf0\xbe|[R\x8a\xec\xd4\x059\x14\xe7 \xa5\x9f\x1di6ްc\xdc\xfc\xb9\xae\x9d\x0eB\x169\xad\xbbۂ\xc0\x0e%\xc6\xf8A\x8e\xd3rҫ*ջ\xf5\xa2\xf5\xa5\xb6(\x94\xb2\x16\xec\xf1\x88\xa7Ҙ\x8ad\xb9\x19\x95c\x95z\x9eV\xfau=" +
-			"m-F\xdaF\n\xea*\x8a\xbdo\xd6\xfai\xa8\xb2\xf1s\xd7\xfd\xf4\xaa\xe6\xb4F#\xa6\xc1\x12\xcbzv'yߒ\xa1\x1b\xa6\xf4P\x04\xb2P\x8f\x94,\xb7ix\xe1\x9b{\xb7\xb1c\xe3{}\xc7y\x03\xfd>/;\xb7k{\x06O\xd63e\x99\xf7\x1dB\xf0H\x9d6]\x00ᰇ\xe9\x99\x02.)\x8a\xe6H\x12S\xceΪ" +
-			"ÎisWgC\x9c\x8d\x19\xa7λ\xe0\x9b7a\x8bԟ\xd6\xfa!\xbe\x8f\xe0:\x831\xee\b\xce\xed\x90e\x03\x86\xcc#\u074c\xe6\xce\xca,\x11\x19\r\x03w坅\xe8+\xb8ʋڥ-\xa3\x00Ei!AЦ\x8b\xcd\x01\xb2\x1d\x9b\xecW]NN\xceJ\xd0\xfc\xfc</\x95F,[|H\xfc+\xa3\xc7v4\xd1" +
-			"\x9dYC㙢O\x0e7\xd7\xc2S\xbb\xa6Bn\x84մ\x16\xa3\xc7#\"w\x1e\xa1\x86\x9cv\xae\xa9Z\x87\xc7v\xbe\x9e\u07fdp\xa6б\xb1\xd6\x12\x15\tY8\n\u0095\xd1Z\xa5v\x96\x1d\xab\xe0)\xcd\x05\xce\xd7>Q\xdd\xcfx\xdb\xdc\x15]=\x16\xf1\xfc\xa2\xdf\xf4\xac\xac?\xca\xce\xfc\b4-I22\x18t\xf6\xea+" +
-			"^3QH\vg\x8cE\xe5gt\x96\xb5h\x10e\x88\x8f\xc8v˜\xfbv\x1a\xc7\xd8lX\x95\xbd\f\xe1 T\xcc5\xdd\xfcvj\x83\x1c\xcb\xce+\xed_#\x02\x9f\xef\xa2T\x9d?\x11H\xc7\xe9\xceË\u0080\xc90B\x02\x80\xa2̍\xac\xbb2\xfb`\xc9wn\xab_\x92\a\x8a\x0f\x85\xf3\x9c\xfdՓ\xc9I\x14\x14\x9d\x83z" +
-			"߬\xce0\x06\xe5S\xbc~7\te\xbaZLÃ)ؚo\x98\x14\xf8\xee\xac?e|\x18\xbc\x88\r\x9e\x91J9\xd6^\xd6\xf1b\xdd\xe4\xab\xedCK\x94=\xb1\xdf[\xfeb\f5Ȗ\x8c\xabL\xd8:\xfe\xa1y\xce\x02\xd9\xd1\xd2K\xf9(cp\x8d\x1f9k\x95\x1c6r`|\x9da\xe1a~\xcdY\x045\x19\xf0\x90," +
-			"\x9cp=\x8dk\x99\x1afX\xef\b\x1b\xc0\x8eGC֫\x02\x00z\x0fU\xe4\xa3[=\x1a\x06\x05\x9cp\\>\xab\x87([\xb6\x86\xef\xa6\x19\xa4\x0f1\x935\x83\x93V\xa3\x93r\xdf=\xf1\xcb\\\xee_\xb9W\x03\x86\xfb\x8b\xb7\xbadj]\x971s\x90\xe8id\x87\xcfH\xc4\xeez\x1fg^g\x0e\xb7|{Lpx\v\xcd&\xd9" +
-			"4n\xb3\xe52H\xdfkOĠ\xb5\x93,c5\\\xa6\xccY\x90\xf8\tP\x9b?\xe1j:\x84\xee\xa8-2D\x03u\xaa&8\xa2#{mr\xb2D\x91kw\x92\xfbJ\x1f\a\xc0\a\xbbP\xd3@P@\x89\xb4\xe3mgN\xd6\xc5MC\x8b\xed4o\xc2k\xc7+\xe8\xb3Rh\xef\x81\u007f\xae\xa8[\t\b$\xaf\x11\xd2\u007fiR" +
-			"t\xd4Ԁ\x9c\xfc\x8e3\x95\x8d\u007f\xdauU\xe2\xca\x10\xb6XM\xf2\x94p\xed(\xf5\x0et\x91~\x13\xb5N\uf34bm\xce<\x97\xd3\xe9s\xf8}\xe5\xe3\x9c\\\\J\xa2\xe8\xfa\xfa{\xe6\x1e\xbc\x19\x10Z\x12\xff\x8f\x12i\xbeb]\t\xec\xc7o?\x01\xb8\xfezX\xdbYFFqo\xe7\r.\x8f;\xd2w\x14\t\u007f\x9d&\x1e\x86" +
-			"]L\xedx\xd9i\xbf\xb7\x82\x87\x02Z\xef]^\xdaXM\n:\x89TU\xef[\xd7v\xa4n\\\xf7\v\xe8ę\xa4\xbfx\a\u0099\xd6\x0e\"\x95\xb8\x88\u007fX\"Z(\xe8n\xab\xa5\xc8\xcd\xf3\x9b\x1b\xb2\xa8\x97\xddk\x0fe\x04\xf7\x8a0\xccn\xbaA\x14\xfc\xe0Dٯ{\xaeznߗX\x8d\xbd#\xd5#\xa1\xd0_\x01q\xb3" +
-			"4F\x8b\x12(\xa7\x18\x8b\xbc\xa7b\x17\v!\xabވx\xc8]\xe8\xbf\xfe\xd6WԨ\xae\xe0\x86\xad\xa8\xc7\xda\xc2\xe0\x04\x97\n\x15EZ\x90\xb8\xa9N\x8dvp\x19so\xaf\x1bql\xe6.\x1a(Vs\xa2Z\xd1\xd0\u007f\xa9R:\t!\xf6b\xf0\x16\x83\xfcZ\xf3\x92\xf4˜\x02\xa8!N\xa8˚=\xd08_\xf3\xb5\xfb9\xc2" +
-			"\x90\xfc\x93\xa7\x83b\xdb]\\ќU?\x01\xd5y\xbbR.y+f\x17\"qb\xdb\xdb3\xaa=\x8b\xc5\r\xad?\x01/\xdb}\xe2\x89\x12<;\fd\xb0'\xd6J\x04\v\xb6\x9e\x15\x81((\x81U\a-\xfe\xd6گE1]\xa4*r?\xb4\xfb\xb1\xbe\xe8w\xd9\xfa+`\xcc\xff\xe7\xda?\xb3d\xfeS4\x8a!\x95\x895\x13" +
-			")$/0m\xbc -\x89\xe8\x91*\xda\v\xcf\x15\xef\xeb\xafD\x1e\"\xba9͊\xa2\xb7Y\xc8;\xe7\xc4\xd3\xdf\xe77\xb6\x0fdR\xd9\xeds\xed\xb8ɱ(R\xad\u0091\xbf\x89V\xae\xabht-\xe9\xc2V\r͌\xd2\vA\x8f\xd6 \x1a٨\xb3X\xc2^\x91\a7\x03Q\x92\x8f^\x1e]\x1f:\x83>\xf9FC\xe7Y\x16" +
-			"\x1eW\x0e\xa6,_\x0e\xc5$w\x8a\xec\xec\xdfW\xb5\xd0\xc9\xf4]\xee\xb7'\xa0\r\x05\xe2w\\<la\xac\xeeU\x96~A2?\x852\xda\x06\xbe\xbc\x9eyA\xd8Q\x9b\xedٶ\xfc\xa3y\x05\x1eh~A:\xb3\xba\x0f\x06\xdf6\x1f\x90?\x1c.\xdfx\xaf\x18=Nя\xed\x89\xc6]goEh\\\x11\x1fP\xd8FT\x0em" +
-			"+\x81t\x193\\\xaa\nI\xf9\xca\x12+МA\xc8AH\x00\x00\xa6tx\xfbk,\xfd\x1c)\xcac7By\xb2\xae\xf7\x83\x95\xe8\xe3o\n\x94g4\xbe؇v\xffI\xc8\xf4\xbd\xe6\xa7#G\xac\xactNZ\xff\xac\xd4U\n캟\x80+wG\xbf\xa1E+\xc8\xe9\xf5\xfc(;\x98.\xf6\xbc\x19\xf7\xf3\xba\x95\xe5A\xb1J" +
-			"\xa2ۗ\xc61\xae8\xb9\xc9>\xc9\xd1D\xb0\xf7\u07b2\xee\xc1\u07b3\x9b\x86ˊ\xa0\x10>1\x8a\xcdb~\xe8\xaa\xe3\x1d\xb09\xe7\xa0#\xd5\nwt5\xe9\xddNh'\xceG\x97\xb7\xceI\xd1.u\xc4wN\x18\x02Xn\xec,\x89\xf8Dʄ5S\xf5ϙ\xd0\xee-\x15\x0e\xe7\xdf}}\x9d\bS\x93\xf9\xd0y\"[\x19\xd8" +
-			"/\xbf?\xba\x80zo0̐X\xd02\x82\xf4\xf6\f\xcf\xd8{\xadç\xac\xecT\xe08\xb8\xc4\x18\xc6o\x82Q\xdc\x19\xb0\xf6f\x8c\x17/\xa39\x81\x83\xaf%U\x89\xc5NDtWSM%nĈ\xbaij\x92$Qrj\xc29\x0eU\xff'\xe0\xaeMPЍ\xc2ڧ\xc5\xd5\xe0\xf0\x06o<II Y\\\xea\x81\xef" +
-			"\xf9j\xec\xdbo.\x80:dv\xaa\xa0\xceE\xbeC-\x98\xc9\xedyLvw\xfe\xe8'0Q@\x9e\x85H/-2\v\xd5c\xe3O@ͨ\xae\xf8QXI\x98\x9d\x8a\xad\xb3\xf2\xd1d\xc5\x11) o\x80\xbe\x15\xccYM\xb0\xb1\x8c\xb5\xd8\xee\xcfz\xd0f@\xc2O\x17P0\x031z\xfd\x15Ӫ\x80f\xf1\x18\xc4\xe5\u007fQR" +
-			"]\x98Fc\xa8\xe0u\x93\xb1ԟA8n@\xae,\xde|\xcdRz]\xe4Z\xb7\xa1ajQ\xceUŕg\x9f@=\x93\xd9Lׂ\x92\x11\x95G-4\xac\xe7\x14k\xe7\x01\x91L\x10,(305-\x1a\x9a%5\xb542\"\xf3\x16\x88\x94\x87\xac\x8dj$\xa4c\xdb\xc5F\xfbo\xf4\x8d\xfb\\\xea!\xf9۔\xae\x19~" +
-			"\x86\xeac\f\"\xee\x13|\xe4\x13\xe5%\xd6b^h\x15\xc7>\xa03\x92\x94)\xb5h\xe92\x11cl'\xe53\x88\x19\xe8\xd2`R:\xf7\xc6\xe3)\xca\xd4\f\xbe\xed\x13\x1dq\xd6lu?\xf4h\x15\xbe\x0e!?\xc5\x0e\x9dh\xe1`ry\xab\x19\xcay\x8bY\xe2S\xe3 ć^]\x82>$\x8f\xdf\x14\xbf$\x89eN\xfd\xf1\xc7" +
-			"\x89\x1ew\xb1!\xec\x95ӏl\x8b\x1c\xba\xd6n\x88.?\xbb\xcb9\xc3\x00\xab%\xeca\x90\xf5\x00\xc2\u007f\xa8\xcb,\xacKp\xc4C\x94cݖ5\x1d\xad\x9f\u007f1\x18\xa9͝6\x1b\xa4\x88~[\xcb;\xf3\x82\xfb\xc3cO\xcdw\xa4\xfa\xb65\x86\xcahjt\x1b\x8bD%\xdaP\x98\xb6\x16\xac\xcf\x19oz\xed͈\xfeJ\xb7A" +
-			"<\x80\xac\x1ao\xc4\xf5\x1a\n^\xcao\xc3\xc3\xd2\xc4\xd6\x1d?nvU\xe4Kڌ\x171\xfe\xf6\xa4\xc8\xc9a\xf6H\x98\xefSuaM\x16\xbb\x1bC\xea\xe3\xb3]\x14%I\f\x9d\xe9\x00hΐU\xe5\x87\xce\xe1a\x1cUv\xff\xef\x1f\x8dJ\xec\x1a\xd2\x0e\xbc2\x9eN\x9d\xa0[\x8e\xcf|\x99\xcb\xf6\xf7\xac\x8a\xb9G\x87S\xccc" +
-			",\x9f:\xf1R\x85=\x14\xa7\xc0&\xbd\x13\xcd,\xd6g\xa6\xe2{\xef4\xbbӰ\x9c\xacj2\xf7)=\xc82\x9a3\x8c\x15s\xceG\x12E\xee(R\xd2\xd9R]\x00\xe4\x82S\xfaC\x1e\x1e`\xe7\x15\f\xb6\xa2\x94\xf6\x9diQ\xdbL\x8d\x10\x15sT\xb6\x8f\xc8\xf1'N\x19\vԏ\x9f\xcd?\xc2\xe5\xf6\x95]p&\xc4E\x81\xd4" +
-			"z\xe4\xc69q3r\xd9\x00\x05\xa1\x13\f\xe3K\xe5jx1~\xfa\xae\xd6\x01Kz\xa8D\xbd\xdb\x05\xc2\nU\xc2t\x1e\xf2\x99q\u007f\x1a\x9e\xd0\xf7]\xf5\xf1\x81V\x89Pc\xaf\xa2\xf7\x1f5湹,\xd3Ǯ\t)\xeb6\xbcG\x11\xbf\xfd\b\u007f\xfb\xbak\xf1>X\xe7r\x8a\xebhzy\t\xb4\xec\x05n\xbc}^\x90\xf1\x8d" +
-			"\tA\b\x9b\xb7\x1f?\x15\xa9\xe4\xe9\vM\xefV##\xea\xc5\v\x05\x10\x16Lu\xcd*N\x85\x9f\xa6\x17;Y\x13\x11}\xf4\x8bӌ\xa1\xd1\xf1\xb1Qd*e%\x17d.\x89ѥ\xa3\x98!\x11\xe9IFw\x8e\xe3u\\;\xf4\x14Xe\xd9i\xa4݄æ\xcaȱ\x80\x94\x97(\xcdT\x97\r\xdf<\x8b\x0e\x00\xfd\xf0\xfa\t" +
-			"\xa0m\xb9\xba\xa1\xae\"\x1e<\xa6\x9d\x01\xefÆ\xfcf\x9bJ\x9d\xdc^w\x12\xa7'g\x9f\xdcׄ\xb4r\x9f_ݔgV\xeaUy\xb8[ǟU\xbfi\xed\xee\xb7\xe4\xd20r\xa1t{I+~\xaeߣs\xa8\xd0\xc70\xebH~\x10\r%m\xcdi\x1e\xa1H\xb5\x95\f\"gn\xed\x92\u007f\xd5\xde\xd6\xde\xd9\x1a\xd8xj" +
-			"\x14\xa3\x133\x01&\x96\x13Neb#\n\x8e%\x81\xf9[A\x8b\xe2\xc7w8?\xbcK;\x99\xf25\xe2n\v\n\x11P\x82\x927\fHS\xfb\xb9\\\x168\xe5\x1b\xb8x;\xf6\xbd\xe7\xf9\xa2\xa8\xe4\xe8\xe5\xc6?\xc2r=8\xe5F\xbd\xa3v\x1d\xd9==G\x8a\x8f^\xdb6F\x1e\x8b}\xbaA\x99\xbc֤\xa7_#\xdd8\x80\xdc" +
-			"A\xda\x03\x12\xcf\xfez.5\x01\x91\x99\xb9\xeb\xea\xe0\x1d\xd9X\x97\xf3\xc1\xa2\xee\\\xc1[;\xb9H\xe7N\xdf\"\x95\\m\xc7\xcc\xedܪ*\xf1\xbf\xb7b\x14\x06y\xb7\a;\x18GaK)۲\xca\xfe\x04\xe85\"\xb6\x8e0\xe4\xbe\xffA\x8fc\xe2U\b\xd9+\xbe\xc0N\x06\xdfƛ\x84\x81W\xd3\xec\xceG\x0f[,w\xdfz" +
-			"&/ܝS&\\\xdd\xe4F)\x80\xe3\xb38\xa2\x02\xcc5\\\xe2\x16\x06\\W(W\xafU\xe8k(\x81\xe34~ě\x88l\xe2\xc8K$\xec{\xcf\xdbQM$GQX\xd0f.\xd7\xe9\xc4\v\x0f\xca\xc3\xd0j?l\x8d\xfb\xa5\x10\xf7\x9b\xac\xae\x1fΚ\x8dX\xda\xe9C7\xd0`֖*i\x16\\\xcb\xee\xae{\x80/~" +
-			";\xddl\xb1\x8eL\xafL\xd7\xd4\xee\xad5X\x9b\xa1\x85\xca\xf3\\@\xce&\xf2P\xb8d/mR\xb6w\xf1'Hw\xc9\a\x15\x01\x1e\xf8E\x8e\x96?\xb2\xc9 Τ\x1f\xc2Q@\xb9\xe1\x05\xaf\x95\x9bdX\x85\x1a\xf2\x1eg\x13\x8aE\xcb\xf4Xd\xbc\x81F\x8f/@\xaa\x8d!\x9b\x8a\xa2\xce\xebG\xc1O\x00\x06\xe6t\x1d\x8cը" +
-			"Q+,cq \b\x03\x86\x1eIw\tUu\a\x16H\x1f\x9f\xfe\x04\xf0\x9e\xe7|i\r\xab\xa6X\x14\xb2\xd1\xc6\xd5C\v\xb3\x94\x11=\x1cC-\xa9\x1622\xaa\xc8\xe1\xf3\xef\xaa/\\Y\xe1% tW\xae\xad,/\x17\xd9s\r\xaa\xbb\x1c+\xd7[6\fk\xcaYʉ\xcc\x00-\x9fKI\x83\xe9α\x1d\x8d\\\xc8Ĺ" +
-			".Fug\xcc\xdc^l77\x8b߱O\xd3\xc7\xc39\xaa,{\x84\xf9\f)\xcbd\x15\f\b\xe4\xb2T{\t=^\xa8\v\xa9bL\xf3&\x05k:\xe8\xce\x1fi\x1c\xf6\xcb{\\\x9b߄\xf5\xf9\xa0\xed%\x82\x9d\x18\x90\xc6h\xde]\x11}\xad\n\xae\xa0\x89Ppe'>\xb8N7O\xb3\xbc\x81\x97x\xe4<*\xad\xba+\x93" +
-			"\xff:o-\xb6\"\xf7k\xe5KUa\x93z\x89fہ\x82_\xabI^M\xee=\u007fڵv\x9e\xdc\xc8%\x97\xe8\xb6x?\xaf\xfa\x13\xb0\x92\x9fY\xb7\x90#7i\x845\xc3\u007f\xf3\xb2O\xde\xfc|\xa8\x19!\xd6\ueadb\x9eS\xfc\x82Eg\xdb=JӶ2\xc9\xdadk\xe2\xe8\x13\xee\xaeT\xc1\xb1\xe3 \x15\xabf\x92\x8fM" +
-			"\xb5mѧX\x0fj\xfb]\xf0\xfe\xb1\xb6\xec҈\x9b\x8e\xb7\x9a\xdea\xa3\x9a\xb8\xe2g\xcb9\xc3K\xf1\xcb\xd1\x17\xd4\\\xd4~\xc2\x10\xb9\xf3\xaa\x91Ճ\x8b\xce\xc1$\xad\xa9\x91Q\x95\xd1}\xfe\xf0\xbd\x17\xd8V\xa2\xbd\x1f\x96\xa2R}+I\xceL\x1a\xdbLR\xb5\xe88y{\xb1\v\x128s\x8f\xfb\xe0@\xe9\xe3\xaf?\x01t\xe7" +
-			"\x8e~^\xc4\x03\xeb\xfa\x9c\x95\xba\xf8Ɯ\xb0\xe60\x93j\x89\xe6_\xb1K\xc7;\r\xea\xb3\x13~\xd8+RؼP\xc3\xfdq!\u007f\te\xb8\x0f\xa5\xcf\xd5\xe4\xfd\x81\x1fXN\xaeЦ\xf9.7\u007f\xc1\x8f\xa0\xb9m5&\xf2U_\x13\xce}o\xc1\xf8O@=\x10\xf0h\xbe\xb84z\xc8]*7\a3-<!|\xf5\xae\x96" +
-			".\x8a\x0fq(\xfc\x89\xde0\x9fزO\xe4>\x0eG\x831\xe7r\x9fվ\f=W\xb7q\x9bs\x1a\xba{\t%\xf8uZ\x88\xa4\x03p\xd5\xec\xeeH\xa0\x1a\xfe\xc3\xf3O'\xaeƪ\x15\xc2\xe8\xff\xae3).\xd6̆\x87\x9c\x87\xa5\x0ffd\xa78fj\x14k\xdbql\xc1\xd3\x1ci\xbc9Q\xe7\xb3S|\xe2q\xb7$X" +
-			"!w\xa2\xf6\xef(r\xf4ψ\x16\xfdS\xe4\xa6\u007f\x0eS1\xd3<߸&\x9a\xb9?\x0f\x15)t\v\x19ϧ\"\xcc~\xebU\xf0\x9fS\xb5\xec\xd6\x1c\fA\xd9S\x1e\x0e *M\x91>\x1bu\\\x8cii\xb1\xd9\x0fрh}\xcb\\\xe6pO\x03\xfcar}k\xfc/\xb2\xa5\x1c'\x1c\x8d\xdf'uy\n\xd9F\x18i\x87" +
-			"\xd5ʮ*\xde\xff7\xb1t\x14\x95\tJI9:\x9c\x13 \x1fR\"\x95\x1dڢ\x1fE&:]s\x94y\x8bB\xbc\x00\x99\x8eF\x10Z-1\xe7\u007f\xbfS\u007f\x87\x8a\xa2oM\x83.\xa88\xa3\xb2\xd0x\x0e\xb7zMŬ֩!3-]\xd2d\xf6\x1fߣc\xfer\xedƀ\x1b\xb4\x93\x91\x1cd\"+r\x9cP\xfck" +
-			"Q\xc6dE\x10s}f\xff!\xf8OB\x81\xfd\xffj\xa6\xc1\xff\x11\xe6\x81Z\xc1c\n\xb1\xd1q_\xf1\x1f\xfa!:هͧ\xe1\x19(\xc4\xd2\u007f\xa5 \xa2q\x92~\x9b\xf4\xb7CJ\xf5\x16\x93\x91\xace\x0f\xbf\xf2\xbfvi6\x89?^$\x93q\xade\x15\xd4\xf6F~\xcc\xddj\xfcџ\xa4{\xd0c\xb2\x05rI\x9f&\x8c" +
-			"'\xfc\xd1[\x0fd\xe0ih\xdd\xfe\x8dj\x89?3\xd5hHtޟ\xb5\x9d>\xfeѯ\xf2\xec?'\xd2~4\x0e\\\u007f\x9aB\xf3G?\x16\xc1G\xfb\xa1\xe4\xafD\x9fJ\xaf_\x8a\xfev\xec\xb7c\xbf\x1d\xfb\xed\xd8\xff~\xc7\xdah\xb13\xeb\xc8\x06\x1d\x9f\xe4\xe7+\x91\xed\xb2>\x94]\x15\xd2MT\xfc\x1d\xa4A\xe7\x8a\xf4\x1f\xad" +
-			"\xd1\x1dF\xb4l\x1e\xca\xfc\xf1\x0fy\x0f\x81l\b\x8c\x80\xf9C\xc1_\xde\xfd\x87 \x88\xe9\xbfa\x99\xf2\xdfÿ\x87\u007f\x0f\xff\x1e\xfe=\xfc\u007f|\xf8/\x8aN\x9aj\xe6\x8e}\u007f\x93\x1c\x8aE\xfc\xaf\x10;\xff\x8d\xb7\xff\xbbFP\xa9\xf4\x8f\x16\xe5\xfc\xa7*\x9c\x0f\x9b֔U\xa7غ\xbc\x04\xf17p\xb8\rw\x99\xc0\xb6\x14\xfd\x03" +
-			"\x9cHF\x06Ŋ\x96\xe0\xc3yB\xa9\xb3S\xafI\xd3\xf5\xa6u\xc7\x18v\xd5]Ѧ\x8e\u05fa\x9c\x9d\xbc1J\x0f\xeb\xd7\xea\x05bQ\xbf\xf7\xf3(ntV/K<\x9b\xf10\xae\x0e\xdb\xfa\xa15\xd9ݺ\x98\xbb\xbdZ\x9d\x84\xaf?]\xaf*t\xaeze\x8c\xf0\t[W\xec\"\xd0ly\xefp\xdc\xd9^\xef\xc6\xe3P\x98\x90" +
-			"\xac\x10\xb2Mn\xa3\xc6\x06$\xaa\xf3\x92\xf6\xf1E\x90\x99!N(<\xba;-+P\xbeQ\xef\x94\x1fKI=\xa3\xe63\xc0\xe8EG\xa3\xe6\xcd\xf6\xbe\xa9\xfa\xc100g\u007f!1\xbb\xbe\xfe\x92\x11f\xc0\x9cȗj\x89\xe1\x8c\xf2\x94wİ\x84\xf4\x1dh+.?\xfe\xee\xccb\xa0|\xb0>(\xfa\xa9h\xefڌV\xec~x" +
-			"\x96\x19]qچ\x9d\x03\r\xf3b\\Q\xa7p3\x97u\xeaja\xf0d\xf4\x9b\v\x8a\x90՝\xbd\x19S\xc7s\xdf\x10\xa7\xeb\xfa&<\xc9vGW\xf1\xc2czHl9\x10@|$\xb2{w\xaf\x13)\xc1\xee\x80\xdc\f\x89\xa9(\xb9' \xdb\xd1uծ LT\xdc؉\xe7\xed\xa5IєF1\x1c<\x1333M\xa0" +
-			"\xe5\xebG\xae\x88\x1c\xc0\xc6\xeb\xe1&\x12\x8frϸ\xfb\xcfe\xbd\xda\xfe1\xa9\x94Ê+\xa2\xaa\x00\\\x87N-\xbf\x93\x85\xd5CȌm\xfd\xad\x94\xaf+\x06]3\x84\xa1\x8fѮ\xe7\xfc\xc3\xce\x10D\xeeB\x8d\xf8\x1dD\xdfH\xd92uW,\x96GĒ\xb0\fp3\xa5/E\xd4b`\xbc\xb6a҇Ֆ\xd8Gա" +
-			"Ÿ\x1b<,\xc3\xd2\xec\xc0\xf2-\xee\xddŋa\xffw!`\x89U\xd0\xf2\xcb\xe7\xf0\xec\xecX\xbb\xe4\xb1}HW\xda\xd5\x0f\xee\xc1\x1a\xef}v\xdf\xfd+\x83\xde\xf9\xf40\xbe\xd8\xdb\xe3\x9f\x00\x0eT\xebǧ[hgp#\x8a\xab˴T\xefLP(\x9fJ91B\x04v\xe2&\u007f˦ȃ#\xf7w9\xcfG\x0f/" +
-			"ow\x1b\x16\xae\x9es\x98\xc5O@h\x97\xd9B#\xa1Mi֘-?\x87:SW\xf7\xa3\x81\x06\x184\x12\x04\x94\xec=\x94S\xb3e^\xfe\x12~o\xd2\xde\xd4\x11 \xfe\xa2\xd6\u007f\xbf9\xceЪ\x93yv\x80\ruS\x85\x17I\x84\xd8\xf8\xfb\x87s˺C\xa8u\vA\xe53\x81/\xc6J{h\xe4X\xa3\xb7Y\xd4u\x86" +
-			"\xa4\x1b4\xe1\xa7g_7\xdb[\x1bS\x15\xb0\xe8\x82\xfb#\xb0f\x13ewq\x11\xca翫.Ew\x92\xfa\xb8J\xa7\xd2'͛e\xeaP\vCp\xd5z\x88\xa4,\xaa5+\x1fY w\x8c\x11\xcf\x1bS\xf7\xf6[.\xb2+*A\x04u\xec}沮\xb2;Os,V}\xe7U\xa8\x99\x87\x9f-\xc0\xf5\xb4\x14\xcf\xf9U" +
-			"\xd8\x06\x11#l\x9e\U000bba91\ry\x9e\u007fW\x1d\xc1\x0f\x88\xf6\x8f\xe1\xa72\x05\xf2H͏\xe2\xbeu\x0e\x9d\x06\x8aޔ\x037M\x84\x18c\xe5=\xdck\xf0l\xf4`\x85'.ޛ\xd5<\xb8\xca;e\x02y\xc22\xaaB\x8bo\xder\xdd;\x93\x94(5\xf8\x97\x9d\x12g\x0f\x1a+թ\xc3-\xf5\xcfVJj\x9at\xa5\x04" +
-			"R<\xf3\xe0\xa2\x1c\xcf\xc1\xe9\xfd\xa5\x17\xe5\a\xaf\xb5 \xfc};dE\x8b\x9dB˩\xde?\x01\xab\xec^\x92\x83\x1c\x13]\\$τ\x80\x90U[c\xfcls\xcbd\xd5oo\xe1l\xf3\x94\xb7\xd6!\xfb\xeb\xbbI\x86,(iS\x12!ʴ\xd9V\x89b\x97\x1f7:\x1e;\xd0\xda%|ǁ\x9e\xc7F\xc7*$\x19ն" +
-			"B{\xc8A\xa0DO\xc0N\xa2\xd2\x1b\x84c]\xa5h\x9b{\x9a\x1d˟\x00\xd0=\xb0\x89\xa4o\xf1\xf1\x1b\xce\xe7c1+\xea\xb3;\x8fW\x11}\xba\xea\x8cn#`p\xf3\xb3\xce+\x1a\xcb\x1f?\x01fm\x8f\xee\x0e-\x8fd\xf1\xe2*\x19 \x8a\xfd\xedm\xe0\xd08\x02\xdf\xf0\x04\xb3\xeb\v^c\xdc'\xa23\x00\u007f\xad\x95\xbb\xdb" +
-			"\xc0\xbe'>w\x8f]\xa8,1\xd5\x10\xac\xdb\x1a\x81\x83w\x87[>\xa5I\xfai\x96\x00*\x84\x11\xd1\xf5\xd1!\xa6\xbc\xe8\xea+I\xa1\xc7\xed\xe5I\x8c*\x8d\x17a\x19Tԫ\a\u07bc\xe7\xc1\x10i\xccP\xa5oC\x8b\xcdzuG3\x028>lʼl\x9d\x13{\xe7\x18\x89\xd8\x04j\xf9\x87F\xed\xaf\xc3O.w\xdc\x1f\xad" +
-			"\x1b\xba\xa0\xef\x92v\x19\x98d\x18\x90\xeci\x1a+OH\xa3Y\xff3}5\x87G\xaa3M\xa7\x8b)\xe7Yt\xeb(\xf9\xb8z\f\xa2\xb2\x9dR$d?-\xa5\xd0*\x9c\x8aU5\xb0\x8f\x00'Y\x9c\xc6.\xa5\x86,\xb29\n\x12\x03R\xb5}h\u007f\xc80\xb0ƹƅ\xe2p\xcb>\xa5Ȇ\x95\x8f\xa7\rA\t\x13Lt\xd5" +
-			"\xdaXGE\xbaB*|\x97ڂ\xe7\xc0y\x92T\xb5\x15)\xb4\x94\x94\xbc\x9fԶS\x03\xb8Ӱ\xd8\x1ec\xb1\xff\xacQ\xe7\xa4l\x17v\x10\xfdj\xeb\x86\xc3\xca\xe3M>\xa2Ȯ\xdf\xf2Ts\fNwd;\xbd\xf8\a\xb0?\xf9\x8e\xb8~\xf2M:a6\x01\xb0\x1c\xff\xbb\xea\x18N\xf5}L\x86\xe6\xbcp\x94\x06\x8c\xd8\xfaM" +
-			"8N\x18u\xbc\xc8\b\xc0OöQ\xd6\xf3\x86\x8fC\"\xc0\xc1\xcb;\x11&\xbb;$\xf4\x95s0\x86\xe1M\xcfg\x85=\x8f\xefK\x91\x0fF\xa5@\xc1\xfc\xc7q\xaf\xf0\xdb3\xe48\xb6\xbd\xb4\xea\x17;\xa1\xf1\xaej\x92q\xfaM\xdfl\xf3\x9c8\\ZH\x9fP=O\x89\xca\xf06\xbf\x16QW~p\"\x19\x18\xc8|\xef\xb7\xd7" +
-			"\x00\xf6P\xb0\xb1aH\x9f\\\xc1b;\xd9e}\x0e \x9ei\xdb\xd4ԛ}\x9d:=\xdc\ue6e5\x9d\xa6\x9e\xa5\x16\x03L\xca\\_|\xf1\xbc\"Lw\x97j\a9ѣE \xb0߈\xf9kӏ\x10\xb5\x93\xcf\xc2\xe3\xf7\xbag+\xde\xeb\x1bf&\x1d\xadӁ\xa6\xbf><\x9c\xbe\xbe[,\xc4\xccT\xbc\x03\xd5\v\x8c\xa0\xd7" +
-			"\xdb\v\x13\x8a\r\x12\xf3\xd0\xf8\t\xe8\xa7+\xb9\xed9>\x95\x9a\xda\a\xf7\xd8|\xbe\xcfY\x9dyiG~@~\xfc\xcaL%toyH\xf8\f\x04\xd3#\x8c\x12X&Y^\x88dʚ\xd2ja\x04\xc8\xed\xe4~\xa4\xa5\xe17$\x15\v\x83\xbdej\xac\x87\x88\x12\x90\x93\xc8\xc43\x9a@\xf0\xb2\xe2\xb5P\xbaMC\x934\x80[X" +
-			"@\x8b\xb6\xe0\xea\xd3\xeb\xf5\x17́\x1b\xdc\x1bƹR\xa69\xd2t\x87\xaa\x9d\xdf*\x85[\x9c\xfc\x99~\x1c)g\xac֫#Z\x9b\xc3oU\xf9\xd0\x04ث\xfa\xceaG\x15S\x0f\xb2\x95G\xde\x14U\xbb\xf8\x1b\x9d\x1aU\xf5\x13\x98L\xed\xa3H\x1e}\xce\x04h\x13\x8bg3M>\xfc\xfa\x19%\xcdYpB\x96Ջ\xf3\n\v" +
-			"\xebё\xaf\xa8\xa2#\x8e\xa3\x9d߆\xba\xe0\xebSk\xeb\xeb\x8a+\xca\f^@\x80Ih-\x8a\x1au \xff\xf1\xf3\x05\xff@CL\x1c\xceI\xf6<\xb2\x99\x8f\xab\x06X\xa5\xa9\xa7\xc0*\x88\x03\xd5x\x12U\x8b\b:\x82t>j\x13׃\x8ao\x8b\xba\x91C\xa6\xbfɏ\x0f0c\xba\v\x12\xe9\xf7WӲ\xc3\"_\x17\x17\xf9" +
-			"|\x9f\xaayA\xb9\xaaߓ\xbd\xe3\x8e0n\xe7\x96Q\xdd\x18\x88\xc5\xe5a\xdb\xfd\x15\xb2[R\x9f}\xec\xecX\xc7\xc6q\xc9\xeb4\xc5\v}\x9c\xd1\xc8Np\x95d\xf5Y\xb9\xfb\xfa\x13\x00x{h\xf0\xa0\xc1\x04\x13\x9a;$q766\x91\xb3\xfdDmu>K]xj.+\xdeJ\xa5Kar\xe4\xf1]\xec\x95\xf2:LA" +
-			"\xb2\x13\x82?\n\x93\x01\x15\x98\xc5o|\xaa\x0e\x96xj\x87\xad\xceT\xb5\xb4\xc8'SE1\x0e\x01쑝B\xdf\x1a\x9b\xd3dk\x9b}{\xb7\x1a\xc3o\xce]\xf3\x9c)#\x03\xb9\"|=v\u05f9o\xd7\xee\xa2\xf0\xb6\x89\xc2m\xb6\xa3\xc67^\x12\x93\xdd\xd5ꢸmT\xc1\x0e\xbff\"n(\xa7\x95\xdaI\xc0w\x16\xf8\xa1" +
-			"\xbb\x06\xa5 y4m\"\x97\x97~\x8b\u007f.\xd4\xfc\x149\x11\x15\xedP\xefT♃\x97\x136!\f6\xc6\xd4\xd10\"\xde\xea\x80zb㮇\xec\xb6@\x8c\xf5]lZ\x85\xa4ֿ\xa3\v\x02\xdc\x17\xc5ͯ\xeb\xed\x95\xe6\xe2\xe1M\xe4]\xce\xda_J\xa61!d=\x82.\xb7{\x02k\xe6\xd1\xe67\xc8\xe9ٌ\xac\x8b" +
-			"\x95a\xf0a\xf4[\xe5QF+\xc9\xf2\xb0\xf8\xaej\xc35\xa1\x1a\xf1\xe6\x02k}\x85\x8bJW>\xe9\x8c\x06FV\xc6\xf8\xbc\xbcf\xa7\xd2\xd6o0=C\xf3\xf9Sn\x97u\x89>:\xefDr\\\xb1gLn\x8d42#\x86M\\\x11\xaf\xa5\x12\xa1\x12\xe7S\xf4\xc2̊\x16\xfbv\xde\xdf|\x00\xa8\xb2\xd7\x05\xec\xe2aK\x95\xd6" +
-			"f\xbe\xc5\xdb#\xf7\xf2\x93u\x8c\x9f\x1a\x03ϗ\xdez&&V\xcd\xee\xe4\xef$O\x01I\xa3)X\xab?\xeb6\xf8+5\xc5\xd9|\"S60\xderU\xe4\x01\xee\xa0\x15\xc9<b4\xa6\xd8\x11~6\x1duA\x8c\xc2R.\xf1\x1eHy\xc4]/\xbf\xf8n8\xee`\x97\xba\xef\x14\xf58\x87\xd8{\xcc\x10\u0093>\x80\r\xf0A" +
-			"\xe4ϾbYN\xeb\xfa\x11h\x1az\x13X\x93\xf6\x80\x0fui([7\xad\xca-(\x96\xcc\xf7\x9d\x8c~4Or\xe5\xed\xeb%\x93\xb8\x94X\xbe\x1ax\x91z.\x81I\xa1\xa0\xc8\xfa\x04PK\x85\xf4}Y(\xaf  |\xcf\xc5\xf4\xee\xf4\xc02E'\xf3e)Z7\x80\\j\xbf2\xee\x19N\xd1z\xf6\xc2\x14\x9b)\xb90\xa9" +
-			"\xf3{\x02lbU\x11\xa4\xdar\x9f:1\xe3m\x01\x90\xbf&\xe9\xdcO\x80\xe3g!\x12r\xeb\xd6\xe6L\x04B\xae\xa1\x99Q\xb9\x87\xde\x00\xd9\xde\xc3ÏEߏ>\xab\xf7\x84O\xab\x04>\xe3\x02\xeag\xbf\xa8v\x17C\x93\x1d\xb7\xfb\xfc\x13Pc\xa8\x9bKXߒB\xe3\x1f\x13\xd3\x14-\x14-\x903\xc0\xc6\x1eW\t\x00xQ" +
-			"\x05Hc\xc6\xcf\xf4-\xebj\rq\xe8\t\x8b\x9b\x83\xe6\x86\r\xbc\x13\\H\x19\x9fT1\xe6̽\x1a\xd1\xc42#<Z\xf1\xe13R\xf88\xfa\x92\x00\xec\xf1T\x94'\xe2\xd3\x0ev\x80\x8c\x04fu7_\xa7kRu\xfa˯\xae\xd7\x1bz\xd9\x1d\xa1\xf9\xc8\xd77\xc7\v\x1b\xcd\x02\x9fq\x14\x8a\xd6)Kk^3r\f\xe50&" +
-			"\xf2\x89\xe3\xc7\xd6b\xf2\x05\xf6W\xb1D\\~\xb9*YI1\x82\xe3f)\x8fBqy[\xb1\xedDȎ\xe5\xd4d\\Ĳ\a\x03\xf1\x9c7ά\x84\xbe7;H\x14p\xa7w\\\xfc\xd8!\xdd\xc0\xfa\xfa÷2Ni\x90b\xaa\xb3e\xb6\xb8\x80~\x8cx6\x9e\x89dt\x14/ۼ\x9b\xe7\xc9\xd4\xe7\\\xc9\xe3R\x1a\xcc\x05" +
-			"\x88\xe5\xec\x8c\x1b\x91\xa2\x1a\xff\xb8\xa2z\xfe\x81\b\x9aˉ\xbfm\x0ewh\x153p\xdb\u007fm\xaa\x85/*\x1b\x83\x14%Bz\xfd\xee\xad\xfc<DM%\xfe싅\xd9\xcc\x0ek\x13\x02\x88x+\x16\x06t\xa7M\x18\x8fܳP\x1f\x9b\xb9\a\x8fH\x8a\xcd\x1bO\xed<o\x8df\xee8\xa7\xd9\xcaa\x88\x1d\"\xb4\xb36\xba\xe8\xf8" +
-			"Q<zPbx\xc4\xf5 H\xbcw\"|n\xba^\x99\b\xc0\xe3\bB\xe8E\xfe\xb1Q\x95\x90\xb5\\\xb8\xd8)NR\xe2O}\x99\xe6\xa3\xdei\xe6f\x99\xc8V\xf6E\x19E\x8d\xd1\x15\\0\x92\xef\xc1\x90\xbc\xd4db\xa5\xd6\xde\x1c}\xa3}\x8f\x9e:gmn\x0e\xe4e\v\"\xf7E+\x1b\xbb\xa4\xaeh\x9d\xe3r\xf9*P\xe1" +
-			"T\xb1{~\x90\x84\xe05,z\xd5w\x984\xa5\xd5\xfe\xedR\xcf\xf0\xc3\xd1\xf0鑨6\n\x16eP\x96\"\x861\x88\x059^\x11\\\x1d^f\xa6;\x96\xac\xba\xfc+\xb3Ƞ\xfbW\xd7!\xfe\u007fj\u007f<j\xfaG\xb2\x9e\u007f\x92\x0f\xe1\x91˶\xe8\xd2XE\xa12!\x84E\xdf\x0ePF\v7\u007f\x82Z\xb4\xecż\x909" +
-			"7\x80W\xffB>L}-H\xd1\xd9:)>\xc7I<e|\xea\x88=J\x8dr\x15\xfd\xe1\xbe\xc3!?*\xffb\xc7\x16\xea\x13\xd4\xe7\n\x90 P#\x1f\xa9!\xae*\xf8\x1e\xbef\xccϨl\x05\xc1\xe9Hso\x1d\xc0\x0e!\xc7@\xfb<;\xff\xca\x19Gr:$\xfab\xb4S\xa1|\xf5\xc8B\xd0.fb\x95#\roX" +
-			"X\xb0\x9e\x05\xc6q\xa4\xccԺSð\xa8[\x12.x\xdce\xeb\xbfԁ\xf1\xf4\xfd\xa8U\x9c2\x93F\xb8\xa22\vKq\xc0\xf7\x02\xf1\x13\\N\xea\x00\"]\xdd*\x0f\x01<%\x9c\x8257\x0f\xfa!\xf2a\xb7\\\x83\xba\xc7\xf1\x90@LKA\xfbj\x1dA\xa3\xe1yA\xa7\xfa\x81\xc9FȞ\xa5\b\x15z\xc4D\xd2Fq" +
-			"ޠ\xfaڑ\xc6a8\xa9\x8f\x05\xb9%9\xda\xdd4\x82\x8e-\xf6\xa6\x0f\x14\x97\x15\xfe\xc3\x05\x87\x9a\x89x\"\xb0_\xaf\xe4W\x88\b\xb45\xff\t\xa8>\x17>}y\x9b\x9b%\xfcz-W\x184\x1c(f\xd0\xf92\xb1Or\x01:N\x17n\x05\xf0f-?\xa5*\xd8m\xc1DߋG\xe1\xb2i\xbd5X\xd40\xdem\xefl" +
-			"1t\xe6[}\x16\xed\xdf\xf6\x9c\xf7\xc0\t.*\x9e\x8e\xf7\xa2\x16\x13\v\t\xf2\x84\x98h\x83\xd1\xfe\x14\x1b_\u007f\x8a-j\xaa\xd6\xcb\x1a\xa1\bI\x04P+2\xa2\x05M\xaf\xf4\"\xd2\x13\xff\t\xc0@\xfdN\xcbc8\xc14\b\xa7GGP\xd7\xeewc\xd6\xcfiE\xeb\x06\x8f\x96\xd7;\xdd\U0003dcbf\x16\x9a\u007f٠$\xf5\xfd\xad" +
-			"\x8e\x97o\x06\xd1)\x87\f\xa5\xaa\x92\xa9*k;\x16\xb5\r\xf1į\xa0\xbd<=\xbfq3\xe6\x1d\xef۹\xa9BoኋzM\x99\x97\xd7\xde&\xc8t\x13\xb9<\xf5٦\xb2A\x89\xc8m\x82\xa1@\xb6Ɇ\x91\x1b\xbb79i>&~\xf2о\xdaQ\x8d`\x00\xe4$qS+A \xcb\xf6\xdc\xd2V\xd5P\x06\xf3\xe1\xbe" +
-			"\x19!\x14\x02\xdcɴ\xa6a\t9Zbj\n.\xca\xd8\xe7AOJ|\xd8\xf2\xc9˦\xee\xf0\xbeHt0q\xa6\xaa\xf1(\xfdІ\xdeT\xb1\xda8Q&\x97~jxXe\xae\x88\xc46\x18F>,\xbb6&鎢\xe0Qj6\xeer\xa02瀓\xddcg\x06\xbb\r\a\"\x86\xa8l\x12\xa0\xaa\xa2\xc2\xfc\x16Z\xdd" +
-			"\x1b\x1a?\xb4\xd5Ո\x86\xaf\x82\xf2\x85\x93g\x9ed\xf7#\"\xa2\x90!\x87\xec\xf9%\xf7\x1ek6\x97\xbf\x96n\x18\xce\x1e\xc4\xea\xf9\xcc\xcb\x0e\xa1\x93M\x1d=\x01\xb2\xf5D\r!\xd6\xf5(\xb8\xa7\xbeeo\xe9likק\xd6\xdc`\xce\xfa\xca@@X^\xab\xcbD\xb9Z\xbe\xe0\x94\f\x0f#\xe8\xb7\xcd\xf5r@\x16O\xe4\x89S" +
-			"\xbeMO\xedea@\x12\xfd\xb0\xd3 F\xc1N`\"\xe7p\xca\x01\x82\x87>\xb7C\x05\xcbW\x93\xd3k\x8c\xd2^2\xa5?\x99.\x97\xdd\xe6n\x9c \bH\xd5\xffخ\xe4\xcb\x04*\xe4\xfb\xf0+\x122H\x06\x1f\xcb{\x13(\xbdRp_Μ:i\xc9\f(\x8d\xec\xd4\x13z\xeb?\xee]\xb6\x1ev`\xef\x82&\x11\x0ff\xa0" +
-			"B\xd28\x94\xa2\xcfu\x12\xc9|\xdcx\xee\xdd$\x8c\xf0\xf8R\x99\x01\xe9-@\x94Z\x91?6T\xde\xc1\xf4\xd7GCٳ\xbaW\x84>M^\x8f\xeb\x9a\xd56A\t\x10\x90\xe2\x11nf\xb6t=\xe1&\xa7\xa7R#\x1e \xa6\xc6b^\xb6(\xa1\x9c\xb1l\xc2\xf3\xe4\x90'\xea`\xf2\x92\xe5\xcf+W\xf9q\xed\xec$?\x01z\xfe" +
-			"*\xc9N\xaf\x9d8\x17\n-\r\x90\xfd\xdfj3\x06\xc7\r\xbaX\x85\x86Qe\xeb\xf8\xcf\xe0\x15U֗\x1bNq\xe8\x8eW\xb9\xd1\xc3\x11y\x06|\x14i!h,H\xc1/\xb6l=\xb5\xf5\x04\xc1\xdb\xdc\xf5\xb0W\xd8\xde\x1e/\x13%\xdc:#\xd5q\xe6\x83k\x01\x93\x18c\xe6O\x8a\b\x9d\xb4\x80%/-\xa2\xf2;\tbt\xe2" +
-			"ƛ\x99ޘ\xefZ\xe3!\xb9H\xf5Β\x88\x19\xb2c\xdd[\xcaz/\xd7\x19NI\xb8\xb6+pL\xb4 |B\xba\xf1\x80\xa0n\xea\a\x0f)J\xc8\xe49\xe2g9(\xb4e\xafa%\xa4\xbd\xceąY\x89\u05f5Zy\xf3f\"\x8e\xc8+\xb8\xeb\xd1ж\x82\xe1\xf3\xcb\x1b\xcfCT\xea^\x1f\b\xcdW5&nb\x9fR" +
-			"\x85\x86\xc6/q\xa6E\x9fp{\xe1[\x901\xf8\x8b\r\xafͦ\xf2\x1e\x88\xca\f-\xfe\xff\xd8;\uf826\xd6u\xff\a\x11\x10\xc5\b\x18\x90\x12z\x10\x90\"\x10Z\xa4H\x91P\x05)\xd2Az\x0fB\x82  H\x11\x90\x16$@D\f(MPzK\bEi\xd2{h\x86&%F\x05\x82\x80\xc0\xb6\xe1o\xef\xdf\xdd\xfb\xcc\xd9" +
-			"\xfb\x9c;\xe7\x9c}\xef\xcc9s\xc7?ޙ珵\x9e\xf7\xfb\xbekּϚY\xdf\xcf\x03\x06s\xd2+T%\xac\x8c\x14&\xbf\xd9TӖ\xa9\x12L%_\x06[\x1bn\x1d\xadlH\xb4\xe4\x1e\xa1\x83\xb8\a\x1fE]\xb1\xbfY5\xbd\x1c\x90\x14?6\xa79\xc3\x10*\xc2^>\xc9W\x11B\xf8\"\x96\x1bz\x02Z^H|" +
-			"\xb9}\xbcؚ\xdd\xc9n\x90^3\xa3\x8fu\xd3\xf3FV\xb61\x02r\xc4ƍ\xa9\x04~\ba\xd5\n\x15\xa5\xe0j\xdarR\xeb\xd8\x135|\x84\xf4\x9dg{\x9f\x8a\xa1#ś0DҸÝC\b\xd1/B2E\xd5<j<X\xf0V\x9dl\x0ftG\x1e\x0f\x8c\xe0ǁw\xad\xbd\xb6A\x9c\x9f\x9f\xf9 /\xb5\xb7\xa6" +
-			"p\xb9\xf61{r\x1f=\xbbI\x89\b\xe8\x81\xea\xed}\x8b\xfc\xf9\x19\x9f\x93|x\xdf\x06\xc3|^f\xbf\x01\xbf\xbf\xc5\xd1,LG\xa4x\xd2\xdd\x15\xe3;\xc1\xb0>\xc9\xe3\xff\\4az\xc4(e\x96\x9f\xdb-G\xd3\xd5\xd3\xf4\xf9\x00\u05ed\x9f߳\x06*$\xd5,\x983\x82O\x91cHk\xe5\xa6\xccf!f\x84)7\xcc\xe3" +
-			"\xe6%\xfd\xbe\xc0>e\xb9\xa5\x16\xcf1X\x9f\x18\fD\xd9\x16\xc8\xc5\x0f\x9ečJ\x0f\x1a\x16\xaa=\x16wAT\xb6bۿ\xfd\xac\xbdb\x04\x9bLM\xbc{zS\x1b\x8a\x10'@\x1f\xba\x04\xedl\xeejͥz\x85(\xb3\xfc,ψ\xb1\x8d\xea$Ml\xcc\x16\x0e+&\xd8&9>\xd1?pw\xe6\x9b\x152\x01^\x18\xcb" +
-			"Q\b\x06Y\xcb\xf1?\r\n\xf2p\xc7ӌ\x0fP\x1c\xf3\x96GDҒP\x84\x8c\x03\xf8\xd2S\x0e\x05\x9e\x83\xbc\x9b\xf87ӖIo0\v\x9e\xa2!\x0f\x84\x172\xa2C\x9d\xe3UT\n_O\xa1\x13\x92\xd7*\xbe\xba=q\x1c菽+\x12v\x95 \xc2^\x9b\x8a\xbfjS\x87C\xd2\xd3eX\xf4\xae@\xbf\x8c6\xbc\x1af" +
-			"\x1c\xf4jv\xa4\x00ߒ\xf8S\x03\xe7?\xaa\x8b7\vHξ+\xab\n\xfd\x12\xe2\x99Lϗ:\xac\xd8\xe7\x89f\xbf\xa2\x8c}̾a\x84\xb8֪\"\x06+\xd6\x10b\xd7\xf3\x9fV\xaa\x9bW\x1f\xd0_f\x9c\x94\xa4\xb0V\xf1\x06\x01XMTwuB8\xd0\xe8\xe4\xae\xda)\xce\xc3\xed[\x85\x9d\x12\xd2\"MW=\xe8y_" +
-			"5\xe3\xa8j\xc1\xef\xb6\xe0%\x95\xbc\xcc\xf9\\\x95%D9]\xea`M\xd9,.:\xcaUބ\xd4\xfe\xb1\xa0ŷC\xb2\x857\xcbZ9hE\x88'1v\xc2v\xca\xd9\xfe\x81!\a\xbfC\x003\x9f\xfe\xfc,S\x8c\xe3Q\xf3\xe2q\xc88\xae\xa8\xccC\xe3qj\xb2\xb3\xc1\xff\x95\xbf\x93\xfe\x94m\xacǳ\xba\xc4~]I\xda" +
-			"\x059r\x97b w\x8f\x97\x02\x03\xdf\xd3{gk\xb4\xec\x87<2\xef\xe4\xe4\x80@\fR\xf5\x05\x98\xfcĸ\x9dT\xaeS\x1cu9\xb23\x1cFݰ\x99\x9d\x17,\xc7^^\x87\x1a\xf6\x9f$\x12\xae\xd8DN\xe8\x1c\t\xd4\xf8 \xc86Gnhh\xe3\x97>?\xec\x91k\xbb\xe8H\xf4\xb1\x013p\x01\x00\x00\x81\xfb\x8aպ\xaa" +
-			"ǿ\x01\r{;\xf69\xe5\xa1'R\x1e\x14e\xa4\x18\x80**\xc1w\xd0U-\xf0-\xa7\x88\xe9'y\x05>\xe4\xabS/\xa7\xb2\\x^\xa7F\x83\x18\x00\xc1W\x00\xed\xea\xfa{\x95\xb9\x12\x10l\x17\xf2z\xe03\xa4m\xaf\xf4\x86u\x95\x81\xb7\xd9ԙ\xb4\xa2(\xf9Eb{/I\x11\x99\xec\n[O\av\x04\xee\xc6\x04\xa9\x05" +
-			":*}*\xd0.\x00o\xe7\x1bbykG\x8e\r\x1b\xdc~l\xff^~+\xdf\xe7\x8e{\x81\xb7\x96\xf9\x9d\x1e\xe4\xb1\x01\xec\xb5\xc8bO\x82w\x81$p\xa3ˑq\xe1\xfcɉ\xcb\xf2O\xef\x8f\xca\xc6\x1e\xe1>\xb6\xf6q!6L$\x04J~\x828{\xbeN\xb1\xc2\u0088\xd3\xd3'\xae\bБ7\x98Ab@\xeaw\x1cu" +
-			"m,+\\`@\x01\xbb4\xebI\x14\x037\xaf\x90ԁ\xae\x90\xf9\xebY\xb1\xc3\xf1\x00\xfa\x1eF\x00 \xe4)<\xcd\x17\xacA\xed#*;\xea-\x9d\xb3\xe6Ӡ\x9d\x9aYl|\xfbXƥ\xbf\xc0\xbe&\xb5K\xa1f\x10T7\x80\x11rl\x1fz\x99\x17h\xf9\xd4\xdcT[\xf58\x96\x04o\xac\x93U0^k\xc7\bJ!\xed" +
-			"\x87\x9dG\x86\x82\x06١?\xe5\xce\x14\xa60\x8c\xb8^?a;\b\n\x82\xec\xc2\xd9g\xed\xd8K\xe4ǁ\xddu`\x01\xf3\xa6a\x87\xc8\xe3\xd36%\xc9\x06\x10\x8d`\xb3K\xbd\x928m\x03\v\x127\x8cJ\x98\xc0\xbf-\xf0>\xe3\xa98\x92\xe4\x83\f\xe0&\xeb\xfa\xac\xa1\x1d\a\xe0f\xab\x00\t\x87\xb8wK\x8a\xdcc\xae\xb2\xcf\"" +
-			"\vo\xc3\xce\xeeNߪ\xfa\xb0\x86P\xe1\rA\xf4\xf2\x86\xacr\xb4\xe8r\x9eȱ\xb2;٘ٳb\xeb!7\bYj\x15\xa4a\xccb{\x01HƠ=\x05\x02\x8f\xb0Q\x90\xe2˶\xa1\xfd\x17\u07bd\xb8Z\xf9\xe85\x16\xaf7\xe6Wk{,\xe3\xec\xb3̜[`\xcb\xcbs7\x97\t\xc9\xd3+\x11!\x84\xbb\x9d\x90\xb4" +
-			"\xfb(x\x84\x92%\xba5\x16 85'}v\xa2S\xf3C\xc0'\x17}\xd6ӝ\x85\xee\xdbK\x10\\\xe6R\xf2\xe4/+D܂\xfa<0&\x8dB\x8d\xe8\xe7\x02\x90%X\xe5\xf5]\x06\x86\xf4\x02\xc4Tԁ\xa1\x11Q8\x1fF!`E\xddO\xb19d\xfb\xbb\xf3\rtը˟2\x9c*L\t>\xb1\a\x95jh\x89" +
-			"\xe0f\xc9\xe0tuC\xb3\xb5R\x99\xf9\x03\xe9\xd6\xfb!\xb8=\xc5a\x9f|\x81I\xa1\x171\xb4\x87\x88\xb6\nd\xb1\xaf\xe8.Ӗ\n\x1d\x9d\xae ݷ7nc\xc1\x1c\xf3f::&=\x97\xec\xd4[\xe5m\xfbA\x18z/\xe6\x980\x81\v\xac\x8c\xbc\xe9\xfbZ\xab\xe0q\xce\x14\x1fPȶ\xc7+\xc4\xe6\xe2+-\x91\xac\xc8\xc7" +
-			"T\x00j\a\x9dS\x19.\xee\xfb%\x99\xaa|&\x011.j\x10\x10p\x96}\x8e\xd7\x14W:x拊\xd6(q\xb4\xcd\x06?\xb6J\xf0ʚ\x1f\x95\xaa<u\xafNU\xe6-\xe9\x1bN\x9c{\x14&\x98\xcf\x10`\xa6\xe9\xd0\xd5<\xb6J\f\x12'6%\r\t3ss\xa3\x84\x81\xf0\x8d\xa7\xd4́\xd7;\xbe\xaa\xd5@\xb2C" +
-			"\xbf\x10\xfb0\b\xf5Lj\x1c\xde.\x15\xa3\xc2[\xc7\f\x0e\f\x84\xeb\x06\x83\xae\x9c\x91w\x1f\xdft\xf7\x99\xadOװ)\xf1g\xeb\xd2!x\x83\x83?\xc1o\x97\x9c{ǹ\x1e\xb6\xbf0b\x9aKB@\x10\x8b}\"\xa3\xc6\x1f\x8a\xd0\x11\xb9S\x85\xd1r\x90\xb1e\xcc&\xedҮ\xb0\x81\xb0\x11\xe4\\;\x9c\x96ӡ\x16\xb0!O" +
-			"\aX\xcdu\xfe\xf0\xad\x86x\xfa\x13\x89\xebΛך6a\xae\x16#\xb4\x87ͻi\xb3\xa3\xbe\x8b\x85wb\xa9\xea\xdf\x01\xc7(\x04\xb2H\x96\x83\x89H2\ny\x8fvM\xda\x1d\x82\x89\xa2m\xfc\\\xfc\xb7\xf7\xbf4\xf2+\xb0';T\x8c_\x96\xb8\xe4\xe5~'{g\xf29j\\\x87\xad\xbf\x1d\x12C&\x88\x17v\x84\x046\xdf" +
-			"N<?\xb1\u007f\x1e\x8f\xb3\xb7ו\xf2\x8eu\\e\u07fe\xc3ˑ\xf5\xff\xb7\x1e\x98]\xca\x14_\xe9b \xa2\fmn\xabm\xe8\ns\x96\xf2\"\xf9\x02\xf5\xaa\xa3>U\xe5'Kix\xba\xf8\xda]p\xecB\xba~*\xc9\x05\xddet}\xa6\xf6D\xaa\xf4\x9c<\xd0\xd4)\x1c(\xa9l<\xb6\xf5\x1d@\xf2\x98\xf4\xc2\xc0dRm" +
-			"\xdc\xef)\xa4P\xbc\x98\x02u\x9d\"\x95V\xf9P\x99\x8e\x91 \x1b\x83W6T\x8b\xae\x10\x1f\xa6,\xd1\xfb\xb7\xc9\f39J\x8c\xbb\x80\x9e@\xd0Y\xb9\xb9\xeb\xeb\xfa\x87\xbcx\xbf\xae\x10\xc2\xe9\xb3\x01\x9ck=%J\x0f\x98\xc0\x94\x13l\x9b8\xe0\xd4\xf8\xb8nb\x88\x87LF\x00\x9e\xb9\x14\x8b,\x17\x1e\xb0\xc9 湳\xbb\xe3\xdc" +
-			"\xb0\xe0m\xb3\xf9\xb7X\x82\x93\x8fW\x86\x93-\xe6\xf5\x85\x8c\xac\f\x90\xa0\xe4=\xb8,\xec(\xff\x13\xbcwa'\xcch\x81\xfd\x8d\xeaM\x93\xfc-\xa9m\xe3\x89\a\xfc\x9a\x98[\xaf\xe3\x1a6\xdc\xe9\x1f\xcf\xd5s\x1d\x94\xf3(%\xf8;7F\x94J\xab\xbf\xc8\x1b'\xd0S\xf1\xab\x18M)8\x93Zh\x15:YJi\x88m\xf9\x8b\v" +
-			"Rl\xf7\x89\x1c\xf3p{t\xcdNwiނ\xf2\r*om\xfe\x94\x8f\xc2C\xef\xaf\x15\xd0\xc37+F\xd9k\x91\x9f\x94\xd7\xf8/ܢ\n$*>\xd5ef^'fz\x14\xcd\xd9\xf4a\xe0#\x8b\x96\b\x1eF\xc1h\xbe\x83\xda\xd7@\x83\xde\xd7\f0\x85\x87\xee;5#\xb7ʓd\x18\xf5)kK\xf7n\xbe\xdb\xc5E\x81" +
-			"\xa7K@\xf4\x93T\xe7\x0f\x94\xc6:\x05\x1f)L\x8f0F|9#\x11\xfb\xec\x9b\xde\x00=\xdbl\x00$f\xa2.x\xe9Ե\x06\x12\x9f9|\x87<\xf6*\xe6M\x12\xafߍs!g\x01|\xf2\xcc\xeaá 3\x97]\xe8\xd9u}4uc\v<\xd1!]\x97m \"\xd9\xe2\a8&\x14c\x0e\xa4{7\x19\xa4\xfb$\xdd" +
-			"\xc7\x131\xc4\xf0\x1d0^\x84Q\xec\x1f\xb9\xd5Y\xf1\xb2\xa9\x1fRu\xfb\xb4\xdd\xe4\x95\xf3\"<K\x97\b\x8dWj\f\xcee\xf8\xe3\v\xea\xe49\xa0\t\xe0HZ\xf8\xc7\xfd\n\x04\xea\xd2dգCэ\xdba\x88}\x1f\xf0`MM\u007fGPm\x1c\x1d&ʱ\xb2\x9f\xbbc\xb5\xae0\xa1\xaaBa\xf3\xe9\xc8\xe0\xf2\xec\xd94\x8b" +
-			"\xcdӺ9\x9c\x8b\xd7ģEu\xdfr\xb1\x06M\xee\xc8\x06\x86O\u007f\r*6\xab0K{\x16}.f\x16\b(\x1d7\x1d\xfa\x8f\xec\xaf\xfag\x0e\xf7\u007f\xdaখ\xd4\x1a4\tNN\x19̜\xdb\xfd\x0eX\xb7R\x14\xdeBf\x8e!@[\u007fD\xc7\xd4S\"\x13\x83\x1a,Z\u007f\x05\x8e\x15\xfd\n \x83]sn\"K," +
-			":\xe4\xc7\xfdr\xbb\x19\xa5\xff\xedŋ\x87\x8d\xe0\xea\x1a\x87\xbf\x93\x03m=+\xa3\x94\x1cz_\xec\xf1\xd7\xef\x80ϓa\xc8\xf0S\xa3\xdf\x01\x95z\x92\x1bgxuU\xff\x804+\xca\xde?\x91V\x98\xf7kF\xfe\xdff\x18\xf8\xc2\xd03n\xf2G\xe0M\x19%2vջ\xf4/\x19\u007f\x9b\x02\xf9\xbc\xe3\x87\xe8\x1f\xa2\u007f\x88\xfe!" +
-			"\xfa\x87\xe8\u007fM\xf4\u007f\xd9\x1at)}\x91L\xab\x16e\xe4#\xe8y\xab\x86BR\v(\xa7\xe1wD\xcb\xf0?\x12-\xff\xa1\xeb%\xfe\xaf.\xff1\xfej\xfc\xeeLw\xd4\xfbþ\xfeC\xd7H\x86\xa1\xfe\x15&n\x95\xb2\xb0\xbd\x8b\x0f\x9ez\xbe}q\xed\xad\xef\x05jٷ\x95՚\xbf\xe0\x9a\xa49\xe8O\xff\x86b*)\x8c\xfe" +
-			"\x11\xff\x88\u007f\xc4\u007f'\xce\x1f\xba\r\xb5\xbaB\xd3\xecmb,\x9f\xfc\xad\xecV7\xb8\xf7\xfbF\xf9\v\u007fl\x94\xff'!ʨ\xfcs\t\xb4H\xa1\xa2F[\b\xef\xd8@\x06X\xb5\xfb\xd4H9M/`\xaf\xdb{\xff\xb3\xa5ݓR\x88\x92\xb2A\x00v\xa0<@\xe7S\xb1A\x17\u0081\x9a?\xff%\xd5J\x8d<\xfb\xdcQ&<" +
-			"\xfe\x9fŕ!\xf3\xcfXb&\xb6.\x11k[\x12\x98!\x0ffF\xf4twr\xa2τ\xc1\x16ҿ\x03\x1c]\xde\xe2\xf7?{\xa4f\xdc\xf6X\xfc\xf6\xa2?\x17\x06\xb1D\xe6\xc0ڹ\xfd\xc1۩\xc7\xc1\r\xcd\x1b\x8a\x1c3\xc4\xf7V\xf7\xe5\x9c\xec^\x86\x02C\x8ccfD\x98X\xe4%fNK\xb7\x9eRMp\xe1\xc1\ni" +
-			"y\xaa|\xa4\xc7\xf8\r(\fKH\xaf\xd5\xc4\xf2\xf2\xf6\xbd\x9cj\xc8X\xe4\xb4>n\xfb\xfc\xea<j'ZK\xbdI8\x85\xfb,\xa1e\xa2\n\x98\x17\xf9\xc6V\vb\xcd\"\x8c\x04\x82\xe2\x8fC\xe3\xfa5\xf2\"\xc6\xd6DOO\xfa\x9f\u007f<Y\xb1\xfa\x1cߏ6\xcb\xee\xebXGg#[\xfdtH\xf9\x02\x1b\xdb>n\x11\x8c\x85" +
-			"\x99qjU\x15\x19\x9f`\xaa1\xfd=\xc76S\xa9{{\xf1m'\xa3\xa6\xf9\x8d\x1fW\xb8\x9a*T\xd3\xd0&J\t\x87\a\x8d\n4\t\xf8\xc8K\xb2d\xdfb'g\xbe\xc53[_v\xdf\xdb>Rbw\xd8Kt(ڵBG6\xf7WR\xbe\xf0\x12\x88-\xf2vN\x1a\x153G\xc9\xf3g\xb7$\xdc敖\xeb\x1b\xc4\xf9" +
-			"\xb4\xde}\xc5`\x93\xbe\x03\xae}\xa0 \xfd\xfc\xf2\x89\xb6\xe4\xaa\x12`\xa6\x9b\xe8\xc7\xd75ƀ\xf2\xa9\x1c\x1c@\xa3x&\x15\xff/2\x9d\xee\xd0\xc4@\xe8w\x06\x1e,\xf0\tx`\x84E\xf9\xf0\u07ba\xfe\x93\xe9\xd2Ȁ\t\xda\xfb\xf9\xee\xd8ɹ\x04\x0f\x9d\xf4G\xf1\",\xa6\xc6\xd3\xeeӒ\xcdr\x156K\xf3s\x95Ϥ\v" +
-			"\xcbD\x8b\x9c5\x10˧\x80&\xc7F\x03\xda\xc4Q;<\x89\x0fޓ\f\x85\x92\xde8\x0f\xb1\x19\xb1>\xae\a\xb3\xbc\xa6\xebט\xb27\x99\x94\xde3R٪DQ\xfaq\x01\\j\xec\xa7\x04̜\xfc\x14\xb3_}\x11\xfb\n\xd9'ܿ\x8b\x8f\x86\v\xceS<\xe7Q->\x02\x1a\xf3\xc8\xf0\x19\x8di\x9e\x06\xac\x95\xac\xa3\xed|" +
-			"d\uec45\xe3j欢2\xc3-\xae\x1c\x01W\xa3\x8fn\xe3&P\xf8\xe8Ǖ\xc4-\x90\xf5\xff\x00\xfc\x04\xdb#\xec\x8d\xc1\xd4F\xaf\x17\xb6Z\x9d0z\xf0\xb1\xe5\xa4\xc6aϧ֔\x9e\x11\xe1\x1b\xdd,0\xbe\x9bT\x01\x93@?\xdd\n\x1a\xfb@\xa4\xba\xff\x16\xf0\x11\xb0\x80X@\x17\x13\x81<\xd6\xe9Nɒ\xed\a\x1c0" +
-			"\xcdk\\\xff\xc5[.\xbbv\xf8|w\xda\xf7wH;'\x18\u008b\xadv\x86\xf1!\xe2\x06\xa7\x13\x17F\x96k(\xa3\x96!ah\r}\x81?\xa8\xc7O\xaf\xabY\x01\xf3\xca\x17\x8f\xb2ӯ\xb9\xcc\x13\x16\x11\xd7E\xccb\x97\xad\xe8\xfeFk]%\x18:\f\xc1Π&\xa5iBͱ\xaa\x0fC\x16\xc0\xcf\xf8LE\x84\x12\xb4\xa8" +
-			"\xb9\xb9\xad\xf9\x9d*\x1b\x1f\r\x96I\xc1\x0fG\xf1\xeae\x06\xa9)\xc2EJ\x9a\xce\x1a\xfeT\x8c敓ʝ\x9e\b\xf6\xcf\xef\x8d\xee\x06\b\xd7\xcb^\x97\x19k\r\xa2\xa5c\xa2\\\xc3z4\xa9\x8f\fo\x91S2u\xc6g,\x92\xf6<'ܬ\xbc\xf4[g\\\xd2\xca3\xa3K\xe7\xf7>[\xe5\x05\xe2\x95\xea.&V\xef\x19\xf5" +
-			"\xe5\x1b\xa5]v\xb2J\xa3\U000da14bh\xa1M\xce\b\xe4\xa1\xc8\x1e\xf1\xc3q\xb3\xf8\xfb>\xa1'\x87\xf63\xa5\xbb\x9a\x95\x96\x94\xb9\xd2ChgX\xe4U\xc0l\xba\xe4\xf1\xc4s\v\xfc\x04ݛ\ti.\x95s\xefY\rX\xacY\xe71\xebP\xae\xe2.\xceQyU9s\xe8X̖\x85ݬ\xddw\x80\xcf;\xd9Q\xafГ" +
-			"$è)\xc8\xcb\u0601\xf7\xd0{\xaf\xa8\x198\xf4\x02J\x1b\xdb\x15h\xf89\x9f\xa4\x1b\xa6\xf4\xa8d\xd6я\xd4\xd8\xf3\xa8L\x0f\x84)\xb7\xc7\xdar\xb0E\x0f\xaa*!\x99]z\xc5\xe2̾%\xec\x8e\xf67\xb4\x1e\x92\xbf\x98DM톩*S\xb7\xbc\x02\x86qn\xfc\x8b\x93\x12N\xed\xcb~\xf9-Wݛ\xf5i\x85\r\xe2" +
-			"\x94\x12\x16\x1d\x06Q\xc6%\xab\xb7\xea\xe5\xfdU\n\x9b\x95e\xbe\xb26N)\rmVxgL`|\xddKx\xd8\b\xafX~\xb9}nvg\xd7JD\xa4\xf9\xd2\xe5\xe7(\xfem\xd5b\x93\x8e\x8eԣd(\x01\xfd\xed\"\x0f\xa8\xb9&p\xba\xb7h\xdc\xf2\xbfa9a}õ\x1d\xfcf\xe4\xdb\xe2\xe7zf2(\xb1\x06\x8d\x85" +
-			"\xe7.5\t~<\x1f\xf7\xc8$\xe7\xdd\xe5\x16\xef\xa4\xe4\xe7\xa5\x15\x0e\x8e\xa2\xf5\xddh\xf4\"\xea\x0e\x03\xa8\xe6\xe8\x81\u007f0\xff\x9e\xd4\xdf\"\x13øv\xa7I\xfa\x98\xcbJߪ\xa5\x16/\xcau\x9b\xa6Kp']\x81\xa6~\xec\xd13٫\xad,\x0e\xfd|'4\xb7$mc?ݟ\x85\xad\xa7\xb2\x19\x86D\x11-\xd3*S\xdd" +
-			"\xd4̑$m\xa2j\xde0\xba\x04Q6+S\x84h\xea^\xbe;\x90\xb7$!\xb8=\x03\xe3\xc0\x1eJ\x90\xdcH3\x16\x12\x171\x8e\xf7V\xe4\r\xb3UT_\xb4\x8cV\x86Q\xb2\x8b\x9fL\xf4\x9c\xbcnچ\x199Vl*\xc1ֱ;\x88e\xb1d\x9d%\a\x9e\xaa^\xb5*\xc0\xbe?\xc5lZ\x94v\xe1\xa7Aɾ3\xb2" +
-			"\xb5\xedR\\\x9c\x17uf\xf8P\x06\xa3A;\x92%o\xc2_\vE\x8b\xb4I\x87\xa5'\xcd]\x99\x96\xaa\xf0\x8c\x9d\xa6 \xb2_y%\xe8\xcf*i\xf2\x1a\xc1\x8b\xe5\x9cZ\xe3lD\xf4\xa7\xed\r\x13\"l\xaaX\xa6gn\xc68\xf2)\xc1\xd7q\xf1\x90\xbcg\xed\xac\xa6\xfc\xc47\x0f\xb1\xd5ږ\xc1\xd6^x3'y\x85J+u" +
-			"\x9e\xf7~s\xb6\xfc+\xb4M\xf3\x00\x88+\x1b\xa3q\fG\x8c?\u07b4t9\xf9\xa1q\xc2\xcay\xe0\xfbp\xc4T\xdaz\xb9\xc6\xfa\r\xd6\xe6\x9d.\x8a\xa0\x97\n\v\x9d\b$\xd2\a\x95\x9e9\xb4\u007f\\\xb2wF\xff\xe1![\u007f\xf1De\xb5\xcb\xf3W<\x0e\xdc:\x92\x86m6\x82\xa3\x82n`\xe7U\xf4\xd7\xf7?\x9d#\xd3f\xcc" +
-			"\x9a\xb4\xc0\xe3v\xad\x9a\x9b\xde\xf9*\a\xb1L\xfcnr#\xd0\xdc\xe2\xbd\x1a\x9c\u007f5j\x9c\x82U\x11\xf6y\xe2|7\xba^\xee5\x00\x06\x99\xd6\xc8[Q\x17\xb0\xc2\xcd\xd9\x18\xbbߓ\xf9\n\xba\xf1I\x8b\x1e\xe6\xcc\x14\x9e\xf0\xf9`\x93,\xbdm/\xb1\xe4\x8d\x11\x10\x9d,\xea\x9f\xcd\xea1\x01\xdeT\xa9:o\x9ah\x13\x9a\xaf\xae." +
-			"\xf3\x01k\xaeǻ\xd0-\x82QZ\xf5\x85\xe0N\u007f\x8d\x17\x93\x94+{\x1b\xdeȱ\x1a\xc5\xdd0)\xb5\xa7/\xba#\xe3f\xc1\x9brO(C\xe7k\xf1ס\r-\xff\x8f\xed\xe0\xa9J\xfaK\xa4\xaaj\xcf\xe4/\xa6/R\x15\xea\x14\x18\xb5\x84\xf5\xc06\xcbF^0\x97\x8e\xf5|X\x81A\v}\xb2\xb5\xba/\xa6\xb5\xfc\xf2\x87\xd6" +
-			"\xcd\U000aa1e1\xf4)FL\x98\xab9\xf51\xca\x0f\bΣ\xf4\x92\xe8\xa0w\xe9U\xa2\xd9\tD\xc6RЮn \xa1<\x90\x05Sa.\xe7!\xc6t\xbe\xc5\xc58\xfc8\xa8\x1aA\xf7\xd2\xef\xe9\xbb\xdb\x1frn\x86\x1eF\xa5/\xed|\aL\xf5\x1f\xb4il\xca_\xe7\xfeɗى9\xe0\xc3v\xad\x15\xbfC\xe2Q0\xbf\xf6" +
-			"\xe7\x19Fk\v\x99\x00\x8d\xd12\xff\v\xf7\xc9j\xe9\xf5y-ʴ\x85u\\\xc8\b\xd4\xfe\x00?\xfdb.\xc3F\xe6Q\xc8~\xbc'ڊ\xadV{\x86\xa5\xb2I#\x9c~r\xff\xa9y\xc0ҌVS\xf1\xf5\xf9\x9b\x83]!\xf5\xea\xad\a\x11\xeei\xc95;k*\"e}\x0e\x16\xa5۷\x13\x11\x9e;b\xcd䟬z\xef" +
-			"\x8b\xc1\xe9b\xfa\x1c\x82\x85\x89\vv\xd3䓾\x8b\x8b|\x1a4{$\xa9qP\x8f\xb7Y\x8e\xa8\x99x\xf2\xd1(W\xafo\xe2U\xfc\xf3\xfb\xdd\x17]j\x8d\xca\xfd<\x1b\x8d\x94\xb2\x11_'%1\xfa\xf3݀&5\x82\x03594\x92>\x9b?\x13\x9c1\xcc3x\x9aI\x8f.;\xa6O\xf0\xa3\xe0d\xb6\xd5\xc0\xb4*\xaf\xbe\xff" +
-			"\n\xa4X\xbc\xacxO\b\x0e+\xbfZ$\xff\vش\xf3\xdf\xed\xcb\xff_\xf5\xec\xff)\xbfN\xb72\xab\ue363XR\xd5[\x8ay\xaa<\\\x8bKD$\xb7\xe8\x897\xd9\x13\x8d\xce\r\x16\x16\x12b@\x1b\x9dF?\x902Xi\x14\xaf\xf8\xda\x1b\x15\xf37\x1f\x84ǒ\x87\xde?\x86\xbaA\x80&\x17<\x1f\xe9\xfeʋ5\xd3\xe4" +
-			"&c\xb3]\xca;\xf1\x9fS\xa3\xfc}\xe7\xda\xe7\aG\x8e\x8dX\xc6-V\xd8\xd9\xd9\xdb̕\x94\x14\xbb\xaa\x14q]\xe5c\xb0-\x8cn\xbaj?'\x89\xd0~\xb5\xb1u\xfa\x96\xb9\x1br-\xf55\xb5Lp\x8f\xc37\x190Jjh9y\x96\xfezi\xb7\xb0v(+3䑹\x89A\xe5\U0005f5d7;PU\x02f\xb4\xe2" +
-			"\x12\xdc9\xa6\x9ev!\x19\xa0\t\xe0\xe2\x93\xf0.\xccap\xb3\xff\xe9\xb6^\xc3\v\x84ɉ@\x19\x87\xfa3RC\xc8\x05\xfd\xa3\xf7\xa0\xf9S\xf5\xba\xe7e\x9b\xfe\xdd\x18\xb8\xff$\xbcܫ\xff\x17\x00\x00\xff\xff\x16\x10Gӷd\x01\x00",
-	},
-
-	"/": {
-		isDir: true,
-	},
-
-	"/internal": {
-		isDir: true,
-	},
-
-	"/internal/assets": {
-		isDir: true,
-	},
-}
@@ -0,0 +1,9 @@
+// Package assets embeds the static resources of rst.
+package assets
+
+import "embed"
+
+// FS holds the HTML pages of the console and of errors.
+//
+//go:embed console.html error.html
+var FS embed.FS
@@ -0,0 +1,512 @@
+package rst
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// JobStatus is the state of a job in its lifecycle.
+type JobStatus string
+
+// Statuses of a job.
+const (
+	JobPending   JobStatus = "pending"
+	JobRunning   JobStatus = "running"
+	JobSucceeded JobStatus = "succeeded"
+	JobFailed    JobStatus = "failed"
+	JobCanceled  JobStatus = "canceled"
+)
+
+// done returns true if status is final.
+func (status JobStatus) done() bool {
+	return status == JobSucceeded || status == JobFailed || status == JobCanceled
+}
+
+/*
+JobRunner is implemented by long-running work enqueued in a JobQueue.
+
+Run is called in its own goroutine. It can report its progress with
+job.SetProgress, and should return as soon as possible when job.Canceled() is
+closed.
+
+	func (e *export) Run(job *rst.Job) (rst.Resource, error) {
+		for i, row := range e.rows {
+			select {
+			case <-job.Canceled():
+				return nil, nil
+			default:
+			}
+			e.write(row)
+			job.SetProgress(float64(i) / float64(len(e.rows)))
+		}
+		return e.file, nil
+	}
+*/
+type JobRunner interface {
+	Run(job *Job) (Resource, error)
+}
+
+// JobFunc allows a function to be used as a JobRunner.
+type JobFunc func(job *Job) (Resource, error)
+
+// Run implements the JobRunner interface.
+func (f JobFunc) Run(job *Job) (Resource, error) {
+	return f(job)
+}
+
+// Job is a unit of work enqueued in a JobQueue. A job is also the resource
+// describing its own status.
+type Job struct {
+	id         string
+	url        string
+	retryAfter time.Duration
+	mu         sync.RWMutex
+	status     JobStatus
+	progress   float64
+	created    time.Time
+	modified   time.Time
+	result     Resource
+	err        error
+	cancel     chan struct{}
+	done       chan struct{}
+	once       sync.Once
+}
+
+// ID returns the identifier of the job.
+func (j *Job) ID() string {
+	return j.id
+}
+
+// URL returns the path of the status resource of the job.
+func (j *Job) URL() string {
+	return j.url
+}
+
+// Status returns the current status of the job.
+func (j *Job) Status() JobStatus {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return j.status
+}
+
+// Progress returns the progress of the job, between 0 and 1.
+func (j *Job) Progress() float64 {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return j.progress
+}
+
+// SetProgress sets the progress of the job. p is clamped between 0 and 1.
+func (j *Job) SetProgress(p float64) {
+	if p < 0 {
+		p = 0
+	} else if p > 1 {
+		p = 1
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if !j.status.done() {
+		j.progress = p
+		j.modified = time.Now()
+	}
+}
+
+// Result returns the resource and the error returned by the runner of the
+// job, if it's done.
+func (j *Job) Result() (Resource, error) {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return j.result, j.err
+}
+
+// Canceled returns a channel that is closed when the job is canceled.
+func (j *Job) Canceled() <-chan struct{} {
+	return j.cancel
+}
+
+// Done returns a channel that is closed when the runner of the job returns.
+func (j *Job) Done() <-chan struct{} {
+	return j.done
+}
+
+// Cancel cancels the job if it's not done yet.
+func (j *Job) Cancel() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.status.done() {
+		return
+	}
+	j.status = JobCanceled
+	j.modified = time.Now()
+	j.once.Do(func() { close(j.cancel) })
+}
+
+// LastModified implements the Resource interface.
+func (j *Job) LastModified() time.Time {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return j.modified
+}
+
+// ETag implements the Resource interface.
+func (j *Job) ETag() string {
+	return fmt.Sprintf("%s-%d", j.id, j.LastModified().UnixNano())
+}
+
+// TTL implements the Resource interface. The status of a job is never cached.
+func (j *Job) TTL() time.Duration {
+	return 0
+}
+
+// jobProjection is the representation of a job.
+type jobProjection struct {
+	ID       string    `json:"id" xml:"ID"`
+	Status   JobStatus `json:"status" xml:"Status"`
+	Progress float64   `json:"progress" xml:"Progress"`
+	Created  time.Time `json:"created" xml:"Created"`
+	Modified time.Time `json:"modified" xml:"Modified"`
+	Result   string    `json:"result,omitempty" xml:"Result,omitempty"`
+	Error    string    `json:"error,omitempty" xml:"Error,omitempty"`
+}
+
+// String implements the fmt.Stringer interface.
+func (p *jobProjection) String() string {
+	return fmt.Sprintf("%s %s (%.0f%%)", p.ID, p.Status, p.Progress*100)
+}
+
+// MarshalRST implements the Marshaler interface.
+func (j *Job) MarshalRST(r *http.Request) (string, []byte, error) {
+	j.mu.RLock()
+	p := &jobProjection{
+		ID:       j.id,
+		Status:   j.status,
+		Progress: j.progress,
+		Created:  j.created.UTC(),
+		Modified: j.modified.UTC(),
+	}
+	switch j.status {
+	case JobSucceeded:
+		p.Result = j.url + "/result"
+	case JobFailed:
+		if e, ok := j.err.(*Error); ok {
+			p.Error = e.Reason
+		} else {
+			p.Error = http.StatusText(http.StatusInternalServerError)
+		}
+	}
+	j.mu.RUnlock()
+	return MarshalResource(p, r)
+}
+
+// setRetryAfter sets the Retry-After header of h to the interval at which the
+// status of j should be polled, unless it's done.
+func (j *Job) setRetryAfter(h http.Header) {
+	if j.retryAfter > 0 && !j.Status().done() {
+		h.Set("Retry-After", strconv.FormatInt(retryAfterSeconds(j.retryAfter), 10))
+	}
+}
+
+// Accepted returns a resource that will respond to the request which enqueued
+// the job with status code 202 Accepted, a Location header pointing to the
+// status resource of the job, and a Retry-After header.
+func (j *Job) Accepted() Resource {
+	return &acceptedJob{j}
+}
+
+type acceptedJob struct {
+	*Job
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (a *acceptedJob) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ct, b, err := Marshal(a.Job, r)
+	if err != nil {
+		writeError(err, w, r)
+		return
+	}
+	w.Header().Set("Content-Type", ct)
+	w.Header().Set("Location", a.url)
+	a.setRetryAfter(w.Header())
+	w.WriteHeader(http.StatusAccepted)
+	w.Write(b)
+}
+
+// jobStatus is the status resource of a job, responding with a Retry-After
+// header until the job is done, and with a Location header pointing to its
+// result once it has succeeded.
+type jobStatus struct {
+	*Job
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (s *jobStatus) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ct, b, err := Marshal(s.Job, r)
+	if err != nil {
+		writeError(err, w, r)
+		return
+	}
+	w.Header().Set("Content-Type", ct)
+	if s.Status() == JobSucceeded {
+		w.Header().Set("Location", s.url+"/result")
+	}
+	s.setRetryAfter(w.Header())
+	w.WriteHeader(http.StatusOK)
+	w.Write(b)
+}
+
+func (j *Job) run(runner JobRunner) {
+	defer close(j.done)
+
+	j.mu.Lock()
+	if j.status != JobPending {
+		j.mu.Unlock()
+		return
+	}
+	j.status = JobRunning
+	j.modified = time.Now()
+	j.mu.Unlock()
+
+	var (
+		result Resource
+		err    error
+	)
+	func() {
+		defer func() {
+			if recover() != nil {
+				err = InternalServerError(http.StatusText(http.StatusInternalServerError), "", false)
+			}
+		}()
+		result, err = runner.Run(j)
+	}()
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.status == JobCanceled {
+		return
+	}
+	j.result, j.err = result, err
+	j.modified = time.Now()
+	if err != nil {
+		j.status = JobFailed
+	} else {
+		j.status = JobSucceeded
+		j.progress = 1
+	}
+}
+
+// DefaultJobRetention is the default duration for which a JobQueue keeps
+// finished jobs.
+const DefaultJobRetention = time.Hour
+
+// DefaultJobRetryAfter is the default interval at which clients are told to
+// poll the status of the jobs of a JobQueue.
+const DefaultJobRetryAfter = 5 * time.Second
+
+// JobStore keeps the jobs of a JobQueue.
+type JobStore interface {
+	// Save records job. It's called when the job is enqueued, and each time
+	// it's done or canceled.
+	Save(job *Job) error
+
+	// Job returns the job with the given id, or nil.
+	Job(id string) (*Job, error)
+
+	// Delete forgets the job with the given id.
+	Delete(id string) error
+}
+
+// NewJobStore returns a JobStore keeping jobs in memory.
+func NewJobStore() JobStore {
+	return &memoryJobStore{jobs: make(map[string]*Job)}
+}
+
+type memoryJobStore struct {
+	mu   sync.RWMutex
+	jobs map[string]*Job
+}
+
+func (s *memoryJobStore) Save(job *Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs[job.id] = job
+	return nil
+}
+
+func (s *memoryJobStore) Job(id string) (*Job, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.jobs[id], nil
+}
+
+func (s *memoryJobStore) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.jobs, id)
+	return nil
+}
+
+/*
+JobQueue runs jobs enqueued by endpoints, and exposes their status in a mux.
+
+	queue := rst.NewJobQueue("/jobs")
+	mux.HandleJobQueue(queue)
+
+	mux.Post("/exports", func(vars rst.RouteVars, r *http.Request) (rst.Resource, string, error) {
+		job := queue.Enqueue(newExport(r))
+		return job.Accepted(), "", nil
+	})
+
+The status of a job is available at /jobs/{id}, with a Retry-After header
+until it's done, and its result at /jobs/{id}/result once it has succeeded. A
+DELETE request on /jobs/{id} cancels a job that is still running, or discards a
+job that is done.
+*/
+type JobQueue struct {
+	// Retention is the duration for which finished jobs are kept.
+	Retention time.Duration
+
+	// RetryAfter is the interval at which clients are told to poll the status
+	// of unfinished jobs. 0 means no Retry-After header.
+	RetryAfter time.Duration
+
+	// Store keeps the jobs of the queue, in memory by default.
+	Store JobStore
+
+	prefix string
+}
+
+// NewJobQueue returns a new queue whose jobs will be exposed under prefix.
+func NewJobQueue(prefix string) *JobQueue {
+	return &JobQueue{
+		Retention:  DefaultJobRetention,
+		RetryAfter: DefaultJobRetryAfter,
+		Store:      NewJobStore(),
+		prefix:     strings.TrimRight(prefix, "/"),
+	}
+}
+
+// Enqueue starts running runner in a new job. If the job can't be saved in the
+// store of q, it fails with the error of the store without running.
+func (q *JobQueue) Enqueue(runner JobRunner) *Job {
+	job, _ := q.enqueue(runner)
+	return job
+}
+
+// enqueue starts running runner in a new job, and returns the error of the
+// store if it fails to save it.
+func (q *JobQueue) enqueue(runner JobRunner) (*Job, error) {
+	b := make([]byte, 16)
+	rand.Read(b)
+	id := hex.EncodeToString(b)
+
+	now := time.Now()
+	job := &Job{
+		id:         id,
+		url:        q.prefix + "/" + id,
+		retryAfter: q.RetryAfter,
+		status:     JobPending,
+		created:    now,
+		modified:   now,
+		cancel:     make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+
+	if err := q.Store.Save(job); err != nil {
+		job.status, job.err = JobFailed, err
+		close(job.done)
+		return job, err
+	}
+
+	go func() {
+		job.run(runner)
+		q.Store.Save(job)
+		time.AfterFunc(q.Retention, func() { q.remove(id) })
+	}()
+	return job, nil
+}
+
+// Job returns the job with the given id, or nil.
+func (q *JobQueue) Job(id string) *Job {
+	job, _ := q.Store.Job(id)
+	return job
+}
+
+// job returns the job with the given id, or a NotFound error.
+func (q *JobQueue) job(id string) (*Job, error) {
+	job, err := q.Store.Job(id)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return nil, NotFound()
+	}
+	return job, nil
+}
+
+func (q *JobQueue) remove(id string) error {
+	return q.Store.Delete(id)
+}
+
+// HandleJobQueue registers the endpoints exposing the jobs of queue.
+func (s *Mux) HandleJobQueue(queue *JobQueue) {
+	s.Handle(queue.prefix+"/{id}", EndpointHandler(&jobEndpoint{queue}))
+	s.Handle(queue.prefix+"/{id}/result", EndpointHandler(&jobResultEndpoint{queue}))
+}
+
+type jobEndpoint struct {
+	queue *JobQueue
+}
+
+// Get returns the status of the job.
+func (e *jobEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	job, err := e.queue.job(vars.Get("id"))
+	if err != nil {
+		return nil, err
+	}
+	return &jobStatus{job}, nil
+}
+
+// Delete cancels the job if it's still running, or discards it.
+func (e *jobEndpoint) Delete(vars RouteVars, r *http.Request) error {
+	job, err := e.queue.job(vars.Get("id"))
+	if err != nil {
+		return err
+	}
+	if job.Status().done() {
+		return e.queue.remove(job.id)
+	}
+	job.Cancel()
+	return e.queue.Store.Save(job)
+}
+
+type jobResultEndpoint struct {
+	queue *JobQueue
+}
+
+// Get returns the result of the job.
+func (e *jobResultEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	job, err := e.queue.job(vars.Get("id"))
+	if err != nil {
+		return nil, err
+	}
+	switch job.Status() {
+	case JobSucceeded:
+		result, _ := job.Result()
+		return result, nil
+	case JobFailed:
+		_, err := job.Result()
+		if e, ok := err.(*Error); ok {
+			return nil, e
+		}
+		return nil, InternalServerError(http.StatusText(http.StatusInternalServerError), "", false)
+	}
+	notFound := NotFound()
+	notFound.Description = fmt.Sprintf("The job is %s, and has no result.", job.Status())
+	return nil, notFound
+}
@@ -0,0 +1,182 @@
+package rst
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestJobQueue(t *testing.T) {
+	queue := NewJobQueue("/jobs")
+	mux := NewMux()
+	mux.HandleJobQueue(queue)
+
+	var test = func(method, path string, expected int) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(method, "http://www.example.com"+path, nil)
+		r.Header.Set("Accept", "application/json")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != expected {
+			t.Fatalf("%s %s: Got: %d Wanted: %d", method, path, w.Code, expected)
+		}
+		return w
+	}
+	var status = func(job *Job) *jobProjection {
+		p := new(jobProjection)
+		if err := json.Unmarshal(test(Get, job.URL(), http.StatusOK).Body.Bytes(), p); err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+
+	release := make(chan struct{})
+	job := queue.Enqueue(JobFunc(func(job *Job) (Resource, error) {
+		job.SetProgress(0.5)
+		<-release
+		return testPeople[0], nil
+	}))
+	if !strings.HasPrefix(job.URL(), "/jobs/") {
+		t.Fatal("unexpected job URL:", job.URL())
+	}
+
+	for job.Progress() < 0.5 {
+		time.Sleep(time.Millisecond)
+	}
+	if p := status(job); p.Status != JobRunning || p.Progress != 0.5 || p.Result != "" {
+		t.Fatalf("unexpected status of running job: %s", p)
+	}
+	test(Get, job.URL()+"/result", http.StatusNotFound)
+
+	close(release)
+	<-job.Done()
+	p := status(job)
+	if p.Status != JobSucceeded || p.Progress != 1 || p.Result != job.URL()+"/result" {
+		t.Fatalf("unexpected status of finished job: %s", p)
+	}
+	b, _ := json.Marshal(testPeople[0])
+	if body := test(Get, p.Result, http.StatusOK).Body.String(); body != string(b) {
+		t.Fatal("Got:", body, "Wanted:", string(b))
+	}
+
+	test(Delete, job.URL(), http.StatusNoContent)
+	test(Get, job.URL(), http.StatusNotFound)
+	test(Get, "/jobs/blablabla", http.StatusNotFound)
+}
+
+func TestJobCancel(t *testing.T) {
+	queue := NewJobQueue("/jobs")
+	mux := NewMux()
+	mux.HandleJobQueue(queue)
+
+	job := queue.Enqueue(JobFunc(func(job *Job) (Resource, error) {
+		<-job.Canceled()
+		return testPeople[0], nil
+	}))
+
+	r, _ := http.NewRequest(Delete, "http://www.example.com"+job.URL(), nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if w.Code != http.StatusNoContent {
+		t.Fatal("Got:", w.Code, "Wanted:", http.StatusNoContent)
+	}
+
+	<-job.Done()
+	if status := job.Status(); status != JobCanceled {
+		t.Fatal("Got:", status, "Wanted:", JobCanceled)
+	}
+	if result, _ := job.Result(); result != nil {
+		t.Fatal("result of canceled job was not discarded")
+	}
+}
+
+func TestJobFailure(t *testing.T) {
+	queue := NewJobQueue("/jobs")
+	job := queue.Enqueue(JobFunc(func(job *Job) (Resource, error) {
+		panic("provoked panic")
+	}))
+	<-job.Done()
+	if status := job.Status(); status != JobFailed {
+		t.Fatal("Got:", status, "Wanted:", JobFailed)
+	}
+
+	job = queue.Enqueue(JobFunc(func(job *Job) (Resource, error) {
+		return nil, Conflict()
+	}))
+	<-job.Done()
+	if _, err := (&jobResultEndpoint{queue}).Get(RouteVars{"id": job.ID()}, nil); err == nil || err.(*Error).Code != http.StatusConflict {
+		t.Fatal("Got:", err, "Wanted: 409 error")
+	}
+}
+
+func TestJobAccepted(t *testing.T) {
+	queue := NewJobQueue("/jobs")
+	job := queue.Enqueue(JobFunc(func(job *Job) (Resource, error) {
+		return nil, nil
+	}))
+
+	r, _ := http.NewRequest(Post, "http://www.example.com/exports", nil)
+	w := httptest.NewRecorder()
+	writeResource(job.Accepted(), w, r)
+	if w.Code != http.StatusAccepted {
+		t.Fatal("Got:", w.Code, "Wanted:", http.StatusAccepted)
+	}
+	if location := w.Header().Get("Location"); location != job.URL() {
+		t.Fatal("Got:", location, "Wanted:", job.URL())
+	}
+}
+
+func TestJobStatusHeaders(t *testing.T) {
+	queue := NewJobQueue("/jobs")
+	mux := NewMux()
+	mux.HandleJobQueue(queue)
+
+	var test = func(path, retryAfter, location string) {
+		r, _ := http.NewRequest(Get, "http://www.example.com"+path, nil)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: Got: %d Wanted: %d", path, w.Code, http.StatusOK)
+		}
+		if got := w.Header().Get("Retry-After"); got != retryAfter {
+			t.Errorf("%s: Retry-After is %q, wanted %q", path, got, retryAfter)
+		}
+		if got := w.Header().Get("Location"); got != location {
+			t.Errorf("%s: Location is %q, wanted %q", path, got, location)
+		}
+	}
+
+	release := make(chan struct{})
+	job := queue.Enqueue(JobFunc(func(job *Job) (Resource, error) {
+		<-release
+		return testPeople[0], nil
+	}))
+	test(job.URL(), "5", "")
+	close(release)
+	<-job.Done()
+	test(job.URL(), "", job.URL()+"/result")
+}
+
+// failingJobStore is a JobStore failing to save jobs.
+type failingJobStore struct {
+	JobStore
+}
+
+func (s *failingJobStore) Save(job *Job) error {
+	return ServiceUnavailable(0)
+}
+
+func TestJobStoreFailure(t *testing.T) {
+	queue := NewJobQueue("/jobs")
+	queue.Store = &failingJobStore{NewJobStore()}
+	job := queue.Enqueue(JobFunc(func(job *Job) (Resource, error) {
+		t.Error("job was run")
+		return nil, nil
+	}))
+	<-job.Done()
+	if _, err := job.Result(); job.Status() != JobFailed || err == nil || err.(*Error).Code != http.StatusServiceUnavailable {
+		t.Fatal("Got:", job.Status(), err, "Wanted: failed job with a 503 error")
+	}
+}
@@ -0,0 +1,680 @@
+package rst
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+/*
+JSONEngine is implemented by JSON codecs that can replace encoding/json, such
+as jsoniter, go-json or sonic, which are API compatible.
+
+	import jsoniter "github.com/json-iterator/go"
+
+	mux.SetJSONEngine(jsoniter.ConfigCompatibleWithStandardLibrary)
+*/
+type JSONEngine interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// standardJSON is the JSONEngine based on encoding/json.
+type standardJSON struct{}
+
+func (standardJSON) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (standardJSON) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+// StandardJSON is the default JSONEngine, based on encoding/json.
+var StandardJSON JSONEngine = standardJSON{}
+
+// SetJSONEngine sets the codec used to encode resources in JSON in the
+// requests served by the mux. A nil value restores StandardJSON.
+func (s *Mux) SetJSONEngine(engine JSONEngine) {
+	s.jsonEngine = engine
+}
+
+// getJSONEngine returns the JSON engine of the mux serving r.
+func getJSONEngine(r *http.Request) JSONEngine {
+	if engine := getMux(r).jsonEngine; engine != nil {
+		return engine
+	}
+	return StandardJSON
+}
+
+// marshalJSON encodes v with the JSON engine and policy of the mux serving r.
+func marshalJSON(v interface{}, r *http.Request) ([]byte, error) {
+	return getMux(r).jsonPolicy.marshal(getJSONEngine(r), v)
+}
+
+// decorateJSON wraps b, the representation of resource, in an envelope or a
+// JSONP callback depending on the settings of the mux serving r, or adds the
+// meta member of JSON:API documents. It returns contentType and b unchanged if
+// b is not encoded in JSON.
+func decorateJSON(resource Resource, contentType string, b []byte, header http.Header, r *http.Request) (string, []byte, error) {
+	if contentType == JSONAPIType && len(b) > 0 {
+		b, err := decorateJSONAPI(b, header)
+		return contentType, b, err
+	}
+	if !isJSON(contentType) || len(b) == 0 {
+		return contentType, b, nil
+	}
+
+	var err error
+	if enveloped(r) {
+		if b, err = wrapData(b, resource, header, r); err != nil {
+			return "", nil, err
+		}
+		if b, err = prettyJSON(b, r); err != nil {
+			return "", nil, err
+		}
+	}
+
+	callback, err := jsonp(r)
+	if err != nil {
+		return "", nil, err
+	}
+	if callback != "" {
+		header.Set("X-Content-Type-Options", "nosniff")
+		return jsonpContentType, wrapJSONP(callback, b), nil
+	}
+	return contentType, b, nil
+}
+
+// NullPolicy controls how fields with a nil value are encoded by a JSONPolicy.
+type NullPolicy int
+
+// Null policies.
+const (
+	NullsAsTagged NullPolicy = iota // Fields are omitted if they're tagged with omitempty.
+	NullsOmitted                    // Nil fields are always omitted.
+	NullsExplicit                   // Nil fields are always encoded as null, even if they're tagged with omitempty.
+)
+
+/*
+JSONPolicy controls how MarshalResource encodes resources in JSON for the
+requests served by a mux. The zero value encodes resources exactly like
+encoding/json.
+
+	mux.SetJSONPolicy(&rst.JSONPolicy{
+		TimeFormat:   time.RFC1123,
+		Nulls:        rst.NullsExplicit,
+		NoHTMLEscape: true,
+		FieldName:    rst.SnakeCase,
+		Indent:       "  ",
+	})
+
+Types implementing json.Marshaler or encoding.TextMarshaler, other than
+time.Time, are encoded by their own methods and are not affected by the
+policy.
+
+Policies setting TimeFormat, Nulls or FieldName walk resources with reflection
+before handing values to the JSON engine, which is significantly slower than
+encoding them directly. They follow the rules of encoding/json for the string
+option of json tags, the fields promoted from embedded structs, and cycles.
+
+NoHTMLEscape is only honored by StandardJSON. Other engines should be
+configured to disable HTML escaping on their own.
+*/
+type JSONPolicy struct {
+	// TimeFormat is the layout used to encode time.Time values. Defaults to
+	// RFC 3339.
+	TimeFormat string
+
+	// Nulls controls whether struct fields with a nil value are omitted.
+	Nulls NullPolicy
+
+	// NoHTMLEscape disables the escaping of <, > and & in strings.
+	NoHTMLEscape bool
+
+	// FieldName transforms the name of struct fields which don't have an
+	// explicit name in their json tag.
+	FieldName func(name string) string
+
+	// Indent is used to indent the output, which is compacted if empty.
+	Indent string
+}
+
+// SetJSONPolicy sets the policy used to encode resources in JSON in the
+// requests served by the mux. A nil value restores the defaults of
+// encoding/json.
+func (s *Mux) SetJSONPolicy(policy *JSONPolicy) {
+	s.jsonPolicy = policy
+}
+
+// marshal returns the JSON encoding of v by engine according to p, which can
+// be nil.
+func (p *JSONPolicy) marshal(engine JSONEngine, v interface{}) ([]byte, error) {
+	if p == nil {
+		return engine.Marshal(v)
+	}
+
+	e := &jsonEncoder{JSONPolicy: p, engine: engine, buffer: getBuffer()}
+	defer putBuffer(e.buffer)
+	if p.TimeFormat == "" && p.Nulls == NullsAsTagged && p.FieldName == nil {
+		if err := e.encodeValue(v); err != nil {
+			return nil, err
+		}
+	} else if err := e.encode(reflect.ValueOf(v)); err != nil {
+		return nil, err
+	}
+
+	if p.Indent == "" {
+		return append([]byte(nil), e.buffer.Bytes()...), nil
+	}
+	indented := getBuffer()
+	if err := json.Indent(indented, e.buffer.Bytes(), "", p.Indent); err != nil {
+		putBuffer(indented)
+		return nil, err
+	}
+	return detachBuffer(indented), nil
+}
+
+// jsonEncoder writes values in buffer according to a policy.
+type jsonEncoder struct {
+	*JSONPolicy
+	engine JSONEngine
+	buffer *bytes.Buffer
+
+	// Pointers, maps and slices being encoded, once they're nested deeply
+	// enough to possibly be a cycle.
+	level int
+	seen  map[interface{}]bool
+}
+
+// startDetectingCyclesAfter is the nesting level of pointers, maps and slices
+// from which cycles are detected, as in encoding/json.
+const startDetectingCyclesAfter = 1000
+
+// encodeValue writes v in the buffer using the engine.
+func (e *jsonEncoder) encodeValue(v interface{}) error {
+	if _, standard := e.engine.(standardJSON); !standard {
+		b, err := e.engine.Marshal(v)
+		if err != nil {
+			return err
+		}
+		e.buffer.Write(b)
+		return nil
+	}
+
+	encoder := json.NewEncoder(e.buffer)
+	encoder.SetEscapeHTML(!e.NoHTMLEscape)
+	if err := encoder.Encode(v); err != nil {
+		return err
+	}
+	e.buffer.Truncate(e.buffer.Len() - 1) // Encode always adds a new line.
+	return nil
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// marshals returns true if t encodes itself with json.Marshaler or
+// encoding.TextMarshaler.
+func marshals(t reflect.Type) bool {
+	return t.Implements(jsonMarshalerType) || t.Implements(textMarshalerType)
+}
+
+// encode walks v to write it in the buffer according to the policy.
+func (e *jsonEncoder) encode(v reflect.Value) error {
+	if !v.IsValid() {
+		e.buffer.WriteString("null")
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice:
+		if v.IsNil() {
+			break
+		}
+		if e.level++; e.level > startDetectingCyclesAfter {
+			var key interface{} = v.Pointer()
+			if v.Kind() == reflect.Slice {
+				key = struct {
+					ptr uintptr
+					len int
+				}{v.Pointer(), v.Len()}
+			}
+			if e.seen[key] {
+				return &json.UnsupportedValueError{Value: v, Str: fmt.Sprintf("encountered a cycle via %s", v.Type())}
+			}
+			if e.seen == nil {
+				e.seen = make(map[interface{}]bool)
+			}
+			e.seen[key] = true
+			defer delete(e.seen, key)
+		}
+		defer func() { e.level-- }()
+	}
+
+	if v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			e.buffer.WriteString("null")
+			return nil
+		}
+		// Pointers are only encoded by their own methods when the type they
+		// point to can't be.
+		if v.Kind() == reflect.Interface || !marshals(v.Type()) || marshals(v.Type().Elem()) {
+			return e.encode(v.Elem())
+		}
+		return e.encodeValue(v.Interface())
+	}
+
+	if v.Type() == timeType && e.TimeFormat != "" {
+		return e.encodeValue(v.Interface().(time.Time).Format(e.TimeFormat))
+	}
+	if marshals(v.Type()) {
+		return e.encodeValue(v.Interface())
+	}
+	if v.CanAddr() && marshals(v.Addr().Type()) {
+		return e.encodeValue(v.Addr().Interface())
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		return e.encodeStruct(v)
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return e.encodeValue(v.Interface())
+		}
+		if v.IsNil() {
+			e.buffer.WriteString("null")
+			return nil
+		}
+		var keys []string
+		for _, key := range v.MapKeys() {
+			keys = append(keys, key.String())
+		}
+		sort.Strings(keys)
+		e.buffer.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				e.buffer.WriteByte(',')
+			}
+			e.encodeValue(key)
+			e.buffer.WriteByte(':')
+			if err := e.encode(v.MapIndex(reflect.ValueOf(key).Convert(v.Type().Key()))); err != nil {
+				return err
+			}
+		}
+		e.buffer.WriteByte('}')
+		return nil
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return e.encodeValue(v.Interface()) // base64
+		}
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			e.buffer.WriteString("null")
+			return nil
+		}
+		e.buffer.WriteByte('[')
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				e.buffer.WriteByte(',')
+			}
+			if err := e.encode(v.Index(i)); err != nil {
+				return err
+			}
+		}
+		e.buffer.WriteByte(']')
+		return nil
+	}
+	return e.encodeValue(v.Interface())
+}
+
+// jsonField is a struct field to encode.
+type jsonField struct {
+	name      string
+	index     []int // Index sequence of the field, through embedded structs.
+	tagged    bool  // True if the name of the field is set by its json tag.
+	omitEmpty bool
+	quoted    bool // True if the field is tagged with the string option.
+}
+
+// fields returns the fields of the struct type t encoded by encoding/json: its
+// exported fields, and the fields of embedded structs without a json tag,
+// unless they're hidden by a field of the same name at a shallower depth.
+func (p *JSONPolicy) fields(t reflect.Type) []*jsonField {
+	var all []*jsonField
+	p.collectFields(t, nil, map[reflect.Type]bool{}, &all)
+
+	// A name is given to the shallowest fields, and among them to the tagged
+	// one. Fields of the same name which remain ambiguous are all dropped, as
+	// encoding/json does.
+	byName := make(map[string][]*jsonField)
+	for _, f := range all {
+		byName[f.name] = append(byName[f.name], f)
+	}
+	var fields []*jsonField
+	for _, f := range all {
+		if dominant(byName[f.name]) == f {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// collectFields appends the fields of t to fields, in the order of their
+// index sequence, with the fields of embedded structs not yet visited.
+func (p *JSONPolicy) collectFields(t reflect.Type, index []int, visited map[reflect.Type]bool, fields *[]*jsonField) {
+	if visited[t] {
+		return
+	}
+	visited[t] = true
+	defer delete(visited, t)
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options := tag, ""
+		if i := strings.Index(tag, ","); i >= 0 {
+			name, options = tag[:i], tag[i+1:]
+		}
+		fieldIndex := append(append([]int(nil), index...), i)
+
+		ft := sf.Type
+		if ft.Name() == "" && ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if sf.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			p.collectFields(ft, fieldIndex, visited, fields)
+			continue
+		}
+		if sf.PkgPath != "" {
+			continue // unexported
+		}
+
+		field := &jsonField{
+			name:      name,
+			index:     fieldIndex,
+			tagged:    name != "",
+			omitEmpty: strings.Contains(","+options+",", ",omitempty,"),
+		}
+		if strings.Contains(","+options+",", ",string,") {
+			switch ft.Kind() {
+			case reflect.Bool, reflect.String,
+				reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+				reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+				reflect.Float32, reflect.Float64:
+				field.quoted = true
+			}
+		}
+		if name == "" {
+			field.name = sf.Name
+			if p.FieldName != nil {
+				field.name = p.FieldName(sf.Name)
+			}
+		}
+		*fields = append(*fields, field)
+	}
+}
+
+// dominant returns the field of fields, which share the same name, that is
+// encoded, or nil if none dominates the others.
+func dominant(fields []*jsonField) *jsonField {
+	var shallowest []*jsonField
+	for _, f := range fields {
+		switch {
+		case len(shallowest) == 0 || len(f.index) < len(shallowest[0].index):
+			shallowest = []*jsonField{f}
+		case len(f.index) == len(shallowest[0].index):
+			shallowest = append(shallowest, f)
+		}
+	}
+	if len(shallowest) == 1 {
+		return shallowest[0]
+	}
+	var tagged *jsonField
+	for _, f := range shallowest {
+		if f.tagged {
+			if tagged != nil {
+				return nil
+			}
+			tagged = f
+		}
+	}
+	return tagged
+}
+
+// fieldValue returns the value of field in the struct v, or false if it's
+// promoted from a nil embedded pointer.
+func fieldValue(v reflect.Value, field *jsonField) (reflect.Value, bool) {
+	for i, index := range field.index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(index)
+	}
+	return v, true
+}
+
+func (e *jsonEncoder) encodeStruct(v reflect.Value) error {
+	e.buffer.WriteByte('{')
+	first := true
+	for _, field := range e.fields(v.Type()) {
+		fv, reachable := fieldValue(v, field)
+		if !reachable || e.omit(field, fv) {
+			continue
+		}
+		if !first {
+			e.buffer.WriteByte(',')
+		}
+		first = false
+		e.encodeValue(field.name)
+		e.buffer.WriteByte(':')
+		if field.quoted {
+			if err := e.encodeQuoted(fv); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := e.encode(fv); err != nil {
+			return err
+		}
+	}
+	e.buffer.WriteByte('}')
+	return nil
+}
+
+// encodeQuoted writes v, a value of a field with the string option, as a JSON
+// string holding its encoding, like encoding/json.
+func (e *jsonEncoder) encodeQuoted(v reflect.Value) error {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			e.buffer.WriteString("null")
+			return nil
+		}
+		v = v.Elem()
+	}
+	if marshals(v.Type()) || (v.CanAddr() && marshals(v.Addr().Type())) {
+		return e.encode(v)
+	}
+	start := e.buffer.Len()
+	if err := e.encodeValue(v.Interface()); err != nil {
+		return err
+	}
+	encoded := string(e.buffer.Bytes()[start:])
+	e.buffer.Truncate(start)
+	return e.encodeValue(encoded)
+}
+
+// omit returns true if field, whose value is v, should not be encoded.
+func (p *JSONPolicy) omit(field *jsonField, v reflect.Value) bool {
+	isNil := false
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+		isNil = v.IsNil()
+	}
+
+	switch {
+	case isNil && p.Nulls == NullsOmitted:
+		return true
+	case isNil && p.Nulls == NullsExplicit:
+		return false
+	case !field.omitEmpty:
+		return false
+	}
+
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
+
+// unmarshal decodes b, a JSON document encoded according to p, in v with
+// engine. Field names and times are mapped back to the ones expected by
+// encoding/json for the type of v.
+func (p *JSONPolicy) unmarshal(engine JSONEngine, b []byte, v interface{}) error {
+	if p != nil && (p.TimeFormat != "" || p.FieldName != nil) {
+		var doc interface{}
+		if err := decodeJSON(b, &doc); err != nil {
+			return err
+		}
+		reverted, err := json.Marshal(p.revert(doc, reflect.TypeOf(v)))
+		if err != nil {
+			return err
+		}
+		b = reverted
+	}
+	return engine.Unmarshal(b, v)
+}
+
+// revert returns doc, the decoded JSON encoding of a value of type t according
+// to p, with the field names and times of encoding/json.
+func (p *JSONPolicy) revert(doc interface{}, t reflect.Type) interface{} {
+	for t.Kind() == reflect.Ptr && (!marshals(t) || marshals(t.Elem())) {
+		t = t.Elem()
+	}
+	if t == timeType && p.TimeFormat != "" {
+		if s, ok := doc.(string); ok {
+			if parsed, err := time.Parse(p.TimeFormat, s); err == nil {
+				return parsed.Format(time.RFC3339Nano)
+			}
+		}
+		return doc
+	}
+	if marshals(t) || marshals(reflect.PtrTo(t)) {
+		return doc
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		object, ok := doc.(map[string]interface{})
+		if !ok {
+			return doc
+		}
+		// Fields are matched by their index through embedded structs.
+		standard := make(map[string]string)
+		for _, field := range (&JSONPolicy{}).fields(t) {
+			standard[fmt.Sprint(field.index)] = field.name
+		}
+		fields := make(map[string]*jsonField)
+		for _, field := range p.fields(t) {
+			if _, known := standard[fmt.Sprint(field.index)]; known {
+				fields[field.name] = field
+			}
+		}
+		reverted := make(map[string]interface{}, len(object))
+		for name, value := range object {
+			if field, found := fields[name]; found {
+				reverted[standard[fmt.Sprint(field.index)]] = p.revert(value, t.FieldByIndex(field.index).Type)
+			} else {
+				reverted[name] = value
+			}
+		}
+		return reverted
+	case reflect.Map:
+		object, ok := doc.(map[string]interface{})
+		if !ok || t.Key().Kind() != reflect.String {
+			return doc
+		}
+		for key, value := range object {
+			object[key] = p.revert(value, t.Elem())
+		}
+	case reflect.Slice, reflect.Array:
+		array, ok := doc.([]interface{})
+		if !ok || t.Elem().Kind() == reflect.Uint8 {
+			return doc
+		}
+		for i, value := range array {
+			array[i] = p.revert(value, t.Elem())
+		}
+	}
+	return doc
+}
+
+// words splits name on case boundaries, so that "UserID" returns "User" and
+// "ID", and "URLPath" returns "URL" and "Path".
+func words(name string) []string {
+	var (
+		result []string
+		runes  = []rune(name)
+		start  = 0
+	)
+	for i := 1; i < len(runes); i++ {
+		if !unicode.IsUpper(runes[i]) {
+			continue
+		}
+		if !unicode.IsUpper(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			result = append(result, string(runes[start:i]))
+			start = i
+		}
+	}
+	return append(result, string(runes[start:]))
+}
+
+// CamelCase transforms the name of a Go field into lower camel case. It can be
+// used as the FieldName of a JSONPolicy to encode "UserID" as "userId".
+func CamelCase(name string) string {
+	parts := words(name)
+	for i, part := range parts {
+		if i == 0 {
+			parts[i] = strings.ToLower(part)
+		} else {
+			parts[i] = strings.ToUpper(part[:1]) + strings.ToLower(part[1:])
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// SnakeCase transforms the name of a Go field into snake case. It can be used
+// as the FieldName of a JSONPolicy to encode "UserID" as "user_id".
+func SnakeCase(name string) string {
+	parts := words(name)
+	for i, part := range parts {
+		parts[i] = strings.ToLower(part)
+	}
+	return strings.Join(parts, "_")
+}
@@ -0,0 +1,80 @@
+//go:build jsonbench
+// +build jsonbench
+
+// Benchmarks comparing the JSON engines which can be set in a mux. They
+// require third-party packages and only run with the jsonbench tag:
+//
+// 	go test -tags jsonbench -run NONE -bench JSONEngine
+
+package rst
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bytedance/sonic"
+	gojson "github.com/goccy/go-json"
+	jsoniter "github.com/json-iterator/go"
+)
+
+type goJSON struct{}
+
+func (goJSON) Marshal(v interface{}) ([]byte, error)      { return gojson.Marshal(v) }
+func (goJSON) Unmarshal(data []byte, v interface{}) error { return gojson.Unmarshal(data, v) }
+
+type benchmarkPerson struct {
+	ID        string    `json:"id"`
+	Firstname string    `json:"firstname"`
+	Lastname  string    `json:"lastname"`
+	Email     string    `json:"email,omitempty"`
+	Birthday  time.Time `json:"birthday"`
+	Tags      []string  `json:"tags"`
+	Score     float64   `json:"score"`
+}
+
+func benchmarkJSONEngine(b *testing.B, engine JSONEngine, policy *JSONPolicy) {
+	people := make([]*benchmarkPerson, 100)
+	for i := range people {
+		people[i] = &benchmarkPerson{
+			ID:        "a1-b2-c3-d4-e5-f6",
+			Firstname: "Francis",
+			Lastname:  "Underwood",
+			Email:     "frank@example.com",
+			Birthday:  time.Date(1959, time.November, 5, 0, 0, 0, 0, time.UTC),
+			Tags:      []string{"politician", "democrat"},
+			Score:     float64(i) / 3,
+		}
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := policy.marshal(engine, people); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkJSONEngineStandard(b *testing.B) {
+	benchmarkJSONEngine(b, StandardJSON, nil)
+}
+
+func BenchmarkJSONEngineJsoniter(b *testing.B) {
+	benchmarkJSONEngine(b, jsoniter.ConfigCompatibleWithStandardLibrary, nil)
+}
+
+func BenchmarkJSONEngineGoJSON(b *testing.B) {
+	benchmarkJSONEngine(b, goJSON{}, nil)
+}
+
+func BenchmarkJSONEngineSonic(b *testing.B) {
+	benchmarkJSONEngine(b, sonic.ConfigStd, nil)
+}
+
+func BenchmarkJSONEngineStandardWithPolicy(b *testing.B) {
+	benchmarkJSONEngine(b, StandardJSON, &JSONPolicy{FieldName: SnakeCase})
+}
+
+func BenchmarkJSONEngineJsoniterWithPolicy(b *testing.B) {
+	benchmarkJSONEngine(b, jsoniter.ConfigCompatibleWithStandardLibrary, &JSONPolicy{FieldName: SnakeCase})
+}
@@ -0,0 +1,212 @@
+package rst
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type jsonPolicyBase struct {
+	UserID string
+}
+
+type jsonPolicyResource struct {
+	jsonPolicyBase
+	FirstName string     `json:",omitempty"`
+	Email     string     `json:"email"`
+	Created   time.Time  `json:"created"`
+	Deleted   *time.Time `json:"deleted,omitempty"`
+	Tags      []string
+	Bio       string
+	Secret    string `json:"-"`
+}
+
+func TestJSONPolicy(t *testing.T) {
+	created := time.Date(2015, time.January, 2, 3, 4, 5, 0, time.UTC)
+	resource := &jsonPolicyResource{
+		jsonPolicyBase: jsonPolicyBase{"u1"},
+		FirstName:      "Francis",
+		Email:          "frank@example.com",
+		Created:        created,
+		Bio:            "<b>",
+		Secret:         "s3cr3t",
+	}
+
+	var test = func(policy *JSONPolicy, expected string) {
+		b, err := policy.marshal(StandardJSON, resource)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != expected {
+			t.Errorf("\nGot:    %s\nWanted: %s", b, expected)
+		}
+	}
+
+	test(nil, `{"UserID":"u1","FirstName":"Francis","email":"frank@example.com","created":"2015-01-02T03:04:05Z","Tags":null,"Bio":"\u003cb\u003e"}`)
+	test(&JSONPolicy{}, `{"UserID":"u1","FirstName":"Francis","email":"frank@example.com","created":"2015-01-02T03:04:05Z","Tags":null,"Bio":"\u003cb\u003e"}`)
+	test(&JSONPolicy{NoHTMLEscape: true, FieldName: SnakeCase}, `{"user_id":"u1","first_name":"Francis","email":"frank@example.com","created":"2015-01-02T03:04:05Z","tags":null,"bio":"<b>"}`)
+	test(&JSONPolicy{FieldName: CamelCase, Nulls: NullsOmitted}, `{"userId":"u1","firstName":"Francis","email":"frank@example.com","created":"2015-01-02T03:04:05Z","bio":"\u003cb\u003e"}`)
+	test(&JSONPolicy{TimeFormat: time.RFC1123, Nulls: NullsExplicit}, `{"UserID":"u1","FirstName":"Francis","email":"frank@example.com","created":"Fri, 02 Jan 2015 03:04:05 UTC","deleted":null,"Tags":null,"Bio":"\u003cb\u003e"}`)
+
+	deleted := created.Add(time.Hour)
+	resource.Deleted = &deleted
+	test(&JSONPolicy{TimeFormat: time.Kitchen, Nulls: NullsOmitted}, `{"UserID":"u1","FirstName":"Francis","email":"frank@example.com","created":"3:04AM","deleted":"4:04AM","Bio":"\u003cb\u003e"}`)
+
+	test(&JSONPolicy{Indent: "  ", Nulls: NullsOmitted, FieldName: SnakeCase, NoHTMLEscape: true}, `{
+  "user_id": "u1",
+  "first_name": "Francis",
+  "email": "frank@example.com",
+  "created": "2015-01-02T03:04:05Z",
+  "deleted": "2015-01-02T04:04:05Z",
+  "bio": "<b>"
+}`)
+}
+
+type jsonQuoted struct {
+	Count   int        `json:",string"`
+	Ratio   *float64   `json:"ratio,string"`
+	Name    string     `json:"name,string"`
+	Flag    bool       `json:"flag,string,omitempty"`
+	Missing *int       `json:",string"`
+	Date    time.Time  `json:"date,string"`
+	Tags    []string   `json:"tags,string"`
+	Inner   jsonShared `json:",string"`
+}
+
+type jsonShared struct {
+	Name   string
+	Shared string
+	Tagged string `json:"Both"`
+}
+
+type jsonOther struct {
+	Shared string
+	Both   string
+}
+
+type jsonExtra struct {
+	Extra string
+}
+
+type jsonDominance struct {
+	jsonShared
+	*jsonOther
+	*jsonExtra
+	Name string
+}
+
+type jsonNode struct {
+	Name string
+	Next *jsonNode
+}
+
+func TestJSONPolicyCompatibility(t *testing.T) {
+	ratio := 0.5
+	cycle := &jsonNode{Name: "a"}
+	cycle.Next = &jsonNode{Name: "b", Next: cycle}
+	identity := func(name string) string { return name }
+
+	for i, v := range []interface{}{
+		&jsonQuoted{Count: 42, Ratio: &ratio, Name: "<b>", Flag: true, Date: time.Date(2015, time.January, 2, 3, 4, 5, 6, time.UTC), Tags: []string{"a"}},
+		&jsonQuoted{},
+		&jsonDominance{jsonShared: jsonShared{"inner", "shared", "tagged"}, Name: "outer"},
+		&jsonDominance{jsonOther: &jsonOther{"other", "both"}, jsonExtra: &jsonExtra{"extra"}},
+		&jsonNode{Name: "a", Next: &jsonNode{Name: "b"}},
+		cycle,
+	} {
+		expected, expectedErr := json.Marshal(v)
+		for _, policy := range []*JSONPolicy{{TimeFormat: time.RFC3339Nano}, {FieldName: identity}} {
+			b, err := policy.marshal(StandardJSON, v)
+			if expectedErr != nil {
+				if err == nil || err.Error() != expectedErr.Error() {
+					t.Errorf("%d: Got error: %v Wanted: %v", i, err, expectedErr)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("%d: %v", i, err)
+			}
+			if string(b) != string(expected) {
+				t.Errorf("%d:\nGot:    %s\nWanted: %s", i, b, expected)
+			}
+		}
+	}
+}
+
+func TestFieldNameCasing(t *testing.T) {
+	for name, expected := range map[string][2]string{
+		"Name":      {"name", "name"},
+		"ID":        {"id", "id"},
+		"UserID":    {"userId", "user_id"},
+		"URLPath":   {"urlPath", "url_path"},
+		"FirstName": {"firstName", "first_name"},
+		"HTTP2Push": {"http2Push", "http2_push"},
+	} {
+		if got := CamelCase(name); got != expected[0] {
+			t.Errorf("CamelCase(%s) Got: %s Wanted: %s", name, got, expected[0])
+		}
+		if got := SnakeCase(name); got != expected[1] {
+			t.Errorf("SnakeCase(%s) Got: %s Wanted: %s", name, got, expected[1])
+		}
+	}
+}
+
+func TestMuxJSONPolicy(t *testing.T) {
+	mux := NewMux()
+	mux.SetJSONPolicy(&JSONPolicy{FieldName: SnakeCase})
+	mux.Get("/resource", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(&jsonPolicyBase{"u1"}, time.Now(), "etag", 0), nil
+	})
+
+	r, _ := http.NewRequest(Get, "http://example.com/resource", nil)
+	r.Header.Set("Accept", "application/json")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if expected := `{"user_id":"u1"}`; w.Body.String() != expected {
+		t.Errorf("Got: %s Wanted: %s", w.Body.String(), expected)
+	}
+}
+
+// upperJSON is a JSONEngine encoding strings in upper case.
+type upperJSON struct{}
+
+func (upperJSON) Marshal(v interface{}) ([]byte, error) {
+	if s, ok := v.(string); ok {
+		v = strings.ToUpper(s)
+	}
+	return StandardJSON.Marshal(v)
+}
+
+func (upperJSON) Unmarshal(data []byte, v interface{}) error {
+	return StandardJSON.Unmarshal(data, v)
+}
+
+func TestJSONEngine(t *testing.T) {
+	mux := NewMux()
+	mux.Get("/resource", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(&jsonPolicyBase{"u1"}, time.Now(), "etag", 0), nil
+	})
+
+	var test = func(expected string) {
+		r, _ := http.NewRequest(Get, "http://example.com/resource", nil)
+		r.Header.Set("Accept", "application/json")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Body.String() != expected {
+			t.Errorf("Got: %s Wanted: %s", w.Body.String(), expected)
+		}
+	}
+
+	mux.SetJSONEngine(upperJSON{})
+	test(`{"UserID":"u1"}`)
+
+	// Values are encoded by the engine when a policy walks the resource.
+	mux.SetJSONPolicy(&JSONPolicy{FieldName: SnakeCase})
+	test(`{"USER_ID":"U1"}`)
+
+	mux.SetJSONEngine(nil)
+	test(`{"user_id":"u1"}`)
+}
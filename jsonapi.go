@@ -0,0 +1,273 @@
+package rst
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// JSONAPIType is the media type of JSON:API documents.
+const JSONAPIType = "application/vnd.api+json"
+
+/*
+JSONAPIIdentifier is implemented by resources to set the type and the id of
+their JSON:API resource object. By default, the type is the name of the Go type
+of the resource in snake case, and the id is the id member of its JSON
+representation.
+
+When application/vnd.api+json is negotiated, resources are encoded as JSON:API
+documents whose primary data is the resource object of the resource, or an
+array of resource objects for collections:
+
+	{"data":{"type":"people","id":"1","attributes":{"name":"Francis Underwood"},"links":{"self":"/people/1"}}}
+
+The members of the JSON representation of the resource, other than id and
+type, are its attributes. The relationships of resources implementing
+JSONAPIRelator and the links of resources implementing Linker are rendered in
+their resource object. Documents of range and paginated requests have a meta
+member with the total number of units of the resource:
+
+	{"data":[...],"meta":{"total":120}}
+*/
+type JSONAPIIdentifier interface {
+	JSONAPIType() string
+	JSONAPIID() string
+}
+
+// JSONAPIRelator is implemented by resources with relationships to other
+// resources, indexed by name. Values are resources, collections of resources,
+// or nil for empty to-one relationships, and are rendered as resource
+// identifiers.
+type JSONAPIRelator interface {
+	Relationships(r *http.Request) map[string]interface{}
+}
+
+// jsonAPIObject is a resource object, or a resource identifier when only its
+// type and id are set.
+type jsonAPIObject struct {
+	Type          string                         `json:"type"`
+	ID            string                         `json:"id,omitempty"`
+	Attributes    json.RawMessage                `json:"attributes,omitempty"`
+	Relationships map[string]jsonAPIRelationship `json:"relationships,omitempty"`
+	Links         map[string]string              `json:"links,omitempty"`
+}
+
+type jsonAPIRelationship struct {
+	Data json.RawMessage `json:"data"`
+}
+
+// marshalJSONAPI encodes resource as the primary data of a JSON:API
+// document. The meta member is added by decorateJSONAPI.
+func marshalJSONAPI(resource interface{}, r *http.Request) ([]byte, error) {
+	var data interface{}
+	if v := reflect.Indirect(reflect.ValueOf(resource)); v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+		objects := make([]*jsonAPIObject, v.Len())
+		for i := range objects {
+			object, err := newJSONAPIObject(v.Index(i).Interface(), r)
+			if err != nil {
+				return nil, err
+			}
+			objects[i] = object
+		}
+		data = objects
+	} else {
+		object, err := newJSONAPIObject(resource, r)
+		if err != nil {
+			return nil, err
+		}
+		data = object
+	}
+	return json.Marshal(map[string]interface{}{"data": data})
+}
+
+// newJSONAPIObject returns the resource object of resource.
+func newJSONAPIObject(resource interface{}, r *http.Request) (*jsonAPIObject, error) {
+	b, err := marshalJSON(resource, r)
+	if err != nil {
+		return nil, err
+	}
+	object := &jsonAPIObject{}
+	id, attributes, err := jsonAPIAttributes(b)
+	if err != nil {
+		return nil, err
+	}
+	if len(attributes) > 2 {
+		object.Attributes = attributes
+	}
+	if object.Type, object.ID, err = jsonAPIIdentity(resource, id); err != nil {
+		return nil, err
+	}
+
+	if relator, implemented := resource.(JSONAPIRelator); implemented {
+		object.Relationships = make(map[string]jsonAPIRelationship)
+		for name, related := range relator.Relationships(r) {
+			linkage, err := jsonAPILinkage(related)
+			if err != nil {
+				return nil, err
+			}
+			object.Relationships[name] = jsonAPIRelationship{linkage}
+		}
+	}
+
+	for _, link := range linksOf(resource, r) {
+		if object.Links == nil {
+			object.Links = make(map[string]string)
+		}
+		if _, found := object.Links[link.Rel]; !found {
+			object.Links[link.Rel] = link.Href
+		}
+	}
+	return object, nil
+}
+
+// jsonAPIAttributes returns the id member of b, a JSON object, and the object
+// of its other members, keeping their order.
+func jsonAPIAttributes(b []byte) (string, json.RawMessage, error) {
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 || b[0] != '{' {
+		return "", nil, NotAcceptable()
+	}
+
+	var id string
+	var buffer bytes.Buffer
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	if _, err := decoder.Token(); err != nil {
+		return "", nil, err
+	}
+	buffer.WriteByte('{')
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", nil, err
+		}
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return "", nil, err
+		}
+		switch key, _ := token.(string); key {
+		case "id":
+			var s string
+			if json.Unmarshal(value, &s) != nil {
+				s = string(value)
+			}
+			id = s
+		case "type":
+		default:
+			if buffer.Len() > 1 {
+				buffer.WriteByte(',')
+			}
+			name, _ := json.Marshal(key)
+			buffer.Write(name)
+			buffer.WriteByte(':')
+			buffer.Write(value)
+		}
+	}
+	buffer.WriteByte('}')
+	return id, buffer.Bytes(), nil
+}
+
+// jsonAPIIdentity returns the type and the id of resource, whose JSON
+// representation has the id member id.
+func jsonAPIIdentity(resource interface{}, id string) (string, string, error) {
+	if identifier, implemented := resource.(JSONAPIIdentifier); implemented {
+		return identifier.JSONAPIType(), identifier.JSONAPIID(), nil
+	}
+	t := reflect.TypeOf(resource)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Name() == "" {
+		return "", "", NotAcceptable()
+	}
+	return SnakeCase(t.Name()), id, nil
+}
+
+// jsonAPILinkage returns the resource identifiers of related.
+func jsonAPILinkage(related interface{}) (json.RawMessage, error) {
+	if related == nil {
+		return json.RawMessage("null"), nil
+	}
+	v := reflect.ValueOf(related)
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return json.RawMessage("null"), nil
+	}
+	if v := reflect.Indirect(v); v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+		identifiers := make([]*jsonAPIObject, v.Len())
+		for i := range identifiers {
+			identifier, err := jsonAPIIdentifierOf(v.Index(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+			identifiers[i] = identifier
+		}
+		return json.Marshal(identifiers)
+	}
+	identifier, err := jsonAPIIdentifierOf(related)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(identifier)
+}
+
+// jsonAPIIdentifierOf returns the resource identifier of resource.
+func jsonAPIIdentifierOf(resource interface{}) (*jsonAPIObject, error) {
+	b, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	id, _, err := jsonAPIAttributes(b)
+	if err != nil {
+		return nil, err
+	}
+	t, id, err := jsonAPIIdentity(resource, id)
+	if err != nil {
+		return nil, err
+	}
+	return &jsonAPIObject{Type: t, ID: id}, nil
+}
+
+// decorateJSONAPI adds a meta member to b, a JSON:API document, with the total
+// number of units of the resource when header has a Content-Range or an
+// X-Total-Count header.
+func decorateJSONAPI(b []byte, header http.Header) ([]byte, error) {
+	var total uint64
+	if cr, err := ParseContentRange(header.Get("Content-Range")); err == nil && cr.Total > 0 {
+		total = cr.Total
+	} else if n, err := strconv.ParseUint(header.Get(TotalCountHeader), 10, 64); err == nil {
+		total = n
+	} else {
+		return b, nil
+	}
+
+	var document map[string]json.RawMessage
+	if err := json.Unmarshal(b, &document); err != nil {
+		return nil, err
+	}
+	meta, _ := json.Marshal(map[string]uint64{"total": total})
+	document["meta"] = meta
+
+	// Members are written in a stable order, with data first.
+	keys := make([]string, 0, len(document))
+	for key := range document {
+		if key != "data" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	var buffer bytes.Buffer
+	buffer.WriteString(`{"data":`)
+	buffer.Write(document["data"])
+	for _, key := range keys {
+		name, _ := json.Marshal(key)
+		buffer.WriteByte(',')
+		buffer.Write(name)
+		buffer.WriteByte(':')
+		buffer.Write(document[key])
+	}
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
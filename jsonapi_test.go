@@ -0,0 +1,94 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type jsonAPICompany struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type jsonAPIPerson struct {
+	ID       string           `json:"id"`
+	Name     string           `json:"name"`
+	Employer *jsonAPICompany  `json:"-"`
+	Friends  []*jsonAPIPerson `json:"-"`
+}
+
+func (p *jsonAPIPerson) JSONAPIType() string { return "people" }
+func (p *jsonAPIPerson) JSONAPIID() string   { return p.ID }
+
+func (p *jsonAPIPerson) Relationships(r *http.Request) map[string]interface{} {
+	return map[string]interface{}{"employer": p.Employer, "friends": p.Friends}
+}
+
+func (p *jsonAPIPerson) Links(r *http.Request) []Link {
+	return []Link{{Rel: "self", Href: "/people/" + p.ID}}
+}
+
+// jsonAPIPeople is a collection of people.
+type jsonAPIPeople []*jsonAPIPerson
+
+func (c jsonAPIPeople) Count() uint64           { return uint64(len(c)) }
+func (c jsonAPIPeople) Units() []string         { return []string{"resources"} }
+func (c jsonAPIPeople) LastModified() time.Time { return testTimeReference }
+func (c jsonAPIPeople) ETag() string            { return "people" }
+func (c jsonAPIPeople) TTL() time.Duration      { return 0 }
+func (c jsonAPIPeople) Range(rg *Range) (*ContentRange, Resource, error) {
+	return &ContentRange{rg, c.Count()}, c[rg.From : rg.To+1], nil
+}
+
+var (
+	testJSONAPIClaire  = &jsonAPIPerson{ID: "2", Name: "Claire Underwood"}
+	testJSONAPIFrancis = &jsonAPIPerson{
+		ID:       "1",
+		Name:     "Francis Underwood",
+		Employer: &jsonAPICompany{3, "The White House"},
+		Friends:  []*jsonAPIPerson{testJSONAPIClaire},
+	}
+)
+
+func TestJSONAPI(t *testing.T) {
+	mux := NewMux()
+	mux.Get("/people/1", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(testJSONAPIFrancis, time.Now(), "v1", 0), nil
+	})
+	mux.Get("/companies/3", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(testJSONAPIFrancis.Employer, time.Now(), "v1", 0), nil
+	})
+	mux.Get("/people", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return jsonAPIPeople{testJSONAPIFrancis, testJSONAPIClaire}, nil
+	})
+
+	var test = func(path string, header http.Header, code int, body string) {
+		r, _ := http.NewRequest(Get, "http://example.com"+path, nil)
+		r.Header = header
+		r.Header.Set("Accept", JSONAPIType)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != code {
+			t.Fatalf("%s: status code is %d, expected %d", path, w.Code, code)
+		}
+		if got := w.Header().Get("Content-Type"); got != JSONAPIType {
+			t.Errorf("%s: Content-Type is %q, expected %q", path, got, JSONAPIType)
+		}
+		if got := w.Body.String(); got != body {
+			t.Errorf("%s: body is\n%s\nexpected\n%s", path, got, body)
+		}
+	}
+
+	test("/people/1", http.Header{}, http.StatusOK,
+		`{"data":{"type":"people","id":"1","attributes":{"name":"Francis Underwood"},`+
+			`"relationships":{"employer":{"data":{"type":"json_api_company","id":"3"}},"friends":{"data":[{"type":"people","id":"2"}]}},`+
+			`"links":{"self":"/people/1"}}}`)
+	test("/companies/3", http.Header{}, http.StatusOK,
+		`{"data":{"type":"json_api_company","id":"3","attributes":{"name":"The White House"}}}`)
+	test("/people", http.Header{"Range": {"resources=1-1"}}, http.StatusPartialContent,
+		`{"data":[{"type":"people","id":"2","attributes":{"name":"Claire Underwood"},`+
+			`"relationships":{"employer":{"data":null},"friends":{"data":[]}},"links":{"self":"/people/2"}}],`+
+			`"meta":{"total":2}}`)
+}
@@ -0,0 +1,91 @@
+package rst
+
+import (
+	"bytes"
+	"net/http"
+	"regexp"
+
+	"github.com/gorilla/context"
+)
+
+/*
+JSONPPolicy is implemented by endpoints to override the JSONP setting of the
+mux.
+
+When enabled, GET requests with a callback parameter in their query string
+receive the JSON representation of the resource wrapped in a call to the
+callback, with the application/javascript content type:
+
+	GET /people/1?callback=showPerson
+
+	showPerson({"id":"1","name":"Francis Underwood"});
+
+The call is preceded by an empty comment to prevent the response from being
+interpreted as another type of content.
+
+JSONP responses don't have an ETag or a Last-Modified header, and conditional
+requests are always answered with the full response, since the wrapped body
+isn't the representation described by the validators of the resource. Requests
+with other methods than GET ignore the callback parameter.
+
+The name of the callback must be a JavaScript identifier, or a path of
+identifiers separated by dots such as jQuery.handlers.person. Requests
+with an invalid callback respond with status code 400 Bad Request.
+*/
+type JSONPPolicy interface {
+	// JSONP returns true if the endpoint supports JSONP requests.
+	JSONP() bool
+}
+
+// jsonpAllowed returns true if handler supports JSONP requests.
+func (s *Mux) jsonpAllowed(handler http.Handler) bool {
+	if h, valid := handler.(*endpointHandler); valid {
+		if policy, implemented := policyEndpoint(h.endpoint).(JSONPPolicy); implemented {
+			return policy.JSONP()
+		}
+	}
+	return s.JSONP
+}
+
+const (
+	jsonpKey         = "__rst__jsonp"
+	jsonpContentType = "application/javascript; charset=utf-8"
+	jsonpMaxLength   = 128
+)
+
+// jsonpCallback matches valid callback names.
+var jsonpCallback = regexp.MustCompile(`^[a-zA-Z_$][0-9a-zA-Z_$]*(\.[a-zA-Z_$][0-9a-zA-Z_$]*)*$`)
+
+// jsonp returns the callback of r, or an empty string if r is not a JSONP
+// request.
+func jsonp(r *http.Request) (string, error) {
+	if !jsonpRequested(r) {
+		return "", nil
+	}
+	callback := r.URL.Query().Get("callback")
+	if len(callback) > jsonpMaxLength || !jsonpCallback.MatchString(callback) {
+		return "", BadRequest(
+			"Invalid JSONP callback",
+			"The callback parameter must be a valid JavaScript identifier.",
+		)
+	}
+	return callback, nil
+}
+
+// jsonpRequested returns true if r is a JSONP request, even if its callback is
+// invalid.
+func jsonpRequested(r *http.Request) bool {
+	if r.Method != Get {
+		return false
+	}
+	if v := context.Get(r, jsonpKey); v == nil || !v.(bool) {
+		return false
+	}
+	return r.URL.Query().Get("callback") != ""
+}
+
+// wrapJSONP wraps b in a call to callback. The leading comment prevents the
+// response from being interpreted as Flash content.
+func wrapJSONP(callback string, b []byte) []byte {
+	return bytes.Join([][]byte{[]byte("/**/"), []byte(callback), []byte("("), b, []byte(");")}, nil)
+}
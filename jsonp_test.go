@@ -0,0 +1,88 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type jsonpEndpoint struct {
+	enabled bool
+}
+
+func (e *jsonpEndpoint) JSONP() bool {
+	return e.enabled
+}
+
+func (e *jsonpEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	return NewEnvelope(map[string]string{"id": "1"}, time.Now(), "etag", 0), nil
+}
+
+func TestJSONP(t *testing.T) {
+	mux := NewMux()
+	mux.Handle("/enabled", EndpointHandler(&jsonpEndpoint{true}))
+	mux.Handle("/disabled", EndpointHandler(&jsonpEndpoint{false}))
+	mux.Get("/default", (&jsonpEndpoint{}).Get)
+
+	var test = func(url string, code int, contentType, body string) {
+		r, _ := http.NewRequest(Get, "http://example.com"+url, nil)
+		r.Header.Set("Accept", "application/json")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != code {
+			t.Errorf("%s: Got status: %d Wanted: %d", url, w.Code, code)
+		}
+		if contentType != "" && w.Header().Get("Content-Type") != contentType {
+			t.Errorf("%s: Got content type: %s Wanted: %s", url, w.Header().Get("Content-Type"), contentType)
+		}
+		if body != "" && w.Body.String() != body {
+			t.Errorf("%s:\nGot:    %s\nWanted: %s", url, w.Body.String(), body)
+		}
+	}
+
+	const (
+		js   = "application/javascript; charset=utf-8"
+		json = "application/json; charset=utf-8"
+	)
+
+	test("/enabled?callback=show", 200, js, `/**/show({"id":"1"});`)
+	test("/enabled?callback=jQuery.handlers.show_1", 200, js, `/**/jQuery.handlers.show_1({"id":"1"});`)
+	test("/enabled", 200, json, `{"id":"1"}`)
+	test("/enabled?callback=alert(1)//", 400, "", "")
+	test("/enabled?callback=<script>", 400, "", "")
+	test("/enabled?callback=a..b", 400, "", "")
+	test("/disabled?callback=show", 200, json, `{"id":"1"}`)
+	test("/default?callback=show", 200, json, `{"id":"1"}`)
+
+	mux.JSONP = true
+	test("/default?callback=show", 200, js, `/**/show({"id":"1"});`)
+	test("/disabled?callback=show", 200, json, `{"id":"1"}`)
+}
+
+func TestJSONPValidators(t *testing.T) {
+	mux := NewMux()
+	mux.JSONP = true
+	mux.Get("/people/1", (&jsonpEndpoint{}).Get)
+
+	var test = func(method, url string, header http.Header, code int, validators bool) {
+		r, _ := http.NewRequest(method, "http://example.com"+url, nil)
+		r.Header = header
+		r.Header.Set("Accept", "application/json")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != code {
+			t.Errorf("%s %s: Got status: %d Wanted: %d", method, url, w.Code, code)
+		}
+		if got := w.Header().Get("ETag") != "" || w.Header().Get("Last-Modified") != ""; got != validators {
+			t.Errorf("%s %s: Got validators: %t Wanted: %t", method, url, got, validators)
+		}
+	}
+
+	test(Get, "/people/1", http.Header{}, 200, true)
+	test(Get, "/people/1?callback=show", http.Header{}, 200, false)
+	test(Get, "/people/1", http.Header{"If-None-Match": {"etag"}}, 304, true)
+	test(Get, "/people/1?callback=show", http.Header{"If-None-Match": {"etag"}}, 200, false)
+	test(Get, "/people/1?callback=show", http.Header{"If-Modified-Since": {time.Now().UTC().Format(rfc1123)}}, 200, false)
+	test(Head, "/people/1?callback=show", http.Header{}, 200, true)
+}
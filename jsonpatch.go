@@ -0,0 +1,450 @@
+package rst
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// JSONPatchType is the media type of JSON Patch documents, defined in RFC
+// 6902.
+const JSONPatchType = "application/json-patch+json"
+
+// JSONPatchOperation is an operation of a JSON Patch document.
+type JSONPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// JSONPatch is a JSON Patch document, as defined in RFC 6902.
+type JSONPatch []*JSONPatchOperation
+
+// ParseJSONPatch parses and validates a JSON Patch document.
+func ParseJSONPatch(b []byte) (JSONPatch, error) {
+	var patch JSONPatch
+	if err := json.Unmarshal(b, &patch); err != nil {
+		return nil, err
+	}
+	for i, op := range patch {
+		if op == nil {
+			return nil, fmt.Errorf("operation %d is null", i)
+		}
+		if err := op.validate(); err != nil {
+			return nil, fmt.Errorf("operation %d: %s", i, err)
+		}
+	}
+	return patch, nil
+}
+
+func (op *JSONPatchOperation) validate() error {
+	if _, err := parsePointer(op.Path); err != nil {
+		return err
+	}
+	switch op.Op {
+	case "add", "replace", "test":
+		if op.Value == nil {
+			return fmt.Errorf("%s operation has no value", op.Op)
+		}
+	case "move", "copy":
+		if _, err := parsePointer(op.From); err != nil {
+			return err
+		}
+		if op.Op == "move" && strings.HasPrefix(op.Path, op.From+"/") {
+			return fmt.Errorf("%s can't be moved to one of its children", op.From)
+		}
+	case "remove":
+	default:
+		return fmt.Errorf("unknown operation %q", op.Op)
+	}
+	return nil
+}
+
+// Apply applies the patch to the JSON document doc, and returns the patched
+// document. The patch is applied as a whole: an error is returned if any of
+// its operations fails.
+func (patch JSONPatch) Apply(doc []byte) ([]byte, error) {
+	var root interface{}
+	if err := decodeJSON(doc, &root); err != nil {
+		return nil, err
+	}
+	root, err := patch.apply(root)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(root)
+}
+
+func (patch JSONPatch) apply(root interface{}) (interface{}, error) {
+	for i, op := range patch {
+		var err error
+		if root, err = op.apply(root); err != nil {
+			return nil, fmt.Errorf("operation %d (%s %s): %s", i, op.Op, op.Path, err)
+		}
+	}
+	return root, nil
+}
+
+func (op *JSONPatchOperation) apply(root interface{}) (interface{}, error) {
+	if err := op.validate(); err != nil {
+		return nil, err
+	}
+	path, _ := parsePointer(op.Path)
+	from, _ := parsePointer(op.From)
+
+	var value interface{}
+	if op.Value != nil {
+		if err := decodeJSON(op.Value, &value); err != nil {
+			return nil, err
+		}
+	}
+
+	switch op.Op {
+	case "add":
+		return addValue(root, path, value)
+	case "remove":
+		return removeValue(root, path)
+	case "replace":
+		if len(path) == 0 {
+			return value, nil
+		}
+		root, err := removeValue(root, path)
+		if err != nil {
+			return nil, err
+		}
+		return addValue(root, path, value)
+	case "move":
+		value, err := getValue(root, from)
+		if err != nil {
+			return nil, err
+		}
+		if root, err = removeValue(root, from); err != nil {
+			return nil, err
+		}
+		return addValue(root, path, value)
+	case "copy":
+		value, err := getValue(root, from)
+		if err != nil {
+			return nil, err
+		}
+		return addValue(root, path, copyValue(value))
+	case "test":
+		current, err := getValue(root, path)
+		if err != nil {
+			return nil, err
+		}
+		if !equalValues(current, value) {
+			return nil, fmt.Errorf("value doesn't match")
+		}
+		return root, nil
+	}
+	return nil, fmt.Errorf("unknown operation %q", op.Op)
+}
+
+// parsePointer parses a JSON Pointer, as defined in RFC 6901.
+func parsePointer(s string) ([]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(s, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q", s)
+	}
+	tokens := strings.Split(s[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+	}
+	return tokens, nil
+}
+
+// arrayIndex returns the index designated by token in an array of length n.
+// The index can be n if end is true.
+func arrayIndex(token string, n int, end bool) (int, error) {
+	if end && token == "-" {
+		return n, nil
+	}
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || (token != "0" && strings.HasPrefix(token, "0")) {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	if i > n || (i == n && !end) {
+		return 0, fmt.Errorf("array index %d is out of bounds", i)
+	}
+	return i, nil
+}
+
+func getValue(node interface{}, path []string) (interface{}, error) {
+	for _, token := range path {
+		switch n := node.(type) {
+		case map[string]interface{}:
+			child, found := n[token]
+			if !found {
+				return nil, fmt.Errorf("member %q doesn't exist", token)
+			}
+			node = child
+		case []interface{}:
+			i, err := arrayIndex(token, len(n), false)
+			if err != nil {
+				return nil, err
+			}
+			node = n[i]
+		default:
+			return nil, fmt.Errorf("%q can't be looked up in a value", token)
+		}
+	}
+	return node, nil
+}
+
+// update replaces the value at path in node with the result of f, which is
+// called with the parent of the value and its key, and returns the updated
+// node.
+func update(node interface{}, path []string, f func(parent interface{}, key string) (interface{}, error)) (interface{}, error) {
+	if len(path) == 1 {
+		return f(node, path[0])
+	}
+	child, err := getValue(node, path[:1])
+	if err != nil {
+		return nil, err
+	}
+	if child, err = update(child, path[1:], f); err != nil {
+		return nil, err
+	}
+	switch n := node.(type) {
+	case map[string]interface{}:
+		n[path[0]] = child
+	case []interface{}:
+		i, _ := arrayIndex(path[0], len(n), false)
+		n[i] = child
+	}
+	return node, nil
+}
+
+func addValue(root interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	return update(root, path, func(parent interface{}, key string) (interface{}, error) {
+		switch p := parent.(type) {
+		case map[string]interface{}:
+			p[key] = value
+			return p, nil
+		case []interface{}:
+			i, err := arrayIndex(key, len(p), true)
+			if err != nil {
+				return nil, err
+			}
+			p = append(p, nil)
+			copy(p[i+1:], p[i:])
+			p[i] = value
+			return p, nil
+		}
+		return nil, fmt.Errorf("%q can't be added to a value", key)
+	})
+}
+
+func removeValue(root interface{}, path []string) (interface{}, error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("the whole document can't be removed")
+	}
+	return update(root, path, func(parent interface{}, key string) (interface{}, error) {
+		switch p := parent.(type) {
+		case map[string]interface{}:
+			if _, found := p[key]; !found {
+				return nil, fmt.Errorf("member %q doesn't exist", key)
+			}
+			delete(p, key)
+			return p, nil
+		case []interface{}:
+			i, err := arrayIndex(key, len(p), false)
+			if err != nil {
+				return nil, err
+			}
+			return append(p[:i], p[i+1:]...), nil
+		}
+		return nil, fmt.Errorf("%q can't be removed from a value", key)
+	})
+}
+
+func copyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(v))
+		for key, child := range v {
+			copied[key] = copyValue(child)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i, child := range v {
+			copied[i] = copyValue(child)
+		}
+		return copied
+	}
+	return value
+}
+
+// equalValues returns true if a and b are equal JSON values. Numbers are
+// compared by value.
+func equalValues(a, b interface{}) bool {
+	switch x := a.(type) {
+	case json.Number:
+		y, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		fx, errx := x.Float64()
+		fy, erry := y.Float64()
+		return errx == nil && erry == nil && fx == fy
+	case map[string]interface{}:
+		y, ok := b.(map[string]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for key, value := range x {
+			other, found := y[key]
+			if !found || !equalValues(value, other) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		y, ok := b.([]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !equalValues(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// nullRemoved sets to null the members of original missing from patched, so
+// that decoding patched in an existing value resets them.
+func nullRemoved(original, patched interface{}) {
+	o, isObject := original.(map[string]interface{})
+	p, isPatchedObject := patched.(map[string]interface{})
+	if !isObject || !isPatchedObject {
+		return
+	}
+	for key, value := range o {
+		if child, found := p[key]; found {
+			nullRemoved(value, child)
+		} else {
+			p[key] = nil
+		}
+	}
+}
+
+/*
+ApplyJSONPatch applies the JSON Patch in the body of r to the JSON
+representation of resource, which must be a pointer.
+
+	func (ep *PersonEP) Patch(vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+		person := database.Find(vars.Get("id"))
+		if person == nil {
+			return nil, rst.NotFound()
+		}
+		if err := rst.ApplyJSONPatch(person, r); err != nil {
+			return nil, err
+		}
+		return person, database.Save(person)
+	}
+
+Requests with another Content-Type are rejected with status code 415
+Unsupported Media Type, and requests whose preconditions don't match the
+current version of resource with status code 412 Precondition Failed. Invalid
+patches are rejected with status code 422 Unprocessable Entity, and patches
+that can't be applied to resource with status code 409 Conflict.
+
+The patch is applied to the JSON encoding of resource by the JSON engine and
+policy of the mux, so that its paths and values use the field names and time
+format of the policy, and the patched representation is decoded in resource
+with the engine. Removed members reset pointers, maps, slices and interfaces, and leave
+other values unchanged.
+*/
+func ApplyJSONPatch(resource Resource, r *http.Request) error {
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != JSONPatchType {
+		err := UnsupportedMediaType(JSONPatchType)
+		err.Header.Set("Accept-Patch", JSONPatchType)
+		return err
+	}
+	if ValidateConditions(resource, r) {
+		return PreconditionFailed()
+	}
+
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	patch, err := ParseJSONPatch(b)
+	if err != nil {
+		return NewError(http.StatusUnprocessableEntity, "Invalid JSON patch", err.Error())
+	}
+
+	engine, policy := getJSONEngine(r), getMux(r).jsonPolicy
+	doc, err := policy.marshal(engine, resource)
+	if err != nil {
+		return err
+	}
+	var original, patched interface{}
+	if err := decodeJSON(doc, &original); err != nil {
+		return err
+	}
+	if err := decodeJSON(doc, &patched); err != nil {
+		return err
+	}
+	if patched, err = patch.apply(patched); err != nil {
+		return NewError(http.StatusConflict, "Patch could not be applied", err.Error())
+	}
+	nullRemoved(original, patched)
+
+	if doc, err = json.Marshal(patched); err != nil {
+		return err
+	}
+	if err := policy.unmarshal(engine, doc, resource); err != nil {
+		return NewError(http.StatusConflict, "Patch could not be applied", err.Error())
+	}
+	return nil
+}
+
+// PatchPolicy is implemented by endpoints to set the patch document formats
+// advertised in the Accept-Patch header of their responses to OPTIONS
+// requests, and of their 405 Method Not Allowed errors.
+type PatchPolicy interface {
+	AcceptPatch() []string
+}
+
+// defaultAcceptPatch lists the patch formats supported by ApplyMergePatch and
+// ApplyJSONPatch.
+var defaultAcceptPatch = []string{MergePatchType, JSONPatchType}
+
+// setAcceptPatch sets the Accept-Patch header in header if endpoint allows
+// the PATCH method.
+func setAcceptPatch(endpoint Endpoint, header http.Header) {
+	patchable := false
+	for _, method := range AllowedMethods(endpoint) {
+		if method == Patch {
+			patchable = true
+			break
+		}
+	}
+	if !patchable {
+		return
+	}
+	formats := defaultAcceptPatch
+	if policy, implemented := policyEndpoint(endpoint).(PatchPolicy); implemented {
+		formats = policy.AcceptPatch()
+	}
+	if len(formats) > 0 {
+		header.Set("Accept-Patch", strings.Join(formats, ", "))
+	}
+}
@@ -0,0 +1,162 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestJSONPatch(t *testing.T) {
+	tests := []struct {
+		doc, patch, result string
+	}{
+		// Examples of RFC 6902, appendix A.
+		{`{"foo":"bar"}`, `[{"op":"add","path":"/baz","value":"qux"}]`, `{"baz":"qux","foo":"bar"}`},
+		{`{"foo":["bar","baz"]}`, `[{"op":"add","path":"/foo/1","value":"qux"}]`, `{"foo":["bar","qux","baz"]}`},
+		{`{"baz":"qux","foo":"bar"}`, `[{"op":"remove","path":"/baz"}]`, `{"foo":"bar"}`},
+		{`{"foo":["bar","qux","baz"]}`, `[{"op":"remove","path":"/foo/1"}]`, `{"foo":["bar","baz"]}`},
+		{`{"baz":"qux","foo":"bar"}`, `[{"op":"replace","path":"/baz","value":"boo"}]`, `{"baz":"boo","foo":"bar"}`},
+		{`{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`, `[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`, `{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`},
+		{`{"foo":["all","grass","cows","eat"]}`, `[{"op":"move","from":"/foo/1","path":"/foo/3"}]`, `{"foo":["all","cows","eat","grass"]}`},
+		{`{"baz":"qux","foo":["a",2,"c"]}`, `[{"op":"test","path":"/baz","value":"qux"},{"op":"test","path":"/foo/1","value":2}]`, `{"baz":"qux","foo":["a",2,"c"]}`},
+		{`{"foo":"bar"}`, `[{"op":"add","path":"/child","value":{"grandchild":{}}}]`, `{"child":{"grandchild":{}},"foo":"bar"}`},
+		{`{"/":9,"~1":10}`, `[{"op":"test","path":"/~01","value":10}]`, `{"/":9,"~1":10}`},
+		{`{"foo":["bar"]}`, `[{"op":"add","path":"/foo/-","value":["abc","def"]}]`, `{"foo":["bar",["abc","def"]]}`},
+		{`{"foo":1}`, `[{"op":"test","path":"/foo","value":1.0}]`, `{"foo":1}`},
+		{`{"foo":{"a":1}}`, `[{"op":"copy","from":"/foo","path":"/bar"},{"op":"add","path":"/bar/b","value":2}]`, `{"bar":{"a":1,"b":2},"foo":{"a":1}}`},
+		{`{"foo":1}`, `[{"op":"replace","path":"","value":[1]}]`, `[1]`},
+	}
+	for i, test := range tests {
+		patch, err := ParseJSONPatch([]byte(test.patch))
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		result, err := patch.Apply([]byte(test.doc))
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if string(result) != test.result {
+			t.Fatalf("%d: got %s, wanted %s", i, result, test.result)
+		}
+	}
+
+	failures := []struct {
+		doc, patch string
+	}{
+		{`{"foo":"bar"}`, `[{"op":"add","path":"/baz/bat","value":"qux"}]`},
+		{`{"baz":"qux"}`, `[{"op":"test","path":"/baz","value":"bar"}]`},
+		{`{"foo":["bar","baz"]}`, `[{"op":"add","path":"/foo/3","value":"qux"}]`},
+		{`{"foo":["bar"]}`, `[{"op":"remove","path":"/foo/01"}]`},
+		{`{"foo":"bar"}`, `[{"op":"remove","path":"/baz"}]`},
+	}
+	for i, test := range failures {
+		patch, err := ParseJSONPatch([]byte(test.patch))
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if result, err := patch.Apply([]byte(test.doc)); err == nil {
+			t.Fatalf("%d: got %s, wanted an error", i, result)
+		}
+	}
+
+	for _, invalid := range []string{
+		`{}`,
+		`[{"op":"add","path":"/a"}]`,
+		`[{"op":"unknown","path":"/a"}]`,
+		`[{"op":"remove","path":"a"}]`,
+		`[{"op":"move","from":"/a","path":"/a/b"}]`,
+	} {
+		if _, err := ParseJSONPatch([]byte(invalid)); err == nil {
+			t.Fatalf("%s: wanted an error", invalid)
+		}
+	}
+}
+
+func TestApplyJSONPatch(t *testing.T) {
+	email := "john@example.com"
+	person := &patchablePerson{Name: "John", Email: &email, Tags: []string{"a"}, version: "v1"}
+	newRequest := func(patch string) *http.Request {
+		r, _ := http.NewRequest(Patch, "/people/1", strings.NewReader(patch))
+		r.Header.Set("Content-Type", JSONPatchType)
+		return r
+	}
+
+	if err := ApplyJSONPatch(person, newRequest(`[{"op":"remove","path":"/email"},{"op":"add","path":"/tags/-","value":"b"}]`)); err != nil {
+		t.Fatal(err)
+	}
+	if person.Name != "John" || person.Email != nil || len(person.Tags) != 2 || person.version != "v1" {
+		t.Fatalf("got %+v", person)
+	}
+
+	if e, ok := ApplyJSONPatch(person, newRequest(`[{"op":"test","path":"/name","value":"Jane"}]`)).(*Error); !ok || e.Code != http.StatusConflict {
+		t.Fatalf("got %v, wanted a 409 error", e)
+	}
+	if e, ok := ApplyJSONPatch(person, newRequest(`[{"op":"jump"}]`)).(*Error); !ok || e.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("got %v, wanted a 422 error", e)
+	}
+}
+
+func TestApplyJSONPatchJSONPolicy(t *testing.T) {
+	account := &patchableAccount{FirstName: "Francis", Created: testTimeReference}
+	mux := NewMux()
+	mux.SetJSONPolicy(&JSONPolicy{FieldName: SnakeCase, TimeFormat: time.RFC1123})
+	mux.Patch("/accounts/1", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return account, ApplyJSONPatch(account, r)
+	})
+
+	deleted := time.Date(2015, time.January, 2, 3, 4, 5, 0, time.UTC)
+	body := `[{"op":"test","path":"/first_name","value":"Francis"},` +
+		`{"op":"replace","path":"/first_name","value":"Claire"},` +
+		`{"op":"add","path":"/deleted","value":"` + deleted.Format(time.RFC1123) + `"}]`
+	r, _ := http.NewRequest(Patch, "/accounts/1", strings.NewReader(body))
+	r.Header.Set("Content-Type", JSONPatchType)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("got %d, wanted %d: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+	if account.FirstName != "Claire" || account.Deleted == nil || !account.Deleted.Equal(deleted) {
+		t.Errorf("the patch must use the names and times of the policy, got %+v", account)
+	}
+	if !account.Created.Equal(testTimeReference) {
+		t.Errorf("unpatched times must be kept, got %v", account.Created)
+	}
+}
+
+// patchPolicyEndpoint is a Patcher accepting the patch formats of its policy.
+type patchPolicyEndpoint struct {
+	formats []string
+}
+
+func (e *patchPolicyEndpoint) Patch(vars RouteVars, r *http.Request) (Resource, error) {
+	return nil, nil
+}
+
+func (e *patchPolicyEndpoint) AcceptPatch() []string {
+	return e.formats
+}
+
+func TestAcceptPatch(t *testing.T) {
+	mux := NewMux()
+	mux.Patch("/people/{id}", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return nil, nil
+	})
+	mux.HandleEndpoint("/merge/{id}", &patchPolicyEndpoint{[]string{MergePatchType}})
+	mux.HandleEndpoint("/none/{id}", &patchPolicyEndpoint{})
+
+	var test = func(path, wanted string) {
+		for _, method := range []string{Options, Put} {
+			r, _ := http.NewRequest(method, path, nil)
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, r)
+			if got := w.Header().Get("Accept-Patch"); got != wanted {
+				t.Fatalf("%s %s: got Accept-Patch %q, wanted %q", method, path, got, wanted)
+			}
+		}
+	}
+	test("/people/1", MergePatchType+", "+JSONPatchType)
+	test("/merge/1", MergePatchType)
+	test("/none/1", "")
+}
@@ -0,0 +1,188 @@
+package rst
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+)
+
+// lifecycle tracks the server running a mux, and the requests it serves.
+type lifecycle struct {
+	mu       sync.Mutex
+	server   *http.Server
+	closed   bool
+	closing  chan struct{} // Closed when the mux starts shutting down.
+	idle     chan struct{} // Closed when no requests are served after shutdown.
+	requests map[*http.Request]context.CancelFunc
+	hooks    []func(context.Context) error
+}
+
+func newLifecycle() *lifecycle {
+	return &lifecycle{
+		closing:  make(chan struct{}),
+		idle:     make(chan struct{}),
+		requests: make(map[*http.Request]context.CancelFunc),
+	}
+}
+
+// track registers r as in-flight, and returns false if the mux is shutting
+// down. cancel cancels the context of r.
+func (l *lifecycle) track(r *http.Request, cancel context.CancelFunc) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return false
+	}
+	l.requests[r] = cancel
+	return true
+}
+
+// done removes r from the in-flight requests.
+func (l *lifecycle) done(r *http.Request) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.requests, r)
+	if l.closed && len(l.requests) == 0 {
+		l.closeIdle()
+	}
+}
+
+// close stops accepting requests, and returns the server to shut down, if
+// any.
+func (l *lifecycle) close() *http.Server {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.closed {
+		l.closed = true
+		close(l.closing)
+	}
+	if len(l.requests) == 0 {
+		l.closeIdle()
+	}
+	return l.server
+}
+
+// closeIdle closes l.idle once. l.mu must be held.
+func (l *lifecycle) closeIdle() {
+	select {
+	case <-l.idle:
+	default:
+		close(l.idle)
+	}
+}
+
+// cancel cancels the contexts of the in-flight requests.
+func (l *lifecycle) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, cancel := range l.requests {
+		cancel()
+	}
+}
+
+// shuttingDown returns the error written in response to requests received
+// while the mux is shutting down.
+func shuttingDown() *Error {
+	err := ServiceUnavailable(0)
+	err.Reason, err.Description = "Service shutting down", "The service is shutting down. Please try again later."
+	err.Header.Set("Connection", "close")
+	return err
+}
+
+// ListenAndServe listens on the TCP network address addr and serves the
+// requests it receives with s, until Shutdown is called. It returns
+// http.ErrServerClosed after a shutdown.
+func (s *Mux) ListenAndServe(addr string) error {
+	if addr == "" {
+		addr = ":http"
+	}
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(l)
+}
+
+// Serve accepts the connections of l and serves their requests with s, until
+// Shutdown is called. It returns http.ErrServerClosed after a shutdown.
+func (s *Mux) Serve(l net.Listener) error {
+	server := &http.Server{Handler: s}
+	s.lifecycle.mu.Lock()
+	if s.lifecycle.closed {
+		s.lifecycle.mu.Unlock()
+		l.Close()
+		return http.ErrServerClosed
+	}
+	s.lifecycle.server = server
+	s.lifecycle.mu.Unlock()
+	return server.Serve(l)
+}
+
+// OnShutdown registers hook to be called by Shutdown once the in-flight
+// requests are completed. Hooks are called in the reverse order of their
+// registration, such as deferred functions.
+func (s *Mux) OnShutdown(hook func(ctx context.Context) error) {
+	s.lifecycle.mu.Lock()
+	defer s.lifecycle.mu.Unlock()
+	s.lifecycle.hooks = append(s.lifecycle.hooks, hook)
+}
+
+/*
+Shutdown gracefully stops s: the server started with ListenAndServe or Serve
+stops accepting connections, requests received by s from then on are rejected
+with status code 503 Service Unavailable, and the in-flight requests are given
+until the deadline of ctx to be completed.
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt)
+	go func() {
+		<-stop
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		mux.Shutdown(ctx)
+	}()
+	if err := mux.ListenAndServe(":8080"); err != http.ErrServerClosed {
+		log.Fatal(err)
+	}
+
+Long-running responses, such as streams, can select on ShuttingDown(r) to end
+early. The contexts of the requests still in flight when ctx expires are
+canceled, which stops streamed resources. The hooks registered with
+OnShutdown are called afterwards, and the first error encountered is returned.
+*/
+func (s *Mux) Shutdown(ctx context.Context) error {
+	var err error
+	if server := s.lifecycle.close(); server != nil {
+		err = server.Shutdown(ctx)
+	}
+
+	select {
+	case <-s.lifecycle.idle:
+	case <-ctx.Done():
+		s.lifecycle.cancel()
+		if err == nil {
+			err = ctx.Err()
+		}
+	}
+
+	s.lifecycle.mu.Lock()
+	hooks := s.lifecycle.hooks
+	s.lifecycle.hooks = nil
+	s.lifecycle.mu.Unlock()
+	for i := len(hooks) - 1; i >= 0; i-- {
+		if e := hooks[i](ctx); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// ShuttingDown returns a channel closed when the mux serving r starts shutting
+// down, or nil if r isn't served by a mux.
+func ShuttingDown(r *http.Request) <-chan struct{} {
+	if s := getMux(r); s.lifecycle != nil {
+		return s.lifecycle.closing
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package rst
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestShutdown(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	mux := NewMux()
+	mux.Handle("/slow", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.Write([]byte("done"))
+	}))
+
+	var calls []string
+	mux.OnShutdown(func(ctx context.Context) error {
+		calls = append(calls, "first")
+		return nil
+	})
+	mux.OnShutdown(func(ctx context.Context) error {
+		calls = append(calls, "second")
+		return nil
+	})
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	served := make(chan error, 1)
+	go func() { served <- mux.Serve(l) }()
+
+	responded := make(chan int, 1)
+	go func() {
+		resp, err := http.Get("http://" + l.Addr().String() + "/slow")
+		if err != nil {
+			responded <- 0
+			return
+		}
+		resp.Body.Close()
+		responded <- resp.StatusCode
+	}()
+	<-started
+
+	shutdown := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		shutdown <- mux.Shutdown(ctx)
+	}()
+	<-mux.lifecycle.closing
+	if ShuttingDown(httptest.NewRequest(Get, "/slow", nil)) != nil {
+		t.Error("channel returned for a request not served by a mux")
+	}
+
+	// Requests received once the shutdown started are rejected.
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(Get, "/slow", nil))
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("Got: %d Wanted: %d", w.Code, http.StatusServiceUnavailable)
+	}
+
+	select {
+	case <-shutdown:
+		t.Fatal("shut down with a request in flight")
+	case <-time.After(20 * time.Millisecond):
+	}
+	close(release)
+
+	if code := <-responded; code != http.StatusOK {
+		t.Errorf("in-flight request: Got: %d Wanted: %d", code, http.StatusOK)
+	}
+	if err := <-shutdown; err != nil {
+		t.Fatal(err)
+	}
+	if err := <-served; err != http.ErrServerClosed {
+		t.Errorf("Got: %v Wanted: %v", err, http.ErrServerClosed)
+	}
+	if len(calls) != 2 || calls[0] != "second" || calls[1] != "first" {
+		t.Errorf("Got: %v Wanted: [second first]", calls)
+	}
+}
+
+func TestShutdownDeadline(t *testing.T) {
+	started, canceled := make(chan struct{}), make(chan struct{})
+	mux := NewMux()
+	mux.Handle("/events", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-ShuttingDown(r)
+		// Ignores the shutdown until its context is canceled.
+		<-r.Context().Done()
+		close(canceled)
+	}))
+
+	go mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(Get, "/events", nil))
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := mux.Shutdown(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Got: %v Wanted: %v", err, context.DeadlineExceeded)
+	}
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Fatal("context of the in-flight request not canceled")
+	}
+}
@@ -0,0 +1,120 @@
+package rst
+
+import (
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/*
+Maintenance puts a mux in maintenance mode, during which requests are rejected
+with status code 503 Service Unavailable, except those addressed to an allowed
+path.
+
+	maintenance := &rst.Maintenance{
+		RetryAfter: 10 * time.Minute,
+		Allow:      []string{"/health", "/admin/"},
+	}
+	mux.SetMaintenance(maintenance)
+
+	maintenance.Enable()
+	defer maintenance.Disable()
+
+Maintenance mode can also be toggled without restarting the process by
+watching a file, or any other condition:
+
+	stop := maintenance.WatchFile("/var/run/api.maintenance", time.Second)
+	defer stop()
+*/
+type Maintenance struct {
+	RetryAfter  time.Duration // Optional. Duration after which clients should retry their requests.
+	Description string        // Optional. Description of the error returned to clients.
+	Allow       []string      // Paths served during maintenance. Paths ending with a slash allow all the paths below them.
+
+	enabled int32
+}
+
+// Enable turns maintenance mode on.
+func (m *Maintenance) Enable() {
+	atomic.StoreInt32(&m.enabled, 1)
+}
+
+// Disable turns maintenance mode off.
+func (m *Maintenance) Disable() {
+	atomic.StoreInt32(&m.enabled, 0)
+}
+
+// Enabled returns true if maintenance mode is on.
+func (m *Maintenance) Enabled() bool {
+	return atomic.LoadInt32(&m.enabled) == 1
+}
+
+// Watch calls check at every interval, and turns maintenance mode on while it
+// returns true. The returned function stops watching.
+func (m *Maintenance) Watch(check func() bool, interval time.Duration) (stop func()) {
+	update := func() {
+		if check() {
+			m.Enable()
+		} else {
+			m.Disable()
+		}
+	}
+	update()
+
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				update()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() { once.Do(func() { close(done) }) }
+}
+
+// WatchFile turns maintenance mode on while a file exists at path, which is
+// checked at every interval. The returned function stops watching.
+func (m *Maintenance) WatchFile(path string, interval time.Duration) (stop func()) {
+	return m.Watch(func() bool {
+		_, err := os.Stat(path)
+		return err == nil
+	}, interval)
+}
+
+// allowed returns true if r can be served during maintenance.
+func (m *Maintenance) allowed(r *http.Request) bool {
+	for _, path := range m.Allow {
+		if r.URL.Path == path || (strings.HasSuffix(path, "/") && strings.HasPrefix(r.URL.Path, path)) {
+			return true
+		}
+	}
+	return false
+}
+
+// error returns the error written in response to requests rejected during
+// maintenance.
+func (m *Maintenance) error() *Error {
+	description := m.Description
+	if description == "" {
+		description = "The service is temporarily unavailable due to maintenance. Please try again later."
+	}
+	err := ServiceUnavailable(m.RetryAfter)
+	err.Reason, err.Description = "Service under maintenance", description
+	return err
+}
+
+// SetMaintenance sets the maintenance mode of the mux. A nil value disables
+// it, which is the default.
+func (s *Mux) SetMaintenance(m *Maintenance) {
+	s.maintenance = m
+}
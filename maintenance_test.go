@@ -0,0 +1,93 @@
+package rst
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestMaintenance(t *testing.T) {
+	maintenance := &Maintenance{
+		RetryAfter: 90 * time.Second,
+		Allow:      []string{"/health", "/admin/"},
+	}
+	mux := NewMux()
+	mux.SetMaintenance(maintenance)
+	mux.Handle("/{path:.*}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	get := func(path string) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(Get, path, nil)
+		r.Header.Set("Accept", "application/json")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		return w
+	}
+
+	if w := get("/people"); w.Code != http.StatusOK {
+		t.Fatalf("disabled: got %d, wanted %d", w.Code, http.StatusOK)
+	}
+
+	maintenance.Enable()
+	w := get("/people")
+	if w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("enabled: got %d, wanted %d", w.Code, http.StatusServiceUnavailable)
+	}
+	if got := w.Header().Get("Retry-After"); got != "90" {
+		t.Fatalf("got Retry-After %q, wanted %q", got, "90")
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/json; charset=utf-8" {
+		t.Fatalf("got Content-Type %q", ct)
+	}
+
+	for _, path := range []string{"/health", "/admin/", "/admin/jobs"} {
+		if w := get(path); w.Code != http.StatusOK {
+			t.Fatalf("%s: got %d, wanted %d", path, w.Code, http.StatusOK)
+		}
+	}
+	if w := get("/healthz"); w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("/healthz: got %d, wanted %d", w.Code, http.StatusServiceUnavailable)
+	}
+
+	maintenance.Disable()
+	if w := get("/people"); w.Code != http.StatusOK {
+		t.Fatalf("disabled: got %d, wanted %d", w.Code, http.StatusOK)
+	}
+}
+
+func TestMaintenanceWatchFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rst")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "maintenance")
+
+	maintenance := &Maintenance{}
+	stop := maintenance.WatchFile(path, 5*time.Millisecond)
+	defer stop()
+
+	if maintenance.Enabled() {
+		t.Fatal("enabled without a file")
+	}
+	if err := ioutil.WriteFile(path, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, maintenance.Enabled)
+	os.Remove(path)
+	waitFor(t, func() bool { return !maintenance.Enabled() })
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	for deadline := time.Now().Add(time.Second); !condition(); {
+		if time.Now().After(deadline) {
+			t.Fatal("condition wasn't met in time")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,248 @@
+/*
+Package metrics instruments the requests served by a rst mux with Prometheus
+metrics.
+
+	collector := metrics.New("api")
+	collector.Instrument(mux)
+	prometheus.MustRegister(collector)
+
+	http.Handle("/metrics", promhttp.Handler())
+
+The following metrics are labeled by the pattern of the matched route, such as
+/people/{id}, which keeps their cardinality bounded. Requests not matching any
+route are labeled "unmatched", and requests with extension methods are labeled
+"OTHER".
+
+	<namespace>_requests_total                counter, by route, method and status class (2xx, 4xx...)
+	<namespace>_request_duration_seconds      histogram, by route and method
+	<namespace>_response_size_bytes           histogram, by route and method
+	<namespace>_requests_in_flight            gauge
+	<namespace>_not_modified_total            counter of 304 Not Modified responses, by route
+	<namespace>_not_acceptable_total          counter of failed content negotiations, by route
+	<namespace>_experiment_requests_total     counter, by experiment, variant and status class
+
+The breakers added to the instrumented muxes are reported by name, which
+should be unique, when the metrics are collected.
+
+	<namespace>_breaker_state                 gauge set to 1 for the current state of each breaker, by breaker and state
+	<namespace>_breaker_transitions_total     counter of the transitions of each breaker, by breaker and state entered
+*/
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/mohamedattahri/rst"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Unmatched is the route label of the requests which didn't match a route.
+const Unmatched = "unmatched"
+
+// OtherMethod is the method label of the requests whose method isn't one of
+// the methods defined by RFC 7231 and RFC 5789.
+const OtherMethod = "OTHER"
+
+// methods are the method labels of requests, other methods being labeled
+// OtherMethod.
+var methods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodPost:    true,
+	http.MethodPut:     true,
+	http.MethodPatch:   true,
+	http.MethodDelete:  true,
+	http.MethodConnect: true,
+	http.MethodOptions: true,
+	http.MethodTrace:   true,
+}
+
+// Collector collects the metrics of the requests served by muxes. It
+// implements prometheus.Collector.
+type Collector struct {
+	requests      *prometheus.CounterVec
+	duration      *prometheus.HistogramVec
+	size          *prometheus.HistogramVec
+	inFlight      prometheus.Gauge
+	notModified   *prometheus.CounterVec
+	notAcceptable *prometheus.CounterVec
+	experiments   *prometheus.CounterVec
+	breakerState  *prometheus.Desc
+	transitions   *prometheus.Desc
+
+	mu    sync.Mutex
+	muxes []*rst.Mux
+}
+
+// breakerStates are the states a breaker is reported in.
+var breakerStates = []rst.BreakerState{rst.BreakerClosed, rst.BreakerOpen, rst.BreakerHalfOpen}
+
+// New returns a collector whose metrics are prefixed by namespace, which can be
+// empty.
+func New(namespace string) *Collector {
+	return &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "requests_total",
+			Help:      "Number of requests served, by route, method and status class.",
+		}, []string{"route", "method", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of the responses, by route and method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"route", "method"}),
+		size: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "response_size_bytes",
+			Help:      "Size of the body of the responses, by route and method.",
+			Buckets:   prometheus.ExponentialBuckets(128, 4, 8),
+		}, []string{"route", "method"}),
+		inFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "requests_in_flight",
+			Help:      "Number of requests being served.",
+		}),
+		notModified: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "not_modified_total",
+			Help:      "Number of conditional requests answered with 304 Not Modified, by route.",
+		}, []string{"route"}),
+		notAcceptable: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "not_acceptable_total",
+			Help:      "Number of requests answered with 406 Not Acceptable, by route.",
+		}, []string{"route"}),
+		experiments: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "experiment_requests_total",
+			Help:      "Number of requests served, by experiment, variant and status class.",
+		}, []string{"experiment", "variant", "status"}),
+		breakerState: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "breaker_state"),
+			"State of the breakers, set to 1 for their current state, by breaker and state.",
+			[]string{"breaker", "state"}, nil,
+		),
+		transitions: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "breaker_transitions_total"),
+			"Number of transitions of the breakers, by breaker and state entered.",
+			[]string{"breaker", "state"}, nil,
+		),
+	}
+}
+
+// Describe implements the prometheus.Collector interface.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.requests.Describe(ch)
+	c.duration.Describe(ch)
+	c.size.Describe(ch)
+	c.inFlight.Describe(ch)
+	c.notModified.Describe(ch)
+	c.notAcceptable.Describe(ch)
+	c.experiments.Describe(ch)
+	ch <- c.breakerState
+	ch <- c.transitions
+}
+
+// Collect implements the prometheus.Collector interface.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.requests.Collect(ch)
+	c.duration.Collect(ch)
+	c.size.Collect(ch)
+	c.inFlight.Collect(ch)
+	c.notModified.Collect(ch)
+	c.notAcceptable.Collect(ch)
+	c.experiments.Collect(ch)
+	c.collectBreakers(ch)
+}
+
+// collectBreakers collects the states and transitions of the breakers of the
+// instrumented muxes.
+func (c *Collector) collectBreakers(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	muxes := c.muxes
+	c.mu.Unlock()
+
+	seen := make(map[*rst.Breaker]bool)
+	for _, mux := range muxes {
+		for _, b := range mux.Breakers() {
+			if seen[b] {
+				continue
+			}
+			seen[b] = true
+			stats := b.Stats()
+			for _, state := range breakerStates {
+				var value float64
+				if stats.State == state {
+					value = 1
+				}
+				ch <- prometheus.MustNewConstMetric(c.breakerState, prometheus.GaugeValue, value, stats.Name, state.String())
+			}
+			ch <- prometheus.MustNewConstMetric(c.transitions, prometheus.CounterValue, float64(stats.Trips), stats.Name, rst.BreakerOpen.String())
+			ch <- prometheus.MustNewConstMetric(c.transitions, prometheus.CounterValue, float64(stats.Probes), stats.Name, rst.BreakerHalfOpen.String())
+			ch <- prometheus.MustNewConstMetric(c.transitions, prometheus.CounterValue, float64(stats.Resets), stats.Name, rst.BreakerClosed.String())
+		}
+	}
+}
+
+// Instrument collects the metrics of the requests served by mux, and of its
+// breakers. The access logger of mux, if any, is still called after each
+// response.
+func (c *Collector) Instrument(mux *rst.Mux) {
+	c.mu.Lock()
+	c.muxes = append(c.muxes, mux)
+	c.mu.Unlock()
+
+	mux.Use(c.track)
+	previous := mux.AccessLogger
+	mux.AccessLogger = rst.AccessLogFunc(func(e *rst.AccessEntry) {
+		c.LogAccess(e)
+		if previous != nil {
+			previous.LogAccess(e)
+		}
+	})
+}
+
+// track is a middleware counting the requests in flight.
+func (c *Collector) track(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.inFlight.Inc()
+		defer c.inFlight.Dec()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// LogAccess implements the rst.AccessLogger interface.
+func (c *Collector) LogAccess(e *rst.AccessEntry) {
+	route := e.Pattern
+	if route == "" {
+		route = Unmatched
+	}
+	method := e.Method
+	if !methods[method] {
+		method = OtherMethod
+	}
+	status := statusClass(e.Status)
+	c.requests.WithLabelValues(route, method, status).Inc()
+	for experiment, variant := range e.Variants {
+		c.experiments.WithLabelValues(experiment, variant, status).Inc()
+	}
+	c.duration.WithLabelValues(route, method).Observe(e.Latency.Seconds())
+	c.size.WithLabelValues(route, method).Observe(float64(e.Bytes))
+	switch e.Status {
+	case http.StatusNotModified:
+		c.notModified.WithLabelValues(route).Inc()
+	case http.StatusNotAcceptable:
+		c.notAcceptable.WithLabelValues(route).Inc()
+	}
+}
+
+// statusClass returns the class of status code, such as 2xx.
+func statusClass(code int) string {
+	if code < 100 || code > 599 {
+		return strconv.Itoa(code)
+	}
+	return strconv.Itoa(code/100) + "xx"
+}
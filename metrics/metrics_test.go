@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mohamedattahri/rst"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type person struct {
+	Name string
+}
+
+func TestCollector(t *testing.T) {
+	lastModified := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	inFlight := -1.0
+
+	collector := New("api")
+	mux := rst.NewMux()
+	logged := 0
+	mux.AccessLogger = rst.AccessLogFunc(func(e *rst.AccessEntry) { logged++ })
+	collector.Instrument(mux)
+	mux.AddExperiment(&rst.Experiment{Name: "shape", Variants: []string{"control"}})
+	mux.Get("/people/{id}", func(vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+		inFlight = testutil.ToFloat64(collector.inFlight)
+		return rst.NewEnvelope(&person{"Francis"}, lastModified, "etag", time.Minute), nil
+	})
+
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(collector); err != nil {
+		t.Fatal(err)
+	}
+
+	var get = func(path string, header http.Header) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(rst.Get, "http://example.com"+path, nil)
+		r.Header = header
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		return w
+	}
+	etag := get("/people/1", http.Header{"Accept": {"application/json"}}).Header().Get("ETag")
+	get("/people/2", http.Header{"Accept": {"application/json"}})
+	get("/people/1", http.Header{"If-None-Match": {etag}})
+	get("/people/1", http.Header{"Accept": {"image/png"}})
+	get("/unknown", nil)
+	r, _ := http.NewRequest("FOOBAR", "http://example.com/unknown", nil)
+	mux.ServeHTTP(httptest.NewRecorder(), r)
+
+	breaker := &rst.Breaker{Name: "billing", Threshold: 1}
+	mux.AddBreaker(breaker)
+	breaker.Do(func() error { return errors.New("failure") })
+
+	var test = func(name string, got, wanted float64) {
+		if got != wanted {
+			t.Errorf("%s: Got: %v Wanted: %v", name, got, wanted)
+		}
+	}
+	test("2xx", testutil.ToFloat64(collector.requests.WithLabelValues("/people/{id}", rst.Get, "2xx")), 2)
+	test("3xx", testutil.ToFloat64(collector.requests.WithLabelValues("/people/{id}", rst.Get, "3xx")), 1)
+	test("4xx", testutil.ToFloat64(collector.requests.WithLabelValues("/people/{id}", rst.Get, "4xx")), 1)
+	test("unmatched", testutil.ToFloat64(collector.requests.WithLabelValues(Unmatched, rst.Get, "4xx")), 1)
+	test("other method", testutil.ToFloat64(collector.requests.WithLabelValues(Unmatched, OtherMethod, "4xx")), 1)
+	test("not modified", testutil.ToFloat64(collector.notModified.WithLabelValues("/people/{id}")), 1)
+	test("not acceptable", testutil.ToFloat64(collector.notAcceptable.WithLabelValues("/people/{id}")), 1)
+	test("in flight", inFlight, 1)
+	test("in flight after", testutil.ToFloat64(collector.inFlight), 0)
+	test("logged", float64(logged), 6)
+	test("variant 2xx", testutil.ToFloat64(collector.experiments.WithLabelValues("shape", "control", "2xx")), 2)
+	test("variant 4xx", testutil.ToFloat64(collector.experiments.WithLabelValues("shape", "control", "4xx")), 1)
+
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(families) != 9 {
+		t.Errorf("Got: %d metrics Wanted: 9", len(families))
+	}
+	values := make(map[string]float64)
+	for _, family := range families {
+		for _, m := range family.GetMetric() {
+			if len(m.GetLabel()) != 2 || m.GetLabel()[0].GetValue() != "billing" {
+				continue
+			}
+			value := m.GetGauge().GetValue() + m.GetCounter().GetValue()
+			values[family.GetName()+" "+m.GetLabel()[1].GetValue()] = value
+		}
+	}
+	test("breaker open", values["api_breaker_state open"], 1)
+	test("breaker closed", values["api_breaker_state closed"], 0)
+	test("breaker trips", values["api_breaker_transitions_total open"], 1)
+	test("breaker resets", values["api_breaker_transitions_total closed"], 0)
+}
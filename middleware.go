@@ -0,0 +1,70 @@
+package rst
+
+import (
+	"net/http"
+
+	"github.com/gorilla/context"
+	gorillaMux "github.com/gorilla/mux"
+)
+
+// Middleware wraps the handler of the route matched by a mux, to run
+// cross-cutting logic such as authentication, logging or metrics.
+type Middleware func(http.Handler) http.Handler
+
+/*
+Use appends middlewares to the chain wrapping the handlers of the routes
+matched by the mux. Middlewares run in the order they were added, after the
+route was matched, so that RoutePattern and Vars are available. Middlewares
+attached to a route with Handle or HandleEndpoint run after them.
+
+	mux.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			next.ServeHTTP(w, r)
+			metrics.Observe(r.Method, rst.RoutePattern(r), time.Since(start))
+		})
+	})
+*/
+func (s *Mux) Use(middlewares ...Middleware) {
+	s.middlewares = append(s.middlewares, middlewares...)
+}
+
+// chain wraps handler in middlewares, the first one being the outermost.
+func chain(handler http.Handler, middlewares []Middleware) http.Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+	return handler
+}
+
+const routeKey = "__rst__route"
+
+func setRoute(r *http.Request, route *gorillaMux.Route) {
+	if route == nil {
+		return
+	}
+	if pattern, err := route.GetPathTemplate(); err == nil {
+		context.Set(r, routeKey, pattern)
+	}
+}
+
+// RoutePattern returns the pattern of the route matched by the mux for r, such
+// as "/people/{id}", or an empty string.
+func RoutePattern(r *http.Request) string {
+	if v := context.Get(r, routeKey); v != nil {
+		return v.(string)
+	}
+	return ""
+}
+
+// Vars returns the variables extracted from the URL of r by the route matched
+// by the mux.
+func Vars(r *http.Request) RouteVars {
+	return getVars(r)
+}
+
+// SetVars replaces the variables of r passed to the endpoints, so that handlers
+// and tests can serve r with an endpoint without routing it.
+func SetVars(r *http.Request, vars RouteVars) {
+	setVars(r, vars)
+}
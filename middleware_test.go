@@ -0,0 +1,77 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestUse(t *testing.T) {
+	var calls []string
+	trace := func(name string) Middleware {
+		return func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, name+":"+RoutePattern(r)+":"+Vars(r).Get("id"))
+				next.ServeHTTP(w, r)
+			})
+		}
+	}
+
+	mux := NewMux()
+	mux.Use(trace("a"), trace("b"))
+	mux.Get("/people/{id}", func(vars RouteVars, r *http.Request) (Resource, error) {
+		calls = append(calls, "endpoint")
+		return nil, NotFound()
+	})
+
+	r, _ := http.NewRequest(Get, "/people/1", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("got %d, wanted %d", w.Code, http.StatusNotFound)
+	}
+	if got, wanted := strings.Join(calls, ", "), "a:/people/{id}:1, b:/people/{id}:1, endpoint"; got != wanted {
+		t.Fatalf("got %q, wanted %q", got, wanted)
+	}
+
+	// Middlewares don't run for unmatched requests.
+	calls = nil
+	r, _ = http.NewRequest(Get, "/unknown", nil)
+	mux.ServeHTTP(httptest.NewRecorder(), r)
+	if len(calls) != 0 {
+		t.Fatalf("got calls %v for an unmatched request", calls)
+	}
+}
+
+func TestRouteMiddlewares(t *testing.T) {
+	authenticate := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") == "" {
+				Unauthorized().ServeHTTP(w, r)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+
+	mux := NewMux()
+	mux.HandleEndpoint("/people/{id}", &versionedEndpoint{doc: &versionedDocument{}}, authenticate)
+	mux.HandleEndpoint("/echo", &versionedEndpoint{doc: &versionedDocument{}})
+
+	tests := []struct {
+		path string
+		code int
+	}{
+		{"/people/1", http.StatusUnauthorized},
+		{"/echo", http.StatusOK},
+	}
+	for _, test := range tests {
+		r, _ := http.NewRequest(Get, test.path, nil)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != test.code {
+			t.Fatalf("%s: got %d, wanted %d", test.path, w.Code, test.code)
+		}
+	}
+}
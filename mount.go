@@ -0,0 +1,50 @@
+package rst
+
+import (
+	"net/http"
+	"strings"
+
+	gorillaMux "github.com/gorilla/mux"
+)
+
+/*
+Mount registers handler for all the paths starting with prefix, such as a pprof
+mux or an existing router, and strips the prefix from the path of requests
+before delegating them to handler. A prefix ending with a slash keeps the
+slash in the path seen by handler.
+
+	mux.Mount("/metrics/", metricsHandler)
+	mux.Mount("/legacy/", legacyRouter)
+
+Mounted handlers are served like the other routes of the mux: the middlewares,
+recovery, custom headers and CORS policy of the mux apply. Unhandle(prefix)
+removes the handler.
+*/
+func (s *Mux) Mount(prefix string, handler http.Handler) {
+	s.mount(prefix, handler, nil)
+}
+
+// Mount registers handler for all the paths of g starting with prefix. See
+// Mux.Mount.
+func (g *Group) Mount(prefix string, handler http.Handler) {
+	g.mux.mount(g.prefix+prefix, handler, g)
+}
+
+// mount registers handler for prefix, in group g if it's not nil.
+func (s *Mux) mount(prefix string, handler http.Handler, g *Group) {
+	handler = http.StripPrefix(strings.TrimSuffix(prefix, "/"), handler)
+	route := gorillaMux.NewRouter().PathPrefix(prefix).Handler(handler)
+	if g != nil && g.host != "" {
+		route.Host(g.host)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.register(&routeEntry{
+		key:     entryKey(prefix, g),
+		route:   route,
+		handler: handler,
+		group:   g,
+		prefix:  true,
+	}, nil)
+}
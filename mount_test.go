@@ -0,0 +1,48 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMount(t *testing.T) {
+	legacy := http.NewServeMux()
+	legacy.HandleFunc("/people", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("people"))
+	})
+	legacy.HandleFunc("/panic", func(w http.ResponseWriter, r *http.Request) {
+		panic("legacy")
+	})
+
+	mux := NewMux()
+	mux.Use(header("mux"))
+	mux.Mount("/legacy/", legacy)
+	mux.Host("admin.example.com").Mount("/debug", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("debug:" + r.URL.Path))
+	}))
+
+	var test = func(host, path string, status int, body string, mounted bool) {
+		r, _ := http.NewRequest(Get, "http://"+host+path, nil)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Errorf("%s%s: Got: %d Wanted: %d", host, path, w.Code, status)
+		}
+		if body != "" && w.Body.String() != body {
+			t.Errorf("%s%s: Got: %s Wanted: %s", host, path, w.Body.String(), body)
+		}
+		if got := w.Header().Get("X-Chain"); mounted != (got == "mux") {
+			t.Errorf("%s%s: middlewares of the mux not applied", host, path)
+		}
+	}
+
+	test("example.com", "/legacy/people", http.StatusOK, "people", true)
+	test("example.com", "/legacy/unknown", http.StatusNotFound, "", true)
+	test("example.com", "/legacy/panic", http.StatusInternalServerError, "", true)
+	test("admin.example.com", "/debug/vars", http.StatusOK, "debug:/vars", true)
+	test("example.com", "/debug/vars", http.StatusNotFound, "", false)
+
+	mux.Unhandle("/legacy/")
+	test("example.com", "/legacy/people", http.StatusNotFound, "", false)
+}
@@ -0,0 +1,304 @@
+package rst
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+)
+
+// MsgPackType is the media type of MessagePack payloads.
+const MsgPackType = "application/msgpack"
+
+// binaryEncoder returns an EncoderFunc which encodes the JSON representation
+// of resources with write, so that binary formats share the field names,
+// policies and engine of the JSON encoding.
+func binaryEncoder(write func(*bytes.Buffer, interface{})) EncoderFunc {
+	return func(resource interface{}, r *http.Request) ([]byte, error) {
+		b, err := marshalJSON(resource, r)
+		if err != nil {
+			return nil, err
+		}
+		var v interface{}
+		if err := decodeJSON(b, &v); err != nil {
+			return nil, err
+		}
+		if v == nil {
+			return []byte{}, nil
+		}
+		buffer := getBuffer()
+		write(buffer, v)
+		return detachBuffer(buffer), nil
+	}
+}
+
+// sortedKeys returns the keys of m in increasing order.
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// writeMsgPack writes the MessagePack encoding of v, a value decoded from JSON,
+// in buffer. The keys of maps are written in increasing order, so that the
+// encoding of a resource is stable.
+func writeMsgPack(buffer *bytes.Buffer, v interface{}) {
+	switch v := v.(type) {
+	case nil:
+		buffer.WriteByte(0xc0)
+	case bool:
+		if v {
+			buffer.WriteByte(0xc3)
+		} else {
+			buffer.WriteByte(0xc2)
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			writeMsgPackInt(buffer, i)
+			return
+		}
+		f, _ := v.Float64()
+		buffer.WriteByte(0xcb)
+		binary.Write(buffer, binary.BigEndian, math.Float64bits(f))
+	case string:
+		writeMsgPackHead(buffer, len(v), 0xa0, 32, 0xd9, 0xda, 0xdb)
+		buffer.WriteString(v)
+	case []interface{}:
+		writeMsgPackHead(buffer, len(v), 0x90, 16, 0, 0xdc, 0xdd)
+		for _, item := range v {
+			writeMsgPack(buffer, item)
+		}
+	case map[string]interface{}:
+		writeMsgPackHead(buffer, len(v), 0x80, 16, 0, 0xde, 0xdf)
+		for _, key := range sortedKeys(v) {
+			writeMsgPack(buffer, key)
+			writeMsgPack(buffer, v[key])
+		}
+	}
+}
+
+// writeMsgPackHead writes the prefix of a string, an array or a map of n
+// elements. fixed is the prefix of the values shorter than max, and the other
+// prefixes are those of the 8, 16 and 32-bit lengths. A zero prefix marks an
+// unsupported length.
+func writeMsgPackHead(buffer *bytes.Buffer, n int, fixed byte, max int, p8, p16, p32 byte) {
+	switch {
+	case n < max:
+		buffer.WriteByte(fixed | byte(n))
+	case n <= math.MaxUint8 && p8 != 0:
+		buffer.WriteByte(p8)
+		buffer.WriteByte(byte(n))
+	case n <= math.MaxUint16:
+		buffer.WriteByte(p16)
+		binary.Write(buffer, binary.BigEndian, uint16(n))
+	default:
+		buffer.WriteByte(p32)
+		binary.Write(buffer, binary.BigEndian, uint32(n))
+	}
+}
+
+// writeMsgPackInt writes i in the shortest integer format of MessagePack.
+func writeMsgPackInt(buffer *bytes.Buffer, i int64) {
+	switch {
+	case i >= 0 && i <= math.MaxInt8:
+		buffer.WriteByte(byte(i))
+	case i < 0 && i >= -32:
+		buffer.WriteByte(byte(int8(i)))
+	case i >= 0 && i <= math.MaxUint8:
+		buffer.WriteByte(0xcc)
+		buffer.WriteByte(byte(i))
+	case i >= 0 && i <= math.MaxUint16:
+		buffer.WriteByte(0xcd)
+		binary.Write(buffer, binary.BigEndian, uint16(i))
+	case i >= 0 && i <= math.MaxUint32:
+		buffer.WriteByte(0xce)
+		binary.Write(buffer, binary.BigEndian, uint32(i))
+	case i >= 0:
+		buffer.WriteByte(0xcf)
+		binary.Write(buffer, binary.BigEndian, uint64(i))
+	case i >= math.MinInt8:
+		buffer.WriteByte(0xd0)
+		buffer.WriteByte(byte(int8(i)))
+	case i >= math.MinInt16:
+		buffer.WriteByte(0xd1)
+		binary.Write(buffer, binary.BigEndian, int16(i))
+	case i >= math.MinInt32:
+		buffer.WriteByte(0xd2)
+		binary.Write(buffer, binary.BigEndian, int32(i))
+	default:
+		buffer.WriteByte(0xd3)
+		binary.Write(buffer, binary.BigEndian, i)
+	}
+}
+
+// maxMsgPackDepth is the maximum nesting of the arrays and maps read by
+// readMsgPack.
+const maxMsgPackDepth = 10000
+
+var errMsgPack = errors.New("malformed MessagePack value")
+
+// readMsgPack decodes b, a single MessagePack value, in the values decoded
+// from JSON, so that it can be encoded in JSON for the JSON engine. Binary
+// strings are decoded as slices of bytes, and extension types aren't
+// supported.
+func readMsgPack(b []byte) (interface{}, error) {
+	reader := &msgPackReader{b}
+	v, err := reader.read(0)
+	if err == nil && len(reader.b) > 0 {
+		err = errMsgPack
+	}
+	return v, err
+}
+
+// msgPackReader reads MessagePack values from b.
+type msgPackReader struct {
+	b []byte
+}
+
+// next returns the next n bytes of the reader.
+func (d *msgPackReader) next(n uint64) ([]byte, error) {
+	if uint64(len(d.b)) < n {
+		return nil, errMsgPack
+	}
+	p := d.b[:n]
+	d.b = d.b[n:]
+	return p, nil
+}
+
+// uint reads a big-endian unsigned integer of size bytes.
+func (d *msgPackReader) uint(size uint64) (uint64, error) {
+	p, err := d.next(size)
+	if err != nil {
+		return 0, err
+	}
+	var n uint64
+	for _, c := range p {
+		n = n<<8 | uint64(c)
+	}
+	return n, nil
+}
+
+func (d *msgPackReader) read(depth int) (interface{}, error) {
+	if depth > maxMsgPackDepth {
+		return nil, errMsgPack
+	}
+	p, err := d.next(1)
+	if err != nil {
+		return nil, err
+	}
+	c := p[0]
+	switch {
+	case c <= 0x7f:
+		return int64(c), nil
+	case c >= 0xe0:
+		return int64(int8(c)), nil
+	case c&0xe0 == 0xa0:
+		return d.str(uint64(c & 0x1f))
+	case c&0xf0 == 0x90:
+		return d.array(uint64(c&0x0f), depth)
+	case c&0xf0 == 0x80:
+		return d.kv(uint64(c&0x0f), depth)
+	}
+
+	switch c {
+	case 0xc0:
+		return nil, nil
+	case 0xc2:
+		return false, nil
+	case 0xc3:
+		return true, nil
+	case 0xc4, 0xc5, 0xc6:
+		n, err := d.uint(1 << (c - 0xc4))
+		if err != nil {
+			return nil, err
+		}
+		p, err := d.next(n)
+		return append([]byte(nil), p...), err
+	case 0xca:
+		n, err := d.uint(4)
+		return float64(math.Float32frombits(uint32(n))), err
+	case 0xcb:
+		n, err := d.uint(8)
+		return math.Float64frombits(n), err
+	case 0xcc, 0xcd, 0xce, 0xcf:
+		return d.uint(1 << (c - 0xcc))
+	case 0xd0:
+		n, err := d.uint(1)
+		return int64(int8(n)), err
+	case 0xd1:
+		n, err := d.uint(2)
+		return int64(int16(n)), err
+	case 0xd2:
+		n, err := d.uint(4)
+		return int64(int32(n)), err
+	case 0xd3:
+		n, err := d.uint(8)
+		return int64(n), err
+	case 0xd9, 0xda, 0xdb:
+		n, err := d.uint(1 << (c - 0xd9))
+		if err != nil {
+			return nil, err
+		}
+		return d.str(n)
+	case 0xdc, 0xdd:
+		n, err := d.uint(2 << (c - 0xdc))
+		if err != nil {
+			return nil, err
+		}
+		return d.array(n, depth)
+	case 0xde, 0xdf:
+		n, err := d.uint(2 << (c - 0xde))
+		if err != nil {
+			return nil, err
+		}
+		return d.kv(n, depth)
+	}
+	return nil, errMsgPack
+}
+
+func (d *msgPackReader) str(n uint64) (interface{}, error) {
+	p, err := d.next(n)
+	return string(p), err
+}
+
+func (d *msgPackReader) array(n uint64, depth int) (interface{}, error) {
+	// Each item takes at least a byte, which bounds the allocation.
+	if n > uint64(len(d.b)) {
+		return nil, errMsgPack
+	}
+	items := make([]interface{}, n)
+	for i := range items {
+		item, err := d.read(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = item
+	}
+	return items, nil
+}
+
+func (d *msgPackReader) kv(n uint64, depth int) (interface{}, error) {
+	if n > uint64(len(d.b))/2 {
+		return nil, errMsgPack
+	}
+	m := make(map[string]interface{}, n)
+	for i := uint64(0); i < n; i++ {
+		key, err := d.read(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		value, err := d.read(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		m[fmt.Sprint(key)] = value
+	}
+	return m, nil
+}
@@ -0,0 +1,59 @@
+package rst
+
+import (
+	"bytes"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWriteMsgPack(t *testing.T) {
+	var test = func(doc, expected string) {
+		var v interface{}
+		if err := decodeJSON([]byte(doc), &v); err != nil {
+			t.Fatal(err)
+		}
+		buffer := &bytes.Buffer{}
+		writeMsgPack(buffer, v)
+		if got := hex.EncodeToString(buffer.Bytes()); got != expected {
+			t.Errorf("%s: Got: %s Wanted: %s", doc, got, expected)
+		}
+	}
+
+	test(`null`, "c0")
+	test(`true`, "c3")
+	test(`false`, "c2")
+	test(`1`, "01")
+	test(`-1`, "ff")
+	test(`-33`, "d0df")
+	test(`200`, "ccc8")
+	test(`1000`, "cd03e8")
+	test(`-200`, "d1ff38")
+	test(`100000`, "ce000186a0")
+	test(`1.5`, "cb3ff8000000000000")
+	test(`"x"`, "a178")
+	test(`{"b":[true,null,"x"],"a":1}`, "82a16101a16293c3c0a178")
+}
+
+func TestMsgPackNegotiation(t *testing.T) {
+	mux := NewMux()
+	mux.Get("/", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(&viewResource{"Francis"}, time.Now(), "etag", 0), nil
+	})
+
+	r, _ := http.NewRequest(Get, "/", nil)
+	r.Header.Set("Accept", MsgPackType)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if ct := w.Header().Get("Content-Type"); ct != MsgPackType {
+		t.Errorf("Content-Type: Got: %s Wanted: %s", ct, MsgPackType)
+	}
+	if etag := w.Header().Get("ETag"); etag != "etag" {
+		t.Errorf("ETag: Got: %s Wanted: etag", etag)
+	}
+	if expected := "81a44e616d65a74672616e636973"; hex.EncodeToString(w.Body.Bytes()) != expected {
+		t.Errorf("Got: %x Wanted: %s", w.Body.Bytes(), expected)
+	}
+}
@@ -0,0 +1,290 @@
+package rst
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"os"
+	"strconv"
+)
+
+// DefaultMultipartMemory is the number of bytes of a multipart form kept in
+// memory by default. The rest of the files is stored in temporary files.
+const DefaultMultipartMemory = 32 << 20
+
+const multipartFormType = "multipart/form-data"
+
+// MultipartLimits are the limits of the multipart forms parsed by
+// ParseMultipartForm.
+type MultipartLimits struct {
+	MaxMemory   int64 // Optional. Bytes of files kept in memory, DefaultMultipartMemory by default.
+	MaxFileSize int64 // Optional. Maximum size of each file, larger ones are rejected with status code 413. 0 means no limit.
+	MaxFiles    int   // Optional. Maximum number of files, more are rejected with status code 413. 0 means no limit.
+	MaxValues   int64 // Optional. Maximum size of the values of the fields which aren't files, MaxMemory by default.
+}
+
+func (l *MultipartLimits) maxMemory() int64 {
+	if l == nil || l.MaxMemory <= 0 {
+		return DefaultMultipartMemory
+	}
+	return l.MaxMemory
+}
+
+// MultipartForm is a parsed multipart/form-data body.
+type MultipartForm struct {
+	Values url.Values                 // Values of the fields which aren't files.
+	Files  map[string][]*UploadedFile // Files, indexed by field.
+}
+
+// UploadedFile is a file of a multipart form.
+type UploadedFile struct {
+	Field       string               // Name of the field of the file.
+	Filename    string               // Name of the file on the client, which must not be trusted.
+	ContentType string               // Content type of the part, or application/octet-stream.
+	Size        int64                // Size of the file in bytes.
+	Header      textproto.MIMEHeader // Headers of the part.
+
+	content []byte // Content of files kept in memory.
+	path    string // Path of the temporary files.
+}
+
+// Open returns a reader of the content of f.
+func (f *UploadedFile) Open() (io.ReadCloser, error) {
+	if f.path != "" {
+		return os.Open(f.path)
+	}
+	return io.NopCloser(bytes.NewReader(f.content)), nil
+}
+
+/*
+ParseMultipartForm parses the multipart/form-data body of r within limits,
+which can be nil.
+
+	func (ep *PhotosEP) Post(vars rst.RouteVars, r *http.Request) (rst.Resource, string, error) {
+		form, err := rst.ParseMultipartForm(r, &rst.MultipartLimits{MaxFileSize: 10 << 20})
+		if err != nil {
+			return nil, "", err
+		}
+		defer form.RemoveAll()
+
+		photo, err := form.File("photo")
+		if err != nil {
+			return nil, "", err
+		}
+		...
+	}
+
+Files are kept in memory until the parts of the form exceed MaxMemory, and are
+streamed to temporary files beyond, which are deleted by RemoveAll. The errors
+returned can be returned by endpoints as is: bodies which aren't multipart
+forms respond with status code 415 Unsupported Media Type, malformed forms with
+400 Bad Request, and forms exceeding the limits or the MaxRequestBodyBytes of
+the mux with 413 Request Entity Too Large.
+*/
+func ParseMultipartForm(r *http.Request, limits *MultipartLimits) (*MultipartForm, error) {
+	mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != multipartFormType || params["boundary"] == "" {
+		return nil, UnsupportedMediaType(multipartFormType)
+	}
+	if limits == nil {
+		limits = &MultipartLimits{}
+	}
+
+	form := &MultipartForm{Values: make(url.Values), Files: make(map[string][]*UploadedFile)}
+	if err := form.read(multipart.NewReader(r.Body, params["boundary"]), limits); err != nil {
+		form.RemoveAll()
+		return nil, err
+	}
+	return form, nil
+}
+
+// read reads the parts of reader in f.
+func (f *MultipartForm) read(reader *multipart.Reader, limits *MultipartLimits) error {
+	memory, maxValues := limits.maxMemory(), limits.MaxValues
+	if maxValues <= 0 {
+		maxValues = memory
+	}
+	var values int64
+	var files int
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return multipartError(err)
+		}
+
+		name := part.FormName()
+		if name == "" {
+			part.Close()
+			continue
+		}
+		if part.FileName() == "" {
+			var b bytes.Buffer
+			n, err := io.CopyN(&b, part, maxValues-values+1)
+			part.Close()
+			if err != nil && err != io.EOF {
+				return multipartError(err)
+			}
+			if values += n; values > maxValues {
+				return formTooLarge(fmt.Sprintf("The values of the form must not exceed %d bytes.", maxValues))
+			}
+			f.Values.Add(name, b.String())
+			continue
+		}
+
+		if files++; limits.MaxFiles > 0 && files > limits.MaxFiles {
+			part.Close()
+			return formTooLarge(fmt.Sprintf("The form must not contain more than %d files.", limits.MaxFiles))
+		}
+		file, err := readUploadedFile(part, &memory, limits.MaxFileSize)
+		part.Close()
+		if file != nil {
+			f.Files[name] = append(f.Files[name], file)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// readUploadedFile reads the file of part, in memory while it fits in
+// memory, and in a temporary file beyond.
+func readUploadedFile(part *multipart.Part, memory *int64, maxSize int64) (*UploadedFile, error) {
+	file := &UploadedFile{
+		Field:       part.FormName(),
+		Filename:    part.FileName(),
+		ContentType: part.Header.Get("Content-Type"),
+		Header:      part.Header,
+	}
+	if file.ContentType == "" {
+		file.ContentType = "application/octet-stream"
+	}
+	var reader io.Reader = part
+	if maxSize > 0 {
+		reader = io.LimitReader(part, maxSize+1)
+	}
+
+	var b bytes.Buffer
+	n, err := io.CopyN(&b, reader, *memory+1)
+	if err != nil && err != io.EOF {
+		return nil, multipartError(err)
+	}
+	if n <= *memory {
+		*memory -= n
+		file.content, file.Size = b.Bytes(), n
+	} else {
+		temp, err := os.CreateTemp("", "rst-multipart-")
+		if err != nil {
+			return nil, err
+		}
+		file.path = temp.Name()
+		rest, err := io.Copy(temp, io.MultiReader(&b, reader))
+		if closeErr := temp.Close(); err == nil {
+			err = closeErr
+		}
+		file.Size = rest
+		if err != nil {
+			return file, multipartError(err)
+		}
+	}
+	if maxSize > 0 && file.Size > maxSize {
+		return file, formTooLarge(fmt.Sprintf("The files of the form must not exceed %d bytes.", maxSize))
+	}
+	return file, nil
+}
+
+// RemoveAll removes the temporary files of f.
+func (f *MultipartForm) RemoveAll() error {
+	var err error
+	for _, files := range f.Files {
+		for _, file := range files {
+			if file.path == "" {
+				continue
+			}
+			if e := os.Remove(file.path); e != nil && !errors.Is(e, os.ErrNotExist) && err == nil {
+				err = e
+			}
+		}
+	}
+	return err
+}
+
+// Value returns the first value of the field name, or an empty string.
+func (f *MultipartForm) Value(name string) string {
+	return f.Values.Get(name)
+}
+
+// Int returns the value of the field name as an integer. It returns a
+// BadRequest error if the field is missing or isn't an integer.
+func (f *MultipartForm) Int(name string) (int, error) {
+	i, err := strconv.Atoi(f.Values.Get(name))
+	if err != nil {
+		return 0, invalidField(name, "an integer")
+	}
+	return i, nil
+}
+
+// Float returns the value of the field name as a number. It returns a
+// BadRequest error if the field is missing or isn't a number.
+func (f *MultipartForm) Float(name string) (float64, error) {
+	v, err := strconv.ParseFloat(f.Values.Get(name), 64)
+	if err != nil {
+		return 0, invalidField(name, "a number")
+	}
+	return v, nil
+}
+
+// Bool returns the value of the field name as a boolean, which is false if
+// the field is missing. It returns a BadRequest error if the field isn't a
+// boolean.
+func (f *MultipartForm) Bool(name string) (bool, error) {
+	value := f.Values.Get(name)
+	if value == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, invalidField(name, "a boolean")
+	}
+	return b, nil
+}
+
+// File returns the first file of the field name. It returns a BadRequest
+// error if the form doesn't have one.
+func (f *MultipartForm) File(name string) (*UploadedFile, error) {
+	if files := f.Files[name]; len(files) > 0 {
+		return files[0], nil
+	}
+	return nil, BadRequest("Missing file", fmt.Sprintf("The form must contain a file in the %s field.", name))
+}
+
+// invalidField is returned when the value of a form field doesn't have the
+// expected type.
+func invalidField(name, expected string) *Error {
+	return BadRequest("Invalid form field", fmt.Sprintf("The value of the %s field must be %s.", name, expected))
+}
+
+// formTooLarge is returned when a multipart form exceeds its limits.
+func formTooLarge(description string) *Error {
+	err := NewError(http.StatusRequestEntityTooLarge, "Request body is too large", description)
+	err.Header.Set("Connection", "close")
+	return err
+}
+
+// multipartError returns the error of the mux matching err, an error reading a
+// multipart form.
+func multipartError(err error) error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	return BadRequest("Malformed multipart form", "The body of the request is not a valid multipart/form-data body.")
+}
@@ -0,0 +1,111 @@
+package rst
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+)
+
+// newMultipartRequest returns a request whose body is a multipart form with
+// values and files, indexed by field.
+func newMultipartRequest(values map[string]string, files map[string]string) *http.Request {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for name, value := range values {
+		writer.WriteField(name, value)
+	}
+	for name, content := range files {
+		part, _ := writer.CreateFormFile(name, name+".txt")
+		part.Write([]byte(content))
+	}
+	writer.Close()
+	r, _ := http.NewRequest(Post, "http://example.com/photos", &body)
+	r.Header.Set("Content-Type", writer.FormDataContentType())
+	return r
+}
+
+func TestParseMultipartForm(t *testing.T) {
+	r := newMultipartRequest(
+		map[string]string{"title": "Inauguration", "count": "3", "public": "true", "ratio": "x"},
+		map[string]string{"small": "tiny", "large": strings.Repeat("a", 100)},
+	)
+	form, err := ParseMultipartForm(r, &MultipartLimits{MaxMemory: 50})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer form.RemoveAll()
+
+	if form.Value("title") != "Inauguration" {
+		t.Errorf("title is %q", form.Value("title"))
+	}
+	if count, err := form.Int("count"); err != nil || count != 3 {
+		t.Errorf("count is %d, %v", count, err)
+	}
+	if public, err := form.Bool("public"); err != nil || !public {
+		t.Errorf("public is %t, %v", public, err)
+	}
+	if _, err := form.Float("ratio"); err == nil || err.(*Error).Code != http.StatusBadRequest {
+		t.Errorf("ratio returned %v, expected a 400 error", err)
+	}
+	if _, err := form.File("missing"); err == nil || err.(*Error).Code != http.StatusBadRequest {
+		t.Errorf("missing file returned %v, expected a 400 error", err)
+	}
+
+	var path string
+	for name, expected := range map[string]string{"small": "tiny", "large": strings.Repeat("a", 100)} {
+		file, err := form.File(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if file.Filename != name+".txt" || file.Size != int64(len(expected)) || file.ContentType != "application/octet-stream" {
+			t.Errorf("%s is %+v", name, file)
+		}
+		if name == "large" {
+			if file.path == "" {
+				t.Error("large file is kept in memory")
+			}
+			path = file.path
+		}
+		f, _ := file.Open()
+		b, _ := io.ReadAll(f)
+		f.Close()
+		if string(b) != expected {
+			t.Errorf("%s contains %q", name, b)
+		}
+	}
+
+	form.RemoveAll()
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("temporary file %s wasn't removed", path)
+	}
+}
+
+func TestParseMultipartFormErrors(t *testing.T) {
+	var test = func(r *http.Request, limits *MultipartLimits, code int) {
+		_, err := ParseMultipartForm(r, limits)
+		if e, ok := err.(*Error); !ok || e.Code != code {
+			t.Errorf("%+v: Got %v, expected %d", limits, err, code)
+		}
+	}
+
+	r, _ := http.NewRequest(Post, "http://example.com/photos", strings.NewReader(`{}`))
+	r.Header.Set("Content-Type", "application/json")
+	test(r, nil, http.StatusUnsupportedMediaType)
+
+	r, _ = http.NewRequest(Post, "http://example.com/photos", strings.NewReader("garbage"))
+	r.Header.Set("Content-Type", "multipart/form-data; boundary=xyz")
+	test(r, nil, http.StatusBadRequest)
+
+	test(newMultipartRequest(nil, map[string]string{"photo": strings.Repeat("a", 20)}), &MultipartLimits{MaxFileSize: 10}, http.StatusRequestEntityTooLarge)
+	test(newMultipartRequest(nil, map[string]string{"a": "a", "b": "b"}), &MultipartLimits{MaxFiles: 1}, http.StatusRequestEntityTooLarge)
+	test(newMultipartRequest(map[string]string{"title": strings.Repeat("a", 20)}, nil), &MultipartLimits{MaxValues: 10}, http.StatusRequestEntityTooLarge)
+
+	r = newMultipartRequest(nil, map[string]string{"photo": strings.Repeat("a", 100)})
+	r.ContentLength = -1
+	limitBody(r, 50)
+	test(r, nil, http.StatusRequestEntityTooLarge)
+}
@@ -0,0 +1,440 @@
+package rst
+
+import (
+	"encoding/json"
+	"net/http"
+	"path"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// OpenAPIVersion is the version of the OpenAPI specification of the documents
+// generated by a mux.
+const OpenAPIVersion = "3.0.3"
+
+// OpenAPI is an OpenAPI 3 document describing the routes of a mux.
+type OpenAPI struct {
+	OpenAPI    string                     `json:"openapi"`
+	Info       OpenAPIInfo                `json:"info"`
+	Paths      map[string]OpenAPIPathItem `json:"paths"`
+	Components *OpenAPIComponents         `json:"components,omitempty"`
+}
+
+// OpenAPIInfo holds the metadata of an API.
+type OpenAPIInfo struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// OpenAPIPathItem holds the operations of a path, indexed by lowercase method.
+type OpenAPIPathItem map[string]*OpenAPIOperation
+
+// OpenAPIOperation describes the requests of a method on a path.
+type OpenAPIOperation struct {
+	Summary     string                      `json:"summary,omitempty"`
+	Description string                      `json:"description,omitempty"`
+	Parameters  []*OpenAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *OpenAPIRequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*OpenAPIResponse `json:"responses"`
+	Deprecated  bool                        `json:"deprecated,omitempty"`
+}
+
+// OpenAPIParameter describes a parameter of an operation.
+type OpenAPIParameter struct {
+	Name        string         `json:"name"`
+	In          string         `json:"in"` // path, query or header.
+	Description string         `json:"description,omitempty"`
+	Required    bool           `json:"required,omitempty"`
+	Schema      *OpenAPISchema `json:"schema,omitempty"`
+}
+
+// OpenAPIRequestBody describes the body of the requests of an operation.
+type OpenAPIRequestBody struct {
+	Required bool                         `json:"required,omitempty"`
+	Content  map[string]*OpenAPIMediaType `json:"content"`
+}
+
+// OpenAPIResponse describes a response of an operation.
+type OpenAPIResponse struct {
+	Description string                       `json:"description"`
+	Content     map[string]*OpenAPIMediaType `json:"content,omitempty"`
+}
+
+// OpenAPIMediaType describes the content of a body in a media type.
+type OpenAPIMediaType struct {
+	Schema  *OpenAPISchema `json:"schema,omitempty"`
+	Example interface{}    `json:"example,omitempty"`
+}
+
+// OpenAPIComponents holds the schemas referred to by a document.
+type OpenAPIComponents struct {
+	Schemas map[string]*OpenAPISchema `json:"schemas,omitempty"`
+}
+
+// OpenAPISchema is the schema of a value, or a reference to a schema of the
+// components of a document.
+type OpenAPISchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	Pattern              string                    `json:"pattern,omitempty"`
+	Properties           map[string]*OpenAPISchema `json:"properties,omitempty"`
+	Items                *OpenAPISchema            `json:"items,omitempty"`
+	AdditionalProperties *OpenAPISchema            `json:"additionalProperties,omitempty"`
+}
+
+/*
+ResourceTyper is implemented by endpoints to declare the type of the resources
+they serve, which is reflected into the schemas of their OpenAPI operations.
+
+	func (ep *PersonEP) ResourceType() interface{} {
+		return &Person{}
+	}
+
+When the value implements Ranger, GET operations document the Range header
+with the units of the value, and 206 Partial Content responses.
+*/
+type ResourceTyper interface {
+	ResourceType() interface{}
+}
+
+/*
+OpenAPI returns an OpenAPI 3 document describing the endpoints registered in s.
+
+	doc := mux.OpenAPI()
+	doc.Info.Title = "People"
+
+The operations of each route are derived from the interfaces implemented by its
+endpoint, and the variables of its pattern are documented as path parameters.
+The schemas of the resources of endpoints implementing ResourceTyper are
+reflected from their Go type, with the field names of their JSON
+representation. The summaries, descriptions, examples and deprecation of
+endpoints implementing Describable are included in their operations. Routes
+registered with Handle aren't documented.
+*/
+func (s *Mux) OpenAPI() *OpenAPI {
+	g := &openAPIGenerator{
+		fieldName: func(name string) string { return name },
+		schemas:   make(map[string]*OpenAPISchema),
+		names:     make(map[reflect.Type]string),
+	}
+	if s.jsonPolicy != nil && s.jsonPolicy.FieldName != nil {
+		g.fieldName = s.jsonPolicy.FieldName
+	}
+	errorSchema := g.schema(reflect.TypeOf(Error{}))
+
+	doc := &OpenAPI{
+		OpenAPI: OpenAPIVersion,
+		Info:    OpenAPIInfo{Title: "API", Version: "1.0.0"},
+		Paths:   make(map[string]OpenAPIPathItem),
+	}
+	for _, route := range s.Routes() {
+		if _, document := route.Endpoint.(*openAPIEndpoint); document || route.Endpoint == nil {
+			continue
+		}
+		template, params := openAPIPath(route.Pattern)
+		item := doc.Paths[template]
+		if item == nil {
+			item = make(OpenAPIPathItem)
+			doc.Paths[template] = item
+		}
+
+		var resource interface{}
+		if typer, implemented := route.Endpoint.(ResourceTyper); implemented {
+			resource = typer.ResourceType()
+		}
+		var schema *OpenAPISchema
+		if resource != nil {
+			schema = g.schema(reflect.TypeOf(resource))
+		}
+		description := route.Description
+
+		for _, method := range route.Methods {
+			if method == Head || method == Options {
+				continue
+			}
+			op := &OpenAPIOperation{
+				Parameters: append([]*OpenAPIParameter(nil), params...),
+				Responses: map[string]*OpenAPIResponse{
+					"default": {Description: "Error", Content: openAPIContent(errorSchema)},
+				},
+			}
+			switch method {
+			case Get:
+				op.Responses["200"] = &OpenAPIResponse{Description: "OK", Content: openAPIContent(schema)}
+				op.Responses["304"] = &OpenAPIResponse{Description: "Not Modified"}
+				if ranger, implemented := resource.(Ranger); implemented && len(ranger.Units()) > 0 {
+					op.Parameters = append(op.Parameters, &OpenAPIParameter{
+						Name:        "Range",
+						In:          "header",
+						Description: "Range of the resource, in " + strings.Join(ranger.Units(), " or ") + ".",
+						Schema:      &OpenAPISchema{Type: "string"},
+					})
+					op.Responses["206"] = &OpenAPIResponse{Description: "Partial Content", Content: openAPIContent(schema)}
+					op.Responses["416"] = &OpenAPIResponse{Description: "Requested Range Not Satisfiable"}
+					if p := s.paginationOf(route.Handler); p != nil {
+						op.Parameters = append(op.Parameters,
+							&OpenAPIParameter{Name: p.pageParam(), In: "query", Schema: &OpenAPISchema{Type: "integer"}},
+							&OpenAPIParameter{Name: p.sizeParam(), In: "query", Schema: &OpenAPISchema{Type: "integer"}},
+						)
+					}
+				}
+			case Post:
+				op.RequestBody = openAPIRequestBody(schema)
+				op.Responses["201"] = &OpenAPIResponse{Description: "Created", Content: openAPIContent(schema)}
+			case Put, Patch:
+				op.RequestBody = openAPIRequestBody(schema)
+				op.Responses["200"] = &OpenAPIResponse{Description: "OK", Content: openAPIContent(schema)}
+				op.Responses["412"] = &OpenAPIResponse{Description: "Precondition Failed"}
+			case Delete:
+				op.Responses["204"] = &OpenAPIResponse{Description: "No Content"}
+			default:
+				op.Responses["200"] = &OpenAPIResponse{Description: "OK"}
+			}
+
+			m := description.method(method)
+			op.Summary, op.Description, op.Deprecated = m.Summary, m.Description, m.Deprecated
+			if description != nil {
+				if op.Summary == "" {
+					op.Summary = description.Summary
+				}
+				if op.Description == "" {
+					op.Description = description.Description
+				}
+				op.Deprecated = op.Deprecated || description.Deprecated
+			}
+			op.Deprecated = op.Deprecated || route.Deprecation != nil
+			if m.RequestExample != nil {
+				if op.RequestBody == nil {
+					op.RequestBody = &OpenAPIRequestBody{Required: true}
+				}
+				op.RequestBody.Content = openAPIExample(op.RequestBody.Content, m.RequestExample)
+			}
+			if m.ResponseExample != nil {
+				for _, code := range []string{"200", "201"} {
+					if resp := op.Responses[code]; resp != nil {
+						resp.Content = openAPIExample(resp.Content, m.ResponseExample)
+					}
+				}
+			}
+			item[strings.ToLower(method)] = op
+		}
+	}
+	if len(g.schemas) > 0 {
+		doc.Components = &OpenAPIComponents{Schemas: g.schemas}
+	}
+	return doc
+}
+
+// openAPIVariable matches the variables of a route pattern.
+var openAPIVariable = regexp.MustCompile(`\{([^{}:]+)(?::([^{}]*(?:\{[^{}]*\}[^{}]*)*))?\}`)
+
+// openAPIPath returns the OpenAPI template of pattern, whose variables can
+// have a regular expression, and its path parameters.
+func openAPIPath(pattern string) (string, []*OpenAPIParameter) {
+	var params []*OpenAPIParameter
+	template := openAPIVariable.ReplaceAllStringFunc(pattern, func(variable string) string {
+		m := openAPIVariable.FindStringSubmatch(variable)
+		param := &OpenAPIParameter{Name: m[1], In: "path", Required: true, Schema: &OpenAPISchema{Type: "string"}}
+		if m[2] != "" {
+			param.Schema.Pattern = "^" + m[2] + "$"
+		}
+		params = append(params, param)
+		return "{" + m[1] + "}"
+	})
+	return template, params
+}
+
+// openAPIContent returns the JSON content of schema, or nil if schema is nil.
+func openAPIContent(schema *OpenAPISchema) map[string]*OpenAPIMediaType {
+	if schema == nil {
+		return nil
+	}
+	return map[string]*OpenAPIMediaType{"application/json": {Schema: schema}}
+}
+
+// openAPIExample returns content, or a new JSON content, with example.
+func openAPIExample(content map[string]*OpenAPIMediaType, example interface{}) map[string]*OpenAPIMediaType {
+	if content == nil {
+		content = map[string]*OpenAPIMediaType{"application/json": {}}
+	}
+	for _, mediaType := range content {
+		mediaType.Example = example
+	}
+	return content
+}
+
+func openAPIRequestBody(schema *OpenAPISchema) *OpenAPIRequestBody {
+	if schema == nil {
+		return nil
+	}
+	return &OpenAPIRequestBody{Required: true, Content: openAPIContent(schema)}
+}
+
+// openAPIGenerator reflects Go types into schemas, recording the ones of named
+// structs in schemas.
+type openAPIGenerator struct {
+	fieldName func(string) string
+	schemas   map[string]*OpenAPISchema
+	names     map[reflect.Type]string
+}
+
+// schema returns the schema of the JSON encoding of t.
+func (g *openAPIGenerator) schema(t reflect.Type) *OpenAPISchema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch {
+	case t == timeType:
+		return &OpenAPISchema{Type: "string", Format: "date-time"}
+	case t.Implements(jsonMarshalerType), reflect.PtrTo(t).Implements(jsonMarshalerType):
+		return &OpenAPISchema{}
+	case t.Implements(textMarshalerType), reflect.PtrTo(t).Implements(textMarshalerType):
+		return &OpenAPISchema{Type: "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &OpenAPISchema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return &OpenAPISchema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return &OpenAPISchema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &OpenAPISchema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &OpenAPISchema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &OpenAPISchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &OpenAPISchema{Type: "string", Format: "byte"}
+		}
+		return &OpenAPISchema{Type: "array", Items: g.schema(t.Elem())}
+	case reflect.Map:
+		return &OpenAPISchema{Type: "object", AdditionalProperties: g.schema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchema(t)
+		}
+		return &OpenAPISchema{Ref: "#/components/schemas/" + g.component(t)}
+	}
+	return &OpenAPISchema{}
+}
+
+// component returns the name of the schema of t in the components, adding it
+// if it's not there yet.
+func (g *openAPIGenerator) component(t reflect.Type) string {
+	if name, found := g.names[t]; found {
+		return name
+	}
+	name := t.Name()
+	if _, taken := g.schemas[name]; taken {
+		name = path.Base(t.PkgPath()) + "." + name
+	}
+	g.names[t] = name
+	g.schemas[name] = &OpenAPISchema{} // Placeholder for recursive types.
+	*g.schemas[name] = *g.structSchema(t)
+	return name
+}
+
+// structSchema returns the schema of the struct type t.
+func (g *openAPIGenerator) structSchema(t reflect.Type) *OpenAPISchema {
+	schema := &OpenAPISchema{Type: "object", Properties: make(map[string]*OpenAPISchema)}
+	g.addFields(schema, t)
+	return schema
+}
+
+// addFields adds the properties of the fields of t to schema, including the
+// ones of its embedded structs.
+func (g *openAPIGenerator) addFields(schema *OpenAPISchema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options := tag, ""
+		if j := strings.IndexByte(tag, ','); j >= 0 {
+			name, options = tag[:j], tag[j:]
+		}
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			g.addFields(schema, fieldType)
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = g.fieldName(field.Name)
+		}
+		if strings.Contains(options, ",string") {
+			schema.Properties[name] = &OpenAPISchema{Type: "string"}
+			continue
+		}
+		schema.Properties[name] = g.schema(field.Type)
+	}
+}
+
+// openAPIEndpoint serves the OpenAPI document of a mux.
+type openAPIEndpoint struct {
+	mux  *Mux
+	info OpenAPIInfo
+}
+
+/*
+HandleOpenAPI serves the OpenAPI document of the endpoints registered in s at
+pattern, such as /openapi.json, with the given info.
+
+	mux.HandleOpenAPI("/openapi.json", rst.OpenAPIInfo{Title: "People", Version: "2.1.0"})
+
+The document is generated for each request, so that it includes the routes
+registered after HandleOpenAPI is called. Its ETag is derived from its content,
+and its Last-Modified header is the date of the last change of the routes.
+*/
+func (s *Mux) HandleOpenAPI(pattern string, info OpenAPIInfo) {
+	s.HandleEndpoint(pattern, &openAPIEndpoint{s, info})
+}
+
+func (e *openAPIEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	doc := e.mux.OpenAPI()
+	doc.Info = e.info
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	resource := &openAPIResource{document: b, lastModified: e.mux.routes().modified}
+	resource.etag = AutoETag(resource, r)
+	return resource, nil
+}
+
+// openAPIResource is an OpenAPI document encoded in JSON.
+type openAPIResource struct {
+	document     []byte
+	etag         string
+	lastModified time.Time
+}
+
+func (d *openAPIResource) ETag() string {
+	return d.etag
+}
+
+func (d *openAPIResource) LastModified() time.Time {
+	return d.lastModified
+}
+
+func (d *openAPIResource) TTL() time.Duration {
+	return 0
+}
+
+func (d *openAPIResource) MarshalRST(r *http.Request) (string, []byte, error) {
+	return "application/json; charset=utf-8", d.document, nil
+}
@@ -0,0 +1,154 @@
+package rst
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type documentedAddress struct {
+	City string `json:"city"`
+}
+
+type documentedPerson struct {
+	ID       string             `json:"id"`
+	Name     string             `json:"name,omitempty"`
+	Age      int                `json:"age,string"`
+	Tags     []string           `json:"tags"`
+	Born     time.Time          `json:"born"`
+	Address  *documentedAddress `json:"address"`
+	Friends  []*documentedPerson
+	Password string `json:"-"`
+	internal string
+}
+
+type documentedEndpoint struct{}
+
+func (e *documentedEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	return nil, NotFound()
+}
+
+func (e *documentedEndpoint) Put(vars RouteVars, r *http.Request) (Resource, error) {
+	return nil, NotFound()
+}
+
+func (e *documentedEndpoint) ResourceType() interface{} {
+	return &documentedPerson{}
+}
+
+type documentedCollection struct{}
+
+func (e *documentedCollection) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	return newNumbers(10), nil
+}
+
+func (e *documentedCollection) ResourceType() interface{} {
+	return numbers{}
+}
+
+func TestOpenAPI(t *testing.T) {
+	mux := NewMux()
+	mux.HandleEndpoint("/people/{id:[0-9]+}", &documentedEndpoint{})
+	mux.HandleEndpoint("/numbers", &documentedCollection{})
+	mux.Delete("/people/{id}/friends/{friend}", func(vars RouteVars, r *http.Request) error { return nil })
+	mux.Handle("/static", http.NotFoundHandler())
+	mux.SetPagination(&Pagination{})
+	mux.HandleOpenAPI("/openapi.json", OpenAPIInfo{Title: "People", Version: "2.0.0"})
+
+	doc := mux.OpenAPI()
+	if doc.OpenAPI != OpenAPIVersion {
+		t.Errorf("OpenAPI is %q, expected %q", doc.OpenAPI, OpenAPIVersion)
+	}
+	var paths []string
+	for path := range doc.Paths {
+		paths = append(paths, path)
+	}
+	if len(paths) != 3 {
+		t.Fatalf("Paths are %v, expected /people/{id}, /numbers and /people/{id}/friends/{friend}", paths)
+	}
+
+	item := doc.Paths["/people/{id}"]
+	if len(item) != 2 || item["get"] == nil || item["put"] == nil {
+		t.Fatalf("Operations of /people/{id} are %v, expected get and put", item)
+	}
+	if params := item["get"].Parameters; len(params) != 1 || params[0].Name != "id" || params[0].In != "path" || !params[0].Required || params[0].Schema.Pattern != "^[0-9]+$" {
+		t.Errorf("Parameters of GET /people/{id} are %+v", params)
+	}
+	if schema := item["get"].Responses["200"].Content["application/json"].Schema; schema.Ref != "#/components/schemas/documentedPerson" {
+		t.Errorf("Schema of GET /people/{id} is %+v", schema)
+	}
+	if item["put"].RequestBody == nil || item["put"].Responses["412"] == nil {
+		t.Errorf("PUT /people/{id} is %+v, expected a request body and a 412 response", item["put"])
+	}
+
+	person := doc.Components.Schemas["documentedPerson"]
+	expected := map[string]*OpenAPISchema{
+		"id":      {Type: "string"},
+		"name":    {Type: "string"},
+		"age":     {Type: "string"},
+		"tags":    {Type: "array", Items: &OpenAPISchema{Type: "string"}},
+		"born":    {Type: "string", Format: "date-time"},
+		"address": {Ref: "#/components/schemas/documentedAddress"},
+		"Friends": {Type: "array", Items: &OpenAPISchema{Ref: "#/components/schemas/documentedPerson"}},
+	}
+	if !reflect.DeepEqual(person.Properties, expected) {
+		got, _ := json.Marshal(person.Properties)
+		t.Errorf("Properties of documentedPerson are %s", got)
+	}
+	if doc.Components.Schemas["documentedAddress"] == nil || doc.Components.Schemas["Error"] == nil {
+		t.Errorf("Components are %v, expected documentedAddress and Error", doc.Components.Schemas)
+	}
+
+	get := doc.Paths["/numbers"]["get"]
+	if get.Responses["206"] == nil || len(get.Parameters) != 3 || get.Parameters[0].Name != "Range" || get.Parameters[1].Name != DefaultPageParam {
+		got, _ := json.Marshal(get)
+		t.Errorf("GET /numbers is %s, expected range and pagination parameters", got)
+	}
+	if del := doc.Paths["/people/{id}/friends/{friend}"]["delete"]; del == nil || len(del.Parameters) != 2 || del.Responses["204"] == nil {
+		t.Errorf("DELETE /people/{id}/friends/{friend} is %+v", del)
+	}
+}
+
+func TestHandleOpenAPI(t *testing.T) {
+	mux := NewMux()
+	mux.HandleOpenAPI("/openapi.json", OpenAPIInfo{Title: "People", Version: "2.0.0"})
+	mux.HandleEndpoint("/people/{id}", &documentedEndpoint{})
+
+	r, _ := http.NewRequest(Get, "http://example.com/openapi.json", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code is %d, expected 200 OK", w.Code)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/json; charset=utf-8" {
+		t.Errorf("Content-Type is %q", ct)
+	}
+	var doc OpenAPI
+	if err := json.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc.Info.Title != "People" || doc.Paths["/people/{id}"] == nil || doc.Paths["/openapi.json"] != nil {
+		t.Errorf("Document is %s", w.Body.String())
+	}
+
+	etag := w.Header().Get("ETag")
+	if etag == "" || w.Header().Get("Last-Modified") == "" {
+		t.Fatalf("Headers are %v, expected an ETag and a Last-Modified header", w.Header())
+	}
+	r.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if w.Code != http.StatusNotModified {
+		t.Errorf("Status code is %d, expected 304 Not Modified", w.Code)
+	}
+
+	mux.Delete("/people", func(vars RouteVars, r *http.Request) error { return nil })
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if w.Code != http.StatusOK || w.Header().Get("ETag") == etag {
+		t.Errorf("Status code is %d with ETag %s, expected a new document", w.Code, w.Header().Get("ETag"))
+	}
+}
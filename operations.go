@@ -0,0 +1,90 @@
+package rst
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"time"
+)
+
+// DefaultOperationsPrefix is the path under which the status of the
+// asynchronous operations of a mux are exposed by default.
+const DefaultOperationsPrefix = "/operations"
+
+/*
+AsyncOperation is a resource returned by endpoints to run long work in the
+background, in the operations queue of the mux.
+
+	func (ep *ExportsEP) Post(vars rst.RouteVars, r *http.Request) (rst.Resource, string, error) {
+		return rst.NewAsyncOperation(newExport(r)), "", nil
+	}
+
+The request is answered with status code 202 Accepted, and a Location header
+pointing to the status of the operation at /operations/{id}, which reports
+whether it's pending, running, succeeded or failed, along with a Retry-After
+header until it's done. Once it has succeeded, the Location header of its status
+points to its result, at /operations/{id}/result.
+*/
+type AsyncOperation struct {
+	Runner JobRunner
+
+	etag    string
+	created time.Time
+}
+
+// NewAsyncOperation returns an operation running runner in the background.
+func NewAsyncOperation(runner JobRunner) *AsyncOperation {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return &AsyncOperation{Runner: runner, etag: hex.EncodeToString(b), created: time.Now()}
+}
+
+// ETag implements the Resource interface.
+func (o *AsyncOperation) ETag() string {
+	return o.etag
+}
+
+// LastModified implements the Resource interface.
+func (o *AsyncOperation) LastModified() time.Time {
+	return o.created
+}
+
+// TTL implements the Resource interface.
+func (o *AsyncOperation) TTL() time.Duration {
+	return 0
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (o *AsyncOperation) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	job, err := getMux(r).Operations().enqueue(o.Runner)
+	if err != nil {
+		writeError(err, w, r)
+		return
+	}
+	w.Header().Set("ETag", job.ETag())
+	w.Header().Set("Last-Modified", job.LastModified().UTC().Format(rfc1123))
+	job.Accepted().(http.Handler).ServeHTTP(w, r)
+}
+
+// Operations returns the queue running the asynchronous operations of the mux,
+// which is registered under DefaultOperationsPrefix the first time it's
+// needed, unless it was set with SetOperations.
+func (s *Mux) Operations() *JobQueue {
+	s.operationsMu.Lock()
+	defer s.operationsMu.Unlock()
+	if s.operations == nil {
+		s.operations = NewJobQueue(DefaultOperationsPrefix)
+		s.HandleJobQueue(s.operations)
+	}
+	return s.operations
+}
+
+// SetOperations registers queue in the mux, and makes it the queue running
+// the asynchronous operations returned by endpoints, to expose them under a
+// custom prefix or keep them in a custom JobStore.
+func (s *Mux) SetOperations(queue *JobQueue) {
+	s.operationsMu.Lock()
+	defer s.operationsMu.Unlock()
+	s.operations = queue
+	s.HandleJobQueue(queue)
+}
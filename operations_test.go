@@ -0,0 +1,60 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAsyncOperation(t *testing.T) {
+	mux := NewMux()
+	release := make(chan struct{})
+	mux.Post("/exports", func(vars RouteVars, r *http.Request) (Resource, string, error) {
+		return NewAsyncOperation(JobFunc(func(job *Job) (Resource, error) {
+			<-release
+			return testPeople[0], nil
+		})), "", nil
+	})
+
+	r, _ := http.NewRequest(Post, "http://www.example.com/exports", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if w.Code != http.StatusAccepted {
+		t.Fatal("Got:", w.Code, "Wanted:", http.StatusAccepted)
+	}
+	location := w.Header().Get("Location")
+	if !strings.HasPrefix(location, DefaultOperationsPrefix+"/") || w.Header().Get("Retry-After") != "5" {
+		t.Fatalf("Location is %q and Retry-After %q", location, w.Header().Get("Retry-After"))
+	}
+
+	job := mux.Operations().Job(strings.TrimPrefix(location, DefaultOperationsPrefix+"/"))
+	if job == nil {
+		t.Fatal("operation isn't in the operations queue of the mux")
+	}
+	close(release)
+	<-job.Done()
+
+	r, _ = http.NewRequest(Get, "http://www.example.com"+location+"/result", nil)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatal("Got:", w.Code, "Wanted:", http.StatusOK)
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	mux := NewMux()
+	queue := NewJobQueue("/tasks")
+	mux.SetOperations(queue)
+	if mux.Operations() != queue {
+		t.Fatal("Operations doesn't return the queue set with SetOperations")
+	}
+	job := queue.Enqueue(JobFunc(func(job *Job) (Resource, error) { return nil, nil }))
+	r, _ := http.NewRequest(Get, "http://www.example.com"+job.URL(), nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatal("Got:", w.Code, "Wanted:", http.StatusOK)
+	}
+}
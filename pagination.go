@@ -0,0 +1,181 @@
+package rst
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/context"
+)
+
+// Defaults of a Pagination.
+const (
+	DefaultPageSize    = 20
+	DefaultMaxPageSize = 100
+	DefaultPageParam   = "page"
+	DefaultSizeParam   = "per_page"
+)
+
+// TotalCountHeader is set with the number of units of a paginated resource.
+const TotalCountHeader = "X-Total-Count"
+
+/*
+Pagination paginates the resources implementing Ranger, with the page and
+per_page parameters of the query string when requests don't have a Range
+header.
+
+	mux.SetPagination(&rst.Pagination{PageSize: 50})
+
+A request for /people?page=3&per_page=10 is passed to Ranger.Range as the range
+resources=20-29, and is responded to with status code 200 OK. Responses to
+paginated requests, including range requests, have an X-Total-Count header and
+a Link header, as defined by RFC 5988, with the URLs of the first, previous,
+next and last pages:
+
+	Link: </people?page=1&per_page=10>; rel="first", </people?page=2&per_page=10>; rel="prev", </people?page=4&per_page=10>; rel="next", </people?page=10&per_page=10>; rel="last"
+
+The links of range requests refer to pages of the size of the range.
+Endpoints implementing PaginationPolicy use their own pagination, or none.
+*/
+type Pagination struct {
+	PageSize    int    // Optional. Size of the pages when the request doesn't have a per_page parameter. DefaultPageSize by default.
+	MaxPageSize int    // Optional. Size of the largest page. DefaultMaxPageSize by default.
+	PageParam   string // Optional. Name of the parameter of the page, DefaultPageParam by default.
+	SizeParam   string // Optional. Name of the parameter of the size of pages, DefaultSizeParam by default.
+}
+
+// PaginationPolicy is implemented by endpoints paginating their resources with
+// their own settings. A nil Pagination disables the pagination of the mux for
+// the endpoint.
+type PaginationPolicy interface {
+	Pagination() *Pagination
+}
+
+// SetPagination sets the pagination of the resources served by the mux,
+// unless their endpoint implements PaginationPolicy. A nil value disables it,
+// which is the default.
+func (s *Mux) SetPagination(p *Pagination) {
+	s.pagination = p
+}
+
+// paginationOf returns the pagination of the resources of handler, or nil.
+func (s *Mux) paginationOf(handler http.Handler) *Pagination {
+	if h, valid := handler.(*endpointHandler); valid {
+		if policy, implemented := policyEndpoint(h.endpoint).(PaginationPolicy); implemented {
+			return policy.Pagination()
+		}
+	}
+	return s.pagination
+}
+
+const paginationKey = "__rst__pagination"
+
+// paginationFor returns the pagination of the resource requested by r, or nil.
+func paginationFor(r *http.Request) *Pagination {
+	if p, ok := context.Get(r, paginationKey).(*Pagination); ok {
+		return p
+	}
+	return getMux(r).pagination
+}
+
+func (p *Pagination) pageParam() string {
+	if p.PageParam == "" {
+		return DefaultPageParam
+	}
+	return p.PageParam
+}
+
+func (p *Pagination) sizeParam() string {
+	if p.SizeParam == "" {
+		return DefaultSizeParam
+	}
+	return p.SizeParam
+}
+
+func (p *Pagination) maxPageSize() int {
+	if p.MaxPageSize <= 0 {
+		return DefaultMaxPageSize
+	}
+	return p.MaxPageSize
+}
+
+// page returns the page and the size of pages requested by r.
+func (p *Pagination) page(r *http.Request) (page, size int, err error) {
+	query := r.URL.Query()
+	page, size = 1, p.PageSize
+	if size <= 0 {
+		size = DefaultPageSize
+	}
+	if raw := query.Get(p.pageParam()); raw != "" {
+		if page, err = strconv.Atoi(raw); err != nil || page < 1 {
+			return 0, 0, invalidPage(p.pageParam(), raw)
+		}
+	}
+	if raw := query.Get(p.sizeParam()); raw != "" {
+		if size, err = strconv.Atoi(raw); err != nil || size < 1 {
+			return 0, 0, invalidPage(p.sizeParam(), raw)
+		}
+	}
+	if max := p.maxPageSize(); size > max {
+		size = max
+	}
+	return page, size, nil
+}
+
+// rangeOf returns the range of the units of ranger in the page requested by r,
+// or nil if ranger is empty.
+func (p *Pagination) rangeOf(ranger Ranger, r *http.Request) (*Range, int, int, error) {
+	page, size, err := p.page(r)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	count := ranger.Count()
+	from := uint64(page-1) * uint64(size)
+	if count == 0 && page == 1 {
+		return nil, page, size, nil
+	}
+	if from >= count {
+		return nil, 0, 0, NotFound()
+	}
+	units := ranger.Units()
+	if len(units) == 0 {
+		return nil, page, size, nil
+	}
+	return &Range{Unit: units[0], From: from, To: from + uint64(size) - 1}, page, size, nil
+}
+
+// setHeaders sets the X-Total-Count and Link headers of the page of count
+// units requested by r in h.
+func (p *Pagination) setHeaders(h http.Header, page, size int, count uint64, r *http.Request) {
+	h.Set(TotalCountHeader, strconv.FormatUint(count, 10))
+
+	last := int((count + uint64(size) - 1) / uint64(size))
+	if last < 1 {
+		last = 1
+	}
+	link := func(page int, rel string) string {
+		query := r.URL.Query()
+		query.Set(p.pageParam(), strconv.Itoa(page))
+		query.Set(p.sizeParam(), strconv.Itoa(size))
+		return fmt.Sprintf(`<%s?%s>; rel="%s"`, r.URL.EscapedPath(), query.Encode(), rel)
+	}
+	links := []string{link(1, "first")}
+	if page > 1 && page <= last+1 {
+		links = append(links, link(page-1, "prev"))
+	}
+	if page < last {
+		links = append(links, link(page+1, "next"))
+	}
+	links = append(links, link(last, "last"))
+	h.Set("Link", strings.Join(links, ", "))
+}
+
+// invalidPage is returned when the value of a pagination parameter isn't a
+// positive integer.
+func invalidPage(param, value string) *Error {
+	return BadRequest(
+		"Invalid pagination parameter",
+		fmt.Sprintf("The value %q of the %s parameter must be a positive integer.", value, param),
+	)
+}
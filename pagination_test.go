@@ -0,0 +1,101 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// numbers is a collection of the integers from 0 to its length.
+type numbers []int
+
+func newNumbers(n int) numbers {
+	c := make(numbers, n)
+	for i := range c {
+		c[i] = i
+	}
+	return c
+}
+
+func (c numbers) Count() uint64           { return uint64(len(c)) }
+func (c numbers) Units() []string         { return []string{"resources"} }
+func (c numbers) LastModified() time.Time { return testTimeReference }
+func (c numbers) ETag() string            { return "numbers" }
+func (c numbers) TTL() time.Duration      { return 0 }
+func (c numbers) Range(rg *Range) (*ContentRange, Resource, error) {
+	return &ContentRange{rg, c.Count()}, c[rg.From : rg.To+1], nil
+}
+
+type paginatedEndpoint struct {
+	numbers    numbers
+	pagination *Pagination
+}
+
+func (e *paginatedEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	return e.numbers, nil
+}
+
+func (e *paginatedEndpoint) Pagination() *Pagination {
+	return e.pagination
+}
+
+func TestPagination(t *testing.T) {
+	mux := NewMux()
+	mux.SetPagination(&Pagination{PageSize: 10, MaxPageSize: 20})
+	mux.Get("/numbers", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return newNumbers(45), nil
+	})
+	mux.Get("/empty", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return numbers{}, nil
+	})
+	mux.HandleEndpoint("/unpaginated", &paginatedEndpoint{numbers: newNumbers(45)})
+	mux.HandleEndpoint("/custom", &paginatedEndpoint{newNumbers(45), &Pagination{PageSize: 5, PageParam: "p", SizeParam: "n"}})
+
+	var test = func(path string, header http.Header, status int, body, link string) {
+		r, _ := http.NewRequest(Get, "http://example.com"+path, nil)
+		r.Header = header
+		r.Header.Set("Accept", "application/json")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Errorf("%s %v: Got: %d Wanted: %d", path, header, w.Code, status)
+		}
+		if got := strings.TrimSpace(w.Body.String()); body != "" && got != body {
+			t.Errorf("%s %v: Got: %s Wanted: %s", path, header, got, body)
+		}
+		if got := w.Header().Get("Link"); got != link {
+			t.Errorf("%s %v: Link: Got: %s Wanted: %s", path, header, got, link)
+		}
+	}
+
+	test("/numbers", http.Header{}, http.StatusOK, "[0,1,2,3,4,5,6,7,8,9]",
+		`</numbers?page=1&per_page=10>; rel="first", </numbers?page=2&per_page=10>; rel="next", </numbers?page=5&per_page=10>; rel="last"`)
+	test("/numbers?page=3&per_page=5&sort=asc", http.Header{}, http.StatusOK, "[10,11,12,13,14]",
+		`</numbers?page=1&per_page=5&sort=asc>; rel="first", </numbers?page=2&per_page=5&sort=asc>; rel="prev", </numbers?page=4&per_page=5&sort=asc>; rel="next", </numbers?page=9&per_page=5&sort=asc>; rel="last"`)
+	test("/numbers?page=5", http.Header{}, http.StatusOK, "[40,41,42,43,44]",
+		`</numbers?page=1&per_page=10>; rel="first", </numbers?page=4&per_page=10>; rel="prev", </numbers?page=5&per_page=10>; rel="last"`)
+	test("/numbers?per_page=100", http.Header{}, http.StatusOK, "",
+		`</numbers?page=1&per_page=20>; rel="first", </numbers?page=2&per_page=20>; rel="next", </numbers?page=3&per_page=20>; rel="last"`)
+	test("/numbers?page=6", http.Header{}, http.StatusNotFound, "", "")
+	test("/numbers?page=0", http.Header{}, http.StatusBadRequest, "", "")
+	test("/numbers?per_page=abc", http.Header{}, http.StatusBadRequest, "", "")
+	test("/empty", http.Header{}, http.StatusOK, "",
+		`</empty?page=1&per_page=10>; rel="first", </empty?page=1&per_page=10>; rel="last"`)
+
+	// Range requests.
+	test("/numbers", http.Header{"Range": {"resources=10-19"}}, http.StatusPartialContent, "[10,11,12,13,14,15,16,17,18,19]",
+		`</numbers?page=1&per_page=10>; rel="first", </numbers?page=1&per_page=10>; rel="prev", </numbers?page=3&per_page=10>; rel="next", </numbers?page=5&per_page=10>; rel="last"`)
+
+	test("/unpaginated", http.Header{}, http.StatusOK, "", "")
+	test("/custom?p=2", http.Header{}, http.StatusOK, "[5,6,7,8,9]",
+		`</custom?n=5&p=1>; rel="first", </custom?n=5&p=1>; rel="prev", </custom?n=5&p=3>; rel="next", </custom?n=5&p=9>; rel="last"`)
+
+	r, _ := http.NewRequest(Get, "http://example.com/numbers", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if got := w.Header().Get(TotalCountHeader); got != "45" {
+		t.Errorf("%s: Got: %s Wanted: 45", TotalCountHeader, got)
+	}
+}
@@ -0,0 +1,137 @@
+package rst
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"reflect"
+)
+
+// MergePatchType is the media type of JSON Merge Patch documents, defined in
+// RFC 7386.
+const MergePatchType = "application/merge-patch+json"
+
+/*
+ApplyMergePatch applies the JSON Merge Patch in the body of r to resource,
+which must be a pointer.
+
+	func (ep *PersonEP) Patch(vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+		person := database.Find(vars.Get("id"))
+		if person == nil {
+			return nil, rst.NotFound()
+		}
+		if err := rst.ApplyMergePatch(person, r); err != nil {
+			return nil, err
+		}
+		return person, database.Save(person)
+	}
+
+Requests with another Content-Type are rejected with status code 415
+Unsupported Media Type and an Accept-Patch header, and requests whose
+If-Match or If-Unmodified-Since headers don't match the current version of
+resource are rejected with status code 412 Precondition Failed.
+
+The patch is merged in the JSON encoding of resource by the JSON engine and
+policy of the mux, as defined by RFC 7386, and the result is decoded in a new
+value whose fields encoded in JSON replace the ones of resource. Patches use
+the field names and time format of the policy, as responses do. Members set to null remove
+the keys of maps and reset fields to their zero value, nested objects are
+merged with the ones of resource, and fields which aren't encoded in JSON are
+left unchanged. ApplyMergePatch panics if resource isn't a pointer.
+*/
+func ApplyMergePatch(resource Resource, r *http.Request) error {
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != MergePatchType {
+		err := UnsupportedMediaType(MergePatchType)
+		err.Header.Set("Accept-Patch", MergePatchType)
+		return err
+	}
+	if ValidateConditions(resource, r) {
+		return PreconditionFailed()
+	}
+
+	rv := reflect.ValueOf(resource)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		panic(errors.New("rst: ApplyMergePatch requires a pointer"))
+	}
+	patch, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+
+	engine, policy := getJSONEngine(r), getMux(r).jsonPolicy
+	doc, err := policy.marshal(engine, resource)
+	if err != nil {
+		return err
+	}
+	patched, err := MergePatch(doc, patch)
+	if err != nil {
+		return BadRequest("Invalid merge patch", err.Error())
+	}
+	fresh := reflect.New(rv.Elem().Type())
+	if err := policy.unmarshal(engine, patched, fresh.Interface()); err != nil {
+		return BadRequest("Invalid merge patch", err.Error())
+	}
+	setEncodedFields(rv.Elem(), fresh.Elem())
+	return nil
+}
+
+// setEncodedFields sets the fields of the struct dst encoded in JSON to the
+// ones of src, or dst to src if it isn't a struct.
+func setEncodedFields(dst, src reflect.Value) {
+	if dst.Kind() != reflect.Struct {
+		dst.Set(src)
+		return
+	}
+	t := dst.Type()
+	for i := 0; i < t.NumField(); i++ {
+		// Unexported fields, including embedded structs, can't be set.
+		if sf := t.Field(i); sf.PkgPath == "" && sf.Tag.Get("json") != "-" {
+			dst.Field(i).Set(src.Field(i))
+		}
+	}
+}
+
+// MergePatch applies the JSON Merge Patch patch to the JSON document doc, as
+// defined in RFC 7386, and returns the patched document.
+func MergePatch(doc, patch []byte) ([]byte, error) {
+	var target interface{}
+	if len(bytes.TrimSpace(doc)) > 0 {
+		if err := decodeJSON(doc, &target); err != nil {
+			return nil, err
+		}
+	}
+	var p interface{}
+	if err := decodeJSON(patch, &p); err != nil {
+		return nil, err
+	}
+	return json.Marshal(mergePatch(target, p))
+}
+
+func mergePatch(target, patch interface{}) interface{} {
+	p, isObject := patch.(map[string]interface{})
+	if !isObject {
+		return patch
+	}
+	t, isObject := target.(map[string]interface{})
+	if !isObject {
+		t = make(map[string]interface{})
+	}
+	for key, value := range p {
+		if value == nil {
+			delete(t, key)
+		} else {
+			t[key] = mergePatch(t[key], value)
+		}
+	}
+	return t
+}
+
+// decodeJSON decodes b in v, preserving the precision of numbers.
+func decodeJSON(b []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
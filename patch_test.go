@@ -0,0 +1,173 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMergePatch(t *testing.T) {
+	tests := []struct {
+		doc, patch, result string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{``, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+		{`{"n":12345678901234567890}`, `{}`, `{"n":12345678901234567890}`},
+	}
+	for i, test := range tests {
+		result, err := MergePatch([]byte(test.doc), []byte(test.patch))
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if string(result) != test.result {
+			t.Fatalf("%d: got %s, wanted %s", i, result, test.result)
+		}
+	}
+}
+
+type patchablePerson struct {
+	Name    string   `json:"name"`
+	Email   *string  `json:"email"`
+	Tags    []string `json:"tags"`
+	version string
+}
+
+func (p *patchablePerson) ETag() string { return p.version }
+
+func (p *patchablePerson) LastModified() time.Time { return testTimeReference }
+
+func (p *patchablePerson) TTL() time.Duration { return 0 }
+
+func TestApplyMergePatch(t *testing.T) {
+	newPerson := func() *patchablePerson {
+		email := "john@example.com"
+		return &patchablePerson{Name: "John", Email: &email, Tags: []string{"a"}, version: "v1"}
+	}
+	newRequest := func(contentType, body string) *http.Request {
+		r, _ := http.NewRequest(Patch, "/people/1", strings.NewReader(body))
+		r.Header.Set("Content-Type", contentType)
+		return r
+	}
+
+	person := newPerson()
+	if err := ApplyMergePatch(person, newRequest(MergePatchType, `{"email":null,"tags":["b","c"]}`)); err != nil {
+		t.Fatal(err)
+	}
+	if person.Name != "John" || person.Email != nil || len(person.Tags) != 2 || person.version != "v1" {
+		t.Fatalf("got %+v", person)
+	}
+
+	err := ApplyMergePatch(newPerson(), newRequest("application/json", `{}`))
+	if e, ok := err.(*Error); !ok || e.Code != http.StatusUnsupportedMediaType || e.Header.Get("Accept-Patch") != MergePatchType {
+		t.Fatalf("got %v, wanted a 415 error with Accept-Patch", err)
+	}
+
+	r := newRequest(MergePatchType, `{"name":"Jane"}`)
+	r.Header.Set("If-Match", "v0")
+	if e, ok := ApplyMergePatch(newPerson(), r).(*Error); !ok || e.Code != http.StatusPreconditionFailed {
+		t.Fatalf("got %v, wanted a 412 error", e)
+	}
+
+	if e, ok := ApplyMergePatch(newPerson(), newRequest(MergePatchType, `{"name":`)).(*Error); !ok || e.Code != http.StatusBadRequest {
+		t.Fatalf("got %v, wanted a 400 error", e)
+	}
+}
+
+type patchableDocument struct {
+	Tags map[string]string `json:"tags"`
+	Meta interface{}       `json:"meta"`
+	Skip string            `json:"-"`
+}
+
+func (d *patchableDocument) ETag() string { return "" }
+
+func (d *patchableDocument) LastModified() time.Time { return testTimeReference }
+
+func (d *patchableDocument) TTL() time.Duration { return 0 }
+
+func TestApplyMergePatchNested(t *testing.T) {
+	doc := &patchableDocument{
+		Tags: map[string]string{"k": "v", "l": "w"},
+		Meta: map[string]interface{}{"x": 1, "y": 2},
+		Skip: "kept",
+	}
+	r, _ := http.NewRequest(Patch, "/docs/1", strings.NewReader(`{"tags":{"k":null,"m":"z"},"meta":{"x":3}}`))
+	r.Header.Set("Content-Type", MergePatchType)
+	if err := ApplyMergePatch(doc, r); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(doc.Tags, map[string]string{"l": "w", "m": "z"}) {
+		t.Errorf("null members must delete keys, got %v", doc.Tags)
+	}
+	meta, _ := doc.Meta.(map[string]interface{})
+	if len(meta) != 2 || meta["x"] != float64(3) || meta["y"] != float64(2) {
+		t.Errorf("nested objects must be merged, got %v", doc.Meta)
+	}
+	if doc.Skip != "kept" {
+		t.Errorf("fields which aren't encoded must be kept, got %q", doc.Skip)
+	}
+}
+
+// patchableAccount is encoded according to the JSON policy of the mux.
+type patchableAccount struct {
+	FirstName string
+	Created   time.Time
+	Deleted   *time.Time `json:",omitempty"`
+}
+
+func (a *patchableAccount) ETag() string { return "v1" }
+
+func (a *patchableAccount) LastModified() time.Time { return testTimeReference }
+
+func (a *patchableAccount) TTL() time.Duration { return 0 }
+
+func TestApplyMergePatchJSONPolicy(t *testing.T) {
+	account := &patchableAccount{FirstName: "Francis", Created: testTimeReference}
+	mux := NewMux()
+	mux.SetJSONPolicy(&JSONPolicy{FieldName: SnakeCase, TimeFormat: time.RFC1123})
+	mux.Patch("/accounts/1", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return account, ApplyMergePatch(account, r)
+	})
+
+	deleted := time.Date(2015, time.January, 2, 3, 4, 5, 0, time.UTC)
+	body := `{"first_name":"Claire","deleted":"` + deleted.Format(time.RFC1123) + `"}`
+	r, _ := http.NewRequest(Patch, "/accounts/1", strings.NewReader(body))
+	r.Header.Set("Content-Type", MergePatchType)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("got %d, wanted %d: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+	if account.FirstName != "Claire" || account.Deleted == nil || !account.Deleted.Equal(deleted) {
+		t.Errorf("the patch must use the names and times of the policy, got %+v", account)
+	}
+	if !account.Created.Equal(testTimeReference) {
+		t.Errorf("unpatched times must be kept, got %v", account.Created)
+	}
+}
+
+func TestMuxPatch(t *testing.T) {
+	mux := NewMux()
+	mux.Patch("/people/{id}", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return nil, nil
+	})
+	r, _ := http.NewRequest(Patch, "/people/1", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("got %d, wanted %d", w.Code, http.StatusOK)
+	}
+}
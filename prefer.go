@@ -0,0 +1,90 @@
+package rst
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Values of the return preference of RFC 7240.
+const (
+	returnMinimal        = "minimal"
+	returnRepresentation = "representation"
+)
+
+// parsePrefer returns the preferences of the Prefer headers of r, indexed by
+// lowercase token. The parameters of the preferences are ignored.
+func parsePrefer(r *http.Request) map[string]string {
+	var prefs map[string]string
+	for _, header := range r.Header[http.CanonicalHeaderKey("Prefer")] {
+		for _, pref := range strings.Split(header, ",") {
+			pref = strings.TrimSpace(strings.SplitN(pref, ";", 2)[0])
+			if pref == "" {
+				continue
+			}
+			token, value := pref, ""
+			if i := strings.Index(pref, "="); i >= 0 {
+				token, value = strings.TrimSpace(pref[:i]), strings.Trim(strings.TrimSpace(pref[i+1:]), `"`)
+			}
+			if prefs == nil {
+				prefs = make(map[string]string)
+			}
+			if token = strings.ToLower(token); prefs[token] == "" {
+				prefs[token] = value
+			}
+		}
+	}
+	return prefs
+}
+
+// preferredReturn returns the return preference of r, which is only honored
+// for the POST, PUT and PATCH methods.
+func preferredReturn(r *http.Request) string {
+	switch strings.ToUpper(r.Method) {
+	case Post, Put, Patch:
+	default:
+		return ""
+	}
+	switch value := strings.ToLower(parsePrefer(r)["return"]); value {
+	case returnMinimal, returnRepresentation:
+		return value
+	}
+	return ""
+}
+
+// applyPrefer honors the return preference of r, and returns true if the
+// response was written without a body, with status code 204 No Content or the
+// one chosen by the endpoint, because a minimal response was requested.
+// resource can be nil.
+func applyPrefer(resource Resource, w http.ResponseWriter, r *http.Request) bool {
+	// Redirections and operations aren't representations of the resource.
+	switch resource.(type) {
+	case *Redirection, *AsyncOperation, *acceptedJob:
+		return false
+	}
+	pref := preferredReturn(r)
+	if pref == "" || (pref == returnRepresentation && resource == nil) {
+		return false
+	}
+	w.Header().Set("Preference-Applied", "return="+pref)
+	addVary(w.Header(), "Prefer")
+	if pref == returnRepresentation {
+		return false
+	}
+
+	if resource != nil {
+		if etag := representationETag(resource.ETag(), r); etag != "" {
+			w.Header().Set("ETag", etag)
+		}
+		if lm := resource.LastModified(); !lm.IsZero() {
+			w.Header().Set("Last-Modified", lm.UTC().Format(rfc1123))
+		}
+	}
+	// A status code chosen by the endpoint, such as 201 Created, is kept.
+	code := http.StatusNoContent
+	if chosen := statusOf(resource, r); chosen != 0 && chosen != http.StatusOK {
+		code = chosen
+	}
+	w.WriteHeader(code)
+	w.Write(noContent)
+	return true
+}
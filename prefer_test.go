@@ -0,0 +1,61 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParsePrefer(t *testing.T) {
+	r, _ := http.NewRequest(Post, "http://www.example.com/people", nil)
+	r.Header.Add("Prefer", `respond-async, wait=10`)
+	r.Header.Add("Prefer", `Return="minimal"; foo=bar, return=representation`)
+	prefs := parsePrefer(r)
+	expected := map[string]string{"respond-async": "", "wait": "10", "return": "minimal"}
+	if len(prefs) != len(expected) {
+		t.Fatalf("Got: %v Wanted: %v", prefs, expected)
+	}
+	for token, value := range expected {
+		if v, ok := prefs[token]; !ok || v != value {
+			t.Errorf("%s is %q, wanted %q", token, v, value)
+		}
+	}
+}
+
+func TestPrefer(t *testing.T) {
+	mux := NewMux()
+	mux.Post("/people", func(vars RouteVars, r *http.Request) (Resource, string, error) {
+		return testPeople[0], "/people/1", nil
+	})
+	mux.Put("/people/1", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return testPeople[0], nil
+	})
+
+	var test = func(method, path, prefer string, code int, applied string) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(method, "http://www.example.com"+path, nil)
+		r.Header.Set("Accept", "application/json")
+		if prefer != "" {
+			r.Header.Set("Prefer", prefer)
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != code {
+			t.Errorf("%s %s (%s): Got: %d Wanted: %d", method, path, prefer, w.Code, code)
+		}
+		if got := w.Header().Get("Preference-Applied"); got != applied {
+			t.Errorf("%s %s (%s): Preference-Applied is %q, wanted %q", method, path, prefer, got, applied)
+		}
+		return w
+	}
+
+	w := test(Post, "/people", "return=minimal", http.StatusNoContent, "return=minimal")
+	if w.Body.Len() != 0 || w.Header().Get("Location") != "/people/1" || w.Header().Get("ETag") != testPeople[0].ETag() {
+		t.Errorf("Minimal response has headers %v and body %q", w.Header(), w.Body.String())
+	}
+	if w = test(Post, "/people", "return=representation", http.StatusCreated, "return=representation"); w.Body.Len() == 0 {
+		t.Error("Representation wasn't written")
+	}
+	test(Post, "/people", "", http.StatusCreated, "")
+	test(Put, "/people/1", "return=minimal", http.StatusNoContent, "return=minimal")
+	test(Put, "/people/1", "return=unknown", http.StatusOK, "")
+}
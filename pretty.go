@@ -0,0 +1,87 @@
+package rst
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/context"
+)
+
+/*
+PrettyJSONPolicy is implemented by endpoints to override the PrettyJSON setting
+of the mux.
+
+When enabled, requests with a pretty parameter in their query string, or with
+a pretty parameter in the JSON media range of their Accept header, receive an
+indented JSON representation:
+
+	GET /people/1?pretty=1
+	Accept: application/json; pretty=true
+
+The representation is indented with the Indent of the JSONPolicy of the mux,
+or with two spaces. Requests without the parameter, or with a false value such
+as pretty=0, keep receiving compact JSON.
+*/
+type PrettyJSONPolicy interface {
+	// PrettyJSON returns true if the endpoint supports the pretty parameter.
+	PrettyJSON() bool
+}
+
+// prettyJSONAllowed returns true if handler supports the pretty parameter.
+func (s *Mux) prettyJSONAllowed(handler http.Handler) bool {
+	if h, valid := handler.(*endpointHandler); valid {
+		if policy, implemented := policyEndpoint(h.endpoint).(PrettyJSONPolicy); implemented {
+			return policy.PrettyJSON()
+		}
+	}
+	return s.PrettyJSON
+}
+
+const (
+	prettyKey    = "__rst__pretty"
+	prettyParam  = "pretty"
+	prettyIndent = "  "
+)
+
+// prettyRequested returns true if r asks for indented JSON.
+func prettyRequested(r *http.Request) bool {
+	if v := context.Get(r, prettyKey); v == nil || !v.(bool) {
+		return false
+	}
+	if values, found := r.URL.Query()[prettyParam]; found {
+		return truthy(values[0])
+	}
+	for _, clause := range ParseAccept(r.Header.Get("Accept")) {
+		if value, found := clause.Params[prettyParam]; found && isJSON(clause.Type+"/"+clause.SubType) {
+			return truthy(value)
+		}
+	}
+	return false
+}
+
+// truthy returns true if value is empty, as in ?pretty, or a true boolean.
+func truthy(value string) bool {
+	if value == "" {
+		return true
+	}
+	b, err := strconv.ParseBool(value)
+	return err == nil && b
+}
+
+// prettyJSON indents b, a JSON representation, if r asks for it.
+func prettyJSON(b []byte, r *http.Request) ([]byte, error) {
+	if len(b) == 0 || !prettyRequested(r) {
+		return b, nil
+	}
+	indent := prettyIndent
+	if p := getMux(r).jsonPolicy; p != nil && p.Indent != "" {
+		indent = p.Indent
+	}
+	indented := getBuffer()
+	if err := json.Indent(indented, b, "", indent); err != nil {
+		putBuffer(indented)
+		return nil, err
+	}
+	return detachBuffer(indented), nil
+}
@@ -0,0 +1,72 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type prettyEndpoint struct {
+	allowed bool
+}
+
+func (e *prettyEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	return NewEnvelope(testSparsePerson, time.Now(), "v1", 0), nil
+}
+
+func (e *prettyEndpoint) PrettyJSON() bool {
+	return e.allowed
+}
+
+func TestPrettyJSON(t *testing.T) {
+	mux := NewMux()
+	mux.PrettyJSON = true
+	mux.SparseFields = true
+	mux.Get("/people/1", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(testSparsePerson, time.Now(), "v1", 0), nil
+	})
+	mux.HandleEndpoint("/disabled", &prettyEndpoint{false})
+
+	var test = func(path, accept, expected string) {
+		r, _ := http.NewRequest(Get, "http://example.com"+path, nil)
+		r.Header.Set("Accept", accept)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: status code is %d, expected 200 OK", path, w.Code)
+		}
+		if body := w.Body.String(); body != expected {
+			t.Errorf("%s, %s: body is\n%s\nexpected\n%s", path, accept, body, expected)
+		}
+	}
+
+	test("/people/1?fields=firstname,lastname&pretty=1", "application/json", `{
+  "firstname": "Francis",
+  "lastname": "Underwood"
+}`)
+	test("/people/1?fields=firstname,lastname&pretty", "application/json", `{
+  "firstname": "Francis",
+  "lastname": "Underwood"
+}`)
+	test("/people/1?fields=firstname,lastname", "application/json; pretty=true", `{
+  "firstname": "Francis",
+  "lastname": "Underwood"
+}`)
+	test("/people/1?fields=firstname,lastname&pretty=0", "application/json; pretty=true", `{"firstname":"Francis","lastname":"Underwood"}`)
+	test("/people/1?fields=firstname,lastname", "application/json", `{"firstname":"Francis","lastname":"Underwood"}`)
+	test("/disabled?pretty=1", "application/json", `{"firstname":"Francis","lastname":"Underwood","age":55,"employer":{"company":"The White House","city":"Washington"}}`)
+
+	mux.SetJSONPolicy(&JSONPolicy{Indent: "\t"})
+	test("/people/1?fields=lastname&pretty=true", "application/json", "{\n\t\"lastname\": \"Underwood\"\n}")
+
+	mux.SetJSONPolicy(nil)
+	mux.EnvelopeJSON = true
+	r, _ := http.NewRequest(Get, "http://example.com/people/1?fields=lastname&pretty=1", nil)
+	r.Header.Set("Accept", "application/json")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if body := w.Body.String(); body[:12] != "{\n  \"data\": " {
+		t.Errorf("enveloped body is\n%s\nexpected an indented envelope", body)
+	}
+}
@@ -0,0 +1,121 @@
+package rst
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ErrorFormat is the format of the JSON errors written by a mux.
+type ErrorFormat int
+
+// Formats of JSON errors.
+const (
+	DefaultErrorFormat ErrorFormat = iota // Errors are encoded as an *Error, with a message and a description.
+	ProblemJSON                           // Errors are encoded as RFC 7807 problem documents.
+)
+
+// ProblemJSONType is the media type of RFC 7807 problem documents.
+const ProblemJSONType = "application/problem+json"
+
+/*
+Problem is an error written as an RFC 7807 problem document, with its
+extension members.
+
+	func (ep *AccountEP) Post(vars rst.RouteVars, r *http.Request) (rst.Resource, string, error) {
+		if balance < price {
+			p := rst.NewProblem(http.StatusForbidden, "You do not have enough credit.", "")
+			p.Type = "https://example.com/probs/out-of-credit"
+			p.Extensions = map[string]interface{}{"balance": balance}
+			return nil, "", p
+		}
+		...
+	}
+
+Problems are always encoded in JSON, whatever the error format of the mux.
+*/
+type Problem struct {
+	Type       string                 // URI identifying the problem type. Defaults to "about:blank".
+	Title      string                 // Short summary of the problem type.
+	Status     int                    // HTTP status code.
+	Detail     string                 // Explanation specific to this occurrence of the problem.
+	Instance   string                 // URI identifying this occurrence of the problem.
+	Extensions map[string]interface{} // Extension members.
+	Header     http.Header            // Headers written with the problem.
+}
+
+// NewProblem returns a problem with the given status code, title and detail.
+// It will panic if status < 400.
+func NewProblem(status int, title, detail string) *Problem {
+	if status < 400 {
+		panic(fmt.Errorf("%d is not a valid HTTP status code for a problem", status))
+	}
+	return &Problem{
+		Status: status,
+		Title:  title,
+		Detail: detail,
+		Header: make(http.Header),
+	}
+}
+
+func (p *Problem) Error() string {
+	return fmt.Sprintf("%d (%s) - %s\n%s", p.Status, http.StatusText(p.Status), p.Title, p.Detail)
+}
+
+// document returns the members of the problem document.
+func (p *Problem) document() map[string]interface{} {
+	doc := make(map[string]interface{}, len(p.Extensions)+5)
+	for key, value := range p.Extensions {
+		doc[key] = value
+	}
+	doc["type"] = "about:blank"
+	if p.Type != "" {
+		doc["type"] = p.Type
+	}
+	doc["status"] = p.Status
+	if p.Title != "" {
+		doc["title"] = p.Title
+	}
+	if p.Detail != "" {
+		doc["detail"] = p.Detail
+	}
+	if p.Instance != "" {
+		doc["instance"] = p.Instance
+	}
+	return doc
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p *Problem) MarshalJSON() ([]byte, error) {
+	return StandardJSON.Marshal(p.document())
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (p *Problem) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b, err := getJSONEngine(r).Marshal(p.document())
+	if err != nil {
+		writeErrorResponse(w, p.Status, p.Header, "text/plain; charset=utf-8", []byte(p.Error()))
+		return
+	}
+	writeErrorResponse(w, p.Status, p.Header, ProblemJSONType, b)
+}
+
+// problem returns e as a problem document describing r.
+func (e *Error) problem(r *http.Request) *Problem {
+	p := &Problem{
+		Title:    e.Reason,
+		Status:   e.Code,
+		Detail:   e.Description,
+		Instance: r.URL.Path,
+		Header:   e.Header,
+	}
+	if len(e.Fields) > 0 || len(e.Stack) > 0 {
+		p.Extensions = make(map[string]interface{})
+	}
+	if len(e.Fields) > 0 {
+		p.Extensions["errors"] = e.Fields
+	}
+	if len(e.Stack) > 0 {
+		p.Extensions["stack"] = e.Stack
+	}
+	return p
+}
@@ -0,0 +1,77 @@
+package rst
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestProblemJSON(t *testing.T) {
+	mux := NewMux()
+	mux.ErrorFormat = ProblemJSON
+	mux.Get("/people/{id}", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return nil, NotFound()
+	})
+
+	r, _ := http.NewRequest(Get, "/people/1", nil)
+	r.Header.Set("Accept", "application/json")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("got %d, wanted %d", w.Code, http.StatusNotFound)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != ProblemJSONType {
+		t.Fatalf("got Content-Type %q, wanted %q", ct, ProblemJSONType)
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	wanted := map[string]interface{}{
+		"type":     "about:blank",
+		"title":    "Not Found",
+		"status":   float64(http.StatusNotFound),
+		"detail":   "No resource could be found at the requested URI.",
+		"instance": "/people/1",
+	}
+	for key, value := range wanted {
+		if doc[key] != value {
+			t.Fatalf("got %s %v, wanted %v", key, doc[key], value)
+		}
+	}
+
+	// XML errors are unchanged.
+	r.Header.Set("Accept", "application/xml")
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if ct := w.Header().Get("Content-Type"); ct != "application/xml; charset=utf-8" {
+		t.Fatalf("got Content-Type %q", ct)
+	}
+}
+
+func TestProblem(t *testing.T) {
+	mux := NewMux()
+	mux.Post("/accounts/{id}/transfers", func(vars RouteVars, r *http.Request) (Resource, string, error) {
+		p := NewProblem(http.StatusForbidden, "You do not have enough credit.", "Your current balance is 30, but that costs 50.")
+		p.Type = "https://example.com/probs/out-of-credit"
+		p.Extensions = map[string]interface{}{"balance": 30, "status": "ignored"}
+		return nil, "", p
+	})
+
+	r, _ := http.NewRequest(Post, "/accounts/1/transfers", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("got %d, wanted %d", w.Code, http.StatusForbidden)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != ProblemJSONType {
+		t.Fatalf("got Content-Type %q, wanted %q", ct, ProblemJSONType)
+	}
+	wanted := `{"balance":30,"detail":"Your current balance is 30, but that costs 50.","status":403,"title":"You do not have enough credit.","type":"https://example.com/probs/out-of-credit"}`
+	if got := w.Body.String(); got != wanted {
+		t.Fatalf("got %s, wanted %s", got, wanted)
+	}
+}
@@ -0,0 +1,58 @@
+package rst
+
+import "net/http"
+
+// ProtobufType is the media type of protocol buffer payloads.
+const ProtobufType = "application/x-protobuf"
+
+/*
+ProtoResource is implemented by resources which can be represented by a
+protocol buffer message.
+
+	func (p *Person) Proto() interface{} {
+		return &pb.Person{Id: p.ID, Name: p.Name}
+	}
+
+MarshalResource encodes the message with the ProtoMarshalFunc of the mux when
+application/x-protobuf is negotiated, and encodes the resource as usual
+otherwise.
+*/
+type ProtoResource interface {
+	// Proto returns the proto.Message representing the resource.
+	Proto() interface{}
+}
+
+// ProtoMarshalFunc encodes a protocol buffer message returned by
+// ProtoResource.Proto.
+type ProtoMarshalFunc func(message interface{}) ([]byte, error)
+
+/*
+SetProtoMarshaler sets the function used to encode the messages of the
+resources implementing ProtoResource in the requests served by the mux.
+
+	mux.SetProtoMarshaler(func(message interface{}) ([]byte, error) {
+		return proto.Marshal(message.(proto.Message))
+	})
+
+Protocol buffers are not negotiated until a function is set, and a nil value
+disables them.
+*/
+func (s *Mux) SetProtoMarshaler(fn ProtoMarshalFunc) {
+	s.protoMarshaler = fn
+}
+
+// marshalProto returns the protocol buffer encoding of resource, and true if
+// resource implements ProtoResource and application/x-protobuf is negotiated
+// in r.
+func marshalProto(resource interface{}, accept Accept, r *http.Request) ([]byte, bool, error) {
+	pr, implemented := resource.(ProtoResource)
+	if !implemented {
+		return nil, false, nil
+	}
+	marshal := getMux(r).protoMarshaler
+	if marshal == nil || accept.Negotiate(append(encoderTypes(), ProtobufType)...) != ProtobufType {
+		return nil, false, nil
+	}
+	b, err := marshal(pr.Proto())
+	return b, true, err
+}
@@ -0,0 +1,71 @@
+package rst
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type protoMessage struct {
+	Name string
+}
+
+type protoPerson struct {
+	Name string
+}
+
+func (p *protoPerson) ETag() string            { return "etag" }
+func (p *protoPerson) LastModified() time.Time { return time.Now() }
+func (p *protoPerson) TTL() time.Duration      { return 0 }
+func (p *protoPerson) Proto() interface{}      { return &protoMessage{p.Name} }
+
+func marshalTestProto(message interface{}) ([]byte, error) {
+	return []byte(fmt.Sprintf("proto:%s", message.(*protoMessage).Name)), nil
+}
+
+func TestProtoResource(t *testing.T) {
+	mux := NewMux()
+	mux.Get("/", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return &protoPerson{"Francis"}, nil
+	})
+
+	var test = func(accept, contentType, body string) {
+		r, _ := http.NewRequest(Get, "/", nil)
+		r.Header.Set("Accept", accept)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if ct := w.Header().Get("Content-Type"); ct != contentType {
+			t.Errorf("%s: Content-Type: Got: %s Wanted: %s", accept, ct, contentType)
+		}
+		if w.Body.String() != body {
+			t.Errorf("%s: Got: %s Wanted: %s", accept, w.Body.String(), body)
+		}
+	}
+
+	// Without a marshaler, protocol buffers are not negotiated.
+	test(ProtobufType+", application/json;q=0.5", "application/json; charset=utf-8", `{"Name":"Francis"}`)
+
+	mux.SetProtoMarshaler(marshalTestProto)
+	test(ProtobufType, ProtobufType, "proto:Francis")
+	test(ProtobufType+", application/json;q=0.5", ProtobufType, "proto:Francis")
+	test("application/json", "application/json; charset=utf-8", `{"Name":"Francis"}`)
+	test("*/*", "application/json; charset=utf-8", `{"Name":"Francis"}`)
+}
+
+func TestProtoNotImplemented(t *testing.T) {
+	mux := NewMux()
+	mux.SetProtoMarshaler(marshalTestProto)
+	mux.Get("/", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(&viewResource{"Francis"}, time.Now(), "etag", 0), nil
+	})
+
+	r, _ := http.NewRequest(Get, "/", nil)
+	r.Header.Set("Accept", ProtobufType)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if w.Code != http.StatusNotAcceptable {
+		t.Errorf("Got: %d Wanted: %d", w.Code, http.StatusNotAcceptable)
+	}
+}
@@ -0,0 +1,140 @@
+package rst
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/context"
+)
+
+// DefaultPushBudget is the number of related resources pushed or preloaded per
+// response by default.
+const DefaultPushBudget = 4
+
+// pushedHeaders are the headers of a request copied in the requests of the
+// resources pushed in its response.
+var pushedHeaders = []string{"Accept", "Accept-Charset", "Accept-Encoding", "Accept-Language", "Authorization", "Cookie"}
+
+/*
+Push sets which related resources are pushed with the resources of an endpoint
+implementing PushPolicy.
+
+	func (ep *PersonEP) Push() *rst.Push {
+		return &rst.Push{Rels: []string{"employer"}, Budget: 2}
+	}
+
+The links of the resources, declared with the Linker interface, are pushed in
+order when their relation is listed in Rels, until the budget of the response
+is spent. Pushed requests carry the content negotiation and the credentials of
+the request. Connections which don't support HTTP/2 server push, or clients
+which disabled it, receive a preload link instead:
+
+	Link: </companies/2>; rel=preload; as=fetch
+
+Only the links to a path of the same origin are pushed, and templated links
+are ignored.
+*/
+type Push struct {
+	Rels    []string // Relations of the links pushed, such as employer.
+	Budget  int      // Optional. Maximum number of resources pushed or preloaded per response, DefaultPushBudget by default.
+	Preload bool     // Optional. Set to true to always send preload links instead of pushing.
+}
+
+// PushPolicy is implemented by endpoints pushing the resources related to
+// theirs. Endpoints which don't implement it push nothing.
+type PushPolicy interface {
+	Push() *Push
+}
+
+const pushKey = "__rst__push"
+
+// pushOf returns the push settings of handler, or nil.
+func pushOf(handler http.Handler) *Push {
+	if h, valid := handler.(*endpointHandler); valid {
+		if policy, implemented := policyEndpoint(h.endpoint).(PushPolicy); implemented {
+			return policy.Push()
+		}
+	}
+	return nil
+}
+
+func (p *Push) budget() int {
+	if p.Budget <= 0 {
+		return DefaultPushBudget
+	}
+	return p.Budget
+}
+
+// pushes returns true if p pushes the links of relation rel.
+func (p *Push) pushes(rel string) bool {
+	for _, r := range p.Rels {
+		if strings.EqualFold(r, rel) {
+			return true
+		}
+	}
+	return false
+}
+
+// pushRelated pushes the resources related to resource, or adds preload
+// links to them in the header of w, according to the push settings of the
+// endpoint serving r.
+func pushRelated(resource interface{}, w http.ResponseWriter, r *http.Request) {
+	p, _ := context.Get(r, pushKey).(*Push)
+	if p == nil || strings.ToUpper(r.Method) != Get {
+		return
+	}
+
+	pusher, _ := w.(http.Pusher)
+	if p.Preload {
+		pusher = nil
+	}
+	budget := p.budget()
+	for _, link := range linksOf(resource, r) {
+		if budget == 0 {
+			return
+		}
+		if link.Templated || !p.pushes(link.Rel) || !strings.HasPrefix(link.Href, "/") || strings.HasPrefix(link.Href, "//") {
+			continue
+		}
+		budget--
+		if pusher != nil {
+			err := pusher.Push(link.Href, &http.PushOptions{Header: pushHeader(r)})
+			if err == nil {
+				continue
+			}
+			if err != http.ErrNotSupported {
+				budget++
+				continue
+			}
+			pusher = nil
+		}
+		preload := fmt.Sprintf("<%s>; rel=preload; as=fetch", link.Href)
+		if value := w.Header().Get("Link"); value != "" {
+			preload = value + ", " + preload
+		}
+		w.Header().Set("Link", preload)
+	}
+}
+
+// pushHeader returns the headers of the requests pushed in the response to r.
+func pushHeader(r *http.Request) http.Header {
+	header := make(http.Header)
+	for _, key := range pushedHeaders {
+		if values := r.Header[key]; len(values) > 0 {
+			header[key] = values
+		}
+	}
+	if len(header) == 0 {
+		return nil
+	}
+	return header
+}
+
+// Push implements the http.Pusher interface.
+func (rw *responseWriter) Push(target string, opts *http.PushOptions) error {
+	if pusher, ok := rw.ResponseWriter.(http.Pusher); ok {
+		return pusher.Push(target, opts)
+	}
+	return http.ErrNotSupported
+}
@@ -0,0 +1,86 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// pushingEndpoint serves testLinkedPerson and pushes its related resources.
+type pushingEndpoint struct {
+	push *Push
+}
+
+func (e *pushingEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	return NewEnvelope(testLinkedPerson, time.Now(), "v1", 0), nil
+}
+
+func (e *pushingEndpoint) Push() *Push {
+	return e.push
+}
+
+// pushRecorder records the resources pushed in a response.
+type pushRecorder struct {
+	*httptest.ResponseRecorder
+	pushed []string
+	header http.Header
+	err    error
+}
+
+func (p *pushRecorder) Push(target string, opts *http.PushOptions) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.pushed = append(p.pushed, target)
+	p.header = opts.Header
+	return nil
+}
+
+func TestPush(t *testing.T) {
+	endpoint := &pushingEndpoint{&Push{Rels: []string{"employer", "friends"}}}
+	mux := NewMux()
+	mux.HandleEndpoint("/people/1", endpoint)
+	mux.Get("/companies/2", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(testLinkedPerson.Employer, time.Now(), "v1", 0), nil
+	})
+
+	var test = func(path string, err error) *pushRecorder {
+		r, _ := http.NewRequest(Get, "http://example.com"+path, nil)
+		r.Header.Set("Accept", "application/json")
+		r.Header.Set("Authorization", "Bearer token")
+		w := &pushRecorder{ResponseRecorder: httptest.NewRecorder(), err: err}
+		mux.ServeHTTP(w, r)
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: status code is %d, expected 200 OK", path, w.Code)
+		}
+		return w
+	}
+
+	w := test("/people/1", nil)
+	if len(w.pushed) != 1 || w.pushed[0] != "/companies/2" {
+		t.Errorf("Pushed %v, expected /companies/2", w.pushed)
+	}
+	if w.header.Get("Accept") != "application/json" || w.header.Get("Authorization") != "Bearer token" {
+		t.Errorf("Headers of the pushed request are %v", w.header)
+	}
+	if strings.Contains(w.Header().Get("Link"), "preload") {
+		t.Errorf("Link is %q, expected no preload", w.Header().Get("Link"))
+	}
+
+	w = test("/people/1", http.ErrNotSupported)
+	if link := w.Header().Get("Link"); !strings.Contains(link, "</companies/2>; rel=preload; as=fetch") {
+		t.Errorf("Link is %q, expected a preload of /companies/2", link)
+	}
+
+	endpoint.push = &Push{Rels: []string{"employer"}, Preload: true}
+	if w = test("/people/1", nil); len(w.pushed) != 0 || !strings.Contains(w.Header().Get("Link"), "rel=preload") {
+		t.Errorf("Pushed %v with Link %q, expected a preload", w.pushed, w.Header().Get("Link"))
+	}
+
+	endpoint.push = &Push{Rels: []string{"employer"}, Budget: -1}
+	if w = test("/companies/2", nil); len(w.pushed) != 0 {
+		t.Errorf("Pushed %v from a route without push", w.pushed)
+	}
+}
@@ -0,0 +1,313 @@
+package rst
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+/*
+BindQuery decodes the parameters of the query string of r in v, a pointer to a
+struct whose fields are annotated with a query tag.
+
+	type PeopleQuery struct {
+		Name    string    `query:"name"`
+		Tags    []string  `query:"tags"`
+		Since   time.Time `query:"since"`
+		Order   string    `query:"order" default:"asc" enum:"asc,desc"`
+		Limit   int       `query:"limit,required" min:"1" max:"100"`
+		Deleted *bool     `query:"deleted"`
+	}
+
+	func (ep *PeopleEP) Get(vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+		var q PeopleQuery
+		if err := rst.BindQuery(r, &q); err != nil {
+			return nil, err
+		}
+		...
+	}
+
+Fields can be strings, booleans, integers, floats, durations, times in the
+RFC 3339 format or dates such as 2006-01-02, types implementing
+encoding.TextUnmarshaler, pointers to those, which are nil unless the
+parameter is set, and slices of those, which collect the repeated values of a
+parameter as well as its values separated by commas, such as tags=a,b&tags=c.
+Fields of embedded structs without a query tag are bound as well.
+
+Parameters which aren't set, or are empty, take the value of the default tag,
+or leave the field untouched. The required option rejects missing parameters,
+the min and max tags bound numbers, and the enum tag lists the values allowed,
+separated by commas.
+
+Invalid parameters are returned as a BadRequest error listing them in its
+Fields, encoded like the fields of a ValidationError, so that endpoints can
+return it as is:
+
+	{
+		"message": "Invalid query",
+		"description": "A parameter of the query is invalid.",
+		"fields": [
+			{"field": "limit", "code": "out_of_range", "message": "The limit parameter must be between 1 and 100."}
+		]
+	}
+
+BindQuery panics if v isn't a pointer to a struct, or if a field has a type it
+can't decode.
+*/
+func BindQuery(r *http.Request, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		panic(errors.New("rst: BindQuery requires a pointer to a struct"))
+	}
+	b := &valueBinder{tag: "query", noun: "parameter", verr: &ValidationError{}}
+	b.bind(r.URL.Query(), rv.Elem())
+	return b.err("Invalid query", "of the query")
+}
+
+// valueBinder decodes url.Values in the fields of structs annotated with a tag.
+type valueBinder struct {
+	tag  string // Tag holding the names of the values of the fields.
+	noun string // Name of the values in errors, such as "parameter".
+	verr *ValidationError
+}
+
+// err returns the BadRequest error listing the invalid values, or nil.
+func (b *valueBinder) err(reason, of string) error {
+	if len(b.verr.Fields) == 0 {
+		return nil
+	}
+	description := fmt.Sprintf("A %s %s is invalid.", b.noun, of)
+	if len(b.verr.Fields) != 1 {
+		description = fmt.Sprintf("%d %ss %s are invalid.", len(b.verr.Fields), b.noun, of)
+	}
+	err := BadRequest(reason, description)
+	err.Fields = b.verr.Fields
+	return err
+}
+
+// bind decodes values in the fields of the struct v, and adds the invalid
+// ones to b.verr.
+func (b *valueBinder) bind(values url.Values, v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf, fv := t.Field(i), v.Field(i)
+		tag, tagged := sf.Tag.Lookup(b.tag)
+		if sf.Anonymous && !tagged {
+			if sf.Type.Kind() == reflect.Ptr && sf.Type.Elem().Kind() == reflect.Struct {
+				if fv.IsNil() {
+					if !fv.CanSet() {
+						continue
+					}
+					fv.Set(reflect.New(sf.Type.Elem()))
+				}
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct {
+				b.bind(values, fv)
+			}
+			continue
+		}
+		if !tagged || tag == "-" || sf.PkgPath != "" {
+			continue
+		}
+
+		name, options := tag, ""
+		if j := strings.IndexByte(tag, ','); j >= 0 {
+			name, options = tag[:j], tag[j:]
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		set := nonEmptyValues(values[name], fv.Kind() == reflect.Slice)
+		if len(set) == 0 {
+			if def, found := sf.Tag.Lookup("default"); found {
+				set = nonEmptyValues([]string{def}, fv.Kind() == reflect.Slice)
+			} else if strings.Contains(options+",", ",required,") {
+				b.verr.Add(name, "required", fmt.Sprintf("The %s %s is required.", name, b.noun))
+				continue
+			} else {
+				continue
+			}
+		}
+		b.bindField(fv, sf, name, set)
+	}
+}
+
+// nonEmptyValues returns the values which aren't empty, split at commas for
+// lists.
+func nonEmptyValues(values []string, list bool) []string {
+	var kept []string
+	for _, value := range values {
+		if !list {
+			if value = strings.TrimSpace(value); value != "" {
+				return []string{value}
+			}
+			continue
+		}
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				kept = append(kept, item)
+			}
+		}
+	}
+	return kept
+}
+
+// bindField sets values, the values of name, in fv, the field sf.
+func (b *valueBinder) bindField(fv reflect.Value, sf reflect.StructField, name string, values []string) {
+	t := fv.Type()
+	if t.Kind() == reflect.Slice && !reflect.PtrTo(t).Implements(textUnmarshalerType) {
+		slice := reflect.MakeSlice(t, 0, len(values))
+		for _, value := range values {
+			item, ok := b.parse(value, t.Elem(), sf, name)
+			if !ok {
+				return
+			}
+			slice = reflect.Append(slice, item)
+		}
+		fv.Set(slice)
+		return
+	}
+	if value, ok := b.parse(values[0], t, sf, name); ok {
+		fv.Set(value)
+	}
+}
+
+// parse returns value, a value of name decoded in the field sf, as a value of
+// type t. It returns false if the value is invalid.
+func (b *valueBinder) parse(value string, t reflect.Type, sf reflect.StructField, name string) (reflect.Value, bool) {
+	if t.Kind() == reflect.Ptr {
+		parsed, ok := b.parse(value, t.Elem(), sf, name)
+		if !ok {
+			return parsed, false
+		}
+		ptr := reflect.New(t.Elem())
+		ptr.Elem().Set(parsed)
+		return ptr, true
+	}
+
+	parsed := reflect.New(t).Elem()
+	var number float64
+	isNumber := false
+	expected := ""
+	switch {
+	case t == timeType:
+		tm, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			tm, err = time.Parse("2006-01-02", value)
+		}
+		if err != nil {
+			expected = "a date or a time in the RFC 3339 format"
+			break
+		}
+		parsed.Set(reflect.ValueOf(tm))
+	case t == durationType:
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			expected = "a duration, such as 1h30m"
+			break
+		}
+		parsed.SetInt(int64(d))
+	case reflect.PtrTo(t).Implements(textUnmarshalerType):
+		if err := parsed.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(value)); err != nil {
+			expected = "valid"
+		}
+	default:
+		switch t.Kind() {
+		case reflect.String:
+			parsed.SetString(value)
+		case reflect.Bool:
+			boolean, err := strconv.ParseBool(value)
+			if err != nil {
+				expected = "a boolean"
+				break
+			}
+			parsed.SetBool(boolean)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			i, err := strconv.ParseInt(value, 10, t.Bits())
+			if err != nil {
+				expected = "an integer"
+				break
+			}
+			parsed.SetInt(i)
+			number, isNumber = float64(i), true
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			u, err := strconv.ParseUint(value, 10, t.Bits())
+			if err != nil {
+				expected = "a positive integer"
+				break
+			}
+			parsed.SetUint(u)
+			number, isNumber = float64(u), true
+		case reflect.Float32, reflect.Float64:
+			f, err := strconv.ParseFloat(value, t.Bits())
+			if err != nil {
+				expected = "a number"
+				break
+			}
+			parsed.SetFloat(f)
+			number, isNumber = f, true
+		default:
+			panic(fmt.Errorf("rst: unsupported type %s of the %s %s", t, b.noun, name))
+		}
+	}
+	if expected != "" {
+		b.verr.Add(name, "invalid", fmt.Sprintf("The %s %s must be %s.", name, b.noun, expected))
+		return parsed, false
+	}
+
+	if enum, found := sf.Tag.Lookup("enum"); found {
+		allowed := strings.Split(enum, ",")
+		valid := false
+		for _, a := range allowed {
+			valid = valid || a == value
+		}
+		if !valid {
+			b.verr.Add(name, "invalid", fmt.Sprintf("The %s %s must be one of %s.", name, b.noun, strings.Join(allowed, ", ")))
+			return parsed, false
+		}
+	}
+	if isNumber {
+		min, hasMin := queryBound(sf, "min")
+		max, hasMax := queryBound(sf, "max")
+		var message string
+		switch {
+		case hasMin && hasMax && (number < min || number > max):
+			message = fmt.Sprintf("The %s %s must be between %s and %s.", name, b.noun, sf.Tag.Get("min"), sf.Tag.Get("max"))
+		case hasMin && number < min:
+			message = fmt.Sprintf("The %s %s must be at least %s.", name, b.noun, sf.Tag.Get("min"))
+		case hasMax && number > max:
+			message = fmt.Sprintf("The %s %s must be at most %s.", name, b.noun, sf.Tag.Get("max"))
+		}
+		if message != "" {
+			b.verr.Add(name, "out_of_range", message)
+			return parsed, false
+		}
+	}
+	return parsed, true
+}
+
+// queryBound returns the number of the tag key of sf, and false if it doesn't
+// have one.
+func queryBound(sf reflect.StructField, key string) (float64, bool) {
+	tag, found := sf.Tag.Lookup(key)
+	if !found {
+		return 0, false
+	}
+	bound, err := strconv.ParseFloat(tag, 64)
+	if err != nil {
+		panic(fmt.Errorf("rst: invalid %s tag %q of the field %s", key, tag, sf.Name))
+	}
+	return bound, true
+}
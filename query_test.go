@@ -0,0 +1,127 @@
+package rst
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type pageQuery struct {
+	Limit  int    `query:"limit" default:"20" min:"1" max:"100"`
+	Cursor string `query:"cursor"`
+}
+
+type peopleQuery struct {
+	pageQuery
+	Name    string        `query:"name,required"`
+	Tags    []string      `query:"tags"`
+	Ages    []uint8       `query:"ages"`
+	Since   time.Time     `query:"since"`
+	Within  time.Duration `query:"within"`
+	Order   string        `query:"order" default:"asc" enum:"asc,desc"`
+	Deleted *bool         `query:"deleted"`
+	Score   float64       `query:"score" min:"0"`
+	Ignored string
+}
+
+func TestBindQuery(t *testing.T) {
+	var test = func(query string) (*peopleQuery, error) {
+		r, _ := http.NewRequest(Get, "http://example.com/people?"+query, nil)
+		q := &peopleQuery{Ignored: "kept"}
+		return q, BindQuery(r, q)
+	}
+
+	q, err := test("name=francis&tags=a,b&tags=c&ages=7&ages=&since=2016-03-01&within=1h30m&deleted=true&score=2.5&Ignored=x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	deleted := true
+	expected := &peopleQuery{
+		pageQuery: pageQuery{Limit: 20},
+		Name:      "francis",
+		Tags:      []string{"a", "b", "c"},
+		Ages:      []uint8{7},
+		Since:     time.Date(2016, time.March, 1, 0, 0, 0, 0, time.UTC),
+		Within:    90 * time.Minute,
+		Order:     "asc",
+		Deleted:   &deleted,
+		Score:     2.5,
+		Ignored:   "kept",
+	}
+	if !reflect.DeepEqual(q, expected) {
+		t.Errorf("Got: %+v Wanted: %+v", q, expected)
+	}
+
+	if q, err = test("name=claire&limit=5&order=desc&since=2016-03-01T10:00:00Z"); err != nil {
+		t.Fatal(err)
+	}
+	if q.Limit != 5 || q.Order != "desc" || q.Deleted != nil || q.Since.Hour() != 10 {
+		t.Errorf("Got: %+v", q)
+	}
+
+	_, err = test("limit=500&order=random&ages=300&deleted=maybe&score=-1")
+	e, ok := err.(*Error)
+	if !ok || e.Code != http.StatusBadRequest {
+		t.Fatalf("Got: %v Wanted: a BadRequest error", err)
+	}
+	codes := make(map[string]string)
+	for _, f := range e.Fields {
+		codes[f.Field] = f.Code
+	}
+	wanted := map[string]string{
+		"limit":   "out_of_range",
+		"name":    "required",
+		"ages":    "invalid",
+		"order":   "invalid",
+		"deleted": "invalid",
+		"score":   "out_of_range",
+	}
+	if !reflect.DeepEqual(codes, wanted) {
+		t.Errorf("Got: %v Wanted: %v", codes, wanted)
+	}
+}
+
+func TestBindQueryResponse(t *testing.T) {
+	mux := NewMux()
+	mux.Get("/people", func(vars RouteVars, r *http.Request) (Resource, error) {
+		var q pageQuery
+		if err := BindQuery(r, &q); err != nil {
+			return nil, err
+		}
+		return newNumbers(q.Limit), nil
+	})
+
+	r, _ := http.NewRequest(Get, "http://example.com/people?limit=0", nil)
+	r.Header.Set("Accept", "application/json")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("Status code is %d, wanted 400", w.Code)
+	}
+	var body struct {
+		Fields []*FieldError `json:"fields"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &body)
+	if len(body.Fields) != 1 || body.Fields[0].Message != "The limit parameter must be between 1 and 100." {
+		t.Errorf("Body is %s", w.Body.String())
+	}
+}
+
+func TestBindQueryPanics(t *testing.T) {
+	var test = func(v interface{}) {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("BindQuery(%T) didn't panic", v)
+			}
+		}()
+		r, _ := http.NewRequest(Get, "http://example.com/?id=1", nil)
+		BindQuery(r, v)
+	}
+	test(pageQuery{})
+	test(&struct {
+		ID chan int `query:"id"`
+	}{})
+}
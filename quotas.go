@@ -0,0 +1,239 @@
+package rst
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// QuotaWindow returns the start of the accounting window containing t, and the
+// time at which the usage of the window is reset.
+type QuotaWindow func(t time.Time) (start, reset time.Time)
+
+// Windows of quotas.
+var (
+	// Daily windows start at midnight UTC.
+	Daily QuotaWindow = func(t time.Time) (time.Time, time.Time) {
+		t = t.UTC()
+		start := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 0, 1)
+	}
+
+	// Monthly windows start on the first day of each month, at midnight UTC.
+	Monthly QuotaWindow = func(t time.Time) (time.Time, time.Time) {
+		t = t.UTC()
+		start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, 0)
+	}
+)
+
+// Every returns windows of the given duration.
+func Every(d time.Duration) QuotaWindow {
+	return func(t time.Time) (time.Time, time.Time) {
+		start := t.Truncate(d)
+		return start, start.Add(d)
+	}
+}
+
+// Quota limits the number of requests, or bytes served, per API key over a
+// window of time.
+type Quota struct {
+	Name       string      // Name of the quota, reported in the headers of responses.
+	Limit      int64       // Maximum usage per window.
+	Window     QuotaWindow // Daily, Monthly, or a window returned by Every.
+	Bytes      bool        // Set to true to count the bytes of responses instead of requests.
+	StatusCode int         // Optional. Status code of rejected requests: http.StatusTooManyRequests by default, or http.StatusForbidden.
+}
+
+/*
+Quotas enforces long-window quotas on the requests served by a mux, per API
+key.
+
+	mux.SetQuotas(&rst.Quotas{
+		Key: func(r *http.Request) string {
+			return r.Header.Get("X-API-Key")
+		},
+		Store: rst.NewQuotaStore(),
+		Limits: []*rst.Quota{
+			{Name: "requests", Limit: 10000, Window: rst.Daily},
+			{Name: "bytes", Limit: 1 << 30, Window: rst.Monthly, Bytes: true},
+		},
+	})
+
+The usage of each quota is reported in the X-Quota-Limit, X-Quota-Remaining and
+X-Quota-Reset headers of responses, as name=value pairs. X-Quota-Reset is the
+number of seconds before the window of the quota is reset.
+
+Requests exceeding a quota are rejected with status code 429 Too Many Requests,
+or the status code of the quota, and a Retry-After header. Usage is accounted
+per tenant when the mux resolves tenants.
+*/
+type Quotas struct {
+	Key    func(r *http.Request) string // Returns the API key of r. Requests without a key aren't subject to quotas.
+	Store  QuotaStore                   // Stores the usage of quotas.
+	Limits []*Quota                     // Quotas applied to each API key.
+}
+
+// QuotaStore stores the usage of quotas.
+type QuotaStore interface {
+	// Usage returns the current usage recorded for key.
+	Usage(key string) (int64, error)
+
+	// Add adds n to the usage recorded for key, which can be forgotten after
+	// expires, and returns the new usage.
+	Add(key string, n int64, expires time.Time) (int64, error)
+}
+
+// NewQuotaStore returns a QuotaStore keeping usage in memory.
+func NewQuotaStore() QuotaStore {
+	return &memoryQuotaStore{usage: make(map[string]*quotaUsage)}
+}
+
+type quotaUsage struct {
+	value   int64
+	expires time.Time
+}
+
+type memoryQuotaStore struct {
+	mu    sync.Mutex
+	usage map[string]*quotaUsage
+	swept time.Time
+}
+
+func (s *memoryQuotaStore) Usage(key string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if u, found := s.usage[key]; found && time.Now().Before(u.expires) {
+		return u.value, nil
+	}
+	return 0, nil
+}
+
+func (s *memoryQuotaStore) Add(key string, n int64, expires time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.swept) > time.Minute {
+		for k, u := range s.usage {
+			if !now.Before(u.expires) {
+				delete(s.usage, k)
+			}
+		}
+		s.swept = now
+	}
+
+	u, found := s.usage[key]
+	if !found || !now.Before(u.expires) {
+		u = &quotaUsage{expires: expires}
+		s.usage[key] = u
+	}
+	u.value += n
+	return u.value, nil
+}
+
+// SetQuotas sets the quotas enforced by the mux. A nil value disables quotas,
+// which is the default.
+func (s *Mux) SetQuotas(q *Quotas) {
+	s.quotas = q
+}
+
+// apply accounts r in the quotas of its API key. It returns the writer with
+// which the response must be written, a function to call once it's written,
+// and an error if r exceeds a quota.
+func (q *Quotas) apply(w http.ResponseWriter, r *http.Request) (http.ResponseWriter, func(), error) {
+	apiKey := q.Key(r)
+	if apiKey == "" {
+		return w, func() {}, nil
+	}
+
+	now := time.Now()
+	counter := &countingWriter{ResponseWriter: w}
+	var bytesQuotas []func()
+	for _, quota := range q.Limits {
+		start, reset := quota.Window(now)
+		key := fmt.Sprintf("%s\x00%s\x00%s\x00%d", Tenant(r), apiKey, quota.Name, start.Unix())
+
+		var usage int64
+		var err error
+		if quota.Bytes {
+			usage, err = q.Store.Usage(key)
+		} else {
+			usage, err = q.Store.Add(key, 1, reset)
+		}
+		if err != nil {
+			return w, nil, err
+		}
+		if usage > quota.Limit || (quota.Bytes && usage >= quota.Limit) {
+			return w, nil, quotaExceeded(quota, reset)
+		}
+
+		remaining := quota.Limit - usage
+		w.Header().Add("X-Quota-Limit", fmt.Sprintf("%s=%d", quota.Name, quota.Limit))
+		w.Header().Add("X-Quota-Remaining", fmt.Sprintf("%s=%d", quota.Name, remaining))
+		w.Header().Add("X-Quota-Reset", fmt.Sprintf("%s=%d", quota.Name, retryAfterSeconds(reset.Sub(now))))
+
+		if quota.Bytes {
+			bytesQuotas = append(bytesQuotas, func() {
+				q.Store.Add(key, counter.n, reset)
+			})
+		}
+	}
+
+	if len(bytesQuotas) == 0 {
+		return w, func() {}, nil
+	}
+	return counter, func() {
+		for _, record := range bytesQuotas {
+			record()
+		}
+	}, nil
+}
+
+// quotaExceeded is returned when a request exceeds quota, which will be reset
+// at the given time.
+func quotaExceeded(quota *Quota, reset time.Time) *Error {
+	err := TooManyRequests(reset.Sub(time.Now()))
+	if quota.StatusCode != 0 {
+		err.Code = quota.StatusCode
+	}
+	err.Reason = "Quota exceeded"
+	err.Description = fmt.Sprintf("The %s quota of %d has been exceeded, and will be reset on %s.", quota.Name, quota.Limit, reset.UTC().Format(time.RFC1123))
+	err.Header.Set("X-Quota-Reset", fmt.Sprintf("%s=%d", quota.Name, retryAfterSeconds(reset.Sub(time.Now()))))
+	return err
+}
+
+// retryAfterSeconds returns d rounded up to the second.
+func retryAfterSeconds(d time.Duration) int64 {
+	if d <= 0 {
+		return 0
+	}
+	return int64((d + time.Second - 1) / time.Second)
+}
+
+// countingWriter counts the bytes written in a response.
+type countingWriter struct {
+	http.ResponseWriter
+	n int64
+}
+
+func (w *countingWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.n += int64(n)
+	return n, err
+}
+
+// Flush implements the http.Flusher interface.
+func (w *countingWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack implements the http.Hijacker interface.
+func (w *countingWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return hijack(w.ResponseWriter)
+}
@@ -0,0 +1,90 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestQuotas(t *testing.T) {
+	mux := NewMux()
+	mux.SetQuotas(&Quotas{
+		Key: func(r *http.Request) string {
+			return r.Header.Get("X-API-Key")
+		},
+		Store: NewQuotaStore(),
+		Limits: []*Quota{
+			{Name: "requests", Limit: 3, Window: Daily},
+			{Name: "bytes", Limit: 10, Window: Monthly, Bytes: true, StatusCode: http.StatusForbidden},
+		},
+	})
+	mux.Handle("/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.URL.Query().Get("body")))
+	}))
+
+	get := func(key, body string) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(Get, "/?body="+body, nil)
+		r.Header.Set("X-API-Key", key)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		return w
+	}
+
+	w := get("a", "12345")
+	if w.Code != http.StatusOK {
+		t.Fatalf("got %d, wanted %d", w.Code, http.StatusOK)
+	}
+	if got := strings.Join(w.Header()["X-Quota-Remaining"], ", "); got != "requests=2, bytes=10" {
+		t.Fatalf("got X-Quota-Remaining %q", got)
+	}
+
+	w = get("a", "123456")
+	if got := strings.Join(w.Header()["X-Quota-Remaining"], ", "); got != "requests=1, bytes=5" {
+		t.Fatalf("got X-Quota-Remaining %q", got)
+	}
+
+	// The bytes quota is exceeded.
+	w = get("a", "")
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("got %d, wanted %d", w.Code, http.StatusForbidden)
+	}
+	if w.Header().Get("Retry-After") == "" {
+		t.Fatal("Retry-After header is missing")
+	}
+
+	// Quotas are accounted per API key.
+	for i := 0; i < 3; i++ {
+		if w := get("b", ""); w.Code != http.StatusOK {
+			t.Fatalf("request %d: got %d, wanted %d", i, w.Code, http.StatusOK)
+		}
+	}
+	if w := get("b", ""); w.Code != http.StatusTooManyRequests {
+		t.Fatalf("got %d, wanted %d", w.Code, http.StatusTooManyRequests)
+	}
+
+	// Requests without a key are not subject to quotas.
+	if w := get("", ""); w.Code != http.StatusOK || w.Header().Get("X-Quota-Limit") != "" {
+		t.Fatalf("got %d and X-Quota-Limit %q", w.Code, w.Header().Get("X-Quota-Limit"))
+	}
+}
+
+func TestQuotaWindows(t *testing.T) {
+	now := time.Date(2016, time.February, 10, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		window QuotaWindow
+		start  time.Time
+		reset  time.Time
+	}{
+		{Daily, time.Date(2016, time.February, 10, 0, 0, 0, 0, time.UTC), time.Date(2016, time.February, 11, 0, 0, 0, 0, time.UTC)},
+		{Monthly, time.Date(2016, time.February, 1, 0, 0, 0, 0, time.UTC), time.Date(2016, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		{Every(time.Hour), time.Date(2016, time.February, 10, 15, 0, 0, 0, time.UTC), time.Date(2016, time.February, 10, 16, 0, 0, 0, time.UTC)},
+	}
+	for i, test := range tests {
+		start, reset := test.window(now)
+		if !start.Equal(test.start) || !reset.Equal(test.reset) {
+			t.Fatalf("%d: got %s - %s, wanted %s - %s", i, start, reset, test.start, test.reset)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package rst
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+/*
+RateLimit limits the rate of the requests served by a mux, or by an endpoint,
+per client with a token bucket: each request takes a token from the bucket of
+its client, which holds up to Burst tokens and is refilled with Limit tokens
+per Period.
+
+	mux.SetRateLimit(&rst.RateLimit{
+		Limit:  100,
+		Period: time.Minute,
+		Burst:  20,
+	})
+
+Clients are identified by the IP address of the connection by default, which
+is the one of the proxy when the service runs behind one. Key can return any
+other identifier, such as an API key or a forwarded address:
+
+	limit.Key = func(r *http.Request) string {
+		return r.Header.Get("X-API-Key")
+	}
+
+The state of each bucket is reported in the RateLimit-Limit,
+RateLimit-Remaining and RateLimit-Reset headers of responses, the latter being
+the number of seconds before the bucket is full again. Requests finding an
+empty bucket are rejected with status code 429 Too Many Requests and a
+Retry-After header. Buckets are kept per tenant when the mux resolves tenants.
+*/
+type RateLimit struct {
+	Limit  int64                        // Number of tokens added to a bucket per Period, at most one per nanosecond.
+	Period time.Duration                // Period over which Limit tokens are added, one second by default.
+	Burst  int64                        // Optional. Capacity of a bucket, Limit by default.
+	Key    func(r *http.Request) string // Optional. Returns the client of r. Requests without a client aren't limited.
+	Store  RateLimitStore               // Optional. Stores the buckets, in memory by default.
+
+	once  sync.Once
+	store RateLimitStore
+}
+
+// RateLimitPolicy is implemented by endpoints limiting the rate of their
+// requests with their own settings. A nil RateLimit disables the rate limit of
+// the mux for the endpoint.
+type RateLimitPolicy interface {
+	RateLimit() *RateLimit
+}
+
+// RateLimitStore stores the buckets of rate limits. Implementations backed by
+// a shared database, such as Redis, allow several instances of a service to
+// enforce the same limits.
+type RateLimitStore interface {
+	// Take takes a token from the bucket identified by key, which holds up to
+	// capacity tokens and gains one at every interval. New buckets are full.
+	// It returns the number of tokens left, and when the bucket is empty, the
+	// duration before a token is available, in which case no token was taken.
+	Take(key string, capacity int64, interval time.Duration) (remaining int64, wait time.Duration, err error)
+}
+
+// NewRateLimitStore returns a RateLimitStore keeping buckets in memory.
+func NewRateLimitStore() RateLimitStore {
+	return &memoryRateLimitStore{buckets: make(map[string]*tokenBucket)}
+}
+
+type tokenBucket struct {
+	tokens  float64
+	updated time.Time
+	full    time.Time // Time at which the bucket will be full.
+}
+
+type memoryRateLimitStore struct {
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+	swept   time.Time
+}
+
+func (s *memoryRateLimitStore) Take(key string, capacity int64, interval time.Duration) (int64, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.swept) > time.Minute {
+		for k, b := range s.buckets {
+			if !now.Before(b.full) {
+				delete(s.buckets, k)
+			}
+		}
+		s.swept = now
+	}
+
+	b, found := s.buckets[key]
+	if !found {
+		b = &tokenBucket{tokens: float64(capacity), updated: now}
+		s.buckets[key] = b
+	}
+	b.tokens = math.Min(float64(capacity), b.tokens+float64(now.Sub(b.updated))/float64(interval))
+	b.updated = now
+	if b.tokens < 1 {
+		return 0, time.Duration((1 - b.tokens) * float64(interval)), nil
+	}
+	b.tokens--
+	b.full = now.Add(time.Duration((float64(capacity) - b.tokens) * float64(interval)))
+	return int64(b.tokens), 0, nil
+}
+
+// SetRateLimit sets the rate limit applied to the requests served by the mux,
+// unless their endpoint implements RateLimitPolicy. A nil value disables it,
+// which is the default.
+func (s *Mux) SetRateLimit(l *RateLimit) {
+	s.rateLimit = l
+}
+
+// rateLimitOf returns the rate limit applied to the requests of handler, and
+// the scope of its buckets.
+func (s *Mux) rateLimitOf(handler http.Handler, r *http.Request) (*RateLimit, string) {
+	if h, valid := handler.(*endpointHandler); valid {
+		if policy, implemented := policyEndpoint(h.endpoint).(RateLimitPolicy); implemented {
+			return policy.RateLimit(), RoutePattern(r)
+		}
+	}
+	return s.rateLimit, ""
+}
+
+// apply takes a token for r from the bucket of its client in scope, and sets
+// the RateLimit headers of the response. It returns an error if the bucket is
+// empty.
+func (l *RateLimit) apply(scope string, w http.ResponseWriter, r *http.Request) error {
+	client := clientAddr(r)
+	if l.Key != nil {
+		client = l.Key(r)
+	}
+	if client == "" {
+		return nil
+	}
+
+	l.once.Do(func() {
+		if l.store = l.Store; l.store == nil {
+			l.store = NewRateLimitStore()
+		}
+	})
+	period := l.Period
+	if period <= 0 {
+		period = time.Second
+	}
+	capacity := l.Burst
+	if capacity <= 0 {
+		capacity = l.Limit
+	}
+	// Limits above one token per nanosecond are clamped to it.
+	interval := period / time.Duration(l.Limit)
+	if interval <= 0 {
+		interval = 1
+	}
+
+	key := fmt.Sprintf("%s\x00%s\x00%s", Tenant(r), scope, client)
+	remaining, wait, err := l.store.Take(key, capacity, interval)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("RateLimit-Limit", strconv.FormatInt(capacity, 10))
+	w.Header().Set("RateLimit-Remaining", strconv.FormatInt(remaining, 10))
+	w.Header().Set("RateLimit-Reset", strconv.FormatInt(retryAfterSeconds(time.Duration(capacity-remaining)*interval), 10))
+	if wait > 0 {
+		return rateLimitExceeded(wait)
+	}
+	return nil
+}
+
+// rateLimitExceeded is returned when a request finds an empty bucket, which
+// will have a token after wait.
+func rateLimitExceeded(wait time.Duration) *Error {
+	err := TooManyRequests(wait)
+	err.Reason, err.Description = "Rate limit exceeded", "Too many requests were sent. Please slow down."
+	return err
+}
+
+// clientAddr returns the IP address of the client connected to serve r.
+func clientAddr(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
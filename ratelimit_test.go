@@ -0,0 +1,121 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type limitedEndpoint struct {
+	limit *RateLimit
+}
+
+func (e *limitedEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	return nil, nil
+}
+
+func (e *limitedEndpoint) RateLimit() *RateLimit {
+	return e.limit
+}
+
+func TestRateLimit(t *testing.T) {
+	mux := NewMux()
+	mux.SetRateLimit(&RateLimit{Limit: 2, Period: time.Minute})
+	mux.Get("/people", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return nil, nil
+	})
+	mux.HandleEndpoint("/search", &limitedEndpoint{&RateLimit{
+		Limit:  1,
+		Period: time.Hour,
+		Key: func(r *http.Request) string {
+			return r.Header.Get("X-API-Key")
+		},
+	}})
+	mux.HandleEndpoint("/health", &limitedEndpoint{})
+
+	get := func(path, addr, key string) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(Get, path, nil)
+		r.RemoteAddr = addr
+		r.Header.Set("X-API-Key", key)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		return w
+	}
+
+	w := get("/people", "10.0.0.1:1234", "")
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("got %d, wanted %d", w.Code, http.StatusNoContent)
+	}
+	if l, rem, reset := w.Header().Get("RateLimit-Limit"), w.Header().Get("RateLimit-Remaining"), w.Header().Get("RateLimit-Reset"); l != "2" || rem != "1" || reset != "30" {
+		t.Fatalf("got RateLimit headers %s, %s, %s", l, rem, reset)
+	}
+	get("/people", "10.0.0.1:5678", "")
+
+	// The bucket of the client is empty.
+	w = get("/people", "10.0.0.1:1234", "")
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("got %d, wanted %d", w.Code, http.StatusTooManyRequests)
+	}
+	if got := w.Header().Get("Retry-After"); got != "30" {
+		t.Fatalf("got Retry-After %q, wanted 30", got)
+	}
+	if w := get("/people", "10.0.0.2:1234", ""); w.Code != http.StatusNoContent {
+		t.Fatalf("other client: got %d, wanted %d", w.Code, http.StatusNoContent)
+	}
+
+	// Endpoints can have their own limits, or none.
+	if w := get("/search", "10.0.0.1:1234", "a"); w.Code != http.StatusNoContent {
+		t.Fatalf("/search: got %d, wanted %d", w.Code, http.StatusNoContent)
+	}
+	if w := get("/search", "10.0.0.2:1234", "a"); w.Code != http.StatusTooManyRequests {
+		t.Fatalf("/search: got %d, wanted %d", w.Code, http.StatusTooManyRequests)
+	}
+	if w := get("/search", "10.0.0.1:1234", ""); w.Code != http.StatusNoContent {
+		t.Fatalf("/search without key: got %d, wanted %d", w.Code, http.StatusNoContent)
+	}
+	for i := 0; i < 3; i++ {
+		if w := get("/health", "10.0.0.1:1234", ""); w.Code != http.StatusNoContent {
+			t.Fatalf("/health: got %d, wanted %d", w.Code, http.StatusNoContent)
+		}
+	}
+}
+
+func TestRateLimitHighRate(t *testing.T) {
+	mux := NewMux()
+	mux.SetRateLimit(&RateLimit{Limit: 1 << 40, Period: time.Millisecond, Burst: 1})
+	mux.Get("/people", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return nil, nil
+	})
+	for i := 0; i < 3; i++ {
+		r, _ := http.NewRequest(Get, "/people", nil)
+		r.RemoteAddr = "10.0.0.1:1234"
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != http.StatusNoContent && w.Code != http.StatusTooManyRequests {
+			t.Fatalf("%d: got %d", i, w.Code)
+		}
+		if rem := w.Header().Get("RateLimit-Remaining"); rem != "0" && rem != "1" {
+			t.Fatalf("%d: got RateLimit-Remaining %q", i, rem)
+		}
+	}
+}
+
+func TestRateLimitStore(t *testing.T) {
+	store := NewRateLimitStore()
+	interval := 20 * time.Millisecond
+	for i := int64(2); i >= 0; i-- {
+		remaining, wait, err := store.Take("a", 3, interval)
+		if err != nil || remaining != i || wait != 0 {
+			t.Fatalf("got %d, %s, %v, wanted %d, 0, nil", remaining, wait, err, i)
+		}
+	}
+	if _, wait, _ := store.Take("a", 3, interval); wait <= 0 || wait > interval {
+		t.Fatalf("got wait %s", wait)
+	}
+
+	time.Sleep(interval)
+	if _, wait, _ := store.Take("a", 3, interval); wait != 0 {
+		t.Fatalf("bucket not refilled: got wait %s", wait)
+	}
+}
@@ -0,0 +1,49 @@
+package rst
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+)
+
+// RecoveryFunc is called with the value recovered from a panic in the handler
+// of r, and the stack trace of the goroutine that panicked. The error it
+// returns is written in response to r.
+type RecoveryFunc func(p interface{}, stack []byte, r *http.Request) error
+
+/*
+SetRecoveryHandler sets the function called when a handler of the mux panics,
+to report the panic and control the error returned to the client. A nil error
+is written as a 500 Internal Server Error.
+
+	mux.SetRecoveryHandler(func(p interface{}, stack []byte, r *http.Request) error {
+		sentry.Report(p, stack, rst.RoutePattern(r))
+		return rst.InternalServerError("Unexpected failure", "The incident was reported.", false)
+	})
+
+By default, panics are logged with the Logger of the mux, and answered with a
+500 Internal Server Error including the stack trace when Debug is true.
+*/
+func (s *Mux) SetRecoveryHandler(fn RecoveryFunc) {
+	s.recovery = fn
+}
+
+// recovered writes the response to r after its handler panicked with p.
+func (s *Mux) recovered(p interface{}, w http.ResponseWriter, r *http.Request) {
+	if s.recovery != nil {
+		err := s.recovery(p, debug.Stack(), r)
+		if err == nil {
+			err = InternalServerError(http.StatusText(http.StatusInternalServerError), "", false)
+		}
+		writeError(err, w, r)
+		return
+	}
+
+	reason := fmt.Sprintf("%s", p) // Stringer interface
+	if !s.Debug {
+		t := InternalServerError(reason, "", true)
+		s.Logger.Println(t.String())
+		reason = http.StatusText(http.StatusInternalServerError)
+	}
+	InternalServerError(reason, "", s.Debug).ServeHTTP(w, r)
+}
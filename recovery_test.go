@@ -0,0 +1,53 @@
+package rst
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRecoveryHandler(t *testing.T) {
+	mux := NewMux()
+	mux.Get("/people/{id}", func(vars RouteVars, r *http.Request) (Resource, error) {
+		panic("boom")
+	})
+
+	var (
+		recovered interface{}
+		stack     []byte
+		pattern   string
+	)
+	mux.SetRecoveryHandler(func(p interface{}, s []byte, r *http.Request) error {
+		recovered, stack, pattern = p, s, RoutePattern(r)
+		return NewError(http.StatusBadGateway, "Upstream failure", "")
+	})
+
+	r, _ := http.NewRequest(Get, "http://example.com/people/1", nil)
+	r.Header.Set("Accept", "application/json")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusBadGateway {
+		t.Errorf("Got: %d Wanted: %d", w.Code, http.StatusBadGateway)
+	}
+	if recovered != "boom" {
+		t.Errorf("Got: %v Wanted: boom", recovered)
+	}
+	if !bytes.Contains(stack, []byte("TestRecoveryHandler")) {
+		t.Errorf("stack trace doesn't contain the panicking function:\n%s", stack)
+	}
+	if pattern != "/people/{id}" {
+		t.Errorf("Got: %s Wanted: /people/{id}", pattern)
+	}
+
+	// A nil error is written as 500 Internal Server Error.
+	mux.SetRecoveryHandler(func(p interface{}, s []byte, r *http.Request) error {
+		return nil
+	})
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("Got: %d Wanted: %d", w.Code, http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,143 @@
+package rst
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+/*
+Redirection is returned by endpoints as an error, or in place of a resource, to
+redirect the client to another URL. The response is written with the status
+code and the Location header of the redirection, without a body.
+
+	func (ep *endpoint) Get(vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+		if canonical := database.CanonicalID(vars.Get("id")); canonical != vars.Get("id") {
+			return nil, rst.Redirect(http.StatusMovedPermanently, "/people/"+canonical)
+		}
+		...
+	}
+
+	func (ep *endpoint) Post(vars rst.RouteVars, r *http.Request) (rst.Resource, string, error) {
+		order, err := database.CreateOrder(r)
+		if err != nil {
+			return nil, "", err
+		}
+		return rst.SeeOther("/orders/" + order.ID), "", nil
+	}
+
+Redirections are written like any other response of the mux, with its custom
+headers, CORS headers and access logs.
+*/
+type Redirection struct {
+	Code     int
+	Location string
+}
+
+// Redirect returns a redirection to location with the given status code. It
+// will panic if code is not one of 301, 302, 303, 307 or 308.
+func Redirect(code int, location string) *Redirection {
+	switch code {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusSeeOther,
+		http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+	default:
+		panic(fmt.Errorf("%d is not a valid HTTP status code for a redirection", code))
+	}
+	return &Redirection{Code: code, Location: location}
+}
+
+// MovedPermanently returns a redirection to location with status code 301
+// Moved Permanently.
+func MovedPermanently(location string) *Redirection {
+	return Redirect(http.StatusMovedPermanently, location)
+}
+
+// Found returns a redirection to location with status code 302 Found.
+func Found(location string) *Redirection {
+	return Redirect(http.StatusFound, location)
+}
+
+// SeeOther returns a redirection to location with status code 303 See Other,
+// which clients follow with a GET request, as in the POST-redirect-GET
+// pattern.
+func SeeOther(location string) *Redirection {
+	return Redirect(http.StatusSeeOther, location)
+}
+
+// TemporaryRedirect returns a redirection to location with status code 307
+// Temporary Redirect, which clients follow with the method of the request.
+func TemporaryRedirect(location string) *Redirection {
+	return Redirect(http.StatusTemporaryRedirect, location)
+}
+
+// PermanentRedirect returns a redirection to location with status code 308
+// Permanent Redirect, which clients follow with the method of the request.
+func PermanentRedirect(location string) *Redirection {
+	return Redirect(http.StatusPermanentRedirect, location)
+}
+
+// ETag implements the Resource interface.
+func (rd *Redirection) ETag() string {
+	return ""
+}
+
+// LastModified implements the Resource interface.
+func (rd *Redirection) LastModified() time.Time {
+	return time.Time{}
+}
+
+// TTL implements the Resource interface.
+func (rd *Redirection) TTL() time.Duration {
+	return 0
+}
+
+func (rd *Redirection) Error() string {
+	return fmt.Sprintf("%d (%s) - %s", rd.Code, http.StatusText(rd.Code), rd.Location)
+}
+
+// validate returns an error if the location of rd can't be used in a Location
+// header.
+func (rd *Redirection) validate() error {
+	if rd.Location == "" {
+		return fmt.Errorf("empty redirection location")
+	}
+	if strings.ContainsAny(rd.Location, "\r\n") {
+		return fmt.Errorf("redirection location %q contains a line break", rd.Location)
+	}
+	u, err := url.Parse(rd.Location)
+	if err != nil {
+		return err
+	}
+	if u.IsAbs() && u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("redirection location %q has an unsupported scheme", rd.Location)
+	}
+	return nil
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (rd *Redirection) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := rd.validate(); err != nil {
+		// The location can come from the request, so its details are only
+		// reported to the error hooks of the mux.
+		mux := getMux(r)
+		e, ok := mux.handleError(fmt.Errorf("rst: invalid redirection: %s", err), r).(*Error)
+		if !ok {
+			e = InternalServerError("Invalid redirection", "", mux.Debug)
+		}
+		e.ServeHTTP(w, r)
+		return
+	}
+
+	// Remove headers which might have been set by a previous assumption of
+	// success.
+	w.Header().Del("Last-Modified")
+	w.Header().Del("ETag")
+	w.Header().Del("Expires")
+	w.Header().Del("Content-Type")
+
+	w.Header().Set("Location", rd.Location)
+	w.WriteHeader(rd.Code)
+	w.Write(noContent)
+}
@@ -0,0 +1,110 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRedirect(t *testing.T) {
+	var test = func(code int, location string, expected int) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(Get, "http://www.example.com/people/1", nil)
+		w := httptest.NewRecorder()
+		GetFunc(func(vars RouteVars, r *http.Request) (Resource, error) {
+			return nil, Redirect(code, location)
+		}).ServeHTTP(w, r)
+		if w.Code != expected {
+			t.Errorf("%d %s: Got: %d Wanted: %d", code, location, w.Code, expected)
+		}
+		return w
+	}
+
+	for _, code := range []int{301, 302, 303, 307, 308} {
+		w := test(code, "/people/2", code)
+		if location := w.Header().Get("Location"); location != "/people/2" {
+			t.Errorf("Got: %s Wanted: /people/2", location)
+		}
+		if w.Body.Len() != 0 {
+			t.Errorf("%d: redirection should not have a body", code)
+		}
+	}
+
+	test(http.StatusMovedPermanently, "https://www.example.com/people/2", http.StatusMovedPermanently)
+	test(http.StatusMovedPermanently, "", http.StatusInternalServerError)
+	test(http.StatusMovedPermanently, "/people/2\r\nSet-Cookie: a=b", http.StatusInternalServerError)
+	test(http.StatusMovedPermanently, "javascript:alert(1)", http.StatusInternalServerError)
+}
+
+func TestRedirectInvalidLocation(t *testing.T) {
+	mux := NewMux()
+	var reported error
+	mux.OnError(func(err error, r *http.Request) error {
+		reported = err
+		return nil
+	})
+	mux.Get("/people/1", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return nil, Redirect(http.StatusFound, "/people/2\r\nSet-Cookie: a=b")
+	})
+
+	r, _ := http.NewRequest(Get, "http://www.example.com/people/1", nil)
+	r.Header.Set("Accept", "application/json")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("Got: %d Wanted: %d", w.Code, http.StatusInternalServerError)
+	}
+	if strings.Contains(w.Body.String(), "Set-Cookie") || strings.Contains(w.Body.String(), "redirect.go") {
+		t.Errorf("the details of the location shouldn't be written, got %s", w.Body.String())
+	}
+	if reported == nil || !strings.Contains(reported.Error(), "line break") {
+		t.Errorf("the details should be reported to the error hooks, got %v", reported)
+	}
+}
+
+func TestRedirectInvalidCode(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected Redirect to panic with status code 200")
+		}
+	}()
+	Redirect(http.StatusOK, "/")
+}
+
+func TestRedirectionResource(t *testing.T) {
+	mux := NewMux()
+	mux.Header().Set("X-Powered-By", "rst")
+	mux.Post("/orders", func(vars RouteVars, r *http.Request) (Resource, string, error) {
+		return SeeOther("/orders/1"), "", nil
+	})
+	mux.Get("/people/{id}", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return PermanentRedirect("/users/" + vars.Get("id")), nil
+	})
+
+	var test = func(method, path string, code int, location string) {
+		r, _ := http.NewRequest(method, "http://www.example.com"+path, nil)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != code {
+			t.Errorf("%s %s: Got: %d Wanted: %d", method, path, w.Code, code)
+		}
+		if got := w.Header().Get("Location"); got != location {
+			t.Errorf("%s %s: Location is %s, wanted %s", method, path, got, location)
+		}
+		if w.Header().Get("X-Powered-By") != "rst" || w.Header().Get("ETag") != "" || w.Body.Len() != 0 {
+			t.Errorf("%s %s: unexpected headers %v or body %q", method, path, w.Header(), w.Body.String())
+		}
+	}
+	test(Post, "/orders", http.StatusSeeOther, "/orders/1")
+	test(Get, "/people/2", http.StatusPermanentRedirect, "/users/2")
+
+	for code, rd := range map[int]*Redirection{
+		http.StatusMovedPermanently:  MovedPermanently("/"),
+		http.StatusFound:             Found("/"),
+		http.StatusTemporaryRedirect: TemporaryRedirect("/"),
+	} {
+		if rd.Code != code {
+			t.Errorf("Got: %d Wanted: %d", rd.Code, code)
+		}
+	}
+}
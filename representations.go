@@ -0,0 +1,262 @@
+package rst
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Defaults of a RepresentationCache.
+const (
+	DefaultRepresentationCacheMaxEntries = 10000
+	DefaultRepresentationCacheMaxSize    = 64 << 20
+	DefaultRepresentationCacheTTL        = 10 * time.Minute
+)
+
+/*
+RepresentationCache keeps the encoded representations of resources, so that
+the GET and HEAD requests for a resource whose ETag hasn't changed skip its
+encoding and its compression.
+
+	mux.SetRepresentationCache(&rst.RepresentationCache{MaxSize: 32 << 20})
+
+Unlike a ResponseCache, endpoints are still called to return the resource, and
+only the work done afterwards is saved. Representations are keyed by the ETag
+of the resource, the tenant, host and URL of the request, and the values of its
+Accept, Accept-Charset, Accept-Language and Range headers. Resources whose
+representation can change without their ETag must not be served with a cache.
+Resources without an ETag, streamed resources and resources implementing
+http.Handler are never cached.
+*/
+type RepresentationCache struct {
+	MaxEntries int           // Optional. Maximum number of representations, DefaultRepresentationCacheMaxEntries by default.
+	MaxSize    int64         // Optional. Maximum size in bytes of all the representations, DefaultRepresentationCacheMaxSize by default.
+	TTL        time.Duration // Optional. Duration after which a representation is encoded again, DefaultRepresentationCacheTTL by default.
+
+	mu      sync.Mutex
+	entries map[string]*cachedRepresentation
+	size    int64
+	swept   time.Time
+}
+
+// cachedRepresentation is an encoded representation of a resource.
+type cachedRepresentation struct {
+	contentType string
+	header      http.Header       // Headers set while encoding the representation.
+	body        []byte            // Representation before compression.
+	compressed  map[string][]byte // Representation compressed in each format.
+	expires     time.Time
+}
+
+func (rep *cachedRepresentation) size() int64 {
+	size := int64(len(rep.body))
+	for _, b := range rep.compressed {
+		size += int64(len(b))
+	}
+	return size
+}
+
+// SetRepresentationCache sets the cache of the representations encoded by the
+// mux. A nil value disables it, which is the default.
+func (s *Mux) SetRepresentationCache(c *RepresentationCache) {
+	s.representationCache = c
+}
+
+func (c *RepresentationCache) maxEntries() int {
+	if c.MaxEntries <= 0 {
+		return DefaultRepresentationCacheMaxEntries
+	}
+	return c.MaxEntries
+}
+
+func (c *RepresentationCache) maxSize() int64 {
+	if c.MaxSize <= 0 {
+		return DefaultRepresentationCacheMaxSize
+	}
+	return c.MaxSize
+}
+
+func (c *RepresentationCache) ttl() time.Duration {
+	if c.TTL <= 0 {
+		return DefaultRepresentationCacheTTL
+	}
+	return c.TTL
+}
+
+// representationKey returns the key of the representation with etag requested
+// by r, and false if it can't be cached.
+func representationKey(etag string, r *http.Request) (string, bool) {
+	if getMux(r).representationCache == nil || etag == "" {
+		return "", false
+	}
+	if m := strings.ToUpper(r.Method); m != Get && m != Head {
+		return "", false
+	}
+	parts := []string{etag, Tenant(r), r.Host, r.URL.RequestURI()}
+	for _, header := range []string{"Accept", "Accept-Charset", "Accept-Language", "Range"} {
+		parts = append(parts, r.Header.Get(header))
+	}
+	return strings.Join(parts, "\x00"), true
+}
+
+// get returns the representation of key, or nil.
+func (c *RepresentationCache) get(key string) *cachedRepresentation {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if rep, found := c.entries[key]; found && time.Now().Before(rep.expires) {
+		return rep
+	}
+	return nil
+}
+
+// set stores rep for key, unless it's larger than the cache.
+func (c *RepresentationCache) set(key string, rep *cachedRepresentation) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = make(map[string]*cachedRepresentation)
+	}
+	size := rep.size()
+	if size > c.maxSize() {
+		return
+	}
+	c.remove(key)
+
+	now := time.Now()
+	if now.Sub(c.swept) > time.Minute {
+		for k, e := range c.entries {
+			if !now.Before(e.expires) {
+				c.remove(k)
+			}
+		}
+		c.swept = now
+	}
+	for k := range c.entries {
+		if len(c.entries) < c.maxEntries() && c.size+size <= c.maxSize() {
+			break
+		}
+		c.remove(k)
+	}
+	rep.expires = now.Add(c.ttl())
+	c.entries[key] = rep
+	c.size += size
+}
+
+// compress stores b, the representation of key compressed in format.
+func (c *RepresentationCache) compress(key string, rep *cachedRepresentation, format string, b []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries[key] != rep || c.size+int64(len(b)) > c.maxSize() {
+		return
+	}
+	compressed := make(map[string][]byte, len(rep.compressed)+1)
+	for f, cb := range rep.compressed {
+		compressed[f] = cb
+	}
+	compressed[format] = b
+	rep.compressed = compressed
+	c.size += int64(len(b))
+}
+
+// compressed returns the representation compressed in format, or nil.
+func (c *RepresentationCache) compressed(rep *cachedRepresentation, format string) []byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return rep.compressed[format]
+}
+
+// remove forgets the representation of key. c.mu must be locked.
+func (c *RepresentationCache) remove(key string) {
+	if rep, found := c.entries[key]; found {
+		c.size -= rep.size()
+		delete(c.entries, key)
+	}
+}
+
+// encodeRepresentation returns the representation of resource encoded for r,
+// from the cache of the mux if possible. Headers set while encoding are set in
+// w.
+func encodeRepresentation(resource Resource, etag string, w http.ResponseWriter, r *http.Request) (string, []byte, error) {
+	key, cacheable := representationKey(etag, r)
+	if cacheable {
+		if rep := getMux(r).representationCache.get(key); rep != nil {
+			for k, values := range rep.header {
+				w.Header()[k] = append([]string(nil), values...)
+			}
+			return rep.contentType, rep.body, nil
+		}
+	}
+
+	var before http.Header
+	if cacheable {
+		before = w.Header().Clone()
+	}
+	contentType, b, err := Marshal(resource, r)
+	if err != nil {
+		return "", nil, err
+	}
+	if contentType, b, err = decorateJSON(resource, contentType, b, w.Header(), r); err != nil {
+		return "", nil, err
+	}
+	if isJSON(contentType) {
+		setLinkHeader(resource, w.Header(), r)
+	}
+	contentType, b = negotiateCharset(contentType, b, w.Header(), r)
+
+	if cacheable {
+		header := make(http.Header)
+		for k, values := range w.Header() {
+			if !sameValues(before[k], values) {
+				header[k] = append([]string(nil), values...)
+			}
+		}
+		getMux(r).representationCache.set(key, &cachedRepresentation{contentType: contentType, header: header, body: b})
+	}
+	return contentType, b, nil
+}
+
+// compressedRepresentation returns b, the representation of resource with
+// etag, compressed in format for r from the cache of the mux, and the writer
+// it must be written to, bypassing the compression of w. It returns nil if the
+// representation isn't cached, w doesn't compress on its own, or r is a HEAD
+// request for a representation which hasn't been compressed yet.
+func compressedRepresentation(b []byte, etag, format string, w http.ResponseWriter, r *http.Request) (http.ResponseWriter, []byte) {
+	rw, compressing := w.(*responseWriter)
+	key, cacheable := representationKey(etag, r)
+	if !compressing || !cacheable {
+		return nil, nil
+	}
+	cache := getMux(r).representationCache
+	rep := cache.get(key)
+	if rep == nil {
+		return nil, nil
+	}
+	if compressed := cache.compressed(rep, format); compressed != nil {
+		return rw.ResponseWriter, compressed
+	}
+	if strings.ToUpper(r.Method) == Head {
+		return nil, nil
+	}
+	buffer := getBuffer()
+	if _, err := compress(format, rw.level, buffer, b); err != nil {
+		putBuffer(buffer)
+		return nil, nil
+	}
+	compressed := detachBuffer(buffer)
+	cache.compress(key, rep, format, compressed)
+	return rw.ResponseWriter, compressed
+}
+
+// sameValues returns true if a and b hold the same values.
+func sameValues(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
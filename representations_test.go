@@ -0,0 +1,93 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// encodedCounter counts the times it's marshaled.
+type encodedCounter struct {
+	etag    string
+	body    string
+	encoded int
+}
+
+func (c *encodedCounter) ETag() string            { return c.etag }
+func (c *encodedCounter) LastModified() time.Time { return testTimeReference }
+func (c *encodedCounter) TTL() time.Duration      { return 0 }
+
+func (c *encodedCounter) MarshalRST(r *http.Request) (string, []byte, error) {
+	c.encoded++
+	return "text/plain", []byte(c.body), nil
+}
+
+func TestRepresentationCache(t *testing.T) {
+	resource := &encodedCounter{etag: "v1", body: strings.Repeat("representation ", 200)}
+	mux := NewMux()
+	mux.Get("/resource", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return resource, nil
+	})
+	mux.SetRepresentationCache(&RepresentationCache{})
+
+	var test = func(encoding string, encoded int) {
+		r, _ := http.NewRequest(Get, "http://example.com/resource", nil)
+		if encoding != "" {
+			r.Header.Set("Accept-Encoding", encoding)
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != http.StatusOK {
+			t.Fatalf("Status code is %d, expected 200 OK", w.Code)
+		}
+		if resource.encoded != encoded {
+			t.Errorf("Resource was encoded %d times, expected %d", resource.encoded, encoded)
+		}
+		if got := w.Header().Get("Content-Encoding"); got != encoding {
+			t.Errorf("Content-Encoding is %q, expected %q", got, encoding)
+		}
+		body := w.Body.Bytes()
+		if encoding != "" {
+			body, _ = decompress(w.Result().Body, encoding)
+		}
+		if string(body) != resource.body {
+			t.Errorf("Body is %q, expected %q", body, resource.body)
+		}
+	}
+
+	test("", 1)
+	test("", 1)
+	test("gzip", 1)
+	test("gzip", 1)
+	test("deflate", 1)
+
+	resource.etag, resource.body = "v2", strings.Repeat("modified ", 200)
+	test("gzip", 2)
+	test("", 2)
+}
+
+func TestRepresentationCacheBounds(t *testing.T) {
+	cache := &RepresentationCache{MaxEntries: 2, MaxSize: 10}
+	cache.set("a", &cachedRepresentation{body: []byte("12345")})
+	cache.set("b", &cachedRepresentation{body: []byte("12345")})
+	if cache.get("a") == nil || cache.get("b") == nil {
+		t.Fatal("Representations are missing")
+	}
+	cache.set("c", &cachedRepresentation{body: []byte("1")})
+	if len(cache.entries) != 2 || cache.get("c") == nil || cache.size > 10 {
+		t.Errorf("Cache holds %d representations of %d bytes, expected 2 including c", len(cache.entries), cache.size)
+	}
+	cache.set("d", &cachedRepresentation{body: []byte("12345678901")})
+	if cache.get("d") != nil {
+		t.Error("Representation larger than the cache was kept")
+	}
+
+	cache = &RepresentationCache{TTL: time.Millisecond}
+	cache.set("a", &cachedRepresentation{body: []byte("12345")})
+	time.Sleep(2 * time.Millisecond)
+	if cache.get("a") != nil {
+		t.Error("Expired representation was returned")
+	}
+}
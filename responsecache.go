@@ -0,0 +1,397 @@
+package rst
+
+import (
+	"bytes"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/context"
+)
+
+// CacheStatusHeader is set to HIT in the responses served by a ResponseCache,
+// and to MISS in the responses it stores.
+const CacheStatusHeader = "X-Cache"
+
+// Defaults of a ResponseCache.
+const (
+	DefaultResponseCacheMaxBodySize = 1 << 20
+	DefaultResponseStoreMaxEntries  = 10000
+)
+
+/*
+ResponseCache serves the GET and HEAD requests of hot endpoints from the
+responses stored for their URL, instead of calling their Get method.
+
+	mux.SetResponseCache(&rst.ResponseCache{Store: rst.NewResponseStore()})
+
+Responses are stored until the date of their Expires header, which is set with
+the TTL of the resource. Only 200 OK responses are stored, unless their
+Cache-Control header has a private, no-cache or no-store directive, or they set
+a cookie. Requests with an Authorization header are only answered with
+responses whose Cache-Control header has a public directive.
+
+Requests authenticated by the Authenticator of the mux or of the endpoint,
+whatever their credentials, and requests assigned the variants of experiments
+are never answered from the cache, nor stored, since their responses can
+depend on the principal or on the variants.
+
+Each variant of a response is stored separately, according to the values of
+the request headers listed in its Vary header, such as Accept or
+Accept-Encoding. Responses are keyed by tenant, host and URL, including the
+query string.
+
+Stored responses are revalidated with the Validator interface of the endpoint,
+when implemented, and are discarded as soon as the current ETag of the resource
+differs. They're also discarded when a PUT, PATCH, POST or DELETE request sent
+to the same URL succeeds. The conditional headers of requests are evaluated
+against stored responses, which are answered with 304 Not Modified if they
+match.
+
+Endpoints implementing ResponseCachePolicy use their own cache, or none.
+*/
+type ResponseCache struct {
+	Store       ResponseStore // Stores the responses.
+	MaxBodySize int64         // Optional. Larger responses aren't stored. Defaults to DefaultResponseCacheMaxBodySize.
+}
+
+// ResponseCachePolicy is implemented by endpoints storing their responses in
+// their own cache. A nil ResponseCache exempts the endpoint from the cache of
+// the mux.
+type ResponseCachePolicy interface {
+	ResponseCache() *ResponseCache
+}
+
+// CachedResponse is a response stored by a ResponseCache.
+type CachedResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	Stored     time.Time
+	Expires    time.Time
+}
+
+// CachedResponses are the variants of the response to a URL. They must not be
+// modified once stored.
+type CachedResponses struct {
+	Vary      []string                   // Canonical names of the request headers the variants vary with.
+	Responses map[string]*CachedResponse // Variants, indexed by the values of the Vary headers of their request.
+}
+
+// ResponseStore stores the responses of a ResponseCache.
+type ResponseStore interface {
+	// Get returns the responses recorded for key, or nil.
+	Get(key string) (*CachedResponses, error)
+
+	// Set records the responses of key, which can be forgotten after expires.
+	Set(key string, responses *CachedResponses, expires time.Time) error
+
+	// Delete forgets the responses recorded for key.
+	Delete(key string) error
+}
+
+// NewResponseStore returns a ResponseStore keeping up to
+// DefaultResponseStoreMaxEntries URLs in memory.
+func NewResponseStore() ResponseStore {
+	return &memoryResponseStore{entries: make(map[string]*responseStoreEntry)}
+}
+
+type responseStoreEntry struct {
+	responses *CachedResponses
+	expires   time.Time
+}
+
+type memoryResponseStore struct {
+	mu      sync.Mutex
+	entries map[string]*responseStoreEntry
+	swept   time.Time
+}
+
+func (s *memoryResponseStore) Get(key string) (*CachedResponses, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e, found := s.entries[key]; found && time.Now().Before(e.expires) {
+		return e.responses, nil
+	}
+	return nil, nil
+}
+
+func (s *memoryResponseStore) Set(key string, responses *CachedResponses, expires time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.swept) > time.Minute {
+		for k, e := range s.entries {
+			if !now.Before(e.expires) {
+				delete(s.entries, k)
+			}
+		}
+		s.swept = now
+	}
+	if _, found := s.entries[key]; !found && len(s.entries) >= DefaultResponseStoreMaxEntries {
+		for k := range s.entries {
+			delete(s.entries, k)
+			break
+		}
+	}
+	s.entries[key] = &responseStoreEntry{responses, expires}
+	return nil
+}
+
+func (s *memoryResponseStore) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, key)
+	return nil
+}
+
+// SetResponseCache sets the cache of the responses of the endpoints served by
+// the mux, unless they implement ResponseCachePolicy. A nil value disables it,
+// which is the default.
+func (s *Mux) SetResponseCache(c *ResponseCache) {
+	s.responseCache = c
+}
+
+// responseCacheOf returns the cache of the responses of handler, or nil.
+func (s *Mux) responseCacheOf(handler http.Handler) *ResponseCache {
+	h, valid := handler.(*endpointHandler)
+	if !valid {
+		return nil
+	}
+	if policy, implemented := policyEndpoint(h.endpoint).(ResponseCachePolicy); implemented {
+		return policy.ResponseCache()
+	}
+	return s.responseCache
+}
+
+// responseCacheKey returns the key of the responses to the URL of r.
+func responseCacheKey(r *http.Request) string {
+	return strings.Join([]string{Tenant(r), r.Host, r.URL.RequestURI()}, "\x00")
+}
+
+// shared returns true if the response to r can be shared with other clients:
+// r wasn't authenticated, and wasn't assigned the variants of experiments.
+func shared(r *http.Request) bool {
+	return Principal(r) == nil && context.Get(r, experimentsKey) == nil
+}
+
+// variant returns the key of the variant of the responses requested by r.
+func (c *CachedResponses) variant(r *http.Request) string {
+	values := make([]string, len(c.Vary))
+	for i, name := range c.Vary {
+		values[i] = strings.Join(r.Header[name], ",")
+	}
+	return strings.Join(values, "\x00")
+}
+
+// handler returns a handler serving the requests of next from c.
+func (c *ResponseCache) handler(next *endpointHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := responseCacheKey(r)
+		method := strings.ToUpper(r.Method)
+		if method != Get && method != Head {
+			recorder := &recordingWriter{ResponseWriter: w}
+			next.ServeHTTP(recorder, r)
+			if method != Options && method != "TRACE" && recorder.code < 400 {
+				c.Store.Delete(key)
+			}
+			return
+		}
+		if r.Header.Get("Range") != "" || r.Header.Get("Upgrade") != "" || !shared(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		responses, _ := c.Store.Get(key)
+		if responses != nil {
+			if resp := responses.Responses[responses.variant(r)]; resp != nil && c.fresh(resp, next.endpoint, r) {
+				resp.serve(w, r)
+				return
+			}
+		}
+
+		before := cloneHeader(w.Header())
+		recorder := &cacheWriter{ResponseWriter: w, max: c.maxBodySize()}
+		w.Header().Set(CacheStatusHeader, "MISS")
+		next.ServeHTTP(recorder, r)
+		if method == Get {
+			c.store(key, responses, recorder, before, r)
+		}
+	})
+}
+
+func (c *ResponseCache) maxBodySize() int64 {
+	if c.MaxBodySize <= 0 {
+		return DefaultResponseCacheMaxBodySize
+	}
+	return c.MaxBodySize
+}
+
+// fresh returns true if resp can be served in response to r.
+func (c *ResponseCache) fresh(resp *CachedResponse, endpoint Endpoint, r *http.Request) bool {
+	if !time.Now().Before(resp.Expires) {
+		return false
+	}
+	if r.Header.Get("Authorization") != "" && !hasDirective(resp.Header.Get("Cache-Control"), "public") {
+		return false
+	}
+	if v, implemented := endpoint.(Validator); implemented {
+		etag, _, err := v.Validators(getVars(r), r)
+		if err != nil || representationETag(etag, r) != resp.Header.Get("ETag") {
+			c.Store.Delete(responseCacheKey(r))
+			return false
+		}
+	}
+	return true
+}
+
+// store adds the response recorded by w to responses, the responses currently
+// stored for key, if it can be cached. before is a copy of the headers written
+// before the request was served by the endpoint.
+func (c *ResponseCache) store(key string, responses *CachedResponses, w *cacheWriter, before http.Header, r *http.Request) {
+	h := w.Header()
+	cacheControl := h.Get("Cache-Control")
+	if w.code != http.StatusOK || w.overflow || h.Get("Set-Cookie") != "" ||
+		hasDirective(cacheControl, "private") || hasDirective(cacheControl, "no-cache") || hasDirective(cacheControl, "no-store") ||
+		(r.Header.Get("Authorization") != "" && !hasDirective(cacheControl, "public")) {
+		return
+	}
+	expires, err := http.ParseTime(h.Get("Expires"))
+	if err != nil || !time.Now().Before(expires) {
+		return
+	}
+
+	var vary []string
+	for _, value := range h["Vary"] {
+		for _, name := range strings.Split(value, ",") {
+			if name = strings.TrimSpace(name); name == "*" {
+				return
+			} else if name != "" {
+				vary = append(vary, http.CanonicalHeaderKey(name))
+			}
+		}
+	}
+	sort.Strings(vary)
+
+	// Only the headers written by the endpoint are stored, so that the ones
+	// depending on each request, such as CORS headers, aren't replayed.
+	header := make(http.Header)
+	for name, values := range h {
+		if name == CacheStatusHeader || name == "Date" {
+			continue
+		}
+		if previous, found := before[name]; !found || strings.Join(previous, "\x00") != strings.Join(values, "\x00") {
+			header[name] = append([]string(nil), values...)
+		}
+	}
+
+	updated := &CachedResponses{Vary: vary, Responses: make(map[string]*CachedResponse)}
+	if responses != nil && strings.Join(responses.Vary, ",") == strings.Join(vary, ",") {
+		for variant, resp := range responses.Responses {
+			if time.Now().Before(resp.Expires) {
+				updated.Responses[variant] = resp
+			}
+		}
+	}
+	updated.Responses[updated.variant(r)] = &CachedResponse{
+		StatusCode: w.code,
+		Header:     header,
+		Body:       w.body.Bytes(),
+		Stored:     time.Now(),
+		Expires:    expires,
+	}
+
+	latest := expires
+	for _, resp := range updated.Responses {
+		if resp.Expires.After(latest) {
+			latest = resp.Expires
+		}
+	}
+	c.Store.Set(key, updated, latest)
+}
+
+// serve writes resp in w, or a 304 Not Modified response if the conditional
+// headers of r match it.
+func (resp *CachedResponse) serve(w http.ResponseWriter, r *http.Request) {
+	for name, values := range resp.Header {
+		w.Header()[name] = append([]string(nil), values...)
+	}
+	w.Header().Set(CacheStatusHeader, "HIT")
+	w.Header().Set("Age", strconv.FormatInt(int64(time.Since(resp.Stored)/time.Second), 10))
+
+	lastModified, _ := time.Parse(rfc1123, resp.Header.Get("Last-Modified"))
+	if notModified(resp.Header.Get("ETag"), lastModified, r) {
+		for _, name := range []string{"Content-Type", "Content-Length", "Content-Encoding"} {
+			w.Header().Del(name)
+		}
+		w.WriteHeader(http.StatusNotModified)
+		w.Write(noContent)
+		return
+	}
+
+	w.WriteHeader(resp.StatusCode)
+	if strings.ToUpper(r.Method) == Head {
+		w.Write(noContent)
+		return
+	}
+	w.Write(resp.Body)
+}
+
+// hasDirective returns true if the Cache-Control header value contains the
+// directive name.
+func hasDirective(value, name string) bool {
+	for _, directive := range strings.Split(value, ",") {
+		directive = strings.TrimSpace(directive)
+		if i := strings.IndexByte(directive, '='); i >= 0 {
+			directive = directive[:i]
+		}
+		if strings.EqualFold(directive, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// cacheWriter records the status code and the body of a response, up to max
+// bytes.
+type cacheWriter struct {
+	http.ResponseWriter
+	max      int64
+	code     int
+	body     bytes.Buffer
+	overflow bool
+}
+
+func (w *cacheWriter) WriteHeader(code int) {
+	if w.code == 0 && code >= http.StatusOK {
+		w.code = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *cacheWriter) Write(b []byte) (int, error) {
+	if w.code == 0 {
+		w.code = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	if !w.overflow {
+		if int64(w.body.Len()+n) > w.max {
+			w.overflow = true
+			w.body.Reset()
+		} else {
+			w.body.Write(b[:n])
+		}
+	}
+	return n, err
+}
+
+// Flush implements the http.Flusher interface.
+func (w *cacheWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
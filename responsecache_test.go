@@ -0,0 +1,155 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type hotEndpoint struct {
+	name   string
+	etag   string
+	ttl    time.Duration
+	public bool
+	gets   int
+}
+
+func (e *hotEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	e.gets++
+	projection := &cacheableResource{Name: e.name}
+	if e.public {
+		projection.directives = &CacheDirectives{Public: true}
+	}
+	return NewEnvelope(projection, time.Date(2015, 1, 2, 3, 4, 5, 0, time.UTC), e.etag, e.ttl), nil
+}
+
+func (e *hotEndpoint) Put(vars RouteVars, r *http.Request) (Resource, error) {
+	e.name = "Claire"
+	return nil, nil
+}
+
+type revalidatedEndpoint struct {
+	hotEndpoint
+}
+
+func (e *revalidatedEndpoint) Validators(vars RouteVars, r *http.Request) (string, time.Time, error) {
+	return e.etag, time.Time{}, nil
+}
+
+type uncachedEndpoint struct {
+	hotEndpoint
+}
+
+func (e *uncachedEndpoint) ResponseCache() *ResponseCache {
+	return nil
+}
+
+func TestResponseCache(t *testing.T) {
+	hot := &hotEndpoint{name: "Francis", etag: "v1", ttl: time.Hour}
+	revalidated := &revalidatedEndpoint{hotEndpoint{name: "Francis", etag: "v1", ttl: time.Hour}}
+	uncached := &uncachedEndpoint{hotEndpoint{name: "Francis", etag: "v1", ttl: time.Hour}}
+
+	mux := NewMux()
+	mux.SetResponseCache(&ResponseCache{Store: NewResponseStore()})
+	mux.HandleEndpoint("/hot", hot)
+	mux.HandleEndpoint("/revalidated", revalidated)
+	mux.HandleEndpoint("/uncached", uncached)
+
+	var test = func(method, path string, header http.Header, status int, cache, body string) {
+		r, _ := http.NewRequest(method, "http://example.com"+path, nil)
+		r.Header = header
+		if r.Header.Get("Accept") == "" {
+			r.Header.Set("Accept", "application/json")
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Errorf("%s %s %v: Got: %d Wanted: %d", method, path, header, w.Code, status)
+		}
+		if got := w.Header().Get(CacheStatusHeader); got != cache {
+			t.Errorf("%s %s %v: %s: Got: %q Wanted: %q", method, path, header, CacheStatusHeader, got, cache)
+		}
+		if !strings.Contains(w.Body.String(), body) {
+			t.Errorf("%s %s %v: Got: %q Wanted: %q", method, path, header, w.Body.String(), body)
+		}
+	}
+
+	test(Get, "/hot", http.Header{}, http.StatusOK, "MISS", "Francis")
+	test(Get, "/hot", http.Header{}, http.StatusOK, "HIT", "Francis")
+	test(Head, "/hot", http.Header{}, http.StatusOK, "HIT", "")
+	test(Get, "/hot", http.Header{"If-None-Match": {"v1"}}, http.StatusNotModified, "HIT", "")
+	test(Get, "/hot?page=2", http.Header{}, http.StatusOK, "MISS", "Francis")
+	if hot.gets != 2 {
+		t.Errorf("Gets: Got: %d Wanted: 2", hot.gets)
+	}
+
+	// Variants are stored separately.
+	test(Get, "/hot", http.Header{"Accept": {"application/xml"}}, http.StatusOK, "MISS", "<Name>Francis</Name>")
+	test(Get, "/hot", http.Header{"Accept": {"application/xml"}}, http.StatusOK, "HIT", "<Name>Francis</Name>")
+	test(Get, "/hot", http.Header{}, http.StatusOK, "HIT", `"name":"Francis"`)
+
+	// Private responses aren't shared with authenticated requests.
+	test(Get, "/hot", http.Header{"Authorization": {"Bearer token"}}, http.StatusOK, "MISS", "Francis")
+	test(Get, "/hot", http.Header{"Authorization": {"Bearer token"}}, http.StatusOK, "MISS", "Francis")
+
+	// Writes discard the stored responses.
+	test(Put, "/hot", http.Header{}, http.StatusOK, "", "")
+	test(Get, "/hot", http.Header{}, http.StatusOK, "MISS", "Claire")
+
+	// Stored responses are revalidated with the current ETag.
+	test(Get, "/revalidated", http.Header{}, http.StatusOK, "MISS", "Francis")
+	test(Get, "/revalidated", http.Header{}, http.StatusOK, "HIT", "Francis")
+	revalidated.name, revalidated.etag = "Claire", "v2"
+	test(Get, "/revalidated", http.Header{}, http.StatusOK, "MISS", "Claire")
+
+	test(Get, "/uncached", http.Header{}, http.StatusOK, "", "Francis")
+	test(Get, "/uncached", http.Header{}, http.StatusOK, "", "Francis")
+
+	// Public responses are.
+	hot.public = true
+	test(Put, "/hot", http.Header{}, http.StatusOK, "", "")
+	test(Get, "/hot", http.Header{"Authorization": {"Bearer token"}}, http.StatusOK, "MISS", "Claire")
+	test(Get, "/hot", http.Header{"Authorization": {"Bearer other"}}, http.StatusOK, "HIT", "Claire")
+
+	// Responses without a TTL aren't stored.
+	hot.ttl = 0
+	test(Put, "/hot", http.Header{}, http.StatusOK, "", "")
+	test(Get, "/hot", http.Header{}, http.StatusOK, "MISS", "Claire")
+	test(Get, "/hot", http.Header{}, http.StatusOK, "MISS", "Claire")
+}
+
+func TestResponseCacheBypass(t *testing.T) {
+	hot := &hotEndpoint{name: "Francis", etag: "v1", ttl: time.Hour, public: true}
+	mux := NewMux()
+	mux.SetResponseCache(&ResponseCache{Store: NewResponseStore()})
+	mux.SetAuthenticator(AuthenticatorFunc(func(r *http.Request) (interface{}, error) {
+		if c, err := r.Cookie("session"); err == nil {
+			return c.Value, nil
+		}
+		return nil, nil
+	}))
+	mux.HandleEndpoint("/hot", hot)
+
+	var test = func(header http.Header, gets int) {
+		r, _ := http.NewRequest(Get, "http://example.com/hot", nil)
+		r.Header = header
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != http.StatusOK || hot.gets != gets {
+			t.Errorf("%v: Got: %d after %d gets Wanted: 200 after %d gets", header, w.Code, hot.gets, gets)
+		}
+	}
+
+	// Authenticated requests are never served from the cache, nor stored.
+	test(http.Header{"Cookie": {"session=francis"}}, 1)
+	test(http.Header{"Cookie": {"session=claire"}}, 2)
+	test(http.Header{}, 3)
+	test(http.Header{}, 3)
+
+	// Neither are the requests assigned a variant.
+	mux.AddExperiment(&Experiment{Name: "layout", Variants: []string{"a", "b"}})
+	test(http.Header{}, 4)
+	test(http.Header{}, 5)
+}
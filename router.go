@@ -0,0 +1,280 @@
+package rst
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	gorillaMux "github.com/gorilla/mux"
+)
+
+// routeEntry is a route registered in a mux.
+type routeEntry struct {
+	key         string // Host and pattern the route was registered with.
+	route       *gorillaMux.Route
+	handler     http.Handler
+	group       *Group
+	middlewares []Middleware
+	prefix      bool // True if the route matches all the paths under its pattern.
+}
+
+// routeMatch is the route matched for a request, and the variables extracted
+// from its URL.
+type routeMatch struct {
+	*routeEntry
+	vars RouteVars
+}
+
+/*
+routeTable holds the routes of a mux. Tables are never modified once built:
+registering or removing a route builds a new table, which replaces the
+previous one atomically, so that requests are matched without locks.
+*/
+type routeTable struct {
+	entries  []*routeEntry
+	tree     *routeTree
+	modified time.Time // Date at which the table was built.
+}
+
+func newRouteTable(entries []*routeEntry) *routeTable {
+	t := &routeTable{entries: entries, tree: newRouteTree(), modified: time.Now().UTC()}
+	for _, e := range entries {
+		t.tree.add(e)
+	}
+	return t
+}
+
+// match returns the first route matching r, or nil. The routes which aren't
+// indexed are only matched when they were registered before the route of the
+// tree matching r, if any.
+func (t *routeTable) match(r *http.Request) *routeMatch {
+	leaf, indexed := t.tree.lookup(r.URL.Path)
+	unindexed := t.tree.unindexed
+	if !indexed {
+		unindexed = t.tree.all
+	}
+	for _, u := range unindexed {
+		if leaf != nil && u.index > leaf.index {
+			break
+		}
+		var match gorillaMux.RouteMatch
+		if u.entry.route.Match(r, &match) {
+			return &routeMatch{u.entry, RouteVars(match.Vars)}
+		}
+	}
+	if leaf == nil {
+		return nil
+	}
+	if leaf.match != nil {
+		return leaf.match
+	}
+	return &routeMatch{leaf.entry, leaf.vars(r.URL.Path)}
+}
+
+// routes returns the current route table of s.
+func (s *Mux) routes() *routeTable {
+	return s.table.Load().(*routeTable)
+}
+
+// register adds e to the routes of s, or replaces the route served by
+// previous if it's not nil.
+func (s *Mux) register(e *routeEntry, previous http.Handler) {
+	entries := append([]*routeEntry(nil), s.routes().entries...)
+	for i, existing := range entries {
+		if previous != nil && existing.handler == previous {
+			entries[i] = e
+			s.table.Store(newRouteTable(entries))
+			return
+		}
+	}
+	s.table.Store(newRouteTable(append(entries, e)))
+}
+
+// unregister removes the routes registered with key from s.
+func (s *Mux) unregister(key string) {
+	var entries []*routeEntry
+	for _, e := range s.routes().entries {
+		if e.key != key {
+			entries = append(entries, e)
+		}
+	}
+	delete(s.endpoints, key)
+	s.table.Store(newRouteTable(entries))
+}
+
+/*
+routeTree indexes routes in a radix tree of path segments, so that requests are
+matched in a time proportional to the length of their path instead of the
+number of routes.
+
+Only the routes whose pattern is made of static segments and of whole-segment
+variables, such as /people/{id}, are indexed. Other routes, such as the ones
+with constraints, catch-all variables or hosts, are matched one after the
+other. Routes are matched in the order they were registered: a request is
+only matched against the routes which aren't indexed and were registered before
+the route of the tree matching its path, if any.
+
+Matching a route of the tree doesn't allocate, except for the RouteVars of the
+routes with variables.
+*/
+type routeTree struct {
+	root      *routeNode
+	n         int          // Number of registered routes.
+	unindexed []*routeLeaf // Routes which aren't in the tree, in the order they were registered.
+	all       []*routeLeaf // All the routes, for the paths the tree can't match.
+}
+
+type routeNode struct {
+	static map[string]*routeNode
+	param  *routeNode
+	leaf   *routeLeaf
+}
+
+// routeLeaf is a route registered in a routeTree.
+type routeLeaf struct {
+	index  int
+	entry  *routeEntry
+	params []routeParam
+	match  *routeMatch // Shared by the requests of routes without variables.
+}
+
+// routeParam is a variable of a route, and the position of its segment in the
+// path.
+type routeParam struct {
+	segment int
+	name    string
+}
+
+func newRouteTree() *routeTree {
+	return &routeTree{root: &routeNode{}}
+}
+
+// add registers e in the tree. Routes whose template can't be indexed, or
+// which have a host or match a prefix, are only counted.
+func (t *routeTree) add(e *routeEntry) {
+	leaf := &routeLeaf{index: t.n, entry: e}
+	t.n++
+	t.all = append(t.all, leaf)
+
+	template, _ := e.route.GetPathTemplate()
+	_, err := e.route.GetHostTemplate()
+	params, indexed := parseTemplate(template)
+	if !indexed || err == nil || e.prefix {
+		t.unindexed = append(t.unindexed, leaf)
+		return
+	}
+	leaf.params = params
+	if len(params) == 0 {
+		leaf.match = &routeMatch{e, nil}
+	}
+
+	node := t.root
+	for _, segment := range strings.Split(template[1:], "/") {
+		if isParam(segment) {
+			if node.param == nil {
+				node.param = &routeNode{}
+			}
+			node = node.param
+			continue
+		}
+		if node.static == nil {
+			node.static = make(map[string]*routeNode)
+		}
+		child, ok := node.static[segment]
+		if !ok {
+			child = &routeNode{}
+			node.static[segment] = child
+		}
+		node = child
+	}
+	if node.leaf == nil {
+		node.leaf = leaf
+	}
+}
+
+// isParam returns true if segment is a variable, such as {id}.
+func isParam(segment string) bool {
+	return len(segment) > 2 && segment[0] == '{' && segment[len(segment)-1] == '}'
+}
+
+// parseTemplate returns the variables of template, and false if template
+// can't be indexed.
+func parseTemplate(template string) ([]routeParam, bool) {
+	if !strings.HasPrefix(template, "/") {
+		return nil, false
+	}
+	var params []routeParam
+	for i, segment := range strings.Split(template[1:], "/") {
+		if !strings.ContainsAny(segment, "{}") {
+			continue
+		}
+		if !isParam(segment) {
+			return nil, false
+		}
+		name := segment[1 : len(segment)-1]
+		if strings.ContainsAny(name, "{}:") {
+			return nil, false
+		}
+		for _, p := range params {
+			if p.name == name {
+				return nil, false
+			}
+		}
+		params = append(params, routeParam{i, name})
+	}
+	return params, true
+}
+
+// lookup returns the route of the tree matching path, or nil, and false if
+// path can't be matched by the tree.
+func (t *routeTree) lookup(path string) (*routeLeaf, bool) {
+	if path == "" || path[0] != '/' {
+		return nil, false
+	}
+	return t.root.lookup(path[1:], false), true
+}
+
+// vars returns the variables of the route of l extracted from path, or nil if
+// it has none.
+func (l *routeLeaf) vars(path string) RouteVars {
+	if len(l.params) == 0 {
+		return nil
+	}
+	vars := make(RouteVars, len(l.params))
+	params := l.params
+	for i, segment := 0, path[1:]; len(params) > 0; i++ {
+		value := segment
+		if j := strings.IndexByte(segment, '/'); j >= 0 {
+			value, segment = segment[:j], segment[j+1:]
+		}
+		if params[0].segment == i {
+			vars[params[0].name] = value
+			params = params[1:]
+		}
+	}
+	return vars
+}
+
+// lookup returns the leaf registered first among the ones matching path, the
+// segments remaining after n. done is true when there are no segments left.
+func (n *routeNode) lookup(path string, done bool) *routeLeaf {
+	if done {
+		return n.leaf
+	}
+
+	segment, rest, last := path, "", true
+	if i := strings.IndexByte(path, '/'); i >= 0 {
+		segment, rest, last = path[:i], path[i+1:], false
+	}
+
+	var best *routeLeaf
+	if child := n.static[segment]; child != nil {
+		best = child.lookup(rest, last)
+	}
+	if n.param != nil && segment != "" {
+		if leaf := n.param.lookup(rest, last); leaf != nil && (best == nil || leaf.index < best.index) {
+			best = leaf
+		}
+	}
+	return best
+}
@@ -0,0 +1,249 @@
+package rst
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	gorillaMux "github.com/gorilla/mux"
+)
+
+func TestParseTemplate(t *testing.T) {
+	var test = func(template string, params []routeParam, indexed bool) {
+		got, ok := parseTemplate(template)
+		if ok != indexed || !reflect.DeepEqual(got, params) {
+			t.Errorf("%s: Got: %v %t Wanted: %v %t", template, got, ok, params, indexed)
+		}
+	}
+
+	test("/", nil, true)
+	test("/people/{id}", []routeParam{{1, "id"}}, true)
+	test("/{tenant}/people/{id}/", []routeParam{{0, "tenant"}, {2, "id"}}, true)
+	test("/people/{id:[0-9]+}", nil, false)
+	test("/files/{path:.*}", nil, false)
+	test("/files/{name}.json", nil, false)
+	test("/{id}/{id}", nil, false)
+	test("people", nil, false)
+}
+
+// Testing whether the tree matches the same routes and variables as the
+// gorilla/mux router, in the order in which they were registered.
+func TestRouteTree(t *testing.T) {
+	patterns := []string{
+		"/",
+		"/people",
+		"/people/{id}",
+		"/people/me",
+		"/people/{id}/friends/{friend}",
+		"/people/{id}/",
+		"/orders/{id}/items",
+		"/orders/recent/{item}",
+	}
+	tree := newRouteTree()
+	router := gorillaMux.NewRouter()
+	for _, pattern := range patterns {
+		handler := http.NotFoundHandler()
+		tree.add(&routeEntry{route: router.Handle(pattern, handler), handler: handler})
+	}
+
+	for _, path := range []string{
+		"/", "", "/people", "/people/", "/people/1", "/people/me",
+		"/people/1/", "/people/1/friends/2", "/people/1/friends/",
+		"/orders/recent/items", "/orders/recent/3", "/orders//items",
+		"/unknown", "//",
+	} {
+		r, _ := http.NewRequest(Get, "http://example.com", nil)
+		r.URL.Path = path
+
+		var expected gorillaMux.RouteMatch
+		matched := router.Match(r, &expected)
+		leaf, indexed := tree.lookup(path)
+		if !indexed {
+			if path != "" {
+				t.Errorf("%q: not matched by the tree", path)
+			}
+			continue
+		}
+		if (leaf != nil) != matched {
+			t.Errorf("%q: Got: %v Wanted: %t", path, leaf, matched)
+			continue
+		}
+		if leaf == nil {
+			continue
+		}
+		if leaf.entry.route != expected.Route {
+			t.Errorf("%q: matched another route", path)
+		}
+		if vars := leaf.vars(path); (len(vars) > 0 || len(expected.Vars) > 0) && !reflect.DeepEqual(map[string]string(vars), expected.Vars) {
+			t.Errorf("%q: Got: %v Wanted: %v", path, vars, expected.Vars)
+		}
+	}
+}
+
+func TestRouteTreeUnindexed(t *testing.T) {
+	mux := NewMux()
+	mux.Handle("/people/{id}", http.NotFoundHandler())
+	numeric := http.NotFoundHandler()
+	mux.Handle("/orders/{id:[0-9]+}", numeric)
+	mux.Handle("/orders/{id}", http.NotFoundHandler())
+	mux.Handle("/files/{path:.*}", http.NotFoundHandler())
+	mux.Handle("/files/index", http.NotFoundHandler())
+	mux.Handle("/people/{id}/orders", http.NotFoundHandler())
+
+	var test = func(path, pattern, id string) {
+		r, _ := http.NewRequest(Get, "http://example.com"+path, nil)
+		match := mux.match(r)
+		if match == nil {
+			t.Errorf("%s: not matched", path)
+			return
+		}
+		if got, _ := match.route.GetPathTemplate(); got != pattern || match.vars.Get("id") != id {
+			t.Errorf("%s: Got: %s %v Wanted: %s %s", path, got, match.vars, pattern, id)
+		}
+	}
+
+	test("/people/1", "/people/{id}", "1")
+	// A constrained route registered before /orders/{id} is matched first.
+	test("/orders/1", "/orders/{id:[0-9]+}", "1")
+	test("/orders/abc", "/orders/{id}", "abc")
+	// So is a catch-all route.
+	test("/files/index", "/files/{path:.*}", "")
+	// Routes which aren't indexed don't prevent the tree from matching the
+	// ones registered after them.
+	test("/people/1/orders", "/people/{id}/orders", "1")
+}
+
+func TestRouteTableAllocs(t *testing.T) {
+	mux := NewMux()
+	for i := 0; i < 100; i++ {
+		mux.Handle(fmt.Sprintf("/resources%d", i), http.NotFoundHandler())
+		mux.Handle(fmt.Sprintf("/resources%d/{id}", i), http.NotFoundHandler())
+	}
+	// Only matched for the requests which aren't matched by the tree.
+	mux.Handle("/files/{path:.*}", http.NotFoundHandler())
+	table := mux.routes()
+
+	var test = func(path string, expected float64) {
+		r, _ := http.NewRequest(Get, "http://example.com"+path, nil)
+		allocs := testing.AllocsPerRun(100, func() {
+			if table.match(r) == nil {
+				t.Fatalf("%s: not matched", path)
+			}
+		})
+		if allocs > expected {
+			t.Errorf("%s: Got: %.0f allocations Wanted: %.0f", path, allocs, expected)
+		}
+	}
+
+	// Routes without variables are matched without allocations, and the ones
+	// with variables only allocate the match and its variables.
+	test("/resources99", 0)
+	test("/resources99/1", 1+allocsOfVars(1))
+}
+
+// allocsOfVars returns the allocations of the RouteVars of a route with n
+// variables.
+func allocsOfVars(n int) float64 {
+	var vars RouteVars
+	return testing.AllocsPerRun(100, func() {
+		vars = make(RouteVars, n)
+		vars["id"] = "1"
+	})
+}
+
+func TestUnhandle(t *testing.T) {
+	mux := NewMux()
+	get := func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(&viewResource{"Francis"}, time.Now(), "etag", 0), nil
+	}
+	mux.Get("/people/{id}", get)
+	mux.Delete("/people/{id}", func(vars RouteVars, r *http.Request) error { return nil })
+	mux.Handle("/files/{path...}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	plugin := mux.Group("/plugins/{name}")
+	plugin.Get("/status", get)
+
+	var test = func(method, path string, status int) {
+		r, _ := http.NewRequest(method, "http://example.com"+path, nil)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Errorf("%s %s: Got: %d Wanted: %d", method, path, w.Code, status)
+		}
+	}
+
+	test(Get, "/people/1", http.StatusOK)
+	test(Get, "/files/a/b", http.StatusOK)
+	test(Get, "/plugins/search/status", http.StatusOK)
+
+	mux.Unhandle("/people/{id}")
+	mux.Unhandle("/files/{path...}")
+	plugin.Unhandle("/status")
+	test(Get, "/people/1", http.StatusNotFound)
+	test(Delete, "/people/1", http.StatusNotFound)
+	test(Get, "/files/a/b", http.StatusNotFound)
+	test(Get, "/plugins/search/status", http.StatusNotFound)
+	if routes := mux.Routes(); len(routes) != 0 {
+		t.Errorf("Got: %d routes Wanted: 0", len(routes))
+	}
+
+	// Registering the pattern again doesn't restore the previous methods.
+	mux.Delete("/people/{id}", func(vars RouteVars, r *http.Request) error { return nil })
+	test(Get, "/people/1", http.StatusMethodNotAllowed)
+	test(Delete, "/people/1", http.StatusNoContent)
+}
+
+// Testing whether routes can be registered and removed while requests are
+// served. Run with -race.
+func TestHandleConcurrently(t *testing.T) {
+	mux := NewMux()
+	mux.Get("/people", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(&viewResource{"Francis"}, time.Now(), "etag", 0), nil
+	})
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			pattern := fmt.Sprintf("/plugins/%d", i)
+			mux.Handle(pattern, http.NotFoundHandler())
+			mux.Get("/people", func(vars RouteVars, r *http.Request) (Resource, error) {
+				return NewEnvelope(&viewResource{"Francis"}, time.Now(), "etag", 0), nil
+			})
+			mux.Unhandle(pattern)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			r, _ := http.NewRequest(Get, "http://example.com/people", nil)
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, r)
+			if w.Code != http.StatusOK {
+				t.Errorf("Got: %d Wanted: %d", w.Code, http.StatusOK)
+				return
+			}
+		}
+	}()
+	wg.Wait()
+}
+
+func BenchmarkMuxMatch(b *testing.B) {
+	mux := NewMux()
+	for i := 0; i < 800; i++ {
+		mux.Handle(fmt.Sprintf("/resources%d/{id}/items/{item}", i), http.NotFoundHandler())
+	}
+	r, _ := http.NewRequest(Get, "http://example.com/resources799/1/items/2", nil)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if mux.match(r) == nil {
+			b.Fatal("not matched")
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package rst
+
+import (
+	"net/http"
+	"regexp"
+
+	"github.com/gorilla/context"
+)
+
+// wildcardRe matches a trailing catch-all route variable, such as {path...}.
+var wildcardRe = regexp.MustCompile(`\{(\w+)\.\.\.\}$`)
+
+/*
+pathTemplate returns the gorilla/mux template of pattern.
+
+A trailing {name...} variable captures the rest of the path, slashes
+included, and is translated to {name:.*}.
+*/
+func pathTemplate(pattern string) string {
+	return wildcardRe.ReplaceAllString(pattern, "{$1:.*}")
+}
+
+/*
+Group registers routes sharing a path prefix or a host, so that large services
+can be composed from modules. Each group can have its own middlewares, CORS policy
+and error format, which apply to its routes and to those of its nested groups.
+
+	admin := mux.Group("/admin")
+	admin.Use(requireAdmin)
+	admin.SetErrorFormat(rst.ProblemJSON)
+	admin.HandleEndpoint("/users/{id}", &UserEndpoint{})	// /admin/users/{id}
+
+	v1 := mux.Group("/api/v1")
+	v1.SetCORSPolicy(rst.PermissiveAccessControl)
+	v1.Get("/people", listPeople)				// /api/v1/people
+
+Middlewares of a group run after the ones of the mux, and before the ones of
+its nested groups and routes.
+*/
+type Group struct {
+	mux         *Mux
+	parent      *Group
+	host        string
+	prefix      string
+	middlewares []Middleware
+	ac          *AccessControlResponse
+	errorFormat *ErrorFormat
+}
+
+// Group returns a group of routes whose patterns are prefixed with prefix.
+func (s *Mux) Group(prefix string) *Group {
+	return &Group{mux: s, prefix: prefix}
+}
+
+/*
+Host returns a group of routes only matching requests sent to host, so that
+several domains can be served by the same mux.
+
+	api := mux.Host("api.example.com")
+	api.HandleEndpoint("/people/{id}", &PersonEndpoint{})
+
+	tenants := mux.Host("{tenant}.example.com")
+	tenants.HandleEndpoint("/", &HomeEndpoint{})
+
+Variables of host can be constrained like the ones of patterns, and are added
+to the RouteVars of requests. The port of requests is ignored unless host
+includes one.
+*/
+func (s *Mux) Host(host string) *Group {
+	return &Group{mux: s, host: host}
+}
+
+// Group returns a group nested in g, whose patterns are prefixed with the
+// prefix of g followed by prefix.
+func (g *Group) Group(prefix string) *Group {
+	return &Group{mux: g.mux, parent: g, host: g.host, prefix: g.prefix + prefix}
+}
+
+// Use appends middlewares to the chain wrapping the handlers of the routes of
+// the group.
+func (g *Group) Use(middlewares ...Middleware) {
+	g.middlewares = append(g.middlewares, middlewares...)
+}
+
+// SetCORSPolicy sets the access control parameters of the routes of the group,
+// overriding the ones of the mux. A nil value restores the policy of the
+// parent group or of the mux.
+func (g *Group) SetCORSPolicy(ac *AccessControlResponse) {
+	g.ac = ac
+}
+
+// SetErrorFormat sets the format of the JSON errors written by the routes of
+// the group, overriding the ErrorFormat of the mux.
+func (g *Group) SetErrorFormat(format ErrorFormat) {
+	g.errorFormat = &format
+}
+
+// Handle registers handler for the pattern prefixed with the prefix of g.
+func (g *Group) Handle(pattern string, handler http.Handler, middlewares ...Middleware) {
+	g.mux.handle(g.prefix+pattern, handler, g, middlewares)
+}
+
+// Unhandle removes the routes registered in g for pattern.
+func (g *Group) Unhandle(pattern string) {
+	g.mux.mu.Lock()
+	defer g.mux.mu.Unlock()
+	g.mux.unregister(entryKey(g.prefix+pattern, g))
+}
+
+// HandleEndpoint registers endpoint for the pattern prefixed with the prefix of
+// g.
+func (g *Group) HandleEndpoint(pattern string, endpoint Endpoint, middlewares ...Middleware) {
+	g.Handle(pattern, EndpointHandler(endpoint), middlewares...)
+}
+
+// Get registers handler for GET requests on the pattern prefixed with the
+// prefix of g.
+func (g *Group) Get(pattern string, handler GetFunc) {
+	g.mux.handleMethod(g.prefix+pattern, Get, handler, g)
+}
+
+// Post registers handler for POST requests on the pattern prefixed with the
+// prefix of g.
+func (g *Group) Post(pattern string, handler PostFunc) {
+	g.mux.handleMethod(g.prefix+pattern, Post, handler, g)
+}
+
+// Put registers handler for PUT requests on the pattern prefixed with the
+// prefix of g.
+func (g *Group) Put(pattern string, handler PutFunc) {
+	g.mux.handleMethod(g.prefix+pattern, Put, handler, g)
+}
+
+// Patch registers handler for PATCH requests on the pattern prefixed with the
+// prefix of g.
+func (g *Group) Patch(pattern string, handler PatchFunc) {
+	g.mux.handleMethod(g.prefix+pattern, Patch, handler, g)
+}
+
+// Delete registers handler for DELETE requests on the pattern prefixed with
+// the prefix of g.
+func (g *Group) Delete(pattern string, handler DeleteFunc) {
+	g.mux.handleMethod(g.prefix+pattern, Delete, handler, g)
+}
+
+// chain returns the middlewares of g and of its parents, the outermost first.
+func (g *Group) chain() []Middleware {
+	if g.parent == nil {
+		return g.middlewares
+	}
+	return append(append([]Middleware(nil), g.parent.chain()...), g.middlewares...)
+}
+
+// cors returns the access control parameters of g or of its parents, or nil.
+func (g *Group) cors() *AccessControlResponse {
+	for ; g != nil; g = g.parent {
+		if g.ac != nil {
+			return g.ac
+		}
+	}
+	return nil
+}
+
+const groupKey = "__rst__group"
+
+// errorFormat returns the format of the JSON errors written for r.
+func errorFormat(r *http.Request) ErrorFormat {
+	if v := context.Get(r, groupKey); v != nil {
+		for g := v.(*Group); g != nil; g = g.parent {
+			if g.errorFormat != nil {
+				return *g.errorFormat
+			}
+		}
+	}
+	return getMux(r).ErrorFormat
+}
+
+// RouteInfo describes a route registered in a mux.
+type RouteInfo struct {
+	Host        string       // Host template of the route, or an empty string.
+	Pattern     string       // Path template of the route, such as /people/{id}.
+	Methods     []string     // Methods allowed by the endpoint of the route.
+	Handler     http.Handler // Handler serving the route.
+	Endpoint    Endpoint     // Endpoint of the route, or nil if it was registered with Handle.
+	Description *Description // Description of the endpoint if it implements Describable, or nil.
+	Deprecation *Deprecation // Deprecation of the route if it was deprecated with Deprecate, or nil.
+}
+
+/*
+Routes returns the routes registered in s, in the order in which they were
+registered.
+
+The methods of a route are derived from the interfaces implemented by its
+endpoint. They are empty for routes registered with Handle, whose handlers can
+serve any method. Routes of endpoints implementing Describable carry their
+description.
+
+	for _, route := range mux.Routes() {
+		fmt.Println(route.Pattern, route.Methods)
+	}
+*/
+func (s *Mux) Routes() []RouteInfo {
+	var routes []RouteInfo
+	for _, e := range s.routes().entries {
+		pattern, err := e.route.GetPathTemplate()
+		if err != nil {
+			continue
+		}
+		info := RouteInfo{Pattern: pattern, Handler: e.handler, Deprecation: s.deprecation(e.key)}
+		info.Host, _ = e.route.GetHostTemplate()
+		if handler, valid := e.handler.(*endpointHandler); valid {
+			info.Endpoint = handler.endpoint
+			info.Methods = AllowedMethods(handler.endpoint)
+			info.Description = describe(handler.endpoint)
+		}
+		routes = append(routes, info)
+	}
+	return routes
+}
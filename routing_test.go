@@ -0,0 +1,229 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPathTemplate(t *testing.T) {
+	var test = func(pattern, expected string) {
+		if got := pathTemplate(pattern); got != expected {
+			t.Errorf("%s: Got: %s Wanted: %s", pattern, got, expected)
+		}
+	}
+
+	test("/files/{path...}", "/files/{path:.*}")
+	test("/people/{id}", "/people/{id}")
+	test("/{bucket}/{key...}", "/{bucket}/{key:.*}")
+	test("/{path...}/raw", "/{path...}/raw")
+}
+
+func TestWildcardRoute(t *testing.T) {
+	mux := NewMux()
+	mux.Handle("/files/{path...}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(Vars(r).Get("path")))
+	}))
+	mux.Get("/buckets/{bucket}/{key...}", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return nil, NotFound()
+	})
+
+	var test = func(method, path string, status int, body string) {
+		r, _ := http.NewRequest(method, path, nil)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Errorf("%s: Got: %d Wanted: %d", path, w.Code, status)
+		}
+		if body != "" && w.Body.String() != body {
+			t.Errorf("%s: Got: %s Wanted: %s", path, w.Body.String(), body)
+		}
+	}
+
+	test(Get, "/files/a/b/c.txt", http.StatusOK, "a/b/c.txt")
+	test(Get, "/files/", http.StatusOK, "")
+	test(Get, "/files", http.StatusNotFound, "")
+	test(Get, "/buckets/b1/a/b", http.StatusNotFound, "")
+	test(Options, "/buckets/b1/a/b", http.StatusNoContent, "")
+}
+
+func TestConstrainedRoute(t *testing.T) {
+	mux := NewMux()
+	mux.Handle("/people/{id:[0-9]+}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("id:" + Vars(r).Get("id")))
+	}))
+	mux.Handle("/people/{name}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("name:" + Vars(r).Get("name")))
+	}))
+	mux.Handle("/orders/{id:[0-9]+}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	var test = func(path string, status int, body string) {
+		r, _ := http.NewRequest(Get, path, nil)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Errorf("%s: Got: %d Wanted: %d", path, w.Code, status)
+		}
+		if w.Body.String() != body && status == http.StatusOK {
+			t.Errorf("%s: Got: %s Wanted: %s", path, w.Body.String(), body)
+		}
+	}
+
+	test("/people/42", http.StatusOK, "id:42")
+	test("/people/francis", http.StatusOK, "name:francis")
+	test("/orders/42", http.StatusOK, "")
+	test("/orders/abc", http.StatusNotFound, "")
+}
+
+// header returns a middleware adding value to the X-Chain header.
+func header(value string) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Add("X-Chain", value)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func TestGroup(t *testing.T) {
+	mux := NewMux()
+	mux.Use(header("mux"))
+	api := mux.Group("/api")
+	api.Use(header("api"))
+	v1 := api.Group("/v1")
+	v1.Use(header("v1"))
+	v1.Handle("/people", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(RoutePattern(r)))
+	}), header("route"))
+	v1.Get("/people/{id}", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return nil, NotFound()
+	})
+	mux.Handle("/people", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	var test = func(path string, status int, chain string) {
+		r, _ := http.NewRequest(Get, path, nil)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Errorf("%s: Got: %d Wanted: %d", path, w.Code, status)
+		}
+		if got := strings.Join(w.Header()["X-Chain"], ","); got != chain {
+			t.Errorf("%s: Got: %s Wanted: %s", path, got, chain)
+		}
+	}
+
+	test("/api/v1/people", http.StatusOK, "mux,api,v1,route")
+	test("/api/v1/people/1", http.StatusNotFound, "mux,api,v1")
+	test("/people", http.StatusOK, "mux")
+	test("/v1/people", http.StatusNotFound, "")
+}
+
+func TestGroupSettings(t *testing.T) {
+	mux := NewMux()
+	mux.SetCORSPolicy(DefaultAccessControl)
+	admin := mux.Group("/admin")
+	admin.SetErrorFormat(ProblemJSON)
+	admin.SetCORSPolicy(&AccessControlResponse{Origin: "https://admin.example.com"})
+	notFound := func(vars RouteVars, r *http.Request) (Resource, error) {
+		return nil, NotFound()
+	}
+	admin.Group("/users").Get("/{id}", notFound)
+	mux.Get("/users/{id}", notFound)
+
+	var test = func(path, contentType, origin string) {
+		r, _ := http.NewRequest(Get, path, nil)
+		r.Header.Set("Accept", "application/json")
+		r.Header.Set("Origin", "https://admin.example.com")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, contentType) {
+			t.Errorf("%s: Content-Type: Got: %s Wanted: %s", path, ct, contentType)
+		}
+		if got := w.Header().Get("Access-Control-Allow-Origin"); got != origin {
+			t.Errorf("%s: Access-Control-Allow-Origin: Got: %s Wanted: %s", path, got, origin)
+		}
+	}
+
+	test("/admin/users/1", ProblemJSONType, "https://admin.example.com")
+	test("/users/1", "application/json", "*")
+}
+
+func TestHost(t *testing.T) {
+	mux := NewMux()
+	write := func(name string) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(name + ":" + Vars(r).Get("tenant")))
+		})
+	}
+	mux.Host("api.example.com").Handle("/", write("api"))
+	mux.Host("{tenant:[a-z]+}.example.com").Group("/v1").Handle("/", write("tenant"))
+	mux.Handle("/", write("default"))
+
+	notFound := func(vars RouteVars, r *http.Request) (Resource, error) {
+		return nil, NotFound()
+	}
+	mux.Host("admin.example.com").Get("/people", notFound)
+	mux.Get("/people", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(&viewResource{"Francis"}, time.Now(), "etag", 0), nil
+	})
+
+	var test = func(host, path string, status int, body string) {
+		r, _ := http.NewRequest(Get, "http://"+host+path, nil)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Errorf("%s%s: Got: %d Wanted: %d", host, path, w.Code, status)
+		}
+		if body != "" && w.Body.String() != body {
+			t.Errorf("%s%s: Got: %s Wanted: %s", host, path, w.Body.String(), body)
+		}
+	}
+
+	test("api.example.com", "/", http.StatusOK, "api:")
+	test("api.example.com:8080", "/", http.StatusOK, "api:")
+	test("acme.example.com", "/v1/", http.StatusOK, "tenant:acme")
+	test("acme.example.com", "/", http.StatusOK, "default:")
+	test("www.example.org", "/", http.StatusOK, "default:")
+	test("admin.example.com", "/people", http.StatusNotFound, "")
+	test("www.example.org", "/people", http.StatusOK, "")
+}
+
+func TestRoutes(t *testing.T) {
+	mux := NewMux()
+	endpoint := &upgradingEndpoint{}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleEndpoint("/echo/{name}", endpoint)
+	mux.Handle("/files/{path...}", handler)
+	mux.Delete("/people/{id}", func(vars RouteVars, r *http.Request) error { return nil })
+	mux.Get("/people/{id}", func(vars RouteVars, r *http.Request) (Resource, error) { return nil, nil })
+	mux.Host("{tenant}.example.com").Handle("/", handler)
+
+	routes := mux.Routes()
+	expected := []RouteInfo{
+		{Pattern: "/echo/{name}", Methods: []string{Head, Get}, Endpoint: endpoint},
+		{Pattern: "/files/{path:.*}"},
+		{Pattern: "/people/{id}", Methods: []string{Head, Get, Delete}},
+		{Host: "{tenant}.example.com", Pattern: "/"},
+	}
+	if len(routes) != len(expected) {
+		t.Fatalf("Got: %d routes Wanted: %d", len(routes), len(expected))
+	}
+	for i, route := range routes {
+		e := expected[i]
+		if route.Pattern != e.Pattern || route.Host != e.Host || !reflect.DeepEqual(route.Methods, e.Methods) {
+			t.Errorf("%d: Got: %s%s %v Wanted: %s%s %v", i, route.Host, route.Pattern, route.Methods, e.Host, e.Pattern, e.Methods)
+		}
+		if route.Handler == nil {
+			t.Errorf("%d: no handler", i)
+		}
+	}
+	if routes[0].Endpoint != endpoint {
+		t.Error("the endpoint of the route wasn't returned")
+	}
+	if routes[1].Endpoint != nil {
+		t.Error("Got an endpoint for a route registered with Handle")
+	}
+}
@@ -1,9 +1,5 @@
 // Copyright (c) 2014, Mohamed Attahri
 
-// rst relies on esc (https://github.com/mjibson/esc) to embed static resources
-// with go generate (requires go 1.4 or more recent).
-//go:generate esc -pkg=assets -o=internal/assets/assets.go ./internal/assets
-
 /*
 Package rst implements tools and methods to expose resources in a RESTFul
 web service.
@@ -12,7 +8,8 @@ The idea behind rst is to have endpoints and resources implement interfaces to
 support HTTP features.
 
 Endpoints can implement Getter, Poster, Patcher, Putter or Deleter to
-respectively allow the HEAD/GET, POST, PATCH, PUT, and DELETE HTTP methods.
+respectively allow the HEAD/GET, POST, PATCH, PUT, and DELETE HTTP methods,
+and MethodHandler to allow extension methods such as REPORT or PURGE.
 
 Resources can implement Ranger to support partial GET requests, Marshaler to
 customize the process with which they are encoded, or http.Handler to have a
@@ -155,6 +152,11 @@ in a response with the right status code and headers.
 You can implement the Marshaler interface if you want to add support for another
 format, or for more control over the encoding process of a specific resource.
 
+Views registered with RegisterView are rendered with html/template when a
+browser negotiates text/html for a resource of the registered type.
+
+	rst.RegisterView(&Person{}, template.Must(template.ParseFiles("person.html")))
+
 Compression
 
 rst compresses the payload of responses using the supported algorithm detected
@@ -164,19 +166,44 @@ Payloads under the size defined in the CompressionThreshold const are not compre
 
 Both Gzip and Flate are supported.
 
+Resources whose payload is already compressed, such as images or archives, can
+implement Compressible to opt out.
+
+SetCompression changes the minimum size, the level and the media types of
+compressed payloads:
+
+	mux.SetCompression(&rst.Compression{Level: gzip.BestSpeed, ExcludedTypes: []string{"image/*"}})
+
 Options
 
 OPTIONS requests are implicitly supported by all endpoints.
 
 Cache
 
-The ETag, Last-Modified and Vary headers are automatically set.
+The ETag, Last-Modified and Vary headers are automatically set. Vary lists the
+headers the representation was negotiated with, such as Accept,
+Accept-Encoding, Accept-Language and Origin, including in 304 responses.
 
 rst responds with 304 NOT MODIFIED when an appropriate If-Modified-Since or
 If-None-Match header is found in the request.
 
+Resources without a version of their own can use AutoETag, which hashes their
+representation without encoding them twice:
+
+	return rst.NewEnvelope(person, person.Modified, rst.AutoETag(person, r), 0), nil
+
 The Expires header is also automatically inserted with the duration returned by
-Resource.TTL().
+Resource.TTL(). Setting TTLJitter in the mux randomly shortens expirations, so
+that clients caching a hot resource don't all revalidate it at the same time:
+
+	mux.TTLJitter = 0.1 // expirations are shortened by up to 10% of the TTL
+
+Resources implementing CacheableResource specify the other directives of the
+Cache-Control header, whose max-age is set with their TTL:
+
+	func (p *Person) CacheDirectives() *rst.CacheDirectives {
+		return &rst.CacheDirectives{Private: true, StaleWhileRevalidate: time.Minute}
+	}
 
 Partial Gets
 
@@ -190,8 +217,11 @@ incoming GET request.
 The Accept-Range header will be inserted automatically.
 
 The supported range units and the range extent will be validated for you.
+Open-ended ranges, such as resources=20-, and suffix ranges, such as
+resources=-10, are resolved within the extent of the resource.
 
-Note that the If-Range conditional header is supported as well.
+Note that the If-Range conditional header is supported as well: the full resource
+is returned unless its ETag, or its date, strongly matches the resource.
 
 CORS
 
@@ -204,19 +234,991 @@ PermissiveAccessControl), or define your own.
 
 Support can be disabled by passing nil.
 
-Preflighted requests are also supported. However, you can customize the
-responses returned by preflight OPTIONS requests if you implement the
-Preflighter interface in your endpoint.
+Origins allows a list of origins instead, including wildcard patterns such as
+https://*.example.com. The origin of the request is echoed when it matches,
+except for a * origin, which never allows credentials.
+
+The policy of the mux applies to all its routes. Endpoints implementing the
+Preflighter interface override it, for their preflight OPTIONS requests as
+well as for the actual requests, without requiring a policy on the mux.
+
+Console
+
+rst embeds an optional console that lists the routes and the breakers of a
+mux, and lets developers invoke the routes from a browser.
+
+	mux.HandleEndpoint("/console", rst.NewConsole(mux))
+
+Multi-tenancy
+
+A mux can resolve the tenant of incoming requests from a header, a subdomain or
+a path prefix, or with a custom TenantResolver. Endpoints read it with
+rst.Tenant(r).
+
+	mux.SetTenantResolver(rst.TenantFromSubdomain("api.example.com"))
+	mux.RequireTenant = true
+
+Endpoints can implement TenantPolicy to require a tenant, or to be global
+regardless of RequireTenant.
+
+Jobs
+
+Long-running work can be enqueued in a JobQueue. The status of each job is
+exposed as a resource in the mux, with its progress, its result once it has
+succeeded, and cancellation with the DELETE method.
+
+	queue := rst.NewJobQueue("/jobs")
+	mux.HandleJobQueue(queue)
+
+	mux.Post("/exports", func(vars rst.RouteVars, r *http.Request) (rst.Resource, string, error) {
+		job := queue.Enqueue(newExport(r))
+		return job.Accepted(), "", nil
+	})
+
+History
+
+A Changelog records the successful writes made through an endpoint in a
+pluggable HistoryStore, and exposes the history of each resource as a
+collection supporting range requests.
+
+	mux.HandleChangelog("/people/{id}", &PersonEP{}, &rst.Changelog{
+		Store: rst.NewHistoryStore(),
+	})
+
+Soft deletes
+
+Endpoints can return a Tombstone for resources that have been deleted. Getters
+respond with 410 Gone until the optional sunset of the tombstone, and 404 Not
+Found afterwards, while Deleters respond as if the resource had just been
+deleted.
+
+	return nil, rst.Deleted(resource.DeletedAt, resource.DeletedAt.Add(30*24*time.Hour))
+
+Redirections
+
+Endpoints can return a Redirection as an error to redirect clients to another
+URL, for instance when a resource has moved or was requested with a
+non-canonical identifier. The location is validated before it's written, and
+the response has no body.
+
+	return nil, rst.Redirect(http.StatusMovedPermanently, "/people/"+canonicalID)
+
+Redirections can also be returned in place of a resource, with the helpers
+MovedPermanently, Found, SeeOther, TemporaryRedirect and PermanentRedirect.
+
+	return rst.SeeOther("/orders/" + order.ID), "", nil
+
+Timeouts and cancellation
+
+Endpoints implementing TimeoutPolicy set a deadline on the context of the
+requests they serve. The context is also canceled when the client closes the
+connection, so that endpoints can abort expensive work by passing r.Context()
+to the database. Returning context.DeadlineExceeded, even wrapped, will
+respond with status code 503.
+
+	func (ep *endpoint) Timeout() time.Duration {
+		return 2 * time.Second
+	}
+
+The Timeout of the mux sets the deadline of the endpoints which don't
+implement TimeoutPolicy. Requests exceeding their deadline are responded to
+with status code 503, even if the endpoint ignores the context, and the late
+writes of the endpoint are discarded.
+
+JSON encoding
+
+The encoding of resources in JSON can be configured for all the requests
+served by a mux with a JSONPolicy:
+
+	mux.SetJSONPolicy(&rst.JSONPolicy{
+		TimeFormat:   time.RFC1123,        // time.Time layout
+		Nulls:        rst.NullsExplicit,   // or NullsOmitted
+		NoHTMLEscape: true,
+		FieldName:    rst.SnakeCase,       // or CamelCase
+		Indent:       "  ",
+	})
+
+JSON engines
+
+Resources are encoded in JSON with encoding/json by default. Any API
+compatible codec, such as jsoniter, go-json or sonic, can be set as the
+JSONEngine of a mux:
+
+	mux.SetJSONEngine(jsoniter.ConfigCompatibleWithStandardLibrary)
+
+Benchmarks comparing the engines can be run with:
+
+	go test -tags jsonbench -run NONE -bench JSONEngine
+
+JSON envelopes
+
+Setting EnvelopeJSON to true in a mux wraps all JSON responses in a stable
+envelope, which many client generators require. Endpoints can implement
+EnvelopePolicy to override the setting, and clients can request or decline an
+envelope with an envelope parameter in their Accept header.
+
+	Accept: application/json; envelope=true
+
+	{"data": [...], "meta": {"etag": "...", "lastModified": "...", "ttl": 600, "count": 100, "range": "..."}, "links": {"next": {"href": "..."}}}
+	{"errors": [{"status": 404, "message": "Not Found"}]}
+
+The meta member counts the items of collections, and the links member holds
+the links declared with Linker and those of the Link header, such as the pages
+of paginated collections, for clients which can't read headers.
+
+JSONP
+
+JSONP requests from legacy embedders can be supported by setting JSONP to true
+in the mux, or by implementing JSONPPolicy in the endpoints that need it. The
+JSON representation of resources is then wrapped in a call to the function
+named by the callback parameter of GET requests, which must be a valid
+JavaScript identifier.
+
+	GET /people/1?callback=showPerson
+
+JSONP responses don't have validators, so that conditional requests always
+receive the wrapped representation.
+
+Client
+
+The client subpackage implements a client speaking the conventions of rst:
+revalidation of cached GET responses with If-None-Match, iteration over
+collections with range requests, content negotiation with the decoders
+registered with RegisterDecoder, decoding of errors into rst errors, and
+retries of requests rejected with a Retry-After header.
+
+	c := client.New("https://api.example.com")
+	_, err := c.Get("/people/1", &person)
+
+Testing
+
+The rsttest subpackage serves requests in process, with a mux or with a single
+endpoint and fabricated route variables, and asserts the status code, the
+validators, the negotiated content type and the Content-Range of responses.
+
+	c := rsttest.NewEndpoint(&PersonEP{}, rst.RouteVars{"id": "1"})
+	c.Get("/people/1").AssertStatus(t, http.StatusOK).AssertNotModified(t)
+
+Traffic capture and replay
+
+The capture subpackage records a sample of the traffic served by a mux, with
+redaction rules, in a file or a custom store, and replays it against a new
+build of the service to detect regressions before deploys.
+
+	recorder := capture.NewRecorder(store)
+	recorder.SampleRate = 0.01
+	recorder.RedactHeaders = []string{"Authorization", "Cookie"}
+	http.ListenAndServe(":8080", recorder.Handler(mux))
+
+Traffic mirroring
+
+A capture.Mirror asynchronously copies a sample of the requests served by a
+mux to a secondary handler or upstream, whose responses are discarded, to
+validate a rewritten endpoint against real traffic.
+
+	mirror := capture.NewMirror("http://rewrite.internal:8080")
+	mirror.SampleRate = 0.05
+	http.ListenAndServe(":8080", mirror.Handler(mux))
+
+Canary
+
+HandleCanary splits the traffic of a route between a stable and a canary
+implementation of an endpoint, and compares their error rates and latencies.
+
+	canary := &rst.Canary{Weight: 0.05}
+	mux.HandleCanary("/people/{id}", &PersonEP{}, &NewPersonEP{}, canary)
+
+	stable, next := canary.Stats()
+	log.Println(stable.ErrorRate(), next.ErrorRate())
+
+Requests for which Predicate returns true are always served by the canary. When
+Key is set, such as to the principal of the request, assignments are sticky.
+
+Experiments
+
+Experiments registered with AddExperiment assign a variant to each request, so
+that endpoints and resources can A/B test the shape of their payloads.
+
+	mux.AddExperiment(&rst.Experiment{
+		Name:     "compact-person",
+		Variants: []string{"control", "compact"},
+		Weights:  []float64{0.9, 0.1},
+	})
+
+	func (p *Person) MarshalRST(r *http.Request) (string, []byte, error) {
+		if rst.Variant(r, "compact-person") == "compact" {
+			return rst.MarshalResource(p.Compact(), r)
+		}
+		return rst.MarshalResource(p.Full(), r)
+	}
+
+Assignments are sticky for requests sharing the same Principal, recorded in the
+X-Experiment header of responses, and counted by variant. The ETag of
+representations depends on the variants of the request, and the variants are
+reported to the access logger.
+
+Maintenance
+
+A mux set in maintenance mode rejects requests with status code 503 Service
+Unavailable and a Retry-After header, except those addressed to an allowed
+path such as a health check.
+
+	maintenance := &rst.Maintenance{
+		RetryAfter: 10 * time.Minute,
+		Allow:      []string{"/health", "/admin/"},
+	}
+	mux.SetMaintenance(maintenance)
+	maintenance.Enable()
+
+The mode can be toggled at runtime with Enable and Disable, or by watching a
+file with WatchFile.
+
+Quotas
+
+SetQuotas enforces long-window quotas per API key, such as a number of
+requests per day, or of bytes served per month, accounted in a pluggable
+QuotaStore.
+
+	mux.SetQuotas(&rst.Quotas{
+		Key:   func(r *http.Request) string { return r.Header.Get("X-API-Key") },
+		Store: rst.NewQuotaStore(),
+		Limits: []*rst.Quota{
+			{Name: "requests", Limit: 10000, Window: rst.Daily},
+			{Name: "bytes", Limit: 1 << 30, Window: rst.Monthly, Bytes: true},
+		},
+	})
+
+Usage is reported in the X-Quota-Limit, X-Quota-Remaining and X-Quota-Reset
+headers of responses. Requests exceeding a quota are rejected with status code
+429 Too Many Requests, or 403 Forbidden, and a Retry-After header.
+
+Circuit breakers
+
+A Breaker protects the calls endpoints make to a downstream service. After
+repeated failures, the breaker opens and requests are rejected with status code
+503 Service Unavailable and a Retry-After header instead of piling up timeouts.
+
+	var billing = &rst.Breaker{Name: "billing", Threshold: 5, Cooldown: 30 * time.Second}
+
+	err := billing.Do(func() (err error) {
+		invoice, err = billingClient.Invoice(id)
+		return err
+	})
+
+HandleBreaker sets a breaker declaratively on a route, and Stats returns the
+state of a breaker and the number of transitions it went through. The breakers
+added to a mux with HandleBreaker or AddBreaker are reported by the console and
+the metrics subpackage.
+
+Deduplication
+
+SetDeduplication suppresses identical POST and PATCH requests submitted within
+a window of time, even when clients don't send idempotency keys.
+
+	mux.SetDeduplication(&rst.Deduplication{
+		Window: 10 * time.Second,
+		Replay: true,
+	})
+
+Requests sharing the same method, URI, tenant, principal, Accept and
+Accept-Encoding headers and body are rejected with status code 409 Conflict, or receive a copy of the first
+response when Replay is true.
+
+Correlation
+
+The W3C traceparent, tracestate and baggage headers of incoming requests are
+parsed by the mux, and exposed through the context of the request.
+
+	userID := rst.BaggageFromContext(r.Context()).Get("userId")
+
+Propagate re-attaches them to the outbound requests made by endpoints, so that
+cross-service correlation survives the rst layer.
+
+	req, _ := http.NewRequest("GET", "https://billing/invoices", nil)
+	rst.Propagate(r.Context(), req)
+
+Merge patch
+
+ApplyMergePatch applies an application/merge-patch+json body (RFC 7386) to the
+resource of a Patcher, after validating its Content-Type and the preconditions
+of the request.
+
+	func (ep *PersonEP) Patch(vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+		person := database.Find(vars.Get("id"))
+		if err := rst.ApplyMergePatch(person, r); err != nil {
+			return nil, err
+		}
+		return person, database.Save(person)
+	}
+
+MergePatch applies a merge patch to a raw JSON document.
+
+JSON patch
+
+ApplyJSONPatch applies an application/json-patch+json body (RFC 6902) to the
+JSON representation of the resource of a Patcher, and JSONPatch can be used to
+patch raw JSON documents.
+
+	if err := rst.ApplyJSONPatch(person, r); err != nil {
+		return nil, err
+	}
+
+Responses to OPTIONS requests, and 405 Method Not Allowed errors, of endpoints
+implementing Patcher advertise the supported formats in an Accept-Patch header,
+which can be customized by implementing PatchPolicy.
+
+Context
+
+Endpoints needing the context of requests can implement ContextGetter,
+ContextPoster, ContextPutter, ContextPatcher or ContextDeleter, which are
+preferred to their counterparts.
+
+	func (ep *PersonEP) Get(ctx context.Context, vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+		row := database.QueryRowContext(ctx, "SELECT ...", vars.Get("id"))
+		...
+	}
+
+The context is canceled when the client closes the connection, when the
+deadline of the endpoint is exceeded, or once the response has been written.
+
+Middlewares
+
+Use adds middlewares wrapping the handlers of the routes matched by the mux.
+They run in the order they were added, after the route was matched, so that
+RoutePattern and Vars are available.
+
+	mux.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			next.ServeHTTP(w, r)
+			metrics.Observe(rst.RoutePattern(r), time.Since(start))
+		})
+	})
+
+Middlewares can also be attached to a single route, in which case they run
+after the ones added with Use.
+
+	mux.HandleEndpoint("/people/{id}", &PersonEP{}, authenticate)
+
+Problem details
+
+Setting ErrorFormat to ProblemJSON in the mux encodes JSON errors as RFC 7807
+application/problem+json documents, with type, title, status, detail and
+instance members.
+
+	mux.ErrorFormat = rst.ProblemJSON
+
+Endpoints can also return a *Problem directly, with extension members.
+
+	p := rst.NewProblem(http.StatusForbidden, "You do not have enough credit.", "")
+	p.Type = "https://example.com/probs/out-of-credit"
+	p.Extensions = map[string]interface{}{"balance": 30}
+	return nil, p
+
+Encoders
+
+RegisterEncoder adds an encoder for a media type to the registry consulted by
+MarshalResource. Encoders are negotiated with the Accept header of requests,
+and responses are sent with 406 Not Acceptable when none matches.
+
+	rst.RegisterEncoder("application/vnd.myapp+json", func(resource interface{}, r *http.Request) ([]byte, error) {
+		return json.Marshal(&vendorEnvelope{Data: resource})
+	})
+
+MessagePack and CBOR
+
+Resources are encoded in MessagePack or CBOR when application/msgpack or
+application/cbor are negotiated. Both encodings are derived from the JSON
+representation of the resource, and share its field names and policies.
+
+Protocol buffers
+
+Resources implementing ProtoResource are encoded as protocol buffers when
+application/x-protobuf is negotiated, with the function set by
+SetProtoMarshaler.
+
+	mux.SetProtoMarshaler(func(message interface{}) ([]byte, error) {
+		return proto.Marshal(message.(proto.Message))
+	})
+
+Charsets
+
+Text representations are transcoded to the charset negotiated with the
+Accept-Charset header, and the charset parameter of their Content-Type is set
+accordingly. ISO-8859-1 and US-ASCII are supported, and other charsets can be
+added with RegisterCharset.
+
+	rst.RegisterCharset("windows-1252", charmap.Windows1252.NewEncoder().Bytes)
+
+Streaming
+
+Resources implementing StreamedResource are copied to the response from an
+io.ReadCloser instead of being encoded in memory, with a Content-Length header
+when their length is known, and a chunked encoding otherwise.
+
+	func (e *Export) StreamRST(r *http.Request) (string, io.ReadCloser, int64, error) {
+		f, err := os.Open(e.Path)
+		return "text/csv; charset=utf-8", f, e.Size, err
+	}
+
+Streamed resources implementing TrailedResource announce trailers, such as a
+digest of their body, whose values are sent after the body.
+
+Protocol upgrades
+
+Endpoints implementing Upgrader take over the connection of requests asking to
+switch protocols, such as WebSocket handshakes, while their other requests are
+served as usual.
+
+	func (e *PeopleUpdates) Upgrade(vars rst.RouteVars, w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		// ...
+	}
+
+Route patterns
+
+Patterns can end with a catch-all variable capturing the rest of the path,
+slashes included, which suits file-serving and proxying endpoints.
+
+	mux.HandleEndpoint("/files/{path...}", &FilesEndpoint{})
+
+Variables can be constrained with a regular expression, compiled when the
+route is registered. Requests whose path doesn't match the constraint fall
+through to the next route, or receive a 404 Not Found response. Routes are
+matched in the order in which they are registered, so constrained routes must
+be registered before the ones they take precedence over.
+
+	mux.HandleEndpoint("/people/{id:[0-9]+}", &PersonEndpoint{})
+	mux.HandleEndpoint("/people/{username}", &ProfileEndpoint{})
+
+Routes made of static segments and unconstrained variables are indexed in a
+radix tree, and matched in a time proportional to the length of the path of
+requests, plus the number of other routes registered before the matching one,
+which are matched one after the other. Routes without variables are matched
+without allocations.
+
+Route groups
+
+Routes sharing a path prefix can be registered in a group, with its own
+middlewares, CORS policy and error format. Groups can be nested.
+
+	admin := mux.Group("/admin")
+	admin.Use(requireAdmin)
+	admin.SetErrorFormat(rst.ProblemJSON)
+	admin.HandleEndpoint("/users/{id}", &UserEndpoint{})
+
+Host routing
+
+Mux.Host returns a group of routes only matching requests sent to a host.
+Variables of the host are added to the RouteVars of requests.
+
+	mux.Host("api.example.com").HandleEndpoint("/people/{id}", &PersonEndpoint{})
+	mux.Host("{tenant}.example.com").HandleEndpoint("/", &HomeEndpoint{})
+
+Trailing slashes
+
+Requests whose path only differs from a route by a trailing slash are answered
+with 404 Not Found by default. Setting TrailingSlash to RedirectSlash redirects
+them to the path of the route, and MatchSlash serves them transparently.
+Endpoints implementing TrailingSlashPolicy override the setting of the mux.
+
+	mux.TrailingSlash = rst.RedirectSlash
+
+Route introspection
+
+Mux.Routes returns the routes registered in the mux, with their pattern,
+host, handler and endpoint, and the methods derived from the interfaces
+implemented by the endpoint.
+
+	for _, route := range mux.Routes() {
+		fmt.Println(route.Pattern, route.Methods)
+	}
+
+Dynamic routes
+
+Routes can be registered and removed while the mux is serving requests, so
+that plugins can add and tear down their endpoints at runtime. Requests are
+matched against an immutable route table, replaced on each change.
+
+	mux.HandleEndpoint("/plugins/search", &SearchEndpoint{})
+	// ...
+	mux.Unhandle("/plugins/search")
+
+Mounting handlers
+
+Mux.Mount delegates all the paths under a prefix to an http.Handler, such as
+a pprof mux or an existing router, with the prefix stripped from their path.
+The middlewares, recovery and headers of the mux still apply.
+
+	mux.Mount("/legacy/", legacyRouter)
+
+Graceful shutdown
+
+Mux.ListenAndServe serves requests until Mux.Shutdown is called, which stops
+accepting requests, waits for the in-flight ones until the deadline of its
+context, and runs the hooks registered with OnShutdown.
+
+	mux.OnShutdown(func(ctx context.Context) error {
+		return db.Close()
+	})
+	go mux.ListenAndServe(":8080")
+	// ...
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	mux.Shutdown(ctx)
+
+Recovering from panics
+
+Panics are recovered and answered with a 500 Internal Server Error. A recovery
+handler receives the recovered value, the stack trace and the request, and
+returns the error written in response.
+
+	mux.SetRecoveryHandler(func(p interface{}, stack []byte, r *http.Request) error {
+		sentry.Report(p, stack, rst.RoutePattern(r))
+		return rst.InternalServerError("Unexpected failure", "", false)
+	})
+
+Access logging
+
+The AccessLogger of a mux is called after each response with the method, the
+pattern and variables of the matched route, the status code, the negotiated
+content type, the size of the body and the latency of the response.
+
+	mux.AccessLogger = rst.AccessLogFunc(func(e *rst.AccessEntry) {
+		log.Printf("%s %s %d %s", e.Method, e.Pattern, e.Status, e.Latency)
+	})
+
+Metrics
+
+The metrics subpackage instruments a mux with Prometheus metrics labeled by
+the pattern of the matched route: request counts by status class, latency and
+response size histograms, requests in flight, 304 Not Modified responses and
+failed content negotiations.
+
+	collector := metrics.New("api")
+	collector.Instrument(mux)
+	prometheus.MustRegister(collector)
+
+Rate limiting
+
+SetRateLimit limits the rate of the requests of each client with a token
+bucket. Endpoints implementing RateLimitPolicy have their own limits. Requests
+finding an empty bucket are rejected with 429 Too Many Requests.
+
+	mux.SetRateLimit(&rst.RateLimit{Limit: 100, Period: time.Minute, Burst: 20})
+
+Backpressure
+
+Endpoints can signal backpressure with TooManyRequests and ServiceUnavailable,
+which set the Retry-After header of the response.
+
+	if queue.Full() {
+		return nil, rst.ServiceUnavailable(30 * time.Second)
+	}
+
+Authentication
+
+SetAuthenticator authenticates requests before they are dispatched to the
+handler of their method. The principal returned by the Authenticator is
+available with Principal, and its errors are written with status code 401
+Unauthorized and the WWW-Authenticate challenges of the authenticator.
+
+	mux.SetAuthenticator(rst.BasicAuthenticator("api", checkPassword))
+
+Endpoints implementing Authorizer authorize the requests of all their methods
+in one place, before they are dispatched to the handler of their method.
+
+Bearer tokens
+
+The auth subpackage validates the JSON Web Tokens of the Bearer scheme, signed
+with static keys or with the keys of a JWKS URL. The claims of valid tokens are
+the principal of the requests, and failures are responded to with the
+invalid_token and insufficient_scope errors of RFC 6750.
+
+	mux.SetAuthenticator(&auth.Validator{
+		Keys:     auth.NewJWKS("https://accounts.example.com/.well-known/jwks.json"),
+		Issuer:   "https://accounts.example.com/",
+		Audience: "https://api.example.com",
+	})
+	mux.HandleEndpoint("/people", &PeopleEndpoint{}, auth.RequireScope("api", "people:read"))
+
+CSRF protection
+
+SetCSRF protects the state-changing requests of browsers against cross-site
+request forgery with a double-submit cookie. Safe requests issue the token,
+which unsafe requests must echo in the X-CSRF-Token header or in a form field.
+Endpoints implementing CSRFPolicy use their own settings, or none.
+
+	mux.SetCSRF(&rst.CSRF{Secure: true})
+
+Request body limits
+
+MaxRequestBodyBytes limits the size of request bodies. Requests announcing a
+larger Content-Length are rejected with status code 413 Request Entity Too
+Large before they reach the endpoint, and reading more bytes from the body of
+other requests returns an error responding with the same status code.
+Endpoints implementing BodyLimitPolicy have their own limit.
+
+	mux.MaxRequestBodyBytes = 1 << 20
+
+Setting DecompressRequests decompresses the bodies of requests compressed with
+gzip or deflate before they reach the endpoint, so that endpoints and Bind read
+the original content. MaxRequestBodyBytes applies to the compressed body, and
+MaxDecompressedSize to the decompressed one, so that small bombs can't expand
+into gigabytes. Other encodings are rejected with status code 415 Unsupported
+Media Type and an Accept-Encoding header listing the supported ones.
+
+	mux.DecompressRequests = true
+	mux.MaxDecompressedSize = 10 << 20
+
+Write preconditions
+
+PUT, PATCH and DELETE requests with an If-Match or an If-Unmodified-Since
+header are responded to with status code 412 Precondition Failed when their
+conditions don't match the current version of the resource, which is returned
+by the Validator interface of the endpoint, or by its Get method.
+
+	func (ep *PersonEP) Validators(vars rst.RouteVars, r *http.Request) (string, time.Time, error) {
+		return database.Version("people", vars.Get("id"))
+	}
+
+Setting RequirePrecondition rejects the PUT, PATCH and DELETE requests without
+an If-Match header with status code 428 Precondition Required, unless the
+endpoint implements PreconditionPolicy.
+
+Surrogate keys
+
+Resources implementing SurrogateResource tag their representations with
+surrogate keys, and send the instructions of the Surrogate-Control header to
+the CDN fronting the service:
+
+	func (p *Person) Surrogate() *rst.Surrogate {
+		return &rst.Surrogate{Keys: []string{"people", "person-" + p.ID}, MaxAge: 24 * time.Hour}
+	}
+
+Keys are sent in the Surrogate-Key header, or in the header set in the
+SurrogateKeyHeader of the mux, such as Cache-Tag. SurrogatePurger purges the
+representations tagged with a key:
+
+	purger := &rst.SurrogatePurger{
+		URL:    "https://api.fastly.com/service/" + serviceID + "/purge/{key}",
+		Header: http.Header{"Fastly-Key": {token}},
+	}
+	err := purger.Purge("person-" + id)
+
+Response cache
+
+A ResponseCache serves the GET and HEAD requests of hot endpoints from the
+responses stored for their URL, instead of calling their Get method:
+
+	mux.SetResponseCache(&rst.ResponseCache{Store: rst.NewResponseStore()})
+
+Responses are stored per tenant, URL and values of the headers listed in their
+Vary header, until their Expires date. They're revalidated with the Validator
+interface of the endpoint, discarded when a write to the same URL succeeds,
+and answered with 304 Not Modified when the conditional headers of requests
+match. Authenticated requests and requests assigned the variants of
+experiments bypass the cache. Endpoints implementing ResponseCachePolicy use
+their own cache, or none.
+
+Pagination
+
+SetPagination paginates the resources implementing Ranger with the page and
+per_page parameters of the query string, when requests don't have a Range
+header:
+
+	mux.SetPagination(&rst.Pagination{PageSize: 50})
+
+Paginated responses, including the responses to range requests, have an
+X-Total-Count header and a Link header with the URLs of the first, previous,
+next and last pages. Endpoints implementing PaginationPolicy use their own
+pagination, or none.
+
+Sparse fieldsets
+
+Setting SparseFields in the mux limits representations to the fields listed
+in the fields parameter of requests, with dots separating nested fields:
+
+	GET /people?fields=firstname,lastname,employer.company
+
+JSON and XML representations are filtered after being encoded, and the ETag of
+filtered representations depends on the requested fields. Endpoints
+implementing SparseFieldsPolicy override the setting of the mux.
+
+Pretty-printed JSON
+
+Setting PrettyJSON in the mux indents the JSON representations of requests
+with a pretty parameter, such as /people/1?pretty=1, for humans debugging in a
+browser. Other requests keep receiving compact JSON. Endpoints implementing
+PrettyJSONPolicy override the setting of the mux.
+
+Hypermedia links
+
+Resources implementing Linker declare links to themselves and to related
+resources. They're rendered in the _links section of HAL documents when
+application/hal+json is negotiated, along with the resources of Embedder in
+the _embedded section, and in a Link header for other JSON representations.
+
+	func (p *Person) Links(r *http.Request) []rst.Link {
+		return []rst.Link{{Rel: "self", Href: "/people/" + p.ID}}
+	}
+
+JSON:API
+
+Resources are encoded as JSON:API documents when application/vnd.api+json is
+negotiated. The type and id of resource objects are set with
+JSONAPIIdentifier, their relationships with JSONAPIRelator, and their links
+with Linker. The documents of range and paginated requests have a meta member
+with the total number of units of the resource.
+
+	{"data":{"type":"people","id":"1","attributes":{"name":"Francis Underwood"}},"meta":{"total":120}}
+
+OpenAPI
+
+OpenAPI returns an OpenAPI 3 document describing the endpoints of a mux, with
+their operations, path parameters and range units. Endpoints implementing
+ResourceTyper declare the type of their resources, which is reflected into
+schemas. Endpoints implementing Describable document their methods with
+summaries, descriptions, examples and deprecation, which are included in the
+document and reported by Routes. HandleOpenAPI serves the document with an
+ETag and a Last-Modified header.
+
+	mux.HandleOpenAPI("/openapi.json", rst.OpenAPIInfo{Title: "People", Version: "2.1.0"})
+
+Static files
+
+DirEndpoint serves the files and directories of an fs.FS through the pipeline
+of the mux, instead of http.FileServer, so that errors keep the format of the
+mux. Files are served as FileResource, with an ETag derived from their
+modification time and size, and support byte ranges.
+
+	mux.HandleEndpoint("/static/{path:.*}", &rst.DirEndpoint{FS: os.DirFS("public"), Index: "index.html"})
+
+Downloads
+
+Resources implementing Downloadable, such as CSV or PDF exports, are sent with
+a Content-Disposition header encoded as defined by RFC 6266, with an ASCII
+fallback of their file name.
+
+	func (e *Export) Disposition() *rst.Disposition {
+		return &rst.Disposition{Filename: "people.csv"}
+	}
+
+Multipart forms
+
+ParseMultipartForm parses multipart/form-data bodies within limits, keeping
+files in memory until MaxMemory is reached and in temporary files beyond. Its
+errors can be returned by endpoints as is.
+
+	form, err := rst.ParseMultipartForm(r, &rst.MultipartLimits{MaxFileSize: 10 << 20})
+	if err != nil {
+		return nil, err
+	}
+	defer form.RemoveAll()
+
+Expect: 100-continue
+
+Endpoints implementing ContinueValidator validate the requests sent with an
+"Expect: 100-continue" header before their body is transmitted. The error
+returned by ExpectContinue is written in response to the request, and 100
+Continue is only sent once it returns nil.
+
+Asynchronous operations
+
+Endpoints can return an AsyncOperation to run long work in the background. The
+request is answered with status code 202 Accepted and a Location header
+pointing to the status of the operation, at /operations/{id} by default.
+
+	func (ep *ExportsEP) Post(vars rst.RouteVars, r *http.Request) (rst.Resource, string, error) {
+		return rst.NewAsyncOperation(newExport(r)), "", nil
+	}
+
+Error hooks
+
+OnError registers hooks called with each error returned by the endpoints of
+the mux before it's written, to report errors with their context, map internal
+errors to errors of rst, or hide the details of internal failures.
+
+	mux.OnError(func(err error, r *http.Request) error {
+		if err == sql.ErrNoRows {
+			return rst.NotFound()
+		}
+		return err
+	})
+
+Validation errors
+
+Endpoints report invalid input with a ValidationError, which responds with
+status code 422 Unprocessable Entity and lists the invalid fields, with a code
+and a message, in the body of the error.
+
+	verr := &rst.ValidationError{}
+	if person.Name == "" {
+		verr.Add("name", "required", "The name of the person is required.")
+	}
+	if err := verr.Err(); err != nil {
+		return nil, "", err
+	}
+
+Custom error encoding
+
+SetErrorMarshaler replaces the encoding of the errors written by the mux, to
+keep an established error schema. Errors whose encoding fails, or isn't
+accepted by the request, are written in the default formats.
+
+	mux.SetErrorMarshaler(func(e *rst.Error, r *http.Request) (string, []byte, error) {
+		b, err := json.Marshal(&legacyError{Status: e.Code, Title: e.Reason})
+		return "application/json; charset=utf-8", b, err
+	})
+
+Localized errors
+
+SetErrorCatalog sets an ErrorCatalog translating the messages of the standard
+errors of rst, such as 404 Not Found or 405 Method Not Allowed, in the language
+negotiated with the Accept-Language header of the request. Translated errors
+are sent with a Content-Language header.
+
+	mux.SetErrorCatalog(catalog)
+
+Prefer header
+
+POST, PUT and PATCH requests honor the return preference of the Prefer header
+defined by RFC 7240: return=minimal responds with status code 204 No Content
+and the Location and ETag headers only, and return=representation with the
+resource. The preference applied is echoed in the Preference-Applied header.
+
+Deprecation
+
+Deprecate marks the routes of a pattern as deprecated, so that their responses
+carry the Deprecation, Sunset and Link headers announcing it. Hooks registered
+with OnDeprecatedRequest measure their remaining traffic.
+
+	mux.Deprecate("/v1/people/{id}", sunset, "https://example.com/docs/v2-migration")
+
+Versions
+
+Version returns a group of routes served under the path of a version of the
+API. A version inherits the routes of its base that it doesn't override, and
+the version requested is returned by APIVersion.
+
+	v1 := mux.Version("v1", nil)
+	v1.HandleEndpoint("/people/{id}", &PersonEP{})
+	v1.HandleEndpoint("/companies/{id}", &CompanyEP{})
+
+	v2 := mux.Version("v2", v1)
+	v2.HandleEndpoint("/people/{id}", &PersonV2EP{})	// /v2/companies/{id} is served by CompanyEP
+
+Representation cache
+
+A RepresentationCache keeps the encoded and compressed representations of the
+resources, so that repeated requests for a resource whose ETag hasn't changed
+skip its encoding:
+
+	mux.SetRepresentationCache(&rst.RepresentationCache{MaxSize: 32 << 20, TTL: time.Hour})
+
+Representations are keyed by the ETag of the resource and the negotiation
+headers of the request.
+
+Server push
+
+Endpoints implementing PushPolicy push the resources their resources link to
+with HTTP/2 server push, within a budget per response:
+
+	func (ep *PersonEP) Push() *rst.Push {
+		return &rst.Push{Rels: []string{"employer"}, Budget: 2}
+	}
+
+Clients which don't support server push receive preload links instead.
+
+Early hints
+
+Endpoints can send a 103 Early Hints response with EarlyHints, so that clients
+preload resources while the final response is being prepared:
+
+	rst.EarlyHints(r, "</app.css>; rel=preload; as=style")
+
+Early hints are only sent before the response is committed, and never to
+HTTP/1.0 clients.
+
+Batches
+
+HandleBatch serves batches of requests, dispatched to the mux one after the
+other with the authentication of the batch, so that clients can collapse many
+small requests in a single round trip:
+
+	mux.HandleBatch("/batch")
+
+Batches are JSON arrays of requests, or multipart/mixed bodies of
+application/http requests, and respond with the status code, the headers and
+the body of each request.
+
+HEAD requests
+
+Resources implementing Sizer report the content type and the length of their
+representation, so that HEAD requests are answered with a Content-Length
+without marshaling them. Other resources are encoded, or taken from the
+RepresentationCache of the mux, but aren't compressed for HEAD requests.
+
+	func (c *Archive) Size(r *http.Request) (string, int64) {
+		return "application/json; charset=utf-8", c.encodedLength
+	}
+
+Status codes
+
+The status code of responses is inferred from the method of the request and the
+representation written: 201 Created for POST requests, 204 No Content for empty
+representations, 206 Partial Content for ranges, and 200 OK otherwise.
+Resources implementing StatusResource, or endpoints calling SetStatus, choose
+another one, such as 201 Created for a PUT request creating a resource, or 207
+Multi-Status.
+
+	rst.SetStatus(r, http.StatusCreated)
+
+Conditional GET and HEAD requests are only answered with 304 Not Modified when
+the status code chosen is 200 OK, and responses with another status code aren't
+stored by the ResponseCache.
+
+Query parameters
+
+BindQuery decodes the query string of a request in a struct whose fields are
+annotated with a query tag, with defaults and bounds, and returns a BadRequest
+error listing the invalid parameters.
+
+	type PeopleQuery struct {
+		Tags  []string `query:"tags"`
+		Limit int      `query:"limit" default:"20" min:"1" max:"100"`
+	}
+
+	var q PeopleQuery
+	if err := rst.BindQuery(r, &q); err != nil {
+		return nil, err
+	}
+
+Request bodies
+
+Bind decodes the body of a request with the decoder registered for its
+Content-Type: JSON, XML, MessagePack and URL-encoded forms by default, and
+other types registered with RegisterDecoder. Its errors respond with status
+code 415 Unsupported Media Type, 400 Bad Request or 413 Request Entity Too
+Large, and can be returned by endpoints as is.
+
+	person := &Person{}
+	if err := rst.Bind(r, person); err != nil {
+		return nil, "", err
+	}
 */
 package rst
 
 import (
-	"fmt"
+	"bufio"
 	"io"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/context"
@@ -250,7 +1252,11 @@ func (rv RouteVars) Get(key string) string {
 // support.
 type responseWriter struct {
 	http.ResponseWriter
-	wfl io.Writer
+	wfl   io.Writer
+	level int // Level of compression.
+
+	mu        sync.Mutex // Guards the commit of the response against early hints.
+	committed bool
 }
 
 // Flush sends content down the transport.
@@ -270,22 +1276,56 @@ func (rw *responseWriter) flush() {
 	}
 }
 
+// Hijack implements the http.Hijacker interface.
+func (rw *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return hijack(rw.ResponseWriter)
+}
+
+// WriteHeader commits the response, unless code is informational.
+func (rw *responseWriter) WriteHeader(code int) {
+	rw.mu.Lock()
+	if code >= http.StatusOK {
+		rw.committed = true
+	}
+	rw.mu.Unlock()
+	rw.ResponseWriter.WriteHeader(code)
+}
+
 // Write will compress data in the format specified in the Content-Encoding
 // header of the embedded http.ResponseWriter.
 func (rw *responseWriter) Write(b []byte) (int, error) {
-	n, err := compress(rw.ResponseWriter.Header().Get("Content-Encoding"), rw.ResponseWriter, b)
+	rw.mu.Lock()
+	rw.committed = true
+	rw.mu.Unlock()
+	n, err := compress(rw.ResponseWriter.Header().Get("Content-Encoding"), rw.level, rw.ResponseWriter, b)
 	if err == errUnknownCompressionFormat {
 		return rw.ResponseWriter.Write(b)
 	}
 	return n, err
 }
 
-// newResponseWriter returns an enhanced implementation of http.ResponseWriter.
-func newResponseWriter(w http.ResponseWriter) *responseWriter {
-	return &responseWriter{ResponseWriter: w}
+// newResponseWriter returns an enhanced implementation of http.ResponseWriter,
+// compressing data at the given level.
+func newResponseWriter(w http.ResponseWriter, level int) *responseWriter {
+	return &responseWriter{ResponseWriter: w, level: level}
+}
+
+const (
+	varsKey = "__rst__vars"
+	muxKey  = "__rst__mux"
+)
+
+// getMux returns the mux serving r. It returns a mux with the default settings
+// if r is not served by a mux.
+func getMux(r *http.Request) *Mux {
+	if v := context.Get(r, muxKey); v != nil {
+		return v.(*Mux)
+	}
+	return defaultMux
 }
 
-const varsKey = "__rst__vars"
+// defaultMux holds the default settings of a mux.
+var defaultMux = &Mux{}
 
 func getVars(r *http.Request) (vars RouteVars) {
 	if v := context.Get(r, varsKey); v != nil {
@@ -300,15 +1340,68 @@ func delVars(r *http.Request) {
 	context.Clear(r)
 }
 
+// shareVars stores the values set by the mux for r in copied, a shallow copy
+// of r. delVars must be called on copied once it's no longer used.
+func shareVars(r, copied *http.Request) {
+	for key, value := range context.GetAll(r) {
+		context.Set(copied, key, value)
+	}
+}
+
 // Mux is an HTTP request multiplexer. It matches the URL of each incoming
 // requests against a list of registered REST endpoints.
 type Mux struct {
-	Debug     bool // Set to true to display stack traces and debug info in errors.
-	Logger    *log.Logger
-	header    http.Header
-	ac        *AccessControlResponse
-	m         *gorillaMux.Router
-	endpoints map[string]mapEndpoint
+	Debug               bool          // Set to true to display stack traces and debug info in errors.
+	RequireTenant       bool          // Set to true to reject requests without a tenant, unless the endpoint implements TenantPolicy.
+	RequirePrecondition bool          // Set to true to reject PUT, PATCH and DELETE requests without an If-Match header with status code 428, unless the endpoint implements PreconditionPolicy.
+	EnvelopeJSON        bool          // Set to true to wrap JSON responses in an envelope, unless the endpoint implements EnvelopePolicy.
+	JSONP               bool          // Set to true to support JSONP requests, unless the endpoint implements JSONPPolicy.
+	SparseFields        bool          // Set to true to filter representations with the fields parameter of requests, unless the endpoint implements SparseFieldsPolicy.
+	PrettyJSON          bool          // Set to true to indent JSON representations requested with the pretty parameter, unless the endpoint implements PrettyJSONPolicy.
+	TTLJitter           float64       // Fraction of the TTL of resources randomly removed from their expiration, between 0 and 1.
+	SurrogateKeyHeader  string        // Header listing the surrogate keys of representations, DefaultSurrogateKeyHeader by default.
+	ErrorFormat         ErrorFormat   // Set to ProblemJSON to encode JSON errors as RFC 7807 problem documents.
+	TrailingSlash       SlashPolicy   // Set to RedirectSlash or MatchSlash to serve paths differing from a route by a trailing slash, unless the endpoint implements TrailingSlashPolicy.
+	MaxRequestBodyBytes int64         // Maximum size of request bodies, larger ones are rejected with status code 413, unless the endpoint implements BodyLimitPolicy. 0 means no limit.
+	DecompressRequests  bool          // Set to true to decompress the bodies of requests with a Content-Encoding header before they reach the endpoint.
+	MaxDecompressedSize int64         // Maximum size of decompressed request bodies, DefaultMaxDecompressedSize by default.
+	Timeout             time.Duration // Maximum duration of the requests served by endpoints, unless the endpoint implements TimeoutPolicy. 0 means no deadline.
+	Logger              *log.Logger
+	AccessLogger        AccessLogger
+	header              http.Header
+	ac                  *AccessControlResponse
+	tenantResolver      TenantResolver
+	authenticator       Authenticator
+	jsonPolicy          *JSONPolicy
+	jsonEngine          JSONEngine
+	protoMarshaler      ProtoMarshalFunc
+	errorMarshaler      ErrorMarshalFunc
+	errorCatalog        ErrorCatalog
+	experiments         []*Experiment
+	breakers            []*Breaker
+	maintenance         *Maintenance
+	quotas              *Quotas
+	rateLimit           *RateLimit
+	deduplication       *Deduplication
+	csrf                *CSRF
+	compression         *Compression
+	pagination          *Pagination
+	responseCache       *ResponseCache
+	representationCache *RepresentationCache
+	operations          *JobQueue
+	operationsMu        sync.Mutex
+	recovery            RecoveryFunc
+	middlewares         []Middleware
+	errorHooks          []func(err error, r *http.Request) error
+	deprecations        map[string]*Deprecation
+	deprecationHooks    []func(pattern string, r *http.Request)
+	deprecationsMu      sync.RWMutex
+	versions            []*Version
+	versionsMu          sync.RWMutex
+	mu                  sync.Mutex
+	table               atomic.Value
+	endpoints           map[string]*endpointHandler
+	lifecycle           *lifecycle
 }
 
 // NewMux initializes a new REST multiplexer.
@@ -316,9 +1409,10 @@ func NewMux() *Mux {
 	s := &Mux{
 		Logger:    log.New(os.Stdout, "rst: ", log.LstdFlags),
 		header:    make(http.Header),
-		m:         gorillaMux.NewRouter(),
-		endpoints: make(map[string]mapEndpoint),
+		endpoints: make(map[string]*endpointHandler),
+		lifecycle: newLifecycle(),
 	}
+	s.table.Store(newRouteTable(nil))
 	return s
 }
 
@@ -332,8 +1426,10 @@ func (s *Mux) Header() http.Header {
 SetCORSPolicy sets the access control parameters that will be used to write
 CORS related headers. By default, CORS support is disabled.
 
-Endpoints that implement Preflighter can customize the CORS headers returned
-with the response to an HTTP OPTIONS preflight request.
+The policy applies to all the routes of the mux, unless they belong to a group
+with its own policy. Endpoints that implement Preflighter can customize the
+CORS headers returned with the responses to their preflight and actual
+requests.
 
 The ac parameter can be DefaultAccessControl, PermissiveAccessControl, or a
 custom defined AccessControlResponse struct. A nil value will disable support.
@@ -343,15 +1439,25 @@ func (s *Mux) SetCORSPolicy(ac *AccessControlResponse) {
 }
 
 func (s *Mux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r, cancel := withRequestContext(r)
+	defer cancel()
+	if !s.lifecycle.track(r, cancel) {
+		shuttingDown().ServeHTTP(w, r)
+		return
+	}
+	defer s.lifecycle.done(r)
+	context.Set(r, muxKey, s)
+	defer delVars(r)
+
+	if s.AccessLogger != nil {
+		aw := &accessWriter{ResponseWriter: w}
+		defer s.logAccess(aw, r, time.Now())
+		w = aw
+	}
+
 	defer func() {
-		if err := recover(); err != nil {
-			reason := fmt.Sprintf("%s", err) // Stringer interface
-			if !s.Debug {
-				t := InternalServerError(reason, "", true)
-				s.Logger.Println(t.String())
-				reason = http.StatusText(http.StatusInternalServerError)
-			}
-			InternalServerError(reason, "", s.Debug).ServeHTTP(w, r)
+		if p := recover(); p != nil {
+			s.recovered(p, w, r)
 		}
 	}()
 
@@ -366,78 +1472,251 @@ func (s *Mux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if s.maintenance != nil && s.maintenance.Enabled() && !s.maintenance.allowed(r) {
+		s.maintenance.error().ServeHTTP(w, r)
+		return
+	}
+
+	requested := r.URL.Path
+	var tenant string
+	if s.tenantResolver != nil {
+		var err error
+		if tenant, err = s.tenantResolver(r); err != nil {
+			writeError(err, w, r)
+			return
+		}
+	}
+
+	version := s.versionOf(r.URL.Path)
 	match := s.match(r)
-	if match == nil || match.Handler == nil {
-		NotFound().ServeHTTP(w, r)
+	if match == nil && version != nil {
+		match = s.matchVersion(version, r)
+	}
+	if match == nil {
+		if match = s.matchSlash(w, r, requested); match == nil {
+			return
+		}
+	}
+	if version != nil {
+		context.Set(r, versionKey, version.Name)
+	}
+
+	group := match.group
+	setVars(r, match.vars)
+	setRoute(r, match.route)
+	if group != nil {
+		context.Set(r, groupKey, group)
+	}
+	setTenant(r, tenant)
+	context.Set(r, envelopeKey, s.envelopeResponses(match.handler))
+	context.Set(r, jsonpKey, s.jsonpAllowed(match.handler))
+	context.Set(r, sparseFieldsKey, s.sparseFieldsAllowed(match.handler))
+	context.Set(r, prettyKey, s.prettyJSONAllowed(match.handler))
+	context.Set(r, paginationKey, s.paginationOf(match.handler))
+	if push := pushOf(match.handler); push != nil {
+		context.Set(r, pushKey, push)
+	}
+	s.assignVariants(w, r)
+	s.deprecate(match.key, w, r)
+
+	if tenant == "" && s.tenantRequired(match.handler) {
+		TenantRequired().ServeHTTP(w, r)
 		return
 	}
 
-	setVars(r, RouteVars(match.Vars))
-	defer delVars(r)
+	if a := s.authenticatorOf(match.handler); a != nil && strings.ToUpper(r.Method) != Options {
+		if err := authenticate(a, r); err != nil {
+			writeError(err, w, r)
+			return
+		}
+	}
+
+	if limit, scope := s.rateLimitOf(match.handler, r); limit != nil && limit.Limit > 0 {
+		if err := limit.apply(scope, w, r); err != nil {
+			writeError(err, w, r)
+			return
+		}
+	}
 
-	if s.ac != nil {
-		if handler, valid := match.Handler.(*endpointHandler); valid {
-			newAccessControlHandler(handler.endpoint, s.ac).ServeHTTP(w, r)
-		} else {
-			newAccessControlHandler(nil, s.ac).ServeHTTP(w, r)
+	if limit := s.maxRequestBodyBytes(match.handler); limit > 0 {
+		if err := limitBody(r, limit); err != nil {
+			writeError(err, w, r)
+			return
 		}
 	}
-	match.Handler.ServeHTTP(newResponseWriter(w), r)
+
+	if s.DecompressRequests {
+		if err := decompressBody(r, s.maxDecompressedSize()); err != nil {
+			writeError(err, w, r)
+			return
+		}
+	}
+
+	if err := expectContinue(match.handler, r); err != nil {
+		writeError(err, w, r)
+		return
+	}
+
+	if s.deduplication != nil {
+		dw, done := s.deduplication.apply(w, r)
+		if dw == nil {
+			return
+		}
+		w = dw
+		defer done()
+	}
+
+	if s.quotas != nil {
+		var done func()
+		var err error
+		if w, done, err = s.quotas.apply(w, r); err != nil {
+			writeError(err, w, r)
+			return
+		}
+		defer done()
+	}
+
+	ac := s.ac
+	if group != nil && group.cors() != nil {
+		ac = group.cors()
+	}
+	if handler, valid := match.handler.(*endpointHandler); valid {
+		if _, implemented := handler.endpoint.(Preflighter); implemented || ac != nil {
+			newAccessControlHandler(handler.endpoint, ac).ServeHTTP(w, r)
+		}
+	} else if ac != nil {
+		newAccessControlHandler(nil, ac).ServeHTTP(w, r)
+	}
+	if c := s.csrfOf(match.handler); c != nil {
+		if err := c.apply(w, r); err != nil {
+			writeError(err, w, r)
+			return
+		}
+	}
+	handler := match.handler
+	if c := s.responseCacheOf(handler); c != nil && c.Store != nil {
+		handler = c.handler(handler.(*endpointHandler))
+	}
+	handler = chain(handler, match.middlewares)
+	if group != nil {
+		handler = chain(handler, group.chain())
+	}
+	rw := newResponseWriter(w, compressionOf(r).level())
+	context.Set(r, earlyHintsKey, rw)
+	chain(handler, s.middlewares).ServeHTTP(rw, r)
 }
 
 // HandleEndpoint registers the endpoint for the given pattern.
 // It's a shorthand for:
-// 	s.Handle(pattern, EndpointHandler(endpoint))
-func (s *Mux) HandleEndpoint(pattern string, endpoint Endpoint) {
-	s.Handle(pattern, EndpointHandler(endpoint))
+// 	s.Handle(pattern, EndpointHandler(endpoint), middlewares...)
+func (s *Mux) HandleEndpoint(pattern string, endpoint Endpoint, middlewares ...Middleware) {
+	s.Handle(pattern, EndpointHandler(endpoint), middlewares...)
 }
 
-// Handle registers the handler function for the given pattern.
-func (s *Mux) Handle(pattern string, handler http.Handler) {
-	s.m.Handle(pattern, handler)
+// Handle registers the handler function for the given pattern. The optional
+// middlewares only wrap this route, after the ones added with Use.
+//
+// Patterns can end with a catch-all variable, such as /files/{path...}, which
+// captures the rest of the path, slashes included.
+//
+// Handle can be called while the mux is serving requests.
+func (s *Mux) Handle(pattern string, handler http.Handler, middlewares ...Middleware) {
+	s.handle(pattern, handler, nil, middlewares)
 }
 
-// Handle registers the handler function for the given pattern.
-func (s *Mux) handleMethod(pattern string, method string, handler http.Handler) {
-	if _, ok := s.endpoints[pattern]; !ok {
-		s.endpoints[pattern] = make(mapEndpoint)
-		s.m.Handle(pattern, EndpointHandler(s.endpoints[pattern]))
+// Unhandle removes the routes registered for pattern, including the handlers
+// registered with Get, Post, Put, Patch and Delete. It can be called while the
+// mux is serving requests, which are served by the routes registered when they
+// were received.
+func (s *Mux) Unhandle(pattern string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.unregister(entryKey(pattern, nil))
+}
+
+// entryKey returns the key identifying the routes registered for pattern in
+// group g, which can be nil.
+func entryKey(pattern string, g *Group) string {
+	if g != nil {
+		return g.host + pattern
 	}
-	s.endpoints[pattern][method] = handler
+	return pattern
+}
+
+// newEntry returns the route of handler for pattern, in group g if it's not
+// nil.
+func newEntry(pattern string, handler http.Handler, g *Group, middlewares []Middleware) *routeEntry {
+	route := gorillaMux.NewRouter().Handle(pathTemplate(pattern), handler)
+	if g != nil && g.host != "" {
+		route.Host(g.host)
+	}
+	return &routeEntry{
+		key:         entryKey(pattern, g),
+		route:       route,
+		handler:     handler,
+		group:       g,
+		middlewares: middlewares,
+	}
+}
+
+// handle registers handler for the given pattern, in group g if it's not nil.
+func (s *Mux) handle(pattern string, handler http.Handler, g *Group, middlewares []Middleware) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.register(newEntry(pattern, handler, g, middlewares), nil)
+}
+
+// handleMethod registers the handler of method for the given pattern, in group
+// g if it's not nil. The handlers of the pattern are copied, so that requests
+// being served are not affected.
+func (s *Mux) handleMethod(pattern string, method string, handler http.Handler, g *Group) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := entryKey(pattern, g)
+	endpoint := make(mapEndpoint)
+	var previous http.Handler
+	if h, ok := s.endpoints[key]; ok {
+		for m, handler := range h.endpoint.(mapEndpoint) {
+			endpoint[m] = handler
+		}
+		previous = h
+	}
+	endpoint[method] = handler
+
+	h := &endpointHandler{endpoint}
+	s.endpoints[key] = h
+	s.register(newEntry(pattern, h, g, nil), previous)
 }
 
 // Get registers handler for GET requests on the given pattern.
 func (s *Mux) Get(pattern string, handler GetFunc) {
-	s.handleMethod(pattern, Get, handler)
+	s.handleMethod(pattern, Get, handler, nil)
 }
 
 // Post registers handler for POST requests on the given pattern.
 func (s *Mux) Post(pattern string, handler PostFunc) {
-	s.handleMethod(pattern, Post, handler)
+	s.handleMethod(pattern, Post, handler, nil)
 }
 
 // Put registers handler for PUT requests on the given pattern.
 func (s *Mux) Put(pattern string, handler PutFunc) {
-	s.handleMethod(pattern, Put, handler)
+	s.handleMethod(pattern, Put, handler, nil)
 }
 
 // Patch registers handler for PATCH requests on the given pattern.
 func (s *Mux) Patch(pattern string, handler PatchFunc) {
-	s.handleMethod(pattern, Put, handler)
+	s.handleMethod(pattern, Patch, handler, nil)
 }
 
 // Delete registers handler for DELETE requests on the given pattern.
 func (s *Mux) Delete(pattern string, handler DeleteFunc) {
-	s.handleMethod(pattern, Delete, handler)
+	s.handleMethod(pattern, Delete, handler, nil)
 }
 
-// match returns the route
-func (s *Mux) match(r *http.Request) *gorillaMux.RouteMatch {
-	var match gorillaMux.RouteMatch
-	if !s.m.Match(r, &match) {
-		return nil
-	}
-	return &match
+// match returns the route matching r, or nil.
+func (s *Mux) match(r *http.Request) *routeMatch {
+	return s.routes().match(r)
 }
 
 // mapEndpoint defines HTTP handlers for a given set of
@@ -447,11 +1726,10 @@ type mapEndpoint map[string]http.Handler
 // this endpoint.
 func (e mapEndpoint) allowedMethods() []string {
 	var methods []string
-	for method := range e {
-		methods = append(methods, method)
-	}
-	if _, ok := e[Get]; ok {
-		methods = append(methods, Head)
+	for _, method := range supportedMethods {
+		if _, ok := e[e.method(method)]; ok {
+			methods = append(methods, method)
+		}
 	}
 	return methods
 }
@@ -459,8 +1737,8 @@ func (e mapEndpoint) allowedMethods() []string {
 // validateMethod returns an error if the method of r is not allowed by this
 // endpoint.
 func (e mapEndpoint) validateMethod(r *http.Request) error {
-	if _, ok := e[r.Method]; !ok {
-		return MethodNotAllowed(r.Method, e.allowedMethods())
+	if _, ok := e[e.method(r.Method)]; !ok {
+		return methodNotAllowed(e, r)
 	}
 	return nil
 }
@@ -470,10 +1748,19 @@ func (e mapEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
 	if err := e.validateMethod(r); err != nil {
 		return nil, err
 	}
-	fn := e[r.Method].(GetFunc)
+	fn := e[e.method(r.Method)].(GetFunc)
 	return fn(vars, r)
 }
 
+// method returns the method whose handler serves requests with the given
+// method: HEAD requests are served by the handler of GET requests.
+func (e mapEndpoint) method(method string) string {
+	if method == Head {
+		return Get
+	}
+	return method
+}
+
 // Post implements the Poster interface.
 func (e mapEndpoint) Post(vars RouteVars, r *http.Request) (Resource, string, error) {
 	if err := e.validateMethod(r); err != nil {
@@ -558,7 +1845,18 @@ func (e *Envelope) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if contentType, b, err = decorateJSON(e, contentType, b, w.Header(), r); err != nil {
+		writeError(err, w, r)
+		return
+	}
+	if isJSON(contentType) {
+		setLinkHeader(e, w.Header(), r)
+	}
+	contentType, b = negotiateCharset(contentType, b, w.Header(), r)
+
 	w.Header().Set("Content-Type", contentType)
+	setLanguageHeaders(e.projection, w.Header(), r)
+	pushRelated(e, w, r)
 	if e.header != nil {
 		for key, values := range e.header {
 			for _, value := range values {
@@ -567,28 +1865,16 @@ func (e *Envelope) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	if compression := getCompressionFormat(b, r); compression != "" {
-		w.Header().Set("Content-Encoding", compression)
-	}
-
-	if strings.ToUpper(r.Method) == Post {
-		w.WriteHeader(http.StatusCreated)
-		w.Write(b)
-		return
-	}
-
-	if len(b) == 0 {
-		w.WriteHeader(http.StatusNoContent)
-		return
-	}
-
-	if w.Header().Get("Content-Range") != "" {
-		w.WriteHeader(http.StatusPartialContent)
-	} else {
-		w.WriteHeader(http.StatusOK)
+	if compressible(e.projection, w.Header(), len(b), r) {
+		addVary(w.Header(), "Accept-Encoding")
+		if compression := getCompressionFormat(b, r); compression != "" {
+			w.Header().Set("Content-Encoding", compression)
+		}
 	}
 
-	if strings.ToUpper(r.Method) == Head {
+	code := responseStatus(statusOf(e.projection, r), len(b) == 0, w, r)
+	w.WriteHeader(code)
+	if strings.ToUpper(r.Method) == Head || bodiless(code) {
 		return
 	}
 	w.Write(b)
@@ -0,0 +1,267 @@
+/*
+Package rsttest implements utilities to test the endpoints of rst services in
+process, without opening sockets.
+
+A Client serves its requests with a mux, or with a single endpoint and route
+variables fabricated by the test, and records the responses:
+
+	func TestPerson(t *testing.T) {
+		c := rsttest.NewEndpoint(&PersonEP{}, rst.RouteVars{"id": "1"})
+
+		resp := c.Get("/people/1")
+		resp.AssertStatus(t, http.StatusOK).AssertContentType(t, "application/json")
+		resp.AssertNotModified(t)
+
+		person := &Person{}
+		if err := resp.Decode(person); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+Assertions report their failures with t.Errorf, and return the response so
+that they can be chained.
+*/
+package rsttest
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mohamedattahri/rst"
+)
+
+// Client sends requests to a handler in process.
+type Client struct {
+	// Handler serves the requests, such as a mux.
+	Handler http.Handler
+
+	// Header contains the headers added to all requests, such as
+	// Authorization. Accept is application/json unless set.
+	Header http.Header
+}
+
+// New returns a client sending its requests to handler, such as a mux.
+func New(handler http.Handler) *Client {
+	return &Client{Handler: handler, Header: make(http.Header)}
+}
+
+// NewEndpoint returns a client serving all its requests with endpoint, through
+// a new mux. The endpoint receives vars whatever the path of the requests.
+func NewEndpoint(endpoint rst.Endpoint, vars rst.RouteVars) *Client {
+	mux := rst.NewMux()
+	handler := rst.EndpointHandler(endpoint)
+	mux.Handle("/{path:.*}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rst.SetVars(r, vars)
+		handler.ServeHTTP(w, r)
+	}))
+	return New(mux)
+}
+
+// NewRequest returns a request with the headers of c. body can be nil, an
+// io.Reader, a string, a slice of bytes, or a value encoded in JSON.
+func (c *Client) NewRequest(method, path string, body interface{}) (*http.Request, error) {
+	var reader io.Reader
+	contentType := ""
+	switch b := body.(type) {
+	case nil:
+	case io.Reader:
+		reader = b
+	case string:
+		reader, contentType = strings.NewReader(b), "text/plain; charset=utf-8"
+	case []byte:
+		reader, contentType = bytes.NewReader(b), "application/octet-stream"
+	default:
+		encoded, err := json.Marshal(b)
+		if err != nil {
+			return nil, err
+		}
+		reader, contentType = bytes.NewReader(encoded), "application/json"
+	}
+
+	r := httptest.NewRequest(method, path, reader)
+	r.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		r.Header.Set("Content-Type", contentType)
+	}
+	for key, values := range c.Header {
+		r.Header[key] = append([]string(nil), values...)
+	}
+	return r, nil
+}
+
+// Do serves r and returns the response.
+func (c *Client) Do(r *http.Request) *Response {
+	w := httptest.NewRecorder()
+	c.Handler.ServeHTTP(w, r)
+	return &Response{
+		Code:    w.Code,
+		Header:  w.Result().Header,
+		Body:    w.Body.Bytes(),
+		Request: r,
+		client:  c,
+	}
+}
+
+// send serves a request, and panics if it can't be created.
+func (c *Client) send(method, path string, body interface{}) *Response {
+	r, err := c.NewRequest(method, path, body)
+	if err != nil {
+		panic(err)
+	}
+	return c.Do(r)
+}
+
+// Get serves a GET request of path.
+func (c *Client) Get(path string) *Response {
+	return c.send(rst.Get, path, nil)
+}
+
+// Head serves a HEAD request of path.
+func (c *Client) Head(path string) *Response {
+	return c.send(rst.Head, path, nil)
+}
+
+// Post serves a POST request of path with body, as described in NewRequest.
+func (c *Client) Post(path string, body interface{}) *Response {
+	return c.send(rst.Post, path, body)
+}
+
+// Put serves a PUT request of path with body, as described in NewRequest.
+func (c *Client) Put(path string, body interface{}) *Response {
+	return c.send(rst.Put, path, body)
+}
+
+// Patch serves a PATCH request of path with body, as described in NewRequest.
+func (c *Client) Patch(path string, body interface{}) *Response {
+	return c.send(rst.Patch, path, body)
+}
+
+// Delete serves a DELETE request of path.
+func (c *Client) Delete(path string) *Response {
+	return c.send(rst.Delete, path, nil)
+}
+
+// Response is a response recorded by a Client.
+type Response struct {
+	Code    int
+	Header  http.Header
+	Body    []byte
+	Request *http.Request // Request served.
+
+	client *Client
+}
+
+// Decode decodes the body of resp in v, according to its content type.
+func (resp *Response) Decode(v interface{}) error {
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		return json.Unmarshal(resp.Body, v)
+	case mediaType == "application/xml" || mediaType == "text/xml" || strings.HasSuffix(mediaType, "+xml"):
+		return xml.Unmarshal(resp.Body, v)
+	}
+	return fmt.Errorf("rsttest: can't decode content type %q", mediaType)
+}
+
+// ContentRange returns the parsed Content-Range header of resp, or nil.
+func (resp *Response) ContentRange() *rst.ContentRange {
+	cr, err := rst.ParseContentRange(resp.Header.Get("Content-Range"))
+	if err != nil {
+		return nil
+	}
+	return cr
+}
+
+// AssertStatus checks that the status code of resp is code.
+func (resp *Response) AssertStatus(t testing.TB, code int) *Response {
+	t.Helper()
+	if resp.Code != code {
+		t.Errorf("%s %s: status code is %d (%s), wanted %d (%s)", resp.Request.Method, resp.Request.URL, resp.Code, http.StatusText(resp.Code), code, http.StatusText(code))
+	}
+	return resp
+}
+
+// AssertHeader checks that the header key of resp is value.
+func (resp *Response) AssertHeader(t testing.TB, key, value string) *Response {
+	t.Helper()
+	if got := resp.Header.Get(key); got != value {
+		t.Errorf("%s %s: header %s is %q, wanted %q", resp.Request.Method, resp.Request.URL, key, got, value)
+	}
+	return resp
+}
+
+// AssertContentType checks that the media type negotiated for resp is
+// mediaType, regardless of its parameters.
+func (resp *Response) AssertContentType(t testing.TB, mediaType string) *Response {
+	t.Helper()
+	got, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if !strings.EqualFold(got, mediaType) {
+		t.Errorf("%s %s: content type is %q, wanted %q", resp.Request.Method, resp.Request.URL, got, mediaType)
+	}
+	return resp
+}
+
+// AssertETag checks that the ETag of resp is etag, with or without quotes.
+func (resp *Response) AssertETag(t testing.TB, etag string) *Response {
+	t.Helper()
+	got := resp.Header.Get("ETag")
+	if strings.Trim(got, `"`) != strings.Trim(etag, `"`) {
+		t.Errorf("%s %s: ETag is %q, wanted %q", resp.Request.Method, resp.Request.URL, got, etag)
+	}
+	return resp
+}
+
+// AssertLastModified checks that the Last-Modified header of resp is modified,
+// to the second.
+func (resp *Response) AssertLastModified(t testing.TB, modified time.Time) *Response {
+	t.Helper()
+	got, err := http.ParseTime(resp.Header.Get("Last-Modified"))
+	if err != nil || !got.Equal(modified.Truncate(time.Second)) {
+		t.Errorf("%s %s: Last-Modified is %q, wanted %q", resp.Request.Method, resp.Request.URL, resp.Header.Get("Last-Modified"), modified.UTC().Format(http.TimeFormat))
+	}
+	return resp
+}
+
+// AssertNotModified checks that the request of resp is answered with status
+// code 304 Not Modified when it's sent again with the validators of resp.
+func (resp *Response) AssertNotModified(t testing.TB) *Response {
+	t.Helper()
+	etag, modified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+	if etag == "" && modified == "" {
+		t.Errorf("%s %s: response has no ETag and no Last-Modified header", resp.Request.Method, resp.Request.URL)
+		return resp
+	}
+
+	r := resp.Request.Clone(resp.Request.Context())
+	r.Body = nil
+	if etag != "" {
+		r.Header.Set("If-None-Match", etag)
+	}
+	if modified != "" {
+		r.Header.Set("If-Modified-Since", modified)
+	}
+	if revalidated := resp.client.Do(r); revalidated.Code != http.StatusNotModified {
+		t.Errorf("%s %s: revalidation responded with status code %d, wanted 304", r.Method, r.URL, revalidated.Code)
+	}
+	return resp
+}
+
+// AssertContentRange checks that the Content-Range header of resp describes
+// the units from to to, out of total.
+func (resp *Response) AssertContentRange(t testing.TB, unit string, from, to, total uint64) *Response {
+	t.Helper()
+	cr := resp.ContentRange()
+	if cr == nil || cr.Range == nil || !strings.EqualFold(cr.Unit, unit) || cr.From != from || cr.To != to || cr.Total != total {
+		t.Errorf("%s %s: Content-Range is %q, wanted \"%s %d-%d/%d\"", resp.Request.Method, resp.Request.URL, resp.Header.Get("Content-Range"), unit, from, to, total)
+	}
+	return resp
+}
@@ -0,0 +1,119 @@
+package rsttest
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mohamedattahri/rst"
+)
+
+var modified = time.Date(2016, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+type person struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (p *person) ETag() string            { return "person-" + p.ID }
+func (p *person) LastModified() time.Time { return modified }
+func (p *person) TTL() time.Duration      { return time.Minute }
+
+type people []*person
+
+func (c people) Count() uint64           { return uint64(len(c)) }
+func (c people) Units() []string         { return []string{"people"} }
+func (c people) LastModified() time.Time { return modified }
+func (c people) ETag() string            { return fmt.Sprintf("people-%d", len(c)) }
+func (c people) TTL() time.Duration      { return 0 }
+func (c people) Range(rg *rst.Range) (*rst.ContentRange, rst.Resource, error) {
+	return &rst.ContentRange{Range: rg, Total: c.Count()}, c[rg.From : rg.To+1], nil
+}
+
+type personEndpoint struct{}
+
+func (e *personEndpoint) Get(vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+	if vars.Get("id") == "" {
+		return nil, rst.NotFound()
+	}
+	return &person{ID: vars.Get("id"), Name: "Francis"}, nil
+}
+
+// recorder records the failures of assertions.
+type recorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func TestEndpoint(t *testing.T) {
+	c := NewEndpoint(&personEndpoint{}, rst.RouteVars{"id": "1"})
+	resp := c.Get("/anything")
+	resp.AssertStatus(t, http.StatusOK).
+		AssertContentType(t, "application/json").
+		AssertETag(t, "person-1").
+		AssertLastModified(t, modified).
+		AssertNotModified(t)
+
+	p := &person{}
+	if err := resp.Decode(p); err != nil {
+		t.Fatal(err)
+	}
+	if p.ID != "1" || p.Name != "Francis" {
+		t.Errorf("Got: %+v", p)
+	}
+
+	NewEndpoint(&personEndpoint{}, nil).Get("/people/1").AssertStatus(t, http.StatusNotFound)
+	c.Delete("/people/1").AssertStatus(t, http.StatusMethodNotAllowed)
+
+	c.Header.Set("Accept", "application/xml")
+	c.Get("/people/1").AssertContentType(t, "application/xml")
+}
+
+func TestMux(t *testing.T) {
+	mux := rst.NewMux()
+	mux.Get("/people", func(vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+		return people{{ID: "1"}, {ID: "2"}, {ID: "3"}}, nil
+	})
+	c := New(mux)
+
+	r, _ := c.NewRequest(rst.Get, "/people", nil)
+	r.Header.Set("Range", "people=1-2")
+	resp := c.Do(r).AssertStatus(t, http.StatusPartialContent).AssertContentRange(t, "people", 1, 2, 3)
+
+	var page people
+	if err := resp.Decode(&page); err != nil {
+		t.Fatal(err)
+	}
+	if len(page) != 2 || page[0].ID != "2" {
+		t.Errorf("Got: %+v", page)
+	}
+}
+
+func TestAssertions(t *testing.T) {
+	c := NewEndpoint(&personEndpoint{}, rst.RouteVars{"id": "1"})
+	resp := c.Get("/people/1")
+
+	rec := &recorder{TB: t}
+	resp.AssertStatus(rec, http.StatusCreated).
+		AssertContentType(rec, "application/xml").
+		AssertETag(rec, "person-2").
+		AssertLastModified(rec, modified.Add(time.Hour)).
+		AssertHeader(rec, "Cache-Control", "no-cache").
+		AssertContentRange(rec, "people", 0, 1, 2)
+	if len(rec.failures) != 6 {
+		t.Errorf("Failures are %q, wanted 6", rec.failures)
+	}
+
+	rec.failures = nil
+	resp.AssertStatus(rec, http.StatusOK).AssertContentType(rec, "application/json").AssertETag(rec, `"person-1"`)
+	if len(rec.failures) != 0 {
+		t.Errorf("Failures are %q", rec.failures)
+	}
+}
@@ -0,0 +1,89 @@
+package rst
+
+import (
+	"net/http"
+	"strings"
+)
+
+// SlashPolicy controls how a mux handles requests whose path only differs from
+// the pattern of a route by a trailing slash, such as /people/ for /people.
+type SlashPolicy int
+
+// Trailing slash policies.
+const (
+	StrictSlash   SlashPolicy = iota // Requests are answered with 404 Not Found.
+	RedirectSlash                    // Requests are redirected to the path of the route, with 301 for GET and HEAD, and 308 otherwise.
+	MatchSlash                       // Requests are served by the route.
+)
+
+// TrailingSlashPolicy is implemented by endpoints to override the
+// TrailingSlash setting of the mux.
+type TrailingSlashPolicy interface {
+	// TrailingSlash returns the policy applied to requests whose path only
+	// differs from the pattern of the endpoint by a trailing slash.
+	TrailingSlash() SlashPolicy
+}
+
+// slashPolicy returns the trailing slash policy of handler.
+func (s *Mux) slashPolicy(handler http.Handler) SlashPolicy {
+	if h, valid := handler.(*endpointHandler); valid {
+		if policy, implemented := policyEndpoint(h.endpoint).(TrailingSlashPolicy); implemented {
+			return policy.TrailingSlash()
+		}
+	}
+	return s.TrailingSlash
+}
+
+// toggleSlash adds a trailing slash to path, or removes it. It returns an
+// empty string for the root path.
+func toggleSlash(path string) string {
+	switch {
+	case path == "/" || path == "":
+		return ""
+	case strings.HasSuffix(path, "/"):
+		return strings.TrimSuffix(path, "/")
+	}
+	return path + "/"
+}
+
+/*
+matchSlash matches r against the routes of s with a trailing slash added to,
+or removed from its path, and applies the trailing slash policy of the route
+it matches. requested is the path of r before the tenant was resolved.
+
+It returns the match when r must be served by the route, or writes the
+response and returns nil otherwise.
+*/
+func (s *Mux) matchSlash(w http.ResponseWriter, r *http.Request, requested string) *routeMatch {
+	var match *routeMatch
+	u := *r.URL
+	if u.Path = toggleSlash(r.URL.Path); u.Path != "" {
+		u.RawPath = ""
+		alt := *r
+		alt.URL = &u
+		match = s.match(&alt)
+	}
+	if match == nil {
+		NotFound().ServeHTTP(w, r)
+		return nil
+	}
+
+	switch s.slashPolicy(match.handler) {
+	case MatchSlash:
+		r.URL = &u
+		return match
+	case RedirectSlash:
+		code := http.StatusPermanentRedirect
+		if m := strings.ToUpper(r.Method); m == Get || m == Head {
+			code = http.StatusMovedPermanently
+		}
+		location := toggleSlash(requested)
+		if u.RawQuery != "" {
+			location += "?" + u.RawQuery
+		}
+		Redirect(code, location).ServeHTTP(w, r)
+		return nil
+	}
+	NotFound().ServeHTTP(w, r)
+	return nil
+}
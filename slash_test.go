@@ -0,0 +1,81 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type slashEndpoint struct {
+	policy SlashPolicy
+}
+
+func (e *slashEndpoint) TrailingSlash() SlashPolicy {
+	return e.policy
+}
+
+func (e *slashEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	return NewEnvelope(&viewResource{r.URL.Path}, time.Now(), "etag", 0), nil
+}
+
+func TestTrailingSlash(t *testing.T) {
+	mux := NewMux()
+	mux.Get("/people", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(&viewResource{r.URL.Path}, time.Now(), "etag", 0), nil
+	})
+	mux.Post("/people", func(vars RouteVars, r *http.Request) (Resource, string, error) {
+		return nil, "", nil
+	})
+	mux.Get("/orders/", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return NewEnvelope(&viewResource{r.URL.Path}, time.Now(), "etag", 0), nil
+	})
+	mux.HandleEndpoint("/strict", &slashEndpoint{StrictSlash})
+	mux.HandleEndpoint("/redirect", &slashEndpoint{RedirectSlash})
+
+	var test = func(method, path string, status int, location string) {
+		r, _ := http.NewRequest(method, path, nil)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != status {
+			t.Errorf("%s %s: Got: %d Wanted: %d", method, path, w.Code, status)
+		}
+		if got := w.Header().Get("Location"); got != location {
+			t.Errorf("%s %s: Location: Got: %s Wanted: %s", method, path, got, location)
+		}
+	}
+
+	// StrictSlash
+	test(Get, "/people", http.StatusOK, "")
+	test(Get, "/people/", http.StatusNotFound, "")
+	test(Get, "/", http.StatusNotFound, "")
+
+	mux.TrailingSlash = RedirectSlash
+	test(Get, "/people/?page=2", http.StatusMovedPermanently, "/people?page=2")
+	test(Post, "/people/", http.StatusPermanentRedirect, "/people")
+	test(Get, "/orders", http.StatusMovedPermanently, "/orders/")
+	test(Get, "/strict/", http.StatusNotFound, "")
+	test(Get, "/unknown/", http.StatusNotFound, "")
+
+	mux.TrailingSlash = MatchSlash
+	test(Get, "/people/", http.StatusOK, "")
+	test(Get, "/redirect/", http.StatusMovedPermanently, "/redirect")
+}
+
+func TestMatchSlashPath(t *testing.T) {
+	mux := NewMux()
+	mux.TrailingSlash = MatchSlash
+	mux.Handle("/people", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.URL.Path))
+	}))
+
+	r, _ := http.NewRequest(Get, "/people/", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if w.Body.String() != "/people" {
+		t.Errorf("Got: %s Wanted: /people", w.Body.String())
+	}
+	if r.URL.Path != "/people/" {
+		t.Errorf("the URL of the request was modified: %s", r.URL.Path)
+	}
+}
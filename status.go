@@ -0,0 +1,96 @@
+package rst
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/context"
+)
+
+const statusKey = "__rst__status"
+
+/*
+StatusResource is implemented by resources choosing the status code of the
+responses they're written in, instead of the one inferred from the method of
+the request and the representation.
+
+	func (p *Person) Status() int {
+		if p.created {
+			return http.StatusCreated
+		}
+		return http.StatusOK
+	}
+
+Status returns 0 to let the status code be inferred.
+*/
+type StatusResource interface {
+	Status() int
+}
+
+/*
+SetStatus sets the status code of the response to r, in place of the one
+inferred from its method and the resource returned by the endpoint. It takes
+precedence over the Status method of the resource.
+
+	func (ep *PeopleEP) Put(vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+		person, created := database.Upsert(vars.Get("id"), r)
+		if created {
+			rst.SetStatus(r, http.StatusCreated)
+		}
+		return person, nil
+	}
+
+Status codes outside of the 2xx to 5xx classes are ignored.
+*/
+func SetStatus(r *http.Request, code int) {
+	context.Set(r, statusKey, code)
+}
+
+// statusOf returns the status code chosen for the response to r by the
+// endpoint or by resource, which can be nil, or 0 if none was chosen.
+func statusOf(resource interface{}, r *http.Request) int {
+	code, set := context.Get(r, statusKey).(int)
+	if !set {
+		if s, implemented := resource.(StatusResource); implemented {
+			code = s.Status()
+		}
+	}
+	if code < 200 || code > 599 {
+		return 0
+	}
+	return code
+}
+
+// writeEmpty writes a response without a body to r, with the status code
+// chosen by the endpoint, or code.
+func writeEmpty(code int, w http.ResponseWriter, r *http.Request) {
+	if chosen := statusOf(nil, r); chosen != 0 {
+		code = chosen
+	}
+	w.WriteHeader(code)
+	w.Write(noContent)
+}
+
+// responseStatus returns code, the status code chosen for the response to r,
+// or the one inferred from its method and the representation written, empty if
+// it has no body.
+func responseStatus(code int, empty bool, w http.ResponseWriter, r *http.Request) int {
+	switch {
+	case code == http.StatusOK && w.Header().Get("Content-Range") != "":
+		return http.StatusPartialContent
+	case code != 0:
+		return code
+	case strings.ToUpper(r.Method) == Post:
+		return http.StatusCreated
+	case empty:
+		return http.StatusNoContent
+	case w.Header().Get("Content-Range") != "":
+		return http.StatusPartialContent
+	}
+	return http.StatusOK
+}
+
+// bodiless returns true if responses with status code code have no body.
+func bodiless(code int) bool {
+	return code == http.StatusNoContent || code == http.StatusNotModified
+}
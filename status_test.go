@@ -0,0 +1,93 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// statusCounter is an encodedCounter choosing the status code of its responses.
+type statusCounter struct {
+	encodedCounter
+	status int
+}
+
+func (c *statusCounter) Status() int { return c.status }
+
+func TestStatus(t *testing.T) {
+	resource := &statusCounter{encodedCounter: encodedCounter{etag: "v1", body: "representation"}}
+	var chosen int
+	mux := NewMux()
+	mux.Get("/resource", func(vars RouteVars, r *http.Request) (Resource, error) {
+		return resource, nil
+	})
+	mux.Put("/resource", func(vars RouteVars, r *http.Request) (Resource, error) {
+		if chosen != 0 {
+			SetStatus(r, chosen)
+		}
+		return resource, nil
+	})
+	mux.Post("/resource", func(vars RouteVars, r *http.Request) (Resource, string, error) {
+		if chosen != 0 {
+			SetStatus(r, chosen)
+		}
+		return nil, "", nil
+	})
+
+	var test = func(method string, header http.Header, code int, body string) {
+		r, _ := http.NewRequest(method, "http://example.com/resource", nil)
+		for key, values := range header {
+			r.Header[key] = values
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != code {
+			t.Errorf("%s %d/%d: status code is %d, wanted %d", method, resource.status, chosen, w.Code, code)
+		}
+		if w.Body.String() != body {
+			t.Errorf("%s %d/%d: body is %q, wanted %q", method, resource.status, chosen, w.Body.String(), body)
+		}
+		if w.Code != http.StatusNotModified && w.Header().Get("ETag") == "" {
+			t.Errorf("%s %d/%d: ETag header is missing", method, resource.status, chosen)
+		}
+	}
+
+	conditional := http.Header{"If-None-Match": {"v1"}}
+	test(Get, nil, http.StatusOK, "representation")
+	test(Put, nil, http.StatusOK, "representation")
+	test(Get, conditional, http.StatusNotModified, "")
+
+	resource.status = http.StatusMultiStatus
+	test(Get, nil, http.StatusMultiStatus, "representation")
+	test(Head, nil, http.StatusMultiStatus, "")
+	// Conditions aren't evaluated against other status codes.
+	test(Get, conditional, http.StatusMultiStatus, "representation")
+
+	resource.status = http.StatusCreated
+	test(Put, nil, http.StatusCreated, "representation")
+	test(Put, http.Header{"Prefer": {"return=minimal"}}, http.StatusCreated, "")
+
+	// Conditions are only evaluated for GET and HEAD requests.
+	resource.status = 0
+	test(Put, conditional, http.StatusOK, "representation")
+
+	chosen = http.StatusNoContent
+	test(Put, nil, http.StatusNoContent, "")
+	chosen = http.StatusAccepted
+	test(Put, nil, http.StatusAccepted, "representation")
+	chosen = 99
+	test(Put, nil, http.StatusOK, "representation")
+
+	// Endpoints returning no resource.
+	var empty = func(code int) {
+		r, _ := http.NewRequest(Post, "http://example.com/resource", nil)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != code {
+			t.Errorf("POST %d: status code is %d, wanted %d", chosen, w.Code, code)
+		}
+	}
+	empty(http.StatusCreated)
+	chosen = http.StatusAccepted
+	empty(http.StatusAccepted)
+}
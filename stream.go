@@ -0,0 +1,124 @@
+package rst
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+/*
+StreamedResource is implemented by resources whose representation is too large
+to be encoded in memory, such as exports.
+
+	func (e *Export) StreamRST(r *http.Request) (string, io.ReadCloser, int64, error) {
+		f, err := os.Open(e.Path)
+		if err != nil {
+			return "", nil, 0, err
+		}
+		info, err := f.Stat()
+		if err != nil {
+			f.Close()
+			return "", nil, 0, err
+		}
+		return "text/csv; charset=utf-8", f, info.Size(), nil
+	}
+
+The body is copied to the response as it's read, and closed afterwards. It's
+sent with a Content-Length header when length is positive or zero, and with a
+chunked encoding otherwise. Copying stops when the context of the request is
+done, such as when the client disconnects or the deadline of Mux.Shutdown
+expires.
+
+Conditional requests are still answered with the ETag and LastModified of the
+resource before StreamRST is called, but streamed representations are not
+compressed.
+*/
+type StreamedResource interface {
+	// StreamRST returns the content type, the body and the length in bytes of
+	// the representation of the resource, or -1 if the length is unknown.
+	StreamRST(*http.Request) (contentType string, body io.ReadCloser, length int64, err error)
+}
+
+/*
+TrailedResource is implemented by streamed resources sending HTTP trailers
+after their body, such as a digest or a count computed while streaming.
+
+	func (e *Export) Trailers() ([]string, func() http.Header) {
+		return []string{"Digest", "X-Row-Count"}, func() http.Header {
+			return http.Header{
+				"Digest":      {"sha-256=" + base64.StdEncoding.EncodeToString(e.hash.Sum(nil))},
+				"X-Row-Count": {strconv.Itoa(e.rows)},
+			}
+		}
+	}
+
+Trailers is called after StreamRST, and the names of the trailers are
+announced in the Trailer header of the response, which is then sent with a
+chunked encoding. values is called once the body has been copied entirely,
+and its headers which weren't announced are ignored. Trailers aren't sent in
+responses to HEAD requests, nor when the copy of the body fails.
+*/
+type TrailedResource interface {
+	StreamedResource
+	Trailers() (names []string, values func() http.Header)
+}
+
+// writeStream writes the representation of resource in w.
+func writeStream(resource StreamedResource, w http.ResponseWriter, r *http.Request) {
+	contentType, body, length, err := resource.StreamRST(r)
+	if err != nil {
+		writeError(err, w, r)
+		return
+	}
+	defer body.Close()
+
+	var (
+		trailers []string
+		values   func() http.Header
+	)
+	if trailed, implemented := resource.(TrailedResource); implemented && strings.ToUpper(r.Method) != Head {
+		trailers, values = trailed.Trailers()
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	if len(trailers) > 0 && values != nil {
+		// Trailers follow the last chunk of the body.
+		w.Header().Set("Trailer", strings.Join(trailers, ", "))
+		length = -1
+	}
+	if length >= 0 {
+		w.Header().Set("Content-Length", strconv.FormatInt(length, 10))
+	}
+
+	code := responseStatus(statusOf(resource, r), false, w, r)
+	w.WriteHeader(code)
+
+	if strings.ToUpper(r.Method) == Head || bodiless(code) {
+		return
+	}
+	if _, err := copyStream(w, &contextReader{r.Context(), body}); err != nil || len(trailers) == 0 || values == nil {
+		return
+	}
+	announced := values()
+	for _, name := range trailers {
+		if value := announced.Values(name); len(value) > 0 {
+			w.Header()[http.CanonicalHeaderKey(name)] = value
+		}
+	}
+}
+
+// contextReader stops reading from r once ctx is done, such as when the client
+// disconnects or the mux is shut down.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.r.Read(p)
+}
@@ -0,0 +1,179 @@
+package rst
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+type streamedResource struct {
+	body   string
+	length int64
+	closed chan struct{} // Optional. Closed with the body.
+}
+
+func (s *streamedResource) ETag() string            { return "streamed" }
+func (s *streamedResource) LastModified() time.Time { return testTimeReference }
+func (s *streamedResource) TTL() time.Duration      { return 0 }
+
+func (s *streamedResource) StreamRST(r *http.Request) (string, io.ReadCloser, int64, error) {
+	return "text/csv", s, s.length, nil
+}
+
+func (s *streamedResource) Read(p []byte) (int, error) {
+	if s.body == "" {
+		return 0, io.EOF
+	}
+	n := copy(p, s.body)
+	s.body = s.body[n:]
+	return n, nil
+}
+
+func (s *streamedResource) Close() error {
+	if s.closed != nil {
+		close(s.closed)
+	}
+	return nil
+}
+
+func TestStreamedResource(t *testing.T) {
+	// Larger than the buffer of http.ResponseWriter.
+	payload := strings.Repeat("a,b\n", 2048)
+
+	var test = func(method string, length int64, body string) {
+		resource := &streamedResource{body: payload, length: length, closed: make(chan struct{})}
+		mux := NewMux()
+		mux.Get("/export", func(vars RouteVars, r *http.Request) (Resource, error) {
+			return resource, nil
+		})
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		r, _ := http.NewRequest(method, server.URL+"/export", nil)
+		resp, err := http.DefaultClient.Do(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("%s %d: Got: %d Wanted: %d", method, length, resp.StatusCode, http.StatusOK)
+		}
+		if ct := resp.Header.Get("Content-Type"); ct != "text/csv" {
+			t.Errorf("%s %d: Content-Type: Got: %s Wanted: text/csv", method, length, ct)
+		}
+		if resp.Header.Get("ETag") != "streamed" {
+			t.Errorf("%s %d: ETag: Got: %s Wanted: streamed", method, length, resp.Header.Get("ETag"))
+		}
+		if string(b) != body {
+			t.Errorf("%s %d: Got %d bytes Wanted: %d", method, length, len(b), len(body))
+		}
+		if chunked := len(resp.TransferEncoding) > 0 && resp.TransferEncoding[0] == "chunked"; method == Get && chunked != (length < 0) {
+			t.Errorf("%s %d: Transfer-Encoding: %v", method, length, resp.TransferEncoding)
+		}
+		// The body is closed by the handler, once the response is written.
+		select {
+		case <-resource.closed:
+		case <-time.After(time.Second):
+			t.Errorf("%s %d: body was not closed", method, length)
+		}
+	}
+
+	test(Get, int64(len(payload)), payload)
+	test(Get, -1, payload)
+	test(Head, int64(len(payload)), "")
+}
+
+func TestStreamedResourceConditional(t *testing.T) {
+	resource := &streamedResource{body: "a,b\n", length: 4, closed: make(chan struct{})}
+	r, _ := http.NewRequest(Get, "/export", nil)
+	r.Header.Set("If-None-Match", "streamed")
+	w := httptest.NewRecorder()
+	writeResource(resource, w, r)
+	if w.Code != http.StatusNotModified {
+		t.Errorf("Got: %d Wanted: %d", w.Code, http.StatusNotModified)
+	}
+	select {
+	case <-resource.closed:
+		t.Error("body was opened for a conditional request")
+	default:
+	}
+	if resource.body != "a,b\n" {
+		t.Error("body was read for a conditional request")
+	}
+	if strings.Contains(w.Body.String(), "a,b") {
+		t.Errorf("Got: %s", w.Body.String())
+	}
+}
+
+// trailedResource counts the bytes of its body in a trailer.
+type trailedResource struct {
+	streamedResource
+	read int
+}
+
+func (s *trailedResource) StreamRST(r *http.Request) (string, io.ReadCloser, int64, error) {
+	return "text/csv", s, s.length, nil
+}
+
+func (s *trailedResource) Read(p []byte) (int, error) {
+	n, err := s.streamedResource.Read(p)
+	s.read += n
+	return n, err
+}
+
+func (s *trailedResource) Trailers() ([]string, func() http.Header) {
+	return []string{"X-Byte-Count"}, func() http.Header {
+		return http.Header{"X-Byte-Count": {strconv.Itoa(s.read)}, "X-Unannounced": {"true"}}
+	}
+}
+
+func TestStreamedResourceTrailers(t *testing.T) {
+	payload := strings.Repeat("a,b\n", 2048)
+	var test = func(method string, timeout time.Duration) {
+		resource := &trailedResource{streamedResource: streamedResource{body: payload, length: int64(len(payload))}}
+		mux := NewMux()
+		mux.Timeout = timeout
+		mux.Get("/export", func(vars RouteVars, r *http.Request) (Resource, error) {
+			return resource, nil
+		})
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		r, _ := http.NewRequest(method, server.URL+"/export", nil)
+		resp, err := http.DefaultClient.Do(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if method == Head {
+			if resp.Header.Get("Trailer") != "" || len(resp.Trailer) != 0 {
+				t.Errorf("HEAD: Trailer: %q, trailers: %v", resp.Header.Get("Trailer"), resp.Trailer)
+			}
+			return
+		}
+		if string(b) != payload || resp.ContentLength != -1 {
+			t.Errorf("Got %d bytes with length %d, wanted %d bytes with a chunked encoding", len(b), resp.ContentLength, len(payload))
+		}
+		if got := resp.Trailer.Get("X-Byte-Count"); got != strconv.Itoa(len(payload)) {
+			t.Errorf("X-Byte-Count: Got: %q Wanted: %d", got, len(payload))
+		}
+		if resp.Trailer.Get("X-Unannounced") != "" {
+			t.Errorf("Unannounced trailer was sent: %v", resp.Trailer)
+		}
+	}
+
+	test(Get, 0)
+	test(Head, 0)
+	// Trailers are set once the response was committed by the writer of the
+	// timeout.
+	test(Get, time.Minute)
+}
@@ -0,0 +1,158 @@
+package rst
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultSurrogateKeyHeader is the header listing the surrogate keys of
+// representations, unless the mux has a SurrogateKeyHeader.
+const DefaultSurrogateKeyHeader = "Surrogate-Key"
+
+/*
+Surrogate contains the instructions of a representation for the CDN fronting
+the service. Keys tag the representation, so that every representation of a
+resource can be purged at once. The other fields are sent in the
+Surrogate-Control header, which the CDN removes before forwarding responses to
+clients.
+
+	func (p *Person) Surrogate() *rst.Surrogate {
+		return &rst.Surrogate{
+			Keys:   []string{"people", "person-" + p.ID},
+			MaxAge: 24 * time.Hour,
+		}
+	}
+
+Keys are sent in the Surrogate-Key header, separated by spaces, as expected by
+Fastly and Varnish. Setting the SurrogateKeyHeader of the mux to "Cache-Tag"
+sends them separated by commas instead, as expected by Cloudflare and Akamai.
+*/
+type Surrogate struct {
+	Keys                 []string
+	MaxAge               time.Duration // Optional. Lifetime of the representation in the CDN, which overrides the Cache-Control header.
+	StaleWhileRevalidate time.Duration // Optional. Duration during which a stale representation can be served while it's revalidated.
+	StaleIfError         time.Duration // Optional. Duration during which a stale representation can be served when the origin responds with an error.
+	NoStore              bool          // Set to true to prevent the CDN from storing the representation.
+}
+
+// SurrogateResource is implemented by resources tagging their
+// representations for a CDN.
+type SurrogateResource interface {
+	Surrogate() *Surrogate
+}
+
+// surrogateOf returns the instructions of resource, or of the projection of an
+// envelope, or nil.
+func surrogateOf(resource interface{}) *Surrogate {
+	if tagged, implemented := resource.(SurrogateResource); implemented {
+		return tagged.Surrogate()
+	}
+	if envelope, valid := resource.(*Envelope); valid {
+		return surrogateOf(envelope.projection)
+	}
+	return nil
+}
+
+// setSurrogateHeaders writes the headers of the instructions of resource in h.
+func setSurrogateHeaders(resource interface{}, h http.Header, r *http.Request) {
+	s := surrogateOf(resource)
+	if s == nil {
+		return
+	}
+
+	if len(s.Keys) > 0 {
+		name := getMux(r).SurrogateKeyHeader
+		if name == "" {
+			name = DefaultSurrogateKeyHeader
+		}
+		separator := " "
+		if http.CanonicalHeaderKey(name) == "Cache-Tag" {
+			separator = ","
+		}
+		h.Set(name, strings.Join(s.Keys, separator))
+	}
+
+	var directives []string
+	var delta = func(d time.Duration, name string) {
+		if d > 0 {
+			directives = append(directives, name+"="+strconv.FormatInt(int64(d/time.Second), 10))
+		}
+	}
+	if s.NoStore {
+		directives = append(directives, "no-store")
+	} else {
+		delta(s.MaxAge, "max-age")
+	}
+	delta(s.StaleWhileRevalidate, "stale-while-revalidate")
+	delta(s.StaleIfError, "stale-if-error")
+	if len(directives) > 0 {
+		h.Set("Surrogate-Control", strings.Join(directives, ", "))
+	}
+}
+
+/*
+SurrogatePurger purges the representations tagged with surrogate keys from a
+CDN, by sending a request for each key. The {key} placeholder is replaced with
+the escaped key in URL, and with the key in the values of Header.
+
+	purger := &rst.SurrogatePurger{
+		URL:    "https://api.fastly.com/service/" + serviceID + "/purge/{key}",
+		Header: http.Header{"Fastly-Key": {token}},
+	}
+	if err := purger.Purge("person-" + id); err != nil {
+		// ...
+	}
+
+Varnish, which purges keys listed in a header, is configured with:
+
+	purger := &rst.SurrogatePurger{
+		Method: "PURGE",
+		URL:    "http://varnish.internal/",
+		Header: http.Header{"Xkey": {"{key}"}},
+	}
+*/
+type SurrogatePurger struct {
+	URL        string
+	Method     string       // Optional. Method of the requests, POST by default.
+	Header     http.Header  // Optional. Headers of the requests, such as the credentials of the API of the CDN.
+	HTTPClient *http.Client // Optional. Client sending the requests, http.DefaultClient by default.
+}
+
+// Purge purges the representations tagged with keys. It stops at the first
+// request failing, or responded to with a status code other than 2xx.
+func (p *SurrogatePurger) Purge(keys ...string) error {
+	method := p.Method
+	if method == "" {
+		method = Post
+	}
+	client := p.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	for _, key := range keys {
+		req, err := http.NewRequest(method, strings.Replace(p.URL, "{key}", url.PathEscape(key), -1), nil)
+		if err != nil {
+			return err
+		}
+		for name, values := range p.Header {
+			for _, value := range values {
+				req.Header.Add(name, strings.Replace(value, "{key}", key, -1))
+			}
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return fmt.Errorf("rst: purge of surrogate key %q failed with status %s", key, resp.Status)
+		}
+	}
+	return nil
+}
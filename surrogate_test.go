@@ -0,0 +1,91 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type taggedResource struct {
+	Name      string `json:"name"`
+	surrogate *Surrogate
+}
+
+func (t *taggedResource) Surrogate() *Surrogate {
+	return t.surrogate
+}
+
+type taggedEndpoint struct {
+	surrogate *Surrogate
+}
+
+func (e *taggedEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	return NewEnvelope(&taggedResource{"Francis", e.surrogate}, time.Now(), "v1", time.Hour), nil
+}
+
+func TestSurrogateResource(t *testing.T) {
+	endpoint := &taggedEndpoint{}
+	mux := NewMux()
+	mux.HandleEndpoint("/people/1", endpoint)
+
+	var test = func(s *Surrogate, header, keys, control string) {
+		endpoint.surrogate = s
+		r, _ := http.NewRequest(Get, "http://example.com/people/1", nil)
+		r.Header.Set("Accept", "application/json")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != http.StatusOK {
+			t.Fatalf("%+v: Got: %d Wanted: %d", s, w.Code, http.StatusOK)
+		}
+		if got := w.Header().Get(header); got != keys {
+			t.Errorf("%+v: %s: Got: %q Wanted: %q", s, header, got, keys)
+		}
+		if got := w.Header().Get("Surrogate-Control"); got != control {
+			t.Errorf("%+v: Surrogate-Control: Got: %q Wanted: %q", s, got, control)
+		}
+	}
+
+	test(nil, "Surrogate-Key", "", "")
+	test(&Surrogate{Keys: []string{"people", "person-1"}}, "Surrogate-Key", "people person-1", "")
+	test(&Surrogate{Keys: []string{"people"}, MaxAge: time.Hour, StaleIfError: time.Minute}, "Surrogate-Key", "people", "max-age=3600, stale-if-error=60")
+	test(&Surrogate{NoStore: true, MaxAge: time.Hour}, "Surrogate-Key", "", "no-store")
+
+	mux.SurrogateKeyHeader = "Cache-Tag"
+	test(&Surrogate{Keys: []string{"people", "person-1"}}, "Cache-Tag", "people,person-1", "")
+}
+
+func TestSurrogatePurger(t *testing.T) {
+	var purged []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Fastly-Key") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		purged = append(purged, r.Method+" "+r.URL.EscapedPath()+" "+r.Header.Get("Xkey"))
+	}))
+	defer server.Close()
+
+	purger := &SurrogatePurger{
+		URL:    server.URL + "/purge/{key}",
+		Header: http.Header{"Fastly-Key": {"secret"}, "Xkey": {"{key}"}},
+	}
+	if err := purger.Purge("people", "person 1"); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"POST /purge/people people", "POST /purge/person%201 person 1"}
+	if len(purged) != len(expected) {
+		t.Fatalf("Got: %v Wanted: %v", purged, expected)
+	}
+	for i := range expected {
+		if purged[i] != expected[i] {
+			t.Errorf("Got: %q Wanted: %q", purged[i], expected[i])
+		}
+	}
+
+	purger.Method = "PURGE"
+	purger.Header = nil
+	if err := purger.Purge("people"); err == nil {
+		t.Error("Purge should fail when the CDN rejects the request")
+	}
+}
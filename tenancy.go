@@ -0,0 +1,116 @@
+package rst
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/context"
+)
+
+/*
+TenantResolver extracts the tenant of a request. It returns an empty string if
+the request is not addressed to a tenant, or an error that will be written in
+the response if the tenant is invalid.
+
+	mux.SetTenantResolver(func(r *http.Request) (string, error) {
+		tenant := r.Header.Get("X-Tenant")
+		if tenant != "" && !database.TenantExists(tenant) {
+			return "", rst.NotFound()
+		}
+		return tenant, nil
+	})
+*/
+type TenantResolver func(r *http.Request) (tenant string, err error)
+
+// TenantFromHeader returns a resolver reading the tenant from the header with
+// the given name.
+func TenantFromHeader(name string) TenantResolver {
+	return func(r *http.Request) (string, error) {
+		return strings.TrimSpace(r.Header.Get(name)), nil
+	}
+}
+
+// TenantFromSubdomain returns a resolver reading the tenant from the subdomain
+// of domain found in the host of requests. A request to
+// acme.api.example.com will resolve to tenant acme with domain
+// api.example.com.
+func TenantFromSubdomain(domain string) TenantResolver {
+	suffix := "." + strings.ToLower(strings.Trim(domain, "."))
+	return func(r *http.Request) (string, error) {
+		host := strings.ToLower(r.Host)
+		if i := strings.LastIndex(host, ":"); i > strings.LastIndex(host, "]") {
+			host = host[:i]
+		}
+		if !strings.HasSuffix(host, suffix) {
+			return "", nil
+		}
+		return host[:len(host)-len(suffix)], nil
+	}
+}
+
+// TenantFromPathPrefix returns a resolver reading the tenant from the first
+// segment of the path of requests. The segment is removed from the URL before
+// the request is routed, so that a request to /acme/people/1 will resolve to
+// tenant acme and be routed to /people/1.
+func TenantFromPathPrefix() TenantResolver {
+	return func(r *http.Request) (string, error) {
+		path := strings.TrimPrefix(r.URL.Path, "/")
+		i := strings.Index(path, "/")
+		if i <= 0 {
+			return "", nil
+		}
+		r.URL.Path = path[i:]
+		return path[:i], nil
+	}
+}
+
+// TenantPolicy is implemented by endpoints to override the RequireTenant
+// setting of the mux.
+type TenantPolicy interface {
+	// TenantRequired returns true if requests to the endpoint must be
+	// addressed to a tenant, or false if the endpoint is global.
+	TenantRequired() bool
+}
+
+// SetTenantResolver sets the resolver used to extract the tenant from incoming
+// requests. The tenant is available to endpoints through the Tenant function.
+// A nil value disables multi-tenancy, which is the default.
+func (s *Mux) SetTenantResolver(resolver TenantResolver) {
+	s.tenantResolver = resolver
+}
+
+// tenantRequired returns true if requests served by handler must have a tenant.
+func (s *Mux) tenantRequired(handler http.Handler) bool {
+	if h, valid := handler.(*endpointHandler); valid {
+		if policy, implemented := policyEndpoint(h.endpoint).(TenantPolicy); implemented {
+			return policy.TenantRequired()
+		}
+	}
+	return s.RequireTenant
+}
+
+// TenantRequired is returned when a request to an endpoint requiring a tenant
+// could not be resolved to one.
+func TenantRequired() *Error {
+	return BadRequest(
+		"Tenant is required",
+		"This resource is only available to requests addressed to a tenant.",
+	)
+}
+
+const tenantKey = "__rst__tenant"
+
+// Tenant returns the tenant resolved for r by the resolver set in the mux, or
+// an empty string.
+func Tenant(r *http.Request) string {
+	if v := context.Get(r, tenantKey); v != nil {
+		return v.(string)
+	}
+	return ""
+}
+
+func setTenant(r *http.Request, tenant string) {
+	if tenant != "" {
+		context.Set(r, tenantKey, tenant)
+	}
+}
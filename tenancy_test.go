@@ -0,0 +1,87 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type tenantEndpoint struct {
+	required bool
+}
+
+func (e *tenantEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	if tenant := Tenant(r); tenant != "" {
+		return &echoResource{[]byte(tenant)}, nil
+	}
+	return &echoResource{[]byte("global")}, nil
+}
+
+func (e *tenantEndpoint) TenantRequired() bool {
+	return e.required
+}
+
+func TestTenantResolvers(t *testing.T) {
+	var test = func(resolver TenantResolver, url string, header http.Header, tenant, path string) {
+		r, _ := http.NewRequest(Get, url, nil)
+		if header != nil {
+			r.Header = header
+		}
+		got, err := resolver(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tenant {
+			t.Errorf("%s: Got tenant: %s Wanted: %s", url, got, tenant)
+		}
+		if r.URL.Path != path {
+			t.Errorf("%s: Got path: %s Wanted: %s", url, r.URL.Path, path)
+		}
+	}
+
+	header := http.Header{"X-Tenant": []string{"acme"}}
+	test(TenantFromHeader("X-Tenant"), "http://example.com/people", header, "acme", "/people")
+	test(TenantFromHeader("X-Tenant"), "http://example.com/people", nil, "", "/people")
+
+	test(TenantFromSubdomain("example.com"), "http://acme.example.com/people", nil, "acme", "/people")
+	test(TenantFromSubdomain("example.com"), "http://acme.example.com:8080/people", nil, "acme", "/people")
+	test(TenantFromSubdomain("example.com"), "http://example.com/people", nil, "", "/people")
+	test(TenantFromSubdomain("example.com"), "http://acme.example.org/people", nil, "", "/people")
+
+	test(TenantFromPathPrefix(), "http://example.com/acme/people/1", nil, "acme", "/people/1")
+	test(TenantFromPathPrefix(), "http://example.com/people", nil, "", "/people")
+}
+
+func TestTenantPolicy(t *testing.T) {
+	mux := NewMux()
+	mux.SetTenantResolver(TenantFromHeader("X-Tenant"))
+	mux.Handle("/scoped", EndpointHandler(&tenantEndpoint{required: true}))
+	mux.Handle("/global", EndpointHandler(&tenantEndpoint{required: false}))
+	mux.Handle("/default", EndpointHandler(&echoEndpoint{}))
+
+	var test = func(method, path, tenant string, expected int) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(method, "http://example.com"+path, strings.NewReader(testCannedContent))
+		if tenant != "" {
+			r.Header.Set("X-Tenant", tenant)
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != expected {
+			t.Errorf("%s %s (%s): Got: %d Wanted: %d", method, path, tenant, w.Code, expected)
+		}
+		return w
+	}
+
+	if w := test(Get, "/scoped", "acme", http.StatusOK); w.Body.String() != "acme" {
+		t.Error("Got:", w.Body.String(), "Wanted: acme")
+	}
+	test(Get, "/scoped", "", http.StatusBadRequest)
+	test(Get, "/global", "", http.StatusOK)
+	test(Post, "/default", "", http.StatusCreated)
+
+	mux.RequireTenant = true
+	test(Post, "/default", "", http.StatusBadRequest)
+	test(Post, "/default", "acme", http.StatusCreated)
+	test(Get, "/global", "", http.StatusOK)
+}
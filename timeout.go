@@ -0,0 +1,207 @@
+package rst
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+/*
+TimeoutPolicy is implemented by endpoints to set a deadline on the requests
+they serve.
+
+The context of the request passed to the endpoint is canceled when the
+deadline is exceeded, or when the client closes the connection, so that
+expensive work can be aborted when its result will never be read.
+
+	func (ep *endpoint) Timeout() time.Duration {
+		return 2 * time.Second
+	}
+
+	func (ep *endpoint) Get(vars rst.RouteVars, r *http.Request) (rst.Resource, error) {
+		rows, err := database.QueryContext(r.Context(), "SELECT ...")
+		if err != nil {
+			return nil, err
+		}
+		...
+	}
+
+Endpoints can return the error of the context, even wrapped:
+context.DeadlineExceeded will respond with status code 503 Service
+Unavailable, and context.Canceled with status code 499 and no body since the
+client is gone.
+*/
+type TimeoutPolicy interface {
+	// Timeout returns the maximum duration of a request. A zero value means
+	// no deadline.
+	Timeout() time.Duration
+}
+
+// timeoutOf returns the maximum duration of the requests served by endpoint,
+// or 0 if there's none.
+func timeoutOf(endpoint Endpoint, r *http.Request) time.Duration {
+	if policy, implemented := policyEndpoint(endpoint).(TimeoutPolicy); implemented {
+		return policy.Timeout()
+	}
+	return getMux(r).Timeout
+}
+
+/*
+serveWithTimeout serves r with handler, with a deadline of timeout if it's
+positive.
+
+The handler runs in its own goroutine, so that the response can be written
+when the deadline is exceeded even if the handler ignores the context of the
+request. The writes of the handler are then discarded, and the panics it
+raises are propagated to the mux.
+*/
+func serveWithTimeout(handler http.Handler, timeout time.Duration, w http.ResponseWriter, r *http.Request) {
+	if timeout <= 0 {
+		handler.ServeHTTP(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	defer cancel()
+	copied := r.WithContext(ctx)
+	shareVars(r, copied)
+	defer delVars(copied)
+
+	tw := newTimeoutWriter(w)
+	done := make(chan struct{})
+	panicked := make(chan interface{}, 1)
+	go func() {
+		defer func() {
+			if p := recover(); p != nil {
+				panicked <- p
+			}
+		}()
+		handler.ServeHTTP(tw, copied)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		tw.trailers()
+	case p := <-panicked:
+		panic(p)
+	case <-ctx.Done():
+		if !tw.timeout() {
+			writeError(ctx.Err(), w, r)
+		}
+	}
+}
+
+/*
+timeoutWriter guards the ResponseWriter of a request served with a deadline.
+The handler writes its headers in a copy of the header of the response, which
+replaces it when the response is committed, and whose values set afterwards,
+such as trailers, are copied once the handler returns. Once the deadline is
+exceeded, the writes of the handler are discarded.
+*/
+type timeoutWriter struct {
+	w           http.ResponseWriter
+	mu          sync.Mutex
+	header      http.Header
+	wroteHeader bool
+	timedOut    bool
+}
+
+func newTimeoutWriter(w http.ResponseWriter) *timeoutWriter {
+	header := make(http.Header, len(w.Header()))
+	for key, values := range w.Header() {
+		header[key] = append([]string(nil), values...)
+	}
+	return &timeoutWriter{w: w, header: header}
+}
+
+func (tw *timeoutWriter) Header() http.Header {
+	return tw.header
+}
+
+func (tw *timeoutWriter) WriteHeader(code int) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	tw.writeHeader(code)
+}
+
+func (tw *timeoutWriter) writeHeader(code int) {
+	if tw.timedOut || tw.wroteHeader {
+		return
+	}
+	tw.wroteHeader = true
+	header := tw.w.Header()
+	for key := range header {
+		delete(header, key)
+	}
+	for key, values := range tw.header {
+		header[key] = values
+	}
+	tw.w.WriteHeader(code)
+}
+
+func (tw *timeoutWriter) Write(b []byte) (int, error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.timedOut {
+		return 0, http.ErrHandlerTimeout
+	}
+	if !tw.wroteHeader {
+		tw.writeHeader(http.StatusOK)
+	}
+	return tw.w.Write(b)
+}
+
+// Flush implements the http.Flusher interface.
+func (tw *timeoutWriter) Flush() {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if flusher, ok := tw.w.(http.Flusher); ok && !tw.timedOut {
+		flusher.Flush()
+	}
+}
+
+// trailers copies the headers set by the handler after the response was
+// committed, such as its trailers, to the header of the response. It must be
+// called once the handler returned.
+func (tw *timeoutWriter) trailers() {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if !tw.wroteHeader || tw.timedOut {
+		return
+	}
+	header := tw.w.Header()
+	for key, values := range tw.header {
+		header[key] = values
+	}
+}
+
+// timeout discards the next writes of the handler. It returns true if the
+// response was already committed.
+func (tw *timeoutWriter) timeout() bool {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	tw.timedOut = true
+	return tw.wroteHeader
+}
+
+// requestTimeout is returned when an endpoint exceeds its deadline.
+func requestTimeout() *Error {
+	return NewError(
+		http.StatusServiceUnavailable,
+		"Request timed out",
+		"The request could not be served in time.",
+	)
+}
+
+// statusClientClosedRequest is the nonstandard status code used by nginx for
+// the requests whose client closed the connection.
+const statusClientClosedRequest = 499
+
+// clientGone is used to respond to requests canceled by the client. Only the
+// status code is written, for access logs and metrics, since the response will
+// never be read.
+var clientGone = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(statusClientClosedRequest)
+})
@@ -0,0 +1,140 @@
+package rst
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type slowEndpoint struct {
+	timeout time.Duration
+}
+
+func (e *slowEndpoint) Timeout() time.Duration {
+	return e.timeout
+}
+
+func (e *slowEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	if vars.Get("id") != "1" {
+		return nil, NotFound()
+	}
+	select {
+	case <-r.Context().Done():
+		return nil, r.Context().Err()
+	case <-time.After(100 * time.Millisecond):
+		return &echoResource{[]byte("done")}, nil
+	}
+}
+
+func TestTimeoutPolicy(t *testing.T) {
+	var test = func(timeout time.Duration, expected int) {
+		mux := NewMux()
+		mux.Handle("/slow/{id}", EndpointHandler(&slowEndpoint{timeout}))
+
+		r, _ := http.NewRequest(Get, "http://example.com/slow/1", nil)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != expected {
+			t.Errorf("%s: Got: %d Wanted: %d", timeout, w.Code, expected)
+		}
+	}
+
+	test(10*time.Millisecond, http.StatusServiceUnavailable)
+	test(time.Second, http.StatusOK)
+	test(0, http.StatusOK)
+}
+
+func TestClientGone(t *testing.T) {
+	mux := NewMux()
+	mux.Handle("/slow/{id}", EndpointHandler(&slowEndpoint{}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r, _ := http.NewRequest(Get, "http://example.com/slow/1", nil)
+	r = r.WithContext(ctx)
+	cancel()
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if w.Code != statusClientClosedRequest || w.Body.Len() != 0 {
+		t.Error("Got:", w.Code, w.Body.String(), "Wanted an empty 499 response")
+	}
+}
+
+func TestWrappedContextErrors(t *testing.T) {
+	var test = func(err error, expected int) {
+		mux := NewMux()
+		mux.Get("/people", func(vars RouteVars, r *http.Request) (Resource, error) {
+			return nil, fmt.Errorf("querying people: %w", err)
+		})
+		r, _ := http.NewRequest(Get, "http://example.com/people", nil)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != expected {
+			t.Errorf("%s: Got: %d Wanted: %d", err, w.Code, expected)
+		}
+	}
+
+	test(context.DeadlineExceeded, http.StatusServiceUnavailable)
+	test(context.Canceled, statusClientClosedRequest)
+}
+
+// stubbornEndpoint ignores the context of its requests.
+type stubbornEndpoint struct {
+	done chan struct{}
+}
+
+func (e *stubbornEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	defer close(e.done)
+	time.Sleep(50 * time.Millisecond)
+	return &echoResource{[]byte("late")}, nil
+}
+
+func TestMuxTimeout(t *testing.T) {
+	mux := NewMux()
+	mux.Timeout = 10 * time.Millisecond
+	stubborn := &stubbornEndpoint{make(chan struct{})}
+	mux.HandleEndpoint("/stubborn", stubborn)
+	mux.HandleEndpoint("/slow/{id}", &slowEndpoint{})
+	mux.HandleEndpoint("/patient/{id}", &slowEndpoint{time.Second})
+
+	var test = func(path string, expected int) *httptest.ResponseRecorder {
+		r, _ := http.NewRequest(Get, "http://example.com"+path, nil)
+		r.Header.Set("Accept", "application/json")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != expected {
+			t.Errorf("%s: Got: %d Wanted: %d", path, w.Code, expected)
+		}
+		return w
+	}
+
+	w := test("/stubborn", http.StatusServiceUnavailable)
+	<-stubborn.done
+	time.Sleep(10 * time.Millisecond)
+	if body := w.Body.String(); !strings.Contains(body, "timed out") || strings.Contains(body, "late") {
+		t.Errorf("Got: %q Wanted the timeout error only", body)
+	}
+
+	// A TimeoutPolicy overrides the deadline of the mux, and 0 disables it.
+	test("/slow/1", http.StatusOK)
+	test("/patient/1", http.StatusOK)
+}
+
+func TestTimeoutPanic(t *testing.T) {
+	mux := NewMux()
+	mux.Timeout = time.Second
+	mux.Get("/panic", func(vars RouteVars, r *http.Request) (Resource, error) {
+		panic("boom")
+	})
+
+	r, _ := http.NewRequest(Get, "http://example.com/panic", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("Got: %d Wanted: %d", w.Code, http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,61 @@
+package rst
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+)
+
+/*
+Upgrader is implemented by endpoints which take over the connection of
+requests asking to switch protocols, such as WebSocket handshakes.
+
+	func (e *PeopleUpdates) Upgrade(vars rst.RouteVars, w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		// ...
+	}
+
+Upgrade is called instead of the handler of the method when the request has
+an Upgrade header, and Upgrade is listed in its Connection header. The
+ResponseWriter can be hijacked with the http.Hijacker interface, and the
+request isn't subject to the timeout of the endpoint.
+
+Other requests are served as usual, so the same endpoint can expose a resource
+and a stream of its updates.
+*/
+type Upgrader interface {
+	Upgrade(vars RouteVars, w http.ResponseWriter, r *http.Request)
+}
+
+// errNotHijackable is returned when the ResponseWriter of a request doesn't
+// implement http.Hijacker.
+var errNotHijackable = errors.New("rst: the connection can't be hijacked")
+
+// isUpgrade returns true if r asks to upgrade its connection.
+func isUpgrade(r *http.Request) bool {
+	if r.Header.Get("Upgrade") == "" {
+		return false
+	}
+	for _, value := range r.Header["Connection"] {
+		for _, token := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(token), "upgrade") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hijack hijacks the connection of w, if it implements http.Hijacker.
+func hijack(w http.ResponseWriter) (net.Conn, *bufio.ReadWriter, error) {
+	if hijacker, ok := w.(http.Hijacker); ok {
+		return hijacker.Hijack()
+	}
+	return nil, nil, errNotHijackable
+}
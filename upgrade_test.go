@@ -0,0 +1,92 @@
+package rst
+
+import (
+	"bufio"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// upgradingEndpoint exposes a resource, and echoes the lines sent on upgraded
+// connections.
+type upgradingEndpoint struct{}
+
+func (e *upgradingEndpoint) Get(vars RouteVars, r *http.Request) (Resource, error) {
+	return NewEnvelope(&viewResource{vars.Get("name")}, time.Now(), "etag", 0), nil
+}
+
+func (e *upgradingEndpoint) Upgrade(vars RouteVars, w http.ResponseWriter, r *http.Request) {
+	conn, rw, err := w.(http.Hijacker).Hijack()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer conn.Close()
+
+	rw.WriteString("HTTP/1.1 101 Switching Protocols\r\nUpgrade: echo\r\nConnection: Upgrade\r\n\r\n")
+	rw.Flush()
+	line, _ := rw.ReadString('\n')
+	rw.WriteString(vars.Get("name") + ": " + line)
+	rw.Flush()
+}
+
+func TestUpgrader(t *testing.T) {
+	mux := NewMux()
+	mux.HandleEndpoint("/echo/{name}", &upgradingEndpoint{})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	// Regular requests are dispatched to the handler of their method.
+	resp, err := http.Get(server.URL + "/echo/francis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Got: %d Wanted: %d", resp.StatusCode, http.StatusOK)
+	}
+
+	conn, err := net.Dial("tcp", strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.Write([]byte("GET /echo/francis HTTP/1.1\r\nHost: example.com\r\nUpgrade: echo\r\nConnection: keep-alive, Upgrade\r\n\r\n"))
+
+	reader := bufio.NewReader(conn)
+	resp, err = http.ReadResponse(reader, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatalf("Got: %d Wanted: %d", resp.StatusCode, http.StatusSwitchingProtocols)
+	}
+
+	conn.Write([]byte("hello\n"))
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		t.Fatal(err)
+	}
+	if line != "francis: hello\n" {
+		t.Errorf("Got: %q Wanted: %q", line, "francis: hello\n")
+	}
+}
+
+func TestIsUpgrade(t *testing.T) {
+	var test = func(connection, upgrade string, expected bool) {
+		r, _ := http.NewRequest(Get, "/", nil)
+		r.Header.Set("Connection", connection)
+		r.Header.Set("Upgrade", upgrade)
+		if got := isUpgrade(r); got != expected {
+			t.Errorf("%s %s: Got: %t Wanted: %t", connection, upgrade, got, expected)
+		}
+	}
+
+	test("Upgrade", "websocket", true)
+	test("keep-alive, upgrade", "websocket", true)
+	test("keep-alive", "websocket", false)
+	test("Upgrade", "", false)
+}
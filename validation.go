@@ -0,0 +1,86 @@
+package rst
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// FieldError is an invalid field of the input of a request.
+type FieldError struct {
+	Field   string `json:"field" xml:"Name"`      // Path of the field, such as "address.city" or "tags[2]".
+	Code    string `json:"code" xml:"Code"`       // Machine-readable code of the failure, such as "required" or "too_long".
+	Message string `json:"message" xml:"Message"` // Explanation of the failure for humans.
+}
+
+/*
+ValidationError is returned by endpoints when the input of a request is
+invalid. It responds with status code 422 Unprocessable Entity, and lists the
+invalid fields in the body of the error.
+
+	func (ep *PeopleEP) Post(vars rst.RouteVars, r *http.Request) (rst.Resource, string, error) {
+		person, err := decodePerson(r)
+		if err != nil {
+			return nil, "", err
+		}
+		verr := &rst.ValidationError{}
+		if person.Name == "" {
+			verr.Add("name", "required", "The name of the person is required.")
+		}
+		if person.Age < 0 {
+			verr.Add("age", "out_of_range", "The age of the person can't be negative.")
+		}
+		if err := verr.Err(); err != nil {
+			return nil, "", err
+		}
+		...
+	}
+
+The fields are encoded in the "fields" member of the error, and in the
+"errors" member of problem documents when the error format of the mux is
+ProblemJSON.
+
+	{
+		"message": "Invalid input",
+		"description": "2 fields of the request are invalid.",
+		"fields": [
+			{"field": "name", "code": "required", "message": "The name of the person is required."},
+			{"field": "age", "code": "out_of_range", "message": "The age of the person can't be negative."}
+		]
+	}
+*/
+type ValidationError struct {
+	Fields []*FieldError
+}
+
+// Add adds an invalid field to e.
+func (e *ValidationError) Add(field, code, message string) {
+	e.Fields = append(e.Fields, &FieldError{field, code, message})
+}
+
+// Err returns e if it has invalid fields, or nil.
+func (e *ValidationError) Err() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e *ValidationError) Error() string {
+	return e.httpError().Error()
+}
+
+// httpError returns the error written in response to the request.
+func (e *ValidationError) httpError() *Error {
+	description := "A field of the request is invalid."
+	if len(e.Fields) != 1 {
+		description = fmt.Sprintf("%d fields of the request are invalid.", len(e.Fields))
+	}
+	err := NewError(http.StatusUnprocessableEntity, "Invalid input", description)
+	err.Fields = e.Fields
+	return err
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (e *ValidationError) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e.httpError().ServeHTTP(w, r)
+}
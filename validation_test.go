@@ -0,0 +1,46 @@
+package rst
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestValidationError(t *testing.T) {
+	verr := &ValidationError{}
+	if verr.Err() != nil {
+		t.Fatal("Err of a validation error without fields isn't nil")
+	}
+	verr.Add("name", "required", "The name is required.")
+	verr.Add("age", "out_of_range", "The age can't be negative.")
+
+	mux := NewMux()
+	mux.Post("/people", func(vars RouteVars, r *http.Request) (Resource, string, error) {
+		return nil, "", verr.Err()
+	})
+
+	var test = func(format ErrorFormat, contentType string, member string) {
+		mux.ErrorFormat = format
+		r, _ := http.NewRequest(Post, "http://www.example.com/people", nil)
+		r.Header.Set("Accept", "application/json")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != http.StatusUnprocessableEntity {
+			t.Fatal("Got:", w.Code, "Wanted:", http.StatusUnprocessableEntity)
+		}
+		if ct := w.Header().Get("Content-Type"); ct != contentType {
+			t.Errorf("Content-Type is %q, wanted %q", ct, contentType)
+		}
+
+		var doc map[string]json.RawMessage
+		var fields []*FieldError
+		json.Unmarshal(w.Body.Bytes(), &doc)
+		json.Unmarshal(doc[member], &fields)
+		if len(fields) != 2 || *fields[0] != *verr.Fields[0] || *fields[1] != *verr.Fields[1] {
+			t.Errorf("Body is %s, wanted the fields in %q", w.Body.String(), member)
+		}
+	}
+	test(DefaultErrorFormat, "application/json; charset=utf-8", "fields")
+	test(ProblemJSON, ProblemJSONType, "errors")
+}
@@ -0,0 +1,83 @@
+package rst
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/context"
+)
+
+const versionKey = "__rst__version"
+
+/*
+Version is a group of routes served under the path of a version of the API,
+such as /v1 or /v2.
+
+	v1 := mux.Version("v1", nil)
+	v1.HandleEndpoint("/people/{id}", &PersonEP{})
+	v1.HandleEndpoint("/companies/{id}", &CompanyEP{})
+
+	v2 := mux.Version("v2", v1)
+	v2.HandleEndpoint("/people/{id}", &PersonV2EP{})
+
+A version inherits the routes of its base: requests for /v2/companies/1,
+which matches no route of v2, are served by the route /v1/companies/{id} of v1.
+The version requested is returned by APIVersion, so that inherited endpoints
+can adapt their representations to it.
+*/
+type Version struct {
+	*Group
+	Name string   // Name of the version, such as "v2".
+	Base *Version // Version whose routes are inherited, or nil.
+}
+
+// Version returns the version of the API served under /name, inheriting the
+// routes of base, which can be nil.
+func (s *Mux) Version(name string, base *Version) *Version {
+	v := &Version{Group: s.Group("/" + strings.Trim(name, "/")), Name: name, Base: base}
+	s.versionsMu.Lock()
+	defer s.versionsMu.Unlock()
+	s.versions = append(s.versions, v)
+	return v
+}
+
+// APIVersion returns the name of the version of the API requested by r, or an
+// empty string if its path isn't under a version of the mux.
+func APIVersion(r *http.Request) string {
+	if v := context.Get(r, versionKey); v != nil {
+		return v.(string)
+	}
+	return ""
+}
+
+// contains returns true if path is under the prefix of v.
+func (v *Version) contains(path string) bool {
+	return path == v.prefix || strings.HasPrefix(path, v.prefix+"/")
+}
+
+// versionOf returns the version of the API under which path is, or nil.
+func (s *Mux) versionOf(path string) *Version {
+	s.versionsMu.RLock()
+	defer s.versionsMu.RUnlock()
+	for _, v := range s.versions {
+		if v.contains(path) {
+			return v
+		}
+	}
+	return nil
+}
+
+// matchVersion returns the route inherited by version v matching r, or nil.
+func (s *Mux) matchVersion(v *Version, r *http.Request) *routeMatch {
+	rest := strings.TrimPrefix(r.URL.Path, v.prefix)
+	for base := v.Base; base != nil; base = base.Base {
+		u := *r.URL
+		u.Path, u.RawPath = base.prefix+rest, ""
+		alt := *r
+		alt.URL = &u
+		if match := s.match(&alt); match != nil {
+			return match
+		}
+	}
+	return nil
+}
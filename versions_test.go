@@ -0,0 +1,44 @@
+package rst
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestVersion(t *testing.T) {
+	mux := NewMux()
+	var echo = func(name string) GetFunc {
+		return func(vars RouteVars, r *http.Request) (Resource, error) {
+			return &echoResource{[]byte(name + " " + APIVersion(r) + " " + vars.Get("id"))}, nil
+		}
+	}
+	v1 := mux.Version("v1", nil)
+	v1.Get("/people/{id}", echo("people1"))
+	v1.Get("/companies/{id}", echo("companies1"))
+	v2 := mux.Version("v2", v1)
+	v2.Get("/people/{id}", echo("people2"))
+	v3 := mux.Version("v3", v2)
+	v3.Get("/offices/{id}", echo("offices3"))
+	mux.Get("/status", echo("status"))
+
+	var test = func(path string, code int, body string) {
+		r, _ := http.NewRequest(Get, "http://www.example.com"+path, nil)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != code {
+			t.Fatalf("%s: Got: %d Wanted: %d", path, w.Code, code)
+		}
+		if body != "" && w.Body.String() != body {
+			t.Errorf("%s: Got: %q Wanted: %q", path, w.Body.String(), body)
+		}
+	}
+	test("/v1/people/1", http.StatusOK, "people1 v1 1")
+	test("/v2/people/1", http.StatusOK, "people2 v2 1")
+	test("/v2/companies/2", http.StatusOK, "companies1 v2 2")
+	test("/v3/people/3", http.StatusOK, "people2 v3 3")
+	test("/v3/companies/3", http.StatusOK, "companies1 v3 3")
+	test("/v1/offices/1", http.StatusNotFound, "")
+	test("/v2x/people/1", http.StatusNotFound, "")
+	test("/status", http.StatusOK, "status  ")
+}
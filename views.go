@@ -0,0 +1,59 @@
+package rst
+
+import (
+	"html/template"
+	"reflect"
+	"sync"
+)
+
+const htmlContentType = "text/html"
+
+var (
+	viewsMu sync.RWMutex
+	views   = make(map[reflect.Type]*template.Template)
+)
+
+/*
+RegisterView associates an html/template with the type of resource.
+
+When the Accept header of a request negotiates text/html, MarshalResource will
+execute the view registered for the type of the resource with the resource as
+data. Resources whose type has no registered view, or requests negotiating
+another media type, are encoded as usual.
+
+	var personView = template.Must(template.New("person").Parse(`<h1>{{.Firstname}} {{.Lastname}}</h1>`))
+
+	func init() {
+		rst.RegisterView(&Person{}, personView)
+	}
+
+A nil template removes the view registered for the type of resource.
+*/
+func RegisterView(resource interface{}, t *template.Template) {
+	viewsMu.Lock()
+	defer viewsMu.Unlock()
+
+	rt := reflect.TypeOf(resource)
+	if t == nil {
+		delete(views, rt)
+		return
+	}
+	views[rt] = t
+}
+
+// lookupView returns the template registered for the type of resource, or nil.
+func lookupView(resource interface{}) *template.Template {
+	viewsMu.RLock()
+	defer viewsMu.RUnlock()
+	return views[reflect.TypeOf(resource)]
+}
+
+// marshalView executes t with resource as data.
+func marshalView(t *template.Template, resource interface{}) ([]byte, error) {
+	buffer := getBuffer()
+	if err := t.Execute(buffer, resource); err != nil {
+		putBuffer(buffer)
+		return nil, err
+	}
+	return detachBuffer(buffer), nil
+}
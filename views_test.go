@@ -0,0 +1,45 @@
+package rst
+
+import (
+	"html/template"
+	"net/http"
+	"testing"
+)
+
+type viewResource struct {
+	Name string
+}
+
+var testView = template.Must(template.New("view").Parse(`<h1>{{.Name}}</h1>`))
+
+func TestMarshalView(t *testing.T) {
+	RegisterView(&viewResource{}, testView)
+	defer RegisterView(&viewResource{}, nil)
+
+	var test = func(accept, contentType, body string) {
+		r, _ := http.NewRequest(Get, "http://www.example.com", nil)
+		r.Header.Set("Accept", accept)
+		ct, b, err := MarshalResource(&viewResource{"<Francis>"}, r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ct != contentType {
+			t.Errorf("%s: Got: %s Wanted: %s", accept, ct, contentType)
+		}
+		if string(b) != body {
+			t.Errorf("%s: Got: %s Wanted: %s", accept, string(b), body)
+		}
+	}
+
+	test("text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8", "text/html; charset=utf-8", "<h1>&lt;Francis&gt;</h1>")
+	test("application/json", "application/json; charset=utf-8", `{"Name":"\u003cFrancis\u003e"}`)
+	test("*/*", "application/json; charset=utf-8", `{"Name":"\u003cFrancis\u003e"}`)
+}
+
+func TestMarshalNoView(t *testing.T) {
+	r, _ := http.NewRequest(Get, "http://www.example.com", nil)
+	r.Header.Set("Accept", "text/html")
+	if _, _, err := MarshalResource(&viewResource{"Francis"}, r); err == nil {
+		t.Fatal("expected a NotAcceptable error for a resource without a view")
+	}
+}